@@ -8,7 +8,6 @@ package axon
 
 import (
 	// "fmt"
-	"cogentcore.org/core/gpu"
 	"cogentcore.org/core/math32"
 	"cogentcore.org/lab/tensor"
 	"github.com/emer/axon/v2/fsfffb"
@@ -159,6 +158,8 @@ func (ly *LayerParams) GatherSpikesInit(ctx *Context, ni, di uint32) {
 	Neurons.Set(0.0, int(ni), int(di), int(CtxtGeRaw))
 	Neurons.Set(NeuronAvgs.Value(int(ni), int(GeBase)), int(ni), int(di), int(GeSyn))
 	Neurons.Set(NeuronAvgs.Value(int(ni), int(GiBase)), int(ni), int(di), int(GiSyn))
+	Neurons.Set(0.0, int(ni), int(di), int(GiDendSyn))
+	ly.DendGatherSpikesInit(ctx, ni, di)
 }
 
 // GiFromSpikes gets the Spike, GeRaw and GeExt from neurons in the pools
@@ -172,7 +173,7 @@ func (ly *LayerParams) GatherSpikesInit(ctx *Context, ni, di uint32) {
 func (ly *LayerParams) GiFromSpikes(ctx *Context, ni, di uint32) {
 	pi := ly.PoolIndex(NeuronIxs.Value(int(ni), int(NrnSubPool)))
 	spk := Neurons.Value(int(ni), int(di), int(Spike))
-	geRaw := Neurons.Value(int(ni), int(di), int(GeRaw))
+	geRaw := Neurons.Value(int(ni), int(di), int(GeRaw)) + ly.DendGeRawSum(ni, di)
 	geExt := Neurons.Value(int(ni), int(di), int(GeExt))
 	PoolInhibRawIncrInt(pi, di, spk, geRaw, geExt)
 	PoolAvgMaxUpdate(pi, di, ni)
@@ -374,6 +375,14 @@ func (ly *LayerParams) SpecialPreGs(ctx *Context, pi, ni, di uint32, drvGe float
 		geRaw := ly.RWDa.GeFromDA(GlobalScalars.Value(int(GvVtaDA), int(di)))
 		Neurons.Set(geRaw, int(ni), int(di), int(GeRaw))
 		Neurons.Set(ly.Acts.Dt.GeSynFromRawSteady(geRaw), int(ni), int(di), int(GeSyn))
+	case LCLayer:
+		geRaw := 0.4 * GlobalScalars.Value(int(GvNE), int(di))
+		Neurons.Set(geRaw, int(ni), int(di), int(GeRaw))
+		Neurons.Set(ly.Acts.Dt.GeSynFromRawSteady(geRaw), int(ni), int(di), int(GeSyn))
+	case DRNLayer:
+		geRaw := 0.4 * GlobalScalars.Value(int(GvSer), int(di))
+		Neurons.Set(geRaw, int(ni), int(di), int(GeRaw))
+		Neurons.Set(ly.Acts.Dt.GeSynFromRawSteady(geRaw), int(ni), int(di), int(GeSyn))
 
 	case RewLayer:
 		NeuronSetFlag(NeuronHasExt, ni, di)
@@ -484,9 +493,13 @@ func (ly *LayerParams) GFromRawSyn(ctx *Context, ni, di uint32) {
 	ly.Acts.MaintNMDAFromRaw(ctx, ni, di) // uses GMaintRaw directly
 	ly.Learn.LearnNMDAFromRaw(ctx, ni, di, geRaw)
 	ly.Acts.GvgccFromVm(ctx, ni, di)
-	ege := Neurons.Value(int(ni), int(di), int(Gnmda)) + Neurons.Value(int(ni), int(di), int(GnmdaMaint)) + Neurons.Value(int(ni), int(di), int(Gvgcc)) + extraSyn
+	ly.Acts.GcatFromVm(ctx, ni, di)
+	ly.Acts.GnapFromVm(ctx, ni, di)
+	ly.DendGFromRaw(ctx, ni, di)
+	ege := Neurons.Value(int(ni), int(di), int(Gnmda)) + Neurons.Value(int(ni), int(di), int(GnmdaMaint)) + Neurons.Value(int(ni), int(di), int(Gvgcc)) + Neurons.Value(int(ni), int(di), int(Gcat)) + Neurons.Value(int(ni), int(di), int(Gnap)) + extraSyn
 	ly.Acts.GeFromSyn(ctx, ni, di, geSyn, ege) // sets nrn.GeExt too
 	ly.Acts.GkFromVm(ctx, ni, di)
+	ly.Acts.GhFromVm(ctx, ni, di)
 	ly.Acts.GSkCaFromCa(ctx, ni, di)
 	Neurons.Set(ly.Acts.GiFromSyn(ctx, ni, di, Neurons.Value(int(ni), int(di), int(GiSyn))), int(ni), int(di), int(GiSyn))
 }
@@ -495,8 +508,13 @@ func (ly *LayerParams) GFromRawSyn(ctx *Context, ni, di uint32) {
 // and updates GABAB as well
 func (ly *LayerParams) GiInteg(ctx *Context, pi, ni, di uint32) {
 	giMult := LayerStates.Value(int(ly.Index), int(di), int(LayerGiMult))
-	gi := giMult*Pools.Value(int(pi), int(di), int(fsfffb.TotalGi)) + Neurons.Value(int(ni), int(di), int(GiSyn)) + Neurons.Value(int(ni), int(di), int(GiNoise)) + ly.Learn.NeuroMod.GiFromACh(GlobalScalars.Value(int(GvACh), int(di)))
+	poolGi := giMult * Pools.Value(int(pi), int(di), int(fsfffb.TotalGi))
 	ssgi := Pools.Value(int(pi), int(di), int(fsfffb.SSGi))
+	if ly.Inhib.Interneurons.IsTrue() { // FS-FFFB is only computed for comparison
+		poolGi = 0
+		ssgi = 0
+	}
+	gi := poolGi + Neurons.Value(int(ni), int(di), int(GiSyn)) + Neurons.Value(int(ni), int(di), int(GiNoise)) + ly.Learn.NeuroMod.GiFromACh(GlobalScalars.Value(int(GvACh), int(di)))
 	Neurons.Set(gi, int(ni), int(di), int(Gi))
 	Neurons.Set(0.0, int(ni), int(di), int(SSGiDend))
 	if ctx.PlusPhase.IsTrue() && (ly.Type == PulvinarLayer) {
@@ -507,6 +525,7 @@ func (ly *LayerParams) GiInteg(ctx *Context, pi, ni, di uint32) {
 			Neurons.Set(ly.Acts.Dend.SSGi*ssgi, int(ni), int(di), int(SSGiDend))
 		}
 	}
+	Neurons.SetAdd(Neurons.Value(int(ni), int(di), int(GiDendSyn)), int(ni), int(di), int(SSGiDend))
 	vm := Neurons.Value(int(ni), int(di), int(VmDend))
 	nrnGababM := Neurons.Value(int(ni), int(di), int(GababM))
 	nrnGababX := Neurons.Value(int(ni), int(di), int(GababX))
@@ -519,11 +538,13 @@ func (ly *LayerParams) GiInteg(ctx *Context, pi, ni, di uint32) {
 	Neurons.SetAdd(nrnGgabaB, int(ni), int(di), int(Gk))
 }
 
-// GNeuroMod does neuromodulation of conductances
+// GNeuroMod does neuromodulation of conductances, by DA and NE.
 func (ly *LayerParams) GNeuroMod(ctx *Context, ni, di uint32) {
 	ggain := ly.Learn.NeuroMod.GGain(GlobalScalars.Value(int(GvDA), int(di)) + GlobalScalars.Value(int(GvDAtonic), int(di)))
-	Neurons.SetMul(ggain, int(ni), int(di), int(Ge))
+	ne := GlobalScalars.Value(int(GvNE), int(di))
+	Neurons.SetMul(ggain*ly.Learn.NeuroMod.NEGeGain(ne), int(ni), int(di), int(Ge))
 	Neurons.SetMul(ggain, int(ni), int(di), int(Gi))
+	Neurons.SetAdd(ly.Learn.NeuroMod.NENoiseGain(ne)*Neurons.Value(int(ni), int(di), int(GeNoise)), int(ni), int(di), int(Ge))
 }
 
 ////////  SendSpike
@@ -531,6 +552,8 @@ func (ly *LayerParams) GNeuroMod(ctx *Context, ni, di uint32) {
 // SpikeFromG computes Vm from Ge, Gi, Gl conductances and then Spike from that
 func (ly *LayerParams) SpikeFromG(ctx *Context, lpi, ni, di uint32) {
 	ly.Acts.VmFromG(ctx, ni, di)
+	ly.DendVmFromG(ctx, ni, di)
+	ly.GapJunctions(ctx, ni, di)
 	ly.Acts.SpikeFromVm(ctx, ni, di)
 	ly.Learn.CaFromSpike(ctx, ni, di)
 	if !ly.IsNuclear() {
@@ -559,14 +582,56 @@ func (ly *LayerParams) SpikeFromG(ctx *Context, lpi, ni, di uint32) {
 	}
 }
 
-// SendSpike sends spike to receivers for all neurons that spiked
-// last step in Cycle, integrated the next time around.
+// GapJunctionsI computes the total gap junction current Igap from all
+// [GapJunctionPath] pathways into given neuron, using the GapVm values
+// from the end of the prior cycle. Called by [GapJunctionsNeuron].
+func (ly *LayerParams) GapJunctionsI(ctx *Context, ni, di uint32) {
+	lni := ni - ly.Indexes.NeurSt
+	igap := float32(0)
+	for pti := uint32(0); pti < ly.Indexes.RecvN; pti++ {
+		npti := RecvPathIxs.Value1D(int(ly.Indexes.RecvSt + pti))
+		pt := GetPaths(npti)
+		if pt.Type == GapJunctionPath {
+			igap += pt.GapJunctionI(ctx, ni, di, lni)
+		}
+	}
+	Neurons.Set(igap, int(ni), int(di), int(Igap))
+}
+
+// GapJunctions applies the gap junction current Igap computed by
+// GapJunctionsI to Vm, outside of the refractory period, limited to
+// Dt.MaxI as for the other currents. Called after VmFromG.
+func (ly *LayerParams) GapJunctions(ctx *Context, ni, di uint32) {
+	igap := Neurons.Value(int(ni), int(di), int(Igap))
+	if igap == 0 {
+		return
+	}
+	isi := Neurons.Value(int(ni), int(di), int(ISI))
+	if ly.Acts.Spikes.Tr > 0 && isi >= 0 && isi < float32(ly.Acts.Spikes.Tr) {
+		return // refractory
+	}
+	if igap > ly.Acts.Dt.MaxI {
+		igap = ly.Acts.Dt.MaxI
+	} else if igap < -ly.Acts.Dt.MaxI {
+		igap = -ly.Acts.Dt.MaxI
+	}
+	Neurons.Set(ly.Acts.VmFromInet(Neurons.Value(int(ni), int(di), int(Vm)), ly.Acts.Dt.VmDt, igap), int(ni), int(di), int(Vm))
+}
+
+// PostSpikeNeuron calls PostSpike for given neuron, prior to SendSpike.
 // Called directly by Network, iterates over data.
-func (ly *LayerParams) SendSpike(ctx *Context, ni, di uint32) {
+func (ly *LayerParams) PostSpikeNeuron(ctx *Context, ni, di uint32) {
 	pi := ly.PoolIndex(NeuronIxs.Value(int(ni), int(NrnSubPool)))
 	lpi := ly.PoolIndex(0)
-	lni := ni - ly.Indexes.NeurSt
 	ly.PostSpike(ctx, lpi, pi, ni, di)
+}
+
+// SendSpike sends spike to receivers for all neurons that spiked
+// last step in Cycle, integrated the next time around.
+// PostSpikeNeuron must be called first. Called directly by Network,
+// iterates over data.
+func (ly *LayerParams) SendSpike(ctx *Context, ni, di uint32) {
+	lni := ni - ly.Indexes.NeurSt
 
 	for pti := uint32(0); pti < ly.Indexes.SendN; pti++ {
 		pt := GetPaths(ly.Indexes.SendSt + pti)
@@ -675,6 +740,12 @@ func (ly *LayerParams) PostSpikeSpecial(ctx *Context, lpi, pi, ni, di uint32) {
 	case VTALayer:
 		// I set this in CyclePost
 		Neurons.Set(GlobalScalars.Value(int(GvVtaDA), int(di)), int(ni), int(di), int(Act))
+	case LCLayer:
+		// I set this in CyclePost
+		Neurons.Set(GlobalScalars.Value(int(GvNE), int(di)), int(ni), int(di), int(Act))
+	case DRNLayer:
+		// I set this in CyclePost
+		Neurons.Set(GlobalScalars.Value(int(GvSer), int(di)), int(ni), int(di), int(Act))
 
 	case RewLayer:
 		Neurons.Set(GlobalScalars.Value(int(GvRew), int(di)), int(ni), int(di), int(Act))
@@ -746,6 +817,7 @@ func (ly *LayerParams) RLRate(ctx *Context, lpi, pi, ni, di uint32) {
 	default:
 		dlr = ly.Learn.RLRate.RLRateDiff(nrnCaP, nrnCaD)
 	}
+	modlr *= ly.Learn.NeuroMod.NESerLRMod(GlobalScalars.Value(int(GvNE), int(di)), GlobalScalars.Value(int(GvSer), int(di)))
 	Neurons.Set(mlr*dlr*modlr, int(ni), int(di), int(RLRate))
 }
 
@@ -754,6 +826,7 @@ func (ly *LayerParams) RLRate(ctx *Context, lpi, pi, ni, di uint32) {
 func (ly *LayerParams) PostSpike(ctx *Context, lpi, pi, ni, di uint32) {
 	ly.PostSpikeSpecial(ctx, lpi, pi, ni, di)
 	ly.RLRate(ctx, lpi, pi, ni, di)
+	Neurons.Set(Neurons.Value(int(ni), int(di), int(Vm)), int(ni), int(di), int(GapVm))
 	intdt := ly.Acts.Dt.IntDt
 	Neurons.SetAdd(intdt*(Neurons.Value(int(ni), int(di), int(Ge))-Neurons.Value(int(ni), int(di), int(GeInt))), int(ni), int(di), int(GeInt))
 	Neurons.SetAdd(intdt*(Neurons.Value(int(ni), int(di), int(GiSyn))-Neurons.Value(int(ni), int(di), int(GiInt))), int(ni), int(di), int(GiInt))
@@ -767,6 +840,9 @@ func (ly *LayerParams) PostSpike(ctx *Context, lpi, pi, ni, di uint32) {
 		NeuronTraceIncrement(Neurons.Value(int(ni), int(di), int(CaSyn)), CaSynTrace, ctx.CyclesTotal, ni, di)
 		ly.Learn.Timing.LearnRecvTrace(ctx, ni, di)
 	}
+	if Neurons.Value(int(ni), int(di), int(Spike)) > 0 {
+		STDPSpikeRecord(ctx, ni, di)
+	}
 }
 
 // CyclePost is called after the standard Cycle update, as a separate
@@ -807,6 +883,10 @@ func (ly *LayerParams) CyclePost(ctx *Context, di uint32) {
 		ly.CyclePostLDTLayer(ctx, di, srcLay1Act, srcLay2Act, srcLay3Act, srcLay4Act)
 	case VTALayer:
 		ly.CyclePostVTALayer(ctx, di)
+	case LCLayer:
+		ly.CyclePostLCLayer(ctx, di)
+	case DRNLayer:
+		ly.CyclePostDRNLayer(ctx, di)
 	case RWDaLayer:
 		ly.CyclePostRWDaLayer(ctx, di)
 	case TDPredLayer:
@@ -859,6 +939,28 @@ func (ly *LayerParams) CyclePostLDTLayer(ctx *Context, di uint32, srcLay1Act, sr
 	}
 }
 
+// CyclePostLCLayer computes the NE norepinephrine global value from the LC.
+// NE goes up instantly and decays with the Acts.Dt.IntDt rate.
+func (ly *LayerParams) CyclePostLCLayer(ctx *Context, di uint32) {
+	ne := ly.LC.NE(di)
+	if ne > GlobalScalars.Value(int(GvNE), int(di)) { // instant up
+		GlobalScalars.Set(ne, int(GvNE), int(di))
+	} else {
+		GlobalScalars.SetAdd(ly.Acts.Dt.IntDt*(ne-GlobalScalars.Value(int(GvNE), int(di))), int(GvNE), int(di))
+	}
+}
+
+// CyclePostDRNLayer computes the Ser serotonin global value from the DRN.
+// Ser goes up instantly and decays with the Acts.Dt.IntDt rate.
+func (ly *LayerParams) CyclePostDRNLayer(ctx *Context, di uint32) {
+	ser := ly.DRN.Ser(di)
+	if ser > GlobalScalars.Value(int(GvSer), int(di)) { // instant up
+		GlobalScalars.Set(ser, int(GvSer), int(di))
+	} else {
+		GlobalScalars.SetAdd(ly.Acts.Dt.IntDt*(ser-GlobalScalars.Value(int(GvSer), int(di))), int(GvSer), int(di))
+	}
+}
+
 func (ly *LayerParams) CyclePostRWDaLayer(ctx *Context, di uint32) {
 	pli := uint32(ly.RWDa.RWPredLayIndex)
 	pred := LayerStates.Value(int(pli), int(di), int(LayerRewPredPos)) - LayerStates.Value(int(pli), int(di), int(LayerRewPredNeg))
@@ -944,6 +1046,7 @@ func (ly *LayerParams) DecayStateNeuronsAll(ctx *Context, decay, glong, ahp floa
 		}
 		for di := uint32(0); di < ctx.NData; di++ {
 			ly.Acts.DecayState(ctx, ni, di, decay, glong, ahp)
+			ly.DendDecayState(ctx, ni, di, decay, glong)
 		}
 	}
 }
@@ -1015,11 +1118,13 @@ func (ly *LayerParams) NewStateNeuron(ctx *Context, ni, di uint32) {
 	Neurons.Set(0.0, int(ni), int(di), int(CaPMax))
 	Neurons.Set(0.0, int(ni), int(di), int(CaPMaxCa))
 	ly.Acts.DecayState(ctx, ni, di, ly.Acts.Decay.Act, ly.Acts.Decay.Glong, ly.Acts.Decay.AHP)
+	ly.DendDecayState(ctx, ni, di, ly.Acts.Decay.Act, ly.Acts.Decay.Glong)
 	// Note: synapse-level Ca decay happens in DWt
 	ly.Acts.KNaNewState(ctx, ni, di)
 	if ly.IsNuclear() {
 		ly.NuclearLearnReset(ctx, ni, di)
 	}
+	STDPSpikesReset(ni, di)
 }
 
 // Beta1Neuron does neuron level Beta1 updating.
@@ -1204,7 +1309,7 @@ func (ly *Layer) InitExt() {
 // Also sets the Exts values on layer, which are used for the GPU version,
 // which requires calling the network ApplyExts() method -- is a no-op for CPU.
 func (ly *Layer) ApplyExtAll(ctx *Context, ext tensor.Values) {
-	gpu.VectorizeFunc(0, int(ctx.NData), func(idx uint32) {
+	VectorizeFunc("ApplyExtAll", int(ctx.NData), func(idx uint32) {
 		ed := ext.SubSpace(int(idx))
 		ly.ApplyExt(idx, ed)
 	})
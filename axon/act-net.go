@@ -14,20 +14,27 @@ import (
 // If getNeurons is true, then neuron state is synced back
 // from the GPU (for cycle-level display etc). Otherwise, nothing is.
 func (nt *Network) Cycle(getNeurons bool) {
+	if nt.Trace.On {
+		nt.Trace.Begin(TraceFunc, "Cycle", "", 0)
+		defer nt.Trace.EndName("Cycle")
+	}
 	nix := nt.NetIxs()
 	ctx := nt.Context()
 	nd := int(nix.NNeurons * ctx.NData)
 	ld := int(nix.NLayers * ctx.NData)
 	pd := int(nix.NPools * ctx.NData)
 
-	RunGatherSpikes(nd)
-	RunLayerGi(ld)
-	RunBetweenGi(ld)
-	RunPoolGi(pd)
-	RunCycleNeuron(nd)
-	RunSendSpike(nd)
-	RunCyclePost(ld)
-	RunCycleInc(1)
+	RunKernel("GatherSpikes", nd, RunGatherSpikesGPU, GatherSpikes)
+	RunKernel("LayerGi", ld, RunLayerGiGPU, LayerGi)
+	RunKernel("BetweenGi", ld, RunBetweenGiGPU, BetweenGi)
+	RunKernel("PoolGi", pd, RunPoolGiGPU, PoolGi)
+	if nt.gapJunctionsOn() {
+		RunKernel("GapJunctionsNeuron", nd, RunGapJunctionsNeuronGPU, GapJunctionsNeuron)
+	}
+	RunKernel("CycleNeuron", nd, RunCycleNeuronGPU, CycleNeuron)
+	nt.SendSpikes(nd)
+	RunKernel("CyclePost", ld, RunCyclePostGPU, CyclePost)
+	RunKernel("CycleInc", 1, RunCycleIncGPU, CycleInc)
 
 	if getNeurons {
 		RunDoneLayersNeurons()
@@ -36,6 +43,17 @@ func (nt *Network) Cycle(getNeurons bool) {
 	}
 }
 
+// gapJunctionsOn returns true if the network has any [GapJunctionPath]
+// pathways, for which the [GapJunctionsNeuron] kernel must be run.
+func (nt *Network) gapJunctionsOn() bool {
+	for _, pt := range nt.Paths {
+		if !pt.Off && pt.Type == GapJunctionPath {
+			return true
+		}
+	}
+	return false
+}
+
 // ThetaCycleStart starts a new theta cycle, resetting the ctx.Cycle counter
 // and all phase state information in the context.
 // The current Context.NData should be set properly prior to calling this
@@ -49,13 +67,17 @@ func (nt *Network) ThetaCycleStart(mode enums.Enum, testing bool) {
 // MinusPhaseStart should be called at the start of a new minus phase,
 // handling all initialization prior to applying a new input pattern.
 func (nt *Network) MinusPhaseStart() {
+	if nt.Trace.On {
+		nt.Trace.Begin(TraceFunc, "MinusPhaseStart", "", 0)
+		defer nt.Trace.EndName("MinusPhaseStart")
+	}
 	nix := nt.NetIxs()
 	ctx := nt.Context()
 	nd := int(nix.NNeurons * ctx.NData)
 	ctx.MinusPhaseStart()
 	ToGPUCtxGlobal()
-	RunNewStateLayer(int(nix.NLayers))
-	RunNewStateNeuron(nd)
+	RunKernel("NewStateLayer", int(nix.NLayers), RunNewStateLayerGPU, NewStateLayer)
+	RunKernel("NewStateNeuron", nd, RunNewStateNeuronGPU, NewStateNeuron)
 }
 
 // InitExt initializes external input state.
@@ -83,7 +105,7 @@ func (nt *Network) ApplyExts() {
 	nix := nt.NetIxs()
 	ctx := nt.Context()
 	nd := int(nix.NNeurons * ctx.NData)
-	RunApplyExtsNeuron(nd)
+	RunKernel("ApplyExtsNeuron", nd, RunApplyExtsNeuronGPU, ApplyExtsNeuron)
 }
 
 // ClearInputs clears the external input to the network,
@@ -98,7 +120,7 @@ func (nt *Network) Beta1() {
 	nix := nt.NetIxs()
 	ctx := nt.Context()
 	nd := int(nix.NNeurons * ctx.NData)
-	RunBeta1Neuron(nd)
+	RunKernel("Beta1Neuron", nd, RunBeta1NeuronGPU, Beta1Neuron)
 }
 
 // Beta2 does updating at Beta1 timescale.
@@ -106,42 +128,54 @@ func (nt *Network) Beta2() {
 	nix := nt.NetIxs()
 	ctx := nt.Context()
 	nd := int(nix.NNeurons * ctx.NData)
-	RunBeta2Neuron(nd)
+	RunKernel("Beta2Neuron", nd, RunBeta2NeuronGPU, Beta2Neuron)
 }
 
 // MinusPhaseEnd does updating after end of minus phase.
 func (nt *Network) MinusPhaseEnd() {
+	if nt.Trace.On {
+		nt.Trace.Begin(TraceFunc, "MinusPhaseEnd", "", 0)
+		defer nt.Trace.EndName("MinusPhaseEnd")
+	}
 	nix := nt.NetIxs()
 	ctx := nt.Context()
 	nd := int(nix.NNeurons * ctx.NData)
-	RunMinusPhasePool(int(nix.NPools))
-	RunMinusPhaseNeuron(nd)
-	RunMinusPhasePost(int(nix.NLayers))
+	RunKernel("MinusPhasePool", int(nix.NPools), RunMinusPhasePoolGPU, MinusPhasePool)
+	RunKernel("MinusPhaseNeuron", nd, RunMinusPhaseNeuronGPU, MinusPhaseNeuron)
+	RunKernel("MinusPhasePost", int(nix.NLayers), RunMinusPhasePostGPU, MinusPhasePost)
 	RunDoneLayersNeurons() // this is critical for action-taking models to have the minus phase state
 }
 
 // PlusPhaseStart does updating at the start of the plus phase:
 // applies Target inputs as External inputs.
 func (nt *Network) PlusPhaseStart() {
+	if nt.Trace.On {
+		nt.Trace.Begin(TraceFunc, "PlusPhaseStart", "", 0)
+		defer nt.Trace.EndName("PlusPhaseStart")
+	}
 	nix := nt.NetIxs()
 	ctx := nt.Context()
 	nd := int(nix.NNeurons * ctx.NData)
 
-	RunPlusPhaseStartContext(1)
-	RunPlusPhaseStartNeuron(nd)
+	RunKernel("PlusPhaseStartContext", 1, RunPlusPhaseStartContextGPU, PlusPhaseStartContext)
+	RunKernel("PlusPhaseStartNeuron", nd, RunPlusPhaseStartNeuronGPU, PlusPhaseStartNeuron)
 }
 
 // PlusPhaseEnd does updating after end of plus phase.
 // On GPU this is when we finally sync back Layers and Neurons.
 func (nt *Network) PlusPhaseEnd() {
+	if nt.Trace.On {
+		nt.Trace.Begin(TraceFunc, "PlusPhaseEnd", "", 0)
+		defer nt.Trace.EndName("PlusPhaseEnd")
+	}
 	nix := nt.NetIxs()
 	ctx := nt.Context()
 	// fmt.Println("plus start:", ctx.Cycle)
 	nd := int(nix.NNeurons * ctx.NData)
 	pd := int(nix.NPools * ctx.NData)
-	RunPlusPhaseEndPool(pd)
-	RunPlusPhaseEndNeuron(nd)
-	RunPlusPhaseEndPost(int(nix.NLayers))
+	RunKernel("PlusPhaseEndPool", pd, RunPlusPhaseEndPoolGPU, PlusPhaseEndPool)
+	RunKernel("PlusPhaseEndNeuron", nd, RunPlusPhaseEndNeuronGPU, PlusPhaseEndNeuron)
+	RunKernel("PlusPhaseEndPost", int(nix.NLayers), RunPlusPhaseEndPostGPU, PlusPhaseEndPost)
 	RunDoneLayersNeurons()
 }
 
@@ -175,7 +209,7 @@ func (nt *Network) GPUTestWrite() {
 	nix := nt.NetIxs()
 	ctx := nt.Context()
 	nd := int(nix.NNeurons * ctx.NData)
-	RunGPUTestWrite(nd)
+	RunKernel("GPUTestWrite", nd, RunGPUTestWriteGPU, GPUTestWrite)
 	RunDoneLayersNeurons()
 }
 
@@ -230,6 +264,20 @@ func PoolGi(i uint32) { //gosl:kernel
 	PoolPoolGi(ctx, pi, di)
 }
 
+// GapJunctionsNeuron is the kernel over Neurons * Data to compute
+// the gap junction current from [GapJunctionPath] pathways, prior to
+// [CycleNeuron]. It is only run if the network has such pathways.
+func GapJunctionsNeuron(i uint32) { //gosl:kernel
+	ctx := GetCtx(0)
+	ni := ctx.ItemIndex(i)
+	if ni >= NetworkIxs[0].NNeurons {
+		return
+	}
+	di := ctx.DataIndex(i)
+	li := NeuronIxs.Value(int(ni), int(NrnLayIndex))
+	Layers[li].GapJunctionsI(ctx, ni, di)
+}
+
 // CycleNeuron is the kernel over Neurons * Data to do
 // one cycle (msec) of updating at the neuron level.
 func CycleNeuron(i uint32) { //gosl:kernel
@@ -243,6 +291,19 @@ func CycleNeuron(i uint32) { //gosl:kernel
 	Layers[li].CycleNeuron(ctx, ni, di)
 }
 
+// PostSpikeNeuron is the kernel over Neurons * Data to do
+// neuron-level updates after spiking, prior to [SendSpike].
+func PostSpikeNeuron(i uint32) { //gosl:kernel
+	ctx := GetCtx(0)
+	ni := ctx.ItemIndex(i)
+	if ni >= NetworkIxs[0].NNeurons {
+		return
+	}
+	di := ctx.DataIndex(i)
+	li := NeuronIxs.Value(int(ni), int(NrnLayIndex))
+	Layers[li].PostSpikeNeuron(ctx, ni, di)
+}
+
 // SendSpike is the kernel over Neurons * Data to
 // send spike signal for neurons over threshold.
 func SendSpike(i uint32) { //gosl:kernel
@@ -11,6 +11,7 @@ import (
 	"sync/atomic"
 
 	"cogentcore.org/core/math32"
+	"cogentcore.org/lab/gosl/slbool"
 )
 
 //gosl:start
@@ -41,6 +42,11 @@ const (
 	// Context pathways are for inputs to CT layers, which update
 	// only at the end of the plus phase, and send to CtxtGe.
 	ContextG
+
+	// DendInhibitoryG pathways drive GABA-A inhibition on the dendrite
+	// (VmDend) instead of the soma, as from SST+ interneurons.
+	// Send to the GiDendSyn neuron variable, which is added to SSGiDend.
+	DendInhibitoryG
 )
 
 //////////////////////////////////////////////////////////////////////////////////////
@@ -159,6 +165,160 @@ func (sc *SynComParams) FloatFromGBuf(ival int32) float32 {
 	return float32(ival) / sc.FloatToIntFactor()
 }
 
+////////  STPParams
+
+// STPVars are the short-term plasticity state variables in [PathSTP],
+// for each sending neuron in each pathway, for each data parallel index.
+type STPVars int32 //enums:enum
+
+const (
+	// STPUtil is the current utilization, i.e., the probability of release
+	// of the available synaptic resources, which is incremented at each spike
+	// and decays back to 0 with [STPParams.TauF].
+	STPUtil STPVars = iota
+
+	// STPRes is the current fraction of available synaptic resources,
+	// which is decremented by the amount released at each spike,
+	// and recovers back to 1 with [STPParams.TauD].
+	STPRes
+)
+
+// STPParams are Tsodyks-Markram style short-term plasticity parameters,
+// producing synaptic depression and / or facilitation as a function of
+// the recent spiking of each sending neuron. The presynaptic state is
+// maintained in [PathSTP] for each sending neuron in the pathway:
+// at each spike, the utilization u is incremented by U * (1 - u), and
+// the fraction u * x of the available resources x is released, which
+// multiplies the spike sent to all receivers. Between spikes, u decays
+// back to 0 with TauF, and x recovers to 1 with TauD. The released
+// amount is normalized by U, so that the first spike after a long period
+// of inactivity has the same effect as without short-term plasticity.
+// With TauF = 0, u is always U and there is only depression, where the
+// steady-state efficacy for Poisson spiking at rate r is 1 / (1 + U r TauD).
+type STPParams struct {
+
+	// On enables short-term plasticity for this pathway.
+	On slbool.Bool
+
+	// U is the increment in utilization (release probability) at each spike,
+	// which is the utilization for the first spike after a period of inactivity.
+	// High values produce mostly depression, while low values with a
+	// longer TauF produce facilitation.
+	U float32 `default:"0.5" min:"0" max:"1"`
+
+	// TauD is the time constant in cycles (msec) for recovery of the
+	// synaptic resources after release, which determines the duration of depression.
+	TauD float32 `default:"200" min:"1"`
+
+	// TauF is the time constant in cycles (msec) for decay of utilization
+	// back to 0, which determines the duration of facilitation.
+	// 0 = no facilitation, with utilization always equal to U.
+	TauF float32 `default:"0" min:"0"`
+
+	// DecayD = exp(-1 / TauD) is the per-cycle factor for remaining resource depletion.
+	DecayD float32 `display:"-" json:"-" xml:"-"`
+
+	// DecayF = exp(-1 / TauF) is the per-cycle factor for utilization decay,
+	// 0 if TauF = 0.
+	DecayF float32 `display:"-" json:"-" xml:"-"`
+
+	pad, pad1 float32
+}
+
+func (sp *STPParams) Defaults() {
+	sp.U = 0.5
+	sp.TauD = 200
+	sp.TauF = 0
+	sp.Update()
+}
+
+func (sp *STPParams) Update() {
+	sp.TauD = max(sp.TauD, 1)
+	sp.DecayD = math32.Exp(-1 / sp.TauD)
+	if sp.TauF > 0 {
+		sp.DecayF = math32.Exp(-1 / sp.TauF)
+	} else {
+		sp.DecayF = 0
+	}
+}
+
+func (sp *STPParams) ShouldDisplay(field string) bool {
+	switch field {
+	case "On":
+		return true
+	default:
+		return sp.On.IsTrue()
+	}
+}
+
+// Init initializes the short-term plasticity state
+// for given sending connection index and data index.
+func (sp *STPParams) Init(cni, di uint32) {
+	PathSTP.Set(0.0, int(cni), int(di), int(STPUtil))
+	PathSTP.Set(1.0, int(cni), int(di), int(STPRes))
+}
+
+// Decay updates the short-term plasticity state over one cycle,
+// for given sending connection index and data index.
+func (sp *STPParams) Decay(cni, di uint32) {
+	PathSTP.SetMul(sp.DecayF, int(cni), int(di), int(STPUtil))
+	PathSTP.Set(1.0-(1.0-PathSTP.Value(int(cni), int(di), int(STPRes)))*sp.DecayD, int(cni), int(di), int(STPRes))
+}
+
+// Spike updates the short-term plasticity state for a spike,
+// for given sending connection index and data index, returning the
+// efficacy of the spike relative to one after a long period of inactivity.
+func (sp *STPParams) Spike(cni, di uint32) float32 {
+	u := PathSTP.Value(int(cni), int(di), int(STPUtil))
+	u += sp.U * (1.0 - u)
+	x := PathSTP.Value(int(cni), int(di), int(STPRes))
+	rel := u * x
+	PathSTP.Set(u, int(cni), int(di), int(STPUtil))
+	PathSTP.Set(x-rel, int(cni), int(di), int(STPRes))
+	return rel / sp.U
+}
+
+////////  GapJunctionParams
+
+// GapJunctionParams are parameters for the electrical coupling of neurons
+// via gap junctions in a [GapJunctionPath], which produces a current in
+// each receiving neuron of G * Wt * (Vm_s - Vm_r) for each sending neuron,
+// using the Vm values from the end of the prior cycle (GapVm).
+// Because connectivity is symmetric, the coupling current is conserved
+// between each pair of neurons, and drives them toward the same Vm.
+type GapJunctionParams struct {
+
+	// G is the conductance of each gap junction in nS, multiplied by the
+	// synaptic weight, in the same units as Gbar.L (20 nS) and Dend.GAxial.
+	// The total coupling strength increases with the number of connections.
+	G float32 `default:"2" min:"0"`
+
+	pad, pad1, pad2 float32
+}
+
+func (gp *GapJunctionParams) Defaults() {
+	gp.G = 2
+}
+
+func (gp *GapJunctionParams) Update() {
+}
+
+// GapJunctionI returns the total gap junction current into given receiving
+// neuron from all of its coupled sending neurons in this pathway.
+func (pt *PathParams) GapJunctionI(ctx *Context, ni, di, lni uint32) float32 {
+	cni := pt.Indexes.RecvConSt + lni
+	synn := PathRecvCon.Value(int(cni), int(Nitems))
+	synst := pt.Indexes.RecvSynSt + PathRecvCon.Value(int(cni), int(StartOff))
+	vm := Neurons.Value(int(ni), int(di), int(GapVm))
+	igap := float32(0)
+	for ci := uint32(0); ci < synn; ci++ {
+		syni := RecvSynIxs.Value(int(synst + ci))
+		si := SynapseIxs.Value(int(syni), int(SynSendIndex))
+		igap += Synapses.Value(int(syni), int(Wt)) * (Neurons.Value(int(si), int(di), int(GapVm)) - vm)
+	}
+	return pt.Gap.G * igap
+}
+
 ////////  PathScaleParams
 
 // PathScaleParams are pathway scaling parameters: modulates overall strength of pathway,
@@ -235,6 +395,9 @@ func (pt *PathParams) SynSendLayerIndex(syni uint32) uint32 {
 // GatherSpikes integrates G*Raw and G*Syn values for given recv neuron
 // while integrating the Recv Path-level GSyn integrated values.
 func (pt *PathParams) GatherSpikes(ctx *Context, ly *LayerParams, ni, di, lni uint32) {
+	if pt.Type == GapJunctionPath {
+		return
+	}
 	deli := pt.Com.ReadOff(ctx.CyclesTotal)
 	npti := pt.Indexes.NPathNeurSt + lni
 	gRaw := pt.Com.FloatFromGBuf(PathGBuf.Value(int(npti), int(di), int(deli)))
@@ -251,8 +414,14 @@ func (pt *PathParams) GatherSpikesGSyn(ctx *Context, ly *LayerParams, ni, di uin
 	switch pt.Com.GType {
 	case ExcitatoryG:
 		*gSyn = ly.Acts.Dt.GeSynFromRaw(*gSyn, gRaw)
-		Neurons.SetAdd(gRaw, int(ni), int(di), int(GeRaw))
-		Neurons.SetAdd(*gSyn, int(ni), int(di), int(GeSyn))
+		if pt.DendComp > 0 && uint32(pt.DendComp) <= ly.Indexes.DendN {
+			dci := ly.DendIndex(ni, uint32(pt.DendComp))
+			Dendrites.SetAdd(gRaw, int(dci), int(di), int(DendGeRaw))
+			Dendrites.SetAdd(*gSyn, int(dci), int(di), int(DendGeSyn))
+		} else {
+			Neurons.SetAdd(gRaw, int(ni), int(di), int(GeRaw))
+			Neurons.SetAdd(*gSyn, int(ni), int(di), int(GeSyn))
+		}
 	case InhibitoryG:
 		*gSyn = ly.Acts.Dt.GiSynFromRaw(*gSyn, gRaw)
 		Neurons.SetAdd(gRaw, int(ni), int(di), int(GiRaw))
@@ -267,6 +436,9 @@ func (pt *PathParams) GatherSpikesGSyn(ctx *Context, ly *LayerParams, ni, di uin
 	// note: Syn happens via NMDA in Act
 	case ContextG:
 		Neurons.SetAdd(gRaw, int(ni), int(di), int(CtxtGeRaw))
+	case DendInhibitoryG:
+		*gSyn = ly.Acts.Dt.GiSynFromRaw(*gSyn, gRaw)
+		Neurons.SetAdd(*gSyn, int(ni), int(di), int(GiDendSyn))
 	default:
 	}
 }
@@ -276,12 +448,22 @@ func (pt *PathParams) GatherSpikesGSyn(ctx *Context, ly *LayerParams, ni, di uin
 // is a ring buffer, which is used for modelling the time delay between
 // sending and receiving spikes.
 func (pt *PathParams) SendSpike(ctx *Context, ni, di, lni uint32) {
+	if pt.Type == GapJunctionPath { // no spikes: see GapJunctionI
+		return
+	}
 	sendVal := pt.GScale.Scale * pt.Com.FloatToIntFactor() // pre-bake in conversion to uint factor
 	if pt.Type == CTCtxtPath {
 		if uint32(ctx.Cycle) != uint32(ctx.ThetaCycles)-1-pt.Com.DelLen {
 			return
 		}
 		sendVal *= Neurons.Value(int(ni), int(di), int(Burst)) // Burst is regular CaP for all non-SuperLayer neurons
+	} else if pt.STP.On.IsTrue() {
+		cni := pt.Indexes.SendConSt + lni
+		pt.STP.Decay(cni, di)
+		if Neurons.Value(int(ni), int(di), int(Spike)) == 0 {
+			return
+		}
+		sendVal *= pt.STP.Spike(cni, di)
 	} else {
 		if Neurons.Value(int(ni), int(di), int(Spike)) == 0 {
 			return
@@ -302,6 +484,19 @@ func (pt *PathParams) SendSpike(ctx *Context, ni, di, lni uint32) {
 	}
 }
 
+// InitSTP initializes the short-term plasticity state for all
+// sending neurons in the pathway.
+func (pt *PathParams) InitSTP(ctx *Context) {
+	maxd := GetNetworkIxs(0).MaxData
+	snn := pt.Indexes.SendNeurN
+	cnst := pt.Indexes.SendConSt
+	for si := uint32(0); si < snn; si++ {
+		for di := uint32(0); di < maxd; di++ {
+			pt.STP.Init(cnst+si, di)
+		}
+	}
+}
+
 // InitGBuffs initializes the per-pathway synaptic conductance buffers.
 // This is not typically needed (called during InitWeights, InitActs)
 // but can be called when needed. Must be called to completely initialize
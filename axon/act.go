@@ -205,7 +205,11 @@ type DendParams struct {
 	// Net modulation is ModBase + ModGain * GModSyn
 	ModBase float32
 
-	pad int32
+	// GAxial is the axial conductance (in nS) coupling adjacent dendritic
+	// compartments, for layers with [Layer.DendComps] > 1, which is directly
+	// comparable to Gbar.L = 20 for leak. Larger values make the compartments
+	// more electrotonically compact, converging on a single compartment.
+	GAxial float32 `default:"10"`
 }
 
 func (dp *DendParams) Defaults() {
@@ -214,6 +218,7 @@ func (dp *DendParams) Defaults() {
 	dp.GR = 3
 	dp.ModGain = 1
 	dp.ModBase = 0
+	dp.GAxial = 10
 }
 
 func (dp *DendParams) Update() {
@@ -330,7 +335,7 @@ type DecayParams struct {
 	Glong float32 `default:"0,0.6" max:"1" min:"0"`
 
 	// AHP is decay of afterhyperpolarization currents, including mAHP, sAHP,
-	// and KNa, Kir. Has a separate decay because often useful to have this
+	// and KNa, Kir, HCN. Has a separate decay because often useful to have this
 	// not decay at all even if decay is on.
 	AHP float32 `default:"0" max:"1" min:"0"`
 
@@ -686,6 +691,87 @@ func (sm *SMaintParams) ExpInt(isi float32) float32 {
 	return math32.FastExp(-max(isi, sm.ISI.Min) / sm.NNeurons)
 }
 
+////////  IntrinsicParams
+
+// IntrinsicParams are parameters for per-neuron intrinsic plasticity,
+// which slowly adapts the excitability of each neuron so that its
+// long-term average activity [ActAvg] tracks a target firing rate.
+// Neurons that are too active raise their spike threshold (via the
+// [IPThr] offset) and / or leak conductance (via [IPGl]), and vice-versa.
+// This is complementary to synaptic scaling via [TrgAvgActParams], and to the
+// layer-level GiMult adaptation in [ActAvgParams]. Updating happens at the
+// slow adaptation interval (Network.SlowInterval) in SlowAdaptNeuron.
+type IntrinsicParams struct {
+
+	// On switch for intrinsic plasticity.
+	On slbool.Bool
+
+	// Thr adapts the spike threshold offset [IPThr].
+	Thr slbool.Bool `default:"true"`
+
+	// Gl adapts the leak conductance offset [IPGl].
+	Gl slbool.Bool `default:"false"`
+
+	// TrgHz is the target firing rate in Hz, which is converted into
+	// normalized activity using Spikes.MaxHz for comparison with [ActAvg].
+	TrgHz float32 `default:"10"`
+
+	// ThrRate is the rate of change in the spike threshold offset [IPThr],
+	// in mV per unit of normalized activity error, per slow update.
+	ThrRate float32 `default:"10"`
+
+	// ThrMax is the maximum magnitude of the spike threshold offset, in mV.
+	ThrMax float32 `default:"5"`
+
+	// GlRate is the rate of change in the leak conductance offset [IPGl],
+	// as a proportion of Gbar.L per unit of normalized activity error,
+	// per slow update.
+	GlRate float32 `default:"1"`
+
+	// GlMax is the maximum magnitude of the leak conductance offset,
+	// as a proportion of Gbar.L, which must be less than 1.
+	GlMax float32 `default:"0.5" max:"1"`
+}
+
+func (ip *IntrinsicParams) Defaults() {
+	ip.Thr.SetBool(true)
+	ip.TrgHz = 10
+	ip.ThrRate = 10
+	ip.ThrMax = 5
+	ip.GlRate = 1
+	ip.GlMax = 0.5
+}
+
+func (ip *IntrinsicParams) Update() {
+}
+
+func (ip *IntrinsicParams) ShouldDisplay(field string) bool {
+	switch field {
+	case "On":
+		return true
+	case "ThrRate", "ThrMax":
+		return ip.On.IsTrue() && ip.Thr.IsTrue()
+	case "GlRate", "GlMax":
+		return ip.On.IsTrue() && ip.Gl.IsTrue()
+	default:
+		return ip.On.IsTrue()
+	}
+}
+
+// Adapt updates the intrinsic plasticity offsets [IPThr] and [IPGl]
+// for given neuron, based on the difference between its [ActAvg]
+// and the TrgHz target, where maxHz is the Spikes.MaxHz
+// used to normalize activity.
+func (ip *IntrinsicParams) Adapt(ni uint32, maxHz float32) {
+	err := NeuronAvgs.Value(int(ni), int(ActAvg)) - ip.TrgHz/maxHz
+	if ip.Thr.IsTrue() {
+		NeuronAvgs.Set(math32.Clamp(NeuronAvgs.Value(int(ni), int(IPThr))+ip.ThrRate*err, -ip.ThrMax, ip.ThrMax), int(ni), int(IPThr))
+	}
+	if ip.Gl.IsTrue() {
+		NeuronAvgs.Set(math32.Clamp(NeuronAvgs.Value(int(ni), int(IPGl))+ip.GlRate*err, -ip.GlMax, ip.GlMax), int(ni), int(IPGl))
+	}
+}
+
 ////////  PopCodeParams
 
 // PopCodeParams provides an encoding of scalar value using population code,
@@ -884,6 +970,14 @@ type ActParams struct {
 	// spiny neurons (MSNs) relatively quiet in the striatum.
 	Kir chans.KirParams `display:"inline"`
 
+	// HCN is the hyperpolarization-activated cation channel that produces
+	// the Ih current, which slowly opens with hyperpolarization and drives
+	// the neuron back toward firing, with its own Gbar and Erev values.
+	// This channel is off by default but is important for rebound bursting in
+	// thalamic relay neurons, and resonance and dendritic integration in
+	// entorhinal and hippocampal neurons.
+	HCN chans.HCNParams `display:"inline"`
+
 	// NMDA has channel parameters used in computing the Gnmda conductance
 	// that is maximal for more depolarized neurons (due to unblocking of
 	// Mg++ ions), and thus helps keep active neurons active, thereby promoting
@@ -912,6 +1006,19 @@ type ActParams struct {
 	// for limiting the runaway excitation from VGCC channels.
 	AK chans.AKsParams `display:"inline"`
 
+	// CaT is the low-threshold T-type calcium channel, which is de-inactivated
+	// by hyperpolarization and drives a low-threshold calcium spike and burst
+	// firing on subsequent depolarization, contributing Ca to [LearnCa].
+	// This channel is off by default, but is important for burst firing in
+	// thalamic relay, TRN and STN neurons.
+	CaT chans.CaTParams `display:"inline"`
+
+	// NaP is the persistent sodium channel, which provides a sustained
+	// depolarizing drive at subthreshold potentials that slowly inactivates.
+	// This channel is off by default, but supports burst firing and plateau
+	// potentials, e.g., in STN neurons.
+	NaP chans.NaPParams `display:"inline"`
+
 	// SKCa is the small-conductance calcium-activated potassium channel produces
 	// the pausing function as a consequence of rapid bursting. These are not active
 	// by default but are critical for subthalamic nucleus (STN) neurons.
@@ -921,6 +1028,11 @@ type ActParams struct {
 	// NMDA-interconnected spiking neurons.
 	SMaint SMaintParams `display:"inline"`
 
+	// Intrinsic has parameters for per-neuron intrinsic plasticity, which
+	// slowly adapts the spike threshold and / or leak conductance of each
+	// neuron toward a target firing rate.
+	Intrinsic IntrinsicParams `display:"inline"`
+
 	// PopCode provides encoding population codes, used to represent a single
 	// continuous (scalar) value, across a population of units / neurons
 	// (1 dimensional).
@@ -947,6 +1059,8 @@ func (ac *ActParams) Defaults() {
 	ac.KNa.On.SetBool(true)
 	ac.Kir.Defaults()
 	ac.Kir.Gk = 0
+	ac.HCN.Defaults()
+	ac.HCN.Gbar = 0
 	ac.NMDA.Defaults()
 	ac.NMDA.Ge = 0.006
 	ac.MaintNMDA.Defaults()
@@ -958,9 +1072,14 @@ func (ac *ActParams) Defaults() {
 	ac.VGCC.Ca = 0.25
 	ac.AK.Defaults()
 	ac.AK.Gk = 0.1
+	ac.CaT.Defaults()
+	ac.CaT.Ge = 0
+	ac.NaP.Defaults()
+	ac.NaP.Ge = 0
 	ac.SKCa.Defaults()
 	ac.SKCa.Gk = 0
 	ac.SMaint.Defaults()
+	ac.Intrinsic.Defaults()
 	ac.PopCode.Defaults()
 	ac.Update()
 }
@@ -978,13 +1097,17 @@ func (ac *ActParams) Update() {
 	ac.Sahp.Update()
 	ac.KNa.Update()
 	ac.Kir.Update()
+	ac.HCN.Update()
 	ac.NMDA.Update()
 	ac.MaintNMDA.Update()
 	ac.GabaB.Update()
 	ac.VGCC.Update()
 	ac.AK.Update()
+	ac.CaT.Update()
+	ac.NaP.Update()
 	ac.SKCa.Update()
 	ac.SMaint.Update()
+	ac.Intrinsic.Update()
 	ac.PopCode.Update()
 }
 
@@ -1001,6 +1124,7 @@ func (ac *ActParams) DecayLearnCa(ctx *Context, ni, di uint32, decay float32) {
 
 	Neurons.SetSub(decay*Neurons.Value(int(ni), int(di), int(VgccCa)), int(ni), int(di), int(VgccCa))
 	Neurons.SetSub(decay*Neurons.Value(int(ni), int(di), int(VgccCaInt)), int(ni), int(di), int(VgccCaInt))
+	Neurons.SetSub(decay*Neurons.Value(int(ni), int(di), int(CatCa)), int(ni), int(di), int(CatCa))
 
 	Neurons.SetSub(decay*Neurons.Value(int(ni), int(di), int(LearnCa)), int(ni), int(di), int(LearnCa))
 
@@ -1034,6 +1158,9 @@ func (ac *ActParams) DecayAHP(ctx *Context, ni, di uint32, decay float32) {
 	kirMrest := ac.Kir.Mrest
 	Neurons.SetAdd(decay*(kirMrest-Neurons.Value(int(ni), int(di), int(KirM))), int(ni), int(di), int(KirM))
 	Neurons.SetSub(decay*Neurons.Value(int(ni), int(di), int(Gkir)), int(ni), int(di), int(Gkir))
+	hcnMrest := ac.HCN.Mrest
+	Neurons.SetAdd(decay*(hcnMrest-Neurons.Value(int(ni), int(di), int(HcnM))), int(ni), int(di), int(HcnM))
+	Neurons.SetSub(decay*Neurons.Value(int(ni), int(di), int(Gh)), int(ni), int(di), int(Gh))
 }
 
 // DecayState decays the activation state toward initial values
@@ -1057,6 +1184,7 @@ func (ac *ActParams) DecayState(ctx *Context, ni, di uint32, decay, glong, ahp f
 		Neurons.SetSub(decay*Neurons.Value(int(ni), int(di), int(Gk)), int(ni), int(di), int(Gk))
 
 		Neurons.SetSub(decay*(Neurons.Value(int(ni), int(di), int(Vm))-ac.Init.Vm), int(ni), int(di), int(Vm))
+		Neurons.Set(Neurons.Value(int(ni), int(di), int(Vm)), int(ni), int(di), int(GapVm))
 
 		Neurons.SetSub(decay*Neurons.Value(int(ni), int(di), int(GeNoise)), int(ni), int(di), int(GeNoise))
 		Neurons.SetSub(decay*Neurons.Value(int(ni), int(di), int(GiNoise)), int(ni), int(di), int(GiNoise))
@@ -1087,6 +1215,13 @@ func (ac *ActParams) DecayState(ctx *Context, ni, di uint32, decay, glong, ahp f
 	Neurons.SetSub(glong*Neurons.Value(int(ni), int(di), int(VgccH)), int(ni), int(di), int(VgccH))
 	Neurons.SetSub(glong*Neurons.Value(int(ni), int(di), int(Gak)), int(ni), int(di), int(Gak))
 
+	Neurons.SetSub(glong*Neurons.Value(int(ni), int(di), int(Gcat)), int(ni), int(di), int(Gcat))
+	Neurons.SetAdd(glong*(ac.CaT.Mrest-Neurons.Value(int(ni), int(di), int(CatM))), int(ni), int(di), int(CatM))
+	Neurons.SetAdd(glong*(ac.CaT.Hrest-Neurons.Value(int(ni), int(di), int(CatH))), int(ni), int(di), int(CatH))
+	Neurons.SetSub(glong*Neurons.Value(int(ni), int(di), int(Gnap)), int(ni), int(di), int(Gnap))
+	Neurons.SetAdd(glong*(ac.NaP.Mrest-Neurons.Value(int(ni), int(di), int(NapM))), int(ni), int(di), int(NapM))
+	Neurons.SetAdd(glong*(ac.NaP.Hrest-Neurons.Value(int(ni), int(di), int(NapH))), int(ni), int(di), int(NapH))
+
 	// don't mess with SKCa -- longer time scale
 	Neurons.SetSub(glong*Neurons.Value(int(ni), int(di), int(Gsk)), int(ni), int(di), int(Gsk))
 
@@ -1101,6 +1236,7 @@ func (ac *ActParams) DecayState(ctx *Context, ni, di uint32, decay, glong, ahp f
 	Neurons.Set(0.0, int(ni), int(di), int(GModSyn))
 	Neurons.Set(0.0, int(ni), int(di), int(GMaintRaw))
 	Neurons.Set(0.0, int(ni), int(di), int(SSGiDend))
+	Neurons.Set(0.0, int(ni), int(di), int(GiDendSyn))
 	Neurons.Set(0.0, int(ni), int(di), int(GeExt))
 
 	Neurons.SetSub(glong*Neurons.Value(int(ni), int(di), int(CtxtGeOrig)), int(ni), int(di), int(CtxtGeOrig))
@@ -1122,6 +1258,8 @@ func (ac *ActParams) InitActs(ctx *Context, ni, di uint32) {
 	Neurons.Set(0, int(ni), int(di), int(Inet))
 	Neurons.Set(ac.Init.Vm, int(ni), int(di), int(Vm))
 	Neurons.Set(ac.Init.Vm, int(ni), int(di), int(VmDend))
+	Neurons.Set(ac.Init.Vm, int(ni), int(di), int(GapVm))
+	Neurons.Set(0, int(ni), int(di), int(Igap))
 	Neurons.Set(0, int(ni), int(di), int(Target))
 	Neurons.Set(0, int(ni), int(di), int(Ext))
 
@@ -1149,6 +1287,8 @@ func (ac *ActParams) InitActs(ctx *Context, ni, di uint32) {
 	Neurons.Set(0, int(ni), int(di), int(GknaSlow))
 	Neurons.Set(ac.Kir.Mrest, int(ni), int(di), int(KirM))
 	Neurons.Set(0, int(ni), int(di), int(Gkir))
+	Neurons.Set(ac.HCN.Mrest, int(ni), int(di), int(HcnM))
+	Neurons.Set(0, int(ni), int(di), int(Gh))
 
 	Neurons.Set(0, int(ni), int(di), int(GnmdaSyn))
 	Neurons.Set(0, int(ni), int(di), int(Gnmda))
@@ -1166,6 +1306,14 @@ func (ac *ActParams) InitActs(ctx *Context, ni, di uint32) {
 	Neurons.Set(0, int(ni), int(di), int(Gak))
 	Neurons.Set(0, int(ni), int(di), int(VgccCaInt))
 
+	Neurons.Set(0, int(ni), int(di), int(Gcat))
+	Neurons.Set(ac.CaT.Mrest, int(ni), int(di), int(CatM))
+	Neurons.Set(ac.CaT.Hrest, int(ni), int(di), int(CatH))
+	Neurons.Set(0, int(ni), int(di), int(CatCa))
+	Neurons.Set(0, int(ni), int(di), int(Gnap))
+	Neurons.Set(ac.NaP.Mrest, int(ni), int(di), int(NapM))
+	Neurons.Set(ac.NaP.Hrest, int(ni), int(di), int(NapH))
+
 	Neurons.Set(1, int(ni), int(di), int(SKCaIn))
 	Neurons.Set(0, int(ni), int(di), int(SKCaR))
 	Neurons.Set(0, int(ni), int(di), int(SKCaM))
@@ -1180,6 +1328,7 @@ func (ac *ActParams) InitActs(ctx *Context, ni, di uint32) {
 	Neurons.Set(0, int(ni), int(di), int(GMaintSyn))
 
 	Neurons.Set(0, int(ni), int(di), int(SSGiDend))
+	Neurons.Set(0, int(ni), int(di), int(GiDendSyn))
 
 	Neurons.Set(0, int(ni), int(di), int(Burst))
 	Neurons.Set(0, int(ni), int(di), int(BurstPrv))
@@ -1272,6 +1421,48 @@ func (ac *ActParams) GvgccFromVm(ctx *Context, ni, di uint32) {
 	Neurons.Set(ac.VGCC.CaFromG(v, Neurons.Value(int(ni), int(di), int(Gvgcc)), Neurons.Value(int(ni), int(di), int(VgccCa))), int(ni), int(di), int(VgccCa))
 }
 
+// GcatFromVm updates all the CaT T-type calcium channel variables
+// from VmDend, if used.
+func (ac *ActParams) GcatFromVm(ctx *Context, ni, di uint32) {
+	if ac.CaT.Ge == 0 {
+		return
+	}
+	v := Neurons.Value(int(ni), int(di), int(VmDend))
+	m := Neurons.Value(int(ni), int(di), int(CatM))
+	h := Neurons.Value(int(ni), int(di), int(CatH))
+	g := ac.CaT.Gcat(m, h)
+	Neurons.Set(g, int(ni), int(di), int(Gcat))
+	Neurons.Set(m+ac.CaT.DM(v, m), int(ni), int(di), int(CatM))
+	Neurons.Set(h+ac.CaT.DH(v, h), int(ni), int(di), int(CatH))
+	Neurons.Set(ac.CaT.CaFromG(v, g), int(ni), int(di), int(CatCa))
+}
+
+// GnapFromVm updates all the NaP persistent sodium channel variables
+// from Vm, if used.
+func (ac *ActParams) GnapFromVm(ctx *Context, ni, di uint32) {
+	if ac.NaP.Ge == 0 {
+		return
+	}
+	v := Neurons.Value(int(ni), int(di), int(Vm))
+	m := Neurons.Value(int(ni), int(di), int(NapM))
+	h := Neurons.Value(int(ni), int(di), int(NapH))
+	Neurons.Set(ac.NaP.Gnap(m, h), int(ni), int(di), int(Gnap))
+	Neurons.Set(m+ac.NaP.DM(v, m), int(ni), int(di), int(NapM))
+	Neurons.Set(h+ac.NaP.DH(v, h), int(ni), int(di), int(NapH))
+}
+
+// GhFromVm updates the HCN hyperpolarization-activated cation channel
+// conductance and gating from VmDend, if used.
+func (ac *ActParams) GhFromVm(ctx *Context, ni, di uint32) {
+	if ac.HCN.Gbar == 0 {
+		return
+	}
+	v := Neurons.Value(int(ni), int(di), int(VmDend))
+	m := Neurons.Value(int(ni), int(di), int(HcnM))
+	Neurons.Set(ac.HCN.Gh(m), int(ni), int(di), int(Gh))
+	Neurons.Set(m+ac.HCN.DM(v, m), int(ni), int(di), int(HcnM))
+}
+
 // GkFromVm updates all the Gk-based conductances: Mahp, KNa, Gak
 func (ac *ActParams) GkFromVm(ctx *Context, ni, di uint32) {
 	vm := Neurons.Value(int(ni), int(di), int(Vm))
@@ -1385,9 +1576,10 @@ func (ac *ActParams) GiFromSyn(ctx *Context, ni, di uint32, giSyn float32) float
 	return giSyn
 }
 
-// InetFromG computes net current from conductances and Vm
-func (ac *ActParams) InetFromG(vm, ge, gl, gi, gk float32) float32 {
-	inet := ge*(ac.Erev.E-vm) + gl*ac.Gbar.L*(ac.Erev.L-vm) + gi*(ac.Erev.I-vm) + gk*(ac.Erev.K-vm)
+// InetFromG computes net current from conductances and Vm.
+// gh is the HCN conductance, which already includes HCN.Gbar.
+func (ac *ActParams) InetFromG(vm, ge, gl, gi, gk, gh float32) float32 {
+	inet := ge*(ac.Erev.E-vm) + gl*ac.Gbar.L*(ac.Erev.L-vm) + gi*(ac.Erev.I-vm) + gk*(ac.Erev.K-vm) + gh*(ac.HCN.Erev-vm)
 	if inet > ac.Dt.MaxI {
 		inet = ac.Dt.MaxI
 	} else if inet < -ac.Dt.MaxI {
@@ -1403,11 +1595,11 @@ func (ac *ActParams) VmFromInet(vm, dt, inet float32) float32 {
 
 // VmInteg integrates Vm over VmSteps to obtain a more stable value
 // Returns the new Vm and inet values.
-func (ac *ActParams) VmInteg(vm, dt, ge, gl, gi, gk float32, nvm, inet *float32) {
+func (ac *ActParams) VmInteg(vm, dt, ge, gl, gi, gk, gh float32, nvm, inet *float32) {
 	dtEff := dt * ac.Dt.DtStep
 	*nvm = vm
 	for i := int32(0); i < ac.Dt.VmSteps; i++ {
-		*inet = ac.InetFromG(*nvm, ge, gl, gi, gk)
+		*inet = ac.InetFromG(*nvm, ge, gl, gi, gk, gh)
 		*nvm = ac.VmFromInet(*nvm, dtEff, *inet)
 	}
 }
@@ -1425,14 +1617,21 @@ func (ac *ActParams) VmFromG(ctx *Context, ni, di uint32) {
 	ge := Neurons.Value(int(ni), int(di), int(Ge)) * ac.Gbar.E
 	gi := Neurons.Value(int(ni), int(di), int(Gi)) * ac.Gbar.I
 	gk := Neurons.Value(int(ni), int(di), int(Gk)) * ac.Gbar.K
+	gh := Neurons.Value(int(ni), int(di), int(Gh))
+	gl := float32(1)
+	thr := ac.Spikes.Thr
+	if ac.Intrinsic.On.IsTrue() {
+		gl += NeuronAvgs.Value(int(ni), int(IPGl))
+		thr += NeuronAvgs.Value(int(ni), int(IPThr))
+	}
 	var nvm, inet, expi float32
 	if updtVm {
-		ac.VmInteg(Neurons.Value(int(ni), int(di), int(Vm)), ac.Dt.VmDt, ge, 1, gi, gk, &nvm, &inet)
+		ac.VmInteg(Neurons.Value(int(ni), int(di), int(Vm)), ac.Dt.VmDt, ge, gl, gi, gk, gh, &nvm, &inet)
 		if updtVm && ac.Spikes.Exp.IsTrue() { // add spike current if relevant
 			var exVm float32
 			exVm = 0.5 * (nvm + Neurons.Value(int(ni), int(di), int(Vm))) // midpoint for this
 			expi = ac.Gbar.L * ac.Spikes.ExpSlope *
-				math32.FastExp((exVm-ac.Spikes.Thr)/ac.Spikes.ExpSlope)
+				math32.FastExp((exVm-thr)/ac.Spikes.ExpSlope)
 			if expi > ac.Dt.MaxI {
 				expi = ac.Dt.MaxI
 			}
@@ -1452,27 +1651,29 @@ func (ac *ActParams) VmFromG(ctx *Context, ni, di uint32) {
 		Neurons.Set(dvm*ac.Dt.VmC, int(ni), int(di), int(Inet))
 	}
 
-	glEff := float32(1)
+	glEff := gl
 	if !updtVm {
 		glEff += ac.Dend.GR
 	}
 	var giEff float32
 	giEff = gi + ac.Gbar.I*Neurons.Value(int(ni), int(di), int(SSGiDend))
-	ac.VmInteg(Neurons.Value(int(ni), int(di), int(VmDend)), ac.Dt.VmDendDt, ge, glEff, giEff, gk, &nvm, &inet)
+	ac.VmInteg(Neurons.Value(int(ni), int(di), int(VmDend)), ac.Dt.VmDendDt, ge, glEff, giEff, gk, gh, &nvm, &inet)
 	if updtVm {
 		nvm = ac.VmFromInet(nvm, ac.Dt.VmDendDt, ac.Dend.GExp*expi)
 	}
 	Neurons.Set(nvm, int(ni), int(di), int(VmDend))
 }
 
-// SpikeFromVmVars computes Spike from Vm and ISI-based activation, using pointers to variables
-func (ac *ActParams) SpikeFromVmVars(nrnISI, nrnISIAvg, nrnSpike, nrnSpiked, nrnAct *float32, nrnVm float32) {
+// SpikeFromVmVars computes Spike from Vm and ISI-based activation, using pointers to variables.
+// thrOff is an offset on the spike threshold, from intrinsic plasticity.
+func (ac *ActParams) SpikeFromVmVars(nrnISI, nrnISIAvg, nrnSpike, nrnSpiked, nrnAct *float32, nrnVm, thrOff float32) {
 	var thr float32
 	if ac.Spikes.Exp.IsTrue() {
 		thr = ac.Spikes.ExpThr
 	} else {
 		thr = ac.Spikes.Thr
 	}
+	thr += thrOff
 	if nrnVm >= thr {
 		*nrnSpike = 1
 		if *nrnISIAvg == -1 {
@@ -1519,7 +1720,11 @@ func (ac *ActParams) SpikeFromVm(ctx *Context, ni, di uint32) {
 	nrnSpiked := Neurons.Value(int(ni), int(di), int(Spiked))
 	nrnAct := Neurons.Value(int(ni), int(di), int(Act))
 	nrnVm := Neurons.Value(int(ni), int(di), int(Vm))
-	ac.SpikeFromVmVars(&nrnISI, &nrnISIAvg, &nrnSpike, &nrnSpiked, &nrnAct, nrnVm)
+	thrOff := float32(0)
+	if ac.Intrinsic.On.IsTrue() {
+		thrOff = NeuronAvgs.Value(int(ni), int(IPThr))
+	}
+	ac.SpikeFromVmVars(&nrnISI, &nrnISIAvg, &nrnSpike, &nrnSpiked, &nrnAct, nrnVm, thrOff)
 	Neurons.Set(nrnISI, int(ni), int(di), int(ISI))
 	Neurons.Set(nrnISIAvg, int(ni), int(di), int(ISIAvg))
 	Neurons.Set(nrnSpike, int(ni), int(di), int(Spike))
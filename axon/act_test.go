@@ -7,10 +7,13 @@
 package axon
 
 import (
+	"math"
+	"math/rand"
 	"testing"
 
 	"cogentcore.org/core/math32"
 	"cogentcore.org/lab/tensor"
+	"github.com/stretchr/testify/assert"
 )
 
 // TOLERANCE is the numerical difference tolerance for comparing vs. target values
@@ -75,3 +78,78 @@ func TestActUpdate(t *testing.T) {
 	// fmt.Printf("vm vals: %v\n", vm)
 	// fmt.Printf("act vals: %v\n", act)
 }
+
+// stpRun runs the short-term plasticity state for nCyc cycles, with spikes
+// on cycles where spike returns true, returning the mean efficacy of the
+// spikes and the mean utilization after each spike, starting at cycle nBurn.
+func stpRun(sp *STPParams, nCyc, nBurn int, spike func(cyc int) bool) (eff, util float64) {
+	PathSTP = tensor.NewFloat32(1, 1, int(STPVarsN))
+	sp.Init(0, 0)
+	n := 0
+	for cyc := range nCyc {
+		sp.Decay(0, 0)
+		if !spike(cyc) {
+			continue
+		}
+		e := sp.Spike(0, 0)
+		if cyc < nBurn {
+			continue
+		}
+		eff += float64(e)
+		util += float64(PathSTP.Value(int(0), int(0), int(STPUtil)))
+		n++
+	}
+	return eff / float64(n), util / float64(n)
+}
+
+func TestSTPRegular(t *testing.T) {
+	sp := STPParams{}
+	sp.Defaults()
+	sp.On.SetBool(true)
+	isi := 20
+	eff, util := stpRun(&sp, 10000, 5000, func(cyc int) bool { return cyc%isi == 0 })
+	// steady state resources just prior to each spike, with u = U
+	d := math.Exp(-float64(isi) / float64(sp.TauD))
+	u := float64(sp.U)
+	assert.InDelta(t, (1-d)/(1-d*(1-u)), eff, 1.0e-4)
+	assert.InDelta(t, u, util, 1.0e-6)
+
+	// first spike after inactivity has full efficacy
+	eff, _ = stpRun(&sp, 1, 0, func(cyc int) bool { return true })
+	assert.InDelta(t, 1, eff, 1.0e-6)
+}
+
+// stpPoissonDecay returns the expected value of d^isi for a discrete
+// Poisson (Bernoulli per cycle) spike train with spike probability p.
+func stpPoissonDecay(p, d float64) float64 {
+	return p * d / (1 - (1-p)*d)
+}
+
+func TestSTPPoisson(t *testing.T) {
+	rnd := rand.New(rand.NewSource(42))
+	r := 0.02 // 20 Hz = .02 spikes per msec cycle
+	spike := func(cyc int) bool { return rnd.Float64() < r }
+
+	// depression only: x = 1 / (1 + U r TauD)
+	sp := STPParams{}
+	sp.Defaults()
+	sp.On.SetBool(true)
+	u := float64(sp.U)
+	tauD := float64(sp.TauD)
+	eff, _ := stpRun(&sp, 1000000, 1000, spike)
+	a := stpPoissonDecay(r, math.Exp(-1/tauD))
+	assert.InDelta(t, (1-a)/(1-a*(1-u)), eff, 0.01)
+	assert.InDelta(t, 1/(1+u*r*tauD), eff, 0.01)
+
+	// facilitation: mean u after spike = U (1 + r TauF) / (1 + U r TauF)
+	sp.U = 0.1
+	sp.TauF = 500
+	sp.Update()
+	u = float64(sp.U)
+	tauF := float64(sp.TauF)
+	_, util := stpRun(&sp, 1000000, 1000, spike)
+	aF := stpPoissonDecay(r, math.Exp(-1/tauF))
+	um := aF * u / (1 - aF*(1-u))
+	assert.InDelta(t, u+(1-u)*um, util, 0.01)
+	assert.InDelta(t, u*(1+r*tauF)/(1+u*r*tauF), util, 0.01)
+}
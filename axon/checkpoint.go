@@ -0,0 +1,323 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package axon
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"unsafe"
+
+	"cogentcore.org/core/base/errors"
+	"cogentcore.org/core/core"
+	"cogentcore.org/lab/tensor"
+)
+
+// CheckpointVersion is the current version of the checkpoint file format
+// written by [Network.SaveCheckpoint]. Files with a different version
+// are rejected by [Network.LoadCheckpoint].
+const CheckpointVersion uint32 = 1
+
+// checkpointMagic is the identifying tag at the start of a checkpoint file.
+const checkpointMagic = "AXONCKPT"
+
+// SaveCheckpoint writes the full dynamic state of the network to given writer,
+// so that a run can be resumed exactly where it left off via [Network.LoadCheckpoint].
+// This includes everything that [Network.WriteWeightsJSON] does not:
+// Neurons, NeuronAvgs, Dendrites, Pools, PoolsInt, LayerStates, GlobalScalars,
+// GlobalVectors, Exts, Synapses, SynapseTraces, PathGBuf, PathGSyns, PathSTP,
+// the STDP spike times and traces, the weight consolidation state (SynapseConsol),
+// the synapse connectivity indexes, which can change through structural
+// plasticity (see [StructPlastParams]), the state of the learning rate schedules
+// in [Network.LRateScheds], and the Context counters, including the RandCounter used by
+// [GetRandomNumber]. The [Network.Rand] state is not saved, so it is only
+// used for initialization: random numbers during a run come from the
+// RandCounter, including those on the CPU via [Context.RandCPU].
+// Parameters are not saved: the network must be
+// configured and built the same way before loading.
+// The data is in a binary, machine-specific layout, and is not intended
+// for long-term storage: use weights files for that.
+// See [LooperSaveCheckpoint] for saving the looper counters as well.
+func (nt *Network) SaveCheckpoint(w io.Writer) error {
+	RunGPUSync()
+	RunDoneAll()
+	bw := bufio.NewWriter(w)
+	if _, err := bw.WriteString(checkpointMagic); err != nil {
+		return err
+	}
+	if err := binary.Write(bw, binary.LittleEndian, CheckpointVersion); err != nil {
+		return err
+	}
+	if err := writeCheckpointStruct(bw, nt.NetIxs()); err != nil {
+		return err
+	}
+	if err := writeCheckpointStruct(bw, nt.Context()); err != nil {
+		return err
+	}
+	for _, ct := range nt.checkpointTensors() {
+		if err := ct.write(bw); err != nil {
+			return err
+		}
+	}
+	if err := nt.writeLRateScheds(bw); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// LoadCheckpoint reads the full dynamic state of the network from given reader,
+// as saved by [Network.SaveCheckpoint]. The network must already be built with
+// the same configuration and MaxData as when the checkpoint was saved, which is
+// checked using the sizes of all the state variables. All of the state is read
+// before any of it is set, so the network is unchanged if an error is returned.
+// After loading, all state is copied to the GPU if in use. If r is a
+// [bufio.Reader], it is used directly and nothing beyond the network state is read from it.
+func (nt *Network) LoadCheckpoint(r io.Reader) error {
+	set, err := nt.readCheckpoint(r)
+	if err != nil {
+		return err
+	}
+	set()
+	return nil
+}
+
+// readCheckpoint reads the network state for [Network.LoadCheckpoint]
+// into scratch buffers, returning a function that sets the network
+// state from them, which is only valid if there is no error.
+func (nt *Network) readCheckpoint(r io.Reader) (func(), error) {
+	br, ok := r.(*bufio.Reader)
+	if !ok {
+		br = bufio.NewReader(r)
+	}
+	magic := make([]byte, len(checkpointMagic))
+	if _, err := io.ReadFull(br, magic); err != nil {
+		return nil, err
+	}
+	if string(magic) != checkpointMagic {
+		return nil, errors.New("LoadCheckpoint: not an axon checkpoint file")
+	}
+	var vers uint32
+	if err := binary.Read(br, binary.LittleEndian, &vers); err != nil {
+		return nil, err
+	}
+	if vers != CheckpointVersion {
+		return nil, fmt.Errorf("LoadCheckpoint: checkpoint version %d != current version %d", vers, CheckpointVersion)
+	}
+	nix := *nt.NetIxs()
+	if err := readCheckpointStruct(br, &nix); err != nil {
+		return nil, err
+	}
+	if nix != *nt.NetIxs() {
+		return nil, fmt.Errorf("LoadCheckpoint: network indexes in checkpoint: %+v do not match current network: %+v", nix, *nt.NetIxs())
+	}
+	ctx := *nt.Context()
+	if err := readCheckpointStruct(br, &ctx); err != nil {
+		return nil, err
+	}
+	cts := nt.checkpointTensors()
+	for _, ct := range cts {
+		if err := ct.read(br); err != nil {
+			return nil, err
+		}
+	}
+	setScheds, err := nt.readLRateScheds(br)
+	if err != nil {
+		return nil, err
+	}
+	set := func() {
+		for _, ct := range cts {
+			ct.set()
+		}
+		for _, pt := range nt.Paths { // connectivity can change with StructPlast
+			if !pt.Off {
+				pt.ConsFromIxs()
+			}
+		}
+		setScheds()
+		nt.SetContext(&ctx)
+		ToGPUAll()
+		RunGPUSync()
+		RunDone()
+	}
+	return set, nil
+}
+
+// SaveCheckpointFile saves the full network state to given file name,
+// using [Network.SaveCheckpoint]. If filename has .gz extension,
+// then the file is gzip compressed.
+func (nt *Network) SaveCheckpointFile(filename core.Filename) error {
+	return writeCheckpointFile(filename, nt.SaveCheckpoint)
+}
+
+// OpenCheckpointFile loads the full network state from given file name,
+// using [Network.LoadCheckpoint]. If filename has .gz extension,
+// then the file is gzip uncompressed.
+func (nt *Network) OpenCheckpointFile(filename core.Filename) error {
+	return readCheckpointFile(filename, nt.LoadCheckpoint)
+}
+
+// writeCheckpointFile creates given file and calls the write function on it,
+// using gzip compression if the filename has a .gz extension.
+func writeCheckpointFile(filename core.Filename, write func(w io.Writer) error) error {
+	fp, err := os.Create(string(filename))
+	if err != nil {
+		return errors.Log(err)
+	}
+	defer fp.Close()
+	if filepath.Ext(string(filename)) == ".gz" {
+		gzw := gzip.NewWriter(fp)
+		err = write(gzw)
+		gzw.Close()
+	} else {
+		err = write(fp)
+	}
+	return errors.Log(err)
+}
+
+// readCheckpointFile opens given file and calls the read function on it,
+// using gzip decompression if the filename has a .gz extension.
+func readCheckpointFile(filename core.Filename, read func(r io.Reader) error) error {
+	fp, err := os.Open(string(filename))
+	if err != nil {
+		return errors.Log(err)
+	}
+	defer fp.Close()
+	if filepath.Ext(string(filename)) == ".gz" {
+		gzr, err := gzip.NewReader(fp)
+		if err != nil {
+			return errors.Log(err)
+		}
+		defer gzr.Close()
+		return errors.Log(read(gzr))
+	}
+	return errors.Log(read(fp))
+}
+
+// checkpointTensor is one named state tensor in a checkpoint,
+// with the functions to write and read its values.
+// The read function reads into a scratch buffer, which
+// the set function then copies into the tensor.
+type checkpointTensor struct {
+	name  string
+	sizes []int
+	write func(w io.Writer) error
+	read  func(r io.Reader) error
+	set   func()
+}
+
+// checkpointTensors returns the list of state tensors saved in a checkpoint,
+// in the order in which they are saved.
+func (nt *Network) checkpointTensors() []*checkpointTensor {
+	return []*checkpointTensor{
+		newCheckpointTensor("Neurons", &nt.Neurons),
+		newCheckpointTensor("NeuronAvgs", &nt.NeuronAvgs),
+		newCheckpointTensor("Dendrites", &nt.Dendrites),
+		newCheckpointTensor("Pools", &nt.Pools),
+		newCheckpointTensor("PoolsInt", &nt.PoolsInt),
+		newCheckpointTensor("LayerStates", &nt.LayerStates),
+		newCheckpointTensor("GlobalScalars", &nt.GlobalScalars),
+		newCheckpointTensor("GlobalVectors", &nt.GlobalVectors),
+		newCheckpointTensor("Exts", &nt.Exts),
+		newCheckpointTensor("PathGBuf", &nt.PathGBuf),
+		newCheckpointTensor("PathGSyns", &nt.PathGSyns),
+		newCheckpointTensor("Synapses", &nt.Synapses),
+		newCheckpointTensor("SynapseTraces", &nt.SynapseTraces),
+		newCheckpointTensor("PathSTP", &nt.PathSTP),
+		newCheckpointTensor("STDPSpikes", &nt.STDPSpikes),
+		newCheckpointTensor("SynapseSTDP", &nt.SynapseSTDP),
+		newCheckpointTensor("SynapseConsol", &nt.SynapseConsol),
+		newCheckpointTensor("SynapseIxs", &nt.SynapseIxs),
+		newCheckpointTensor("PathSendCon", &nt.PathSendCon),
+		newCheckpointTensor("PathRecvCon", &nt.PathRecvCon),
+		newCheckpointTensor("RecvSynIxs", &nt.RecvSynIxs),
+	}
+}
+
+// newCheckpointTensor returns a checkpointTensor for given tensor,
+// which writes the name and shape followed by the raw values,
+// and checks that the name and shape match when reading.
+func newCheckpointTensor[T float32 | int32 | uint32](name string, tsr *tensor.Number[T]) *checkpointTensor {
+	ct := &checkpointTensor{name: name, sizes: tsr.ShapeSizes()}
+	var buf []T
+	ct.write = func(w io.Writer) error {
+		if err := writeCheckpointHeader(w, ct.name, ct.sizes); err != nil {
+			return err
+		}
+		return binary.Write(w, binary.LittleEndian, tsr.Values)
+	}
+	ct.read = func(r io.Reader) error {
+		if err := readCheckpointHeader(r, ct.name, ct.sizes); err != nil {
+			return err
+		}
+		buf = make([]T, len(tsr.Values))
+		return binary.Read(r, binary.LittleEndian, buf)
+	}
+	ct.set = func() {
+		copy(tsr.Values, buf)
+	}
+	return ct
+}
+
+// writeCheckpointHeader writes the name and shape sizes of a tensor.
+func writeCheckpointHeader(w io.Writer, name string, sizes []int) error {
+	hdr := make([]uint32, 0, 2+len(sizes))
+	hdr = append(hdr, uint32(len(name)), uint32(len(sizes)))
+	for _, sz := range sizes {
+		hdr = append(hdr, uint32(sz))
+	}
+	if err := binary.Write(w, binary.LittleEndian, hdr[:2]); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, name); err != nil {
+		return err
+	}
+	return binary.Write(w, binary.LittleEndian, hdr[2:])
+}
+
+// readCheckpointHeader reads the name and shape sizes of a tensor,
+// returning an error if they do not match those given.
+func readCheckpointHeader(r io.Reader, name string, sizes []int) error {
+	var nn [2]uint32
+	if err := binary.Read(r, binary.LittleEndian, nn[:]); err != nil {
+		return err
+	}
+	nm := make([]byte, nn[0])
+	if _, err := io.ReadFull(r, nm); err != nil {
+		return err
+	}
+	if string(nm) != name {
+		return fmt.Errorf("LoadCheckpoint: expected state variable %q, got %q", name, string(nm))
+	}
+	szs := make([]uint32, nn[1])
+	if err := binary.Read(r, binary.LittleEndian, szs); err != nil {
+		return err
+	}
+	match := len(szs) == len(sizes)
+	for i := 0; match && i < len(szs); i++ {
+		match = int(szs[i]) == sizes[i]
+	}
+	if !match {
+		return fmt.Errorf("LoadCheckpoint: %s shape in checkpoint: %v does not match current network: %v", name, szs, sizes)
+	}
+	return nil
+}
+
+// writeCheckpointStruct writes the raw bytes of a GPU-aligned struct
+// such as [Context], which only has fixed size fields.
+func writeCheckpointStruct[T any](w io.Writer, v *T) error {
+	_, err := w.Write(unsafe.Slice((*byte)(unsafe.Pointer(v)), unsafe.Sizeof(*v)))
+	return err
+}
+
+// readCheckpointStruct reads the raw bytes of a GPU-aligned struct
+// such as [Context], which only has fixed size fields.
+func readCheckpointStruct[T any](r io.Reader, v *T) error {
+	_, err := io.ReadFull(r, unsafe.Slice((*byte)(unsafe.Pointer(v)), unsafe.Sizeof(*v)))
+	return err
+}
@@ -0,0 +1,250 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package axon
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"cogentcore.org/core/core"
+	"cogentcore.org/core/enums"
+	"github.com/emer/emergent/v2/etime"
+	"github.com/emer/emergent/v2/looper"
+	"github.com/stretchr/testify/assert"
+)
+
+// runTestTrials runs given number of learning trials on the test network,
+// starting with given pattern index.
+func runTestTrials(net *Network, stPat, nTrials int) {
+	inPats := newInPats()
+	inLay := net.LayerByName("Input")
+	outLay := net.LayerByName("Output")
+	for trl := range nTrials {
+		pi := (stPat + trl) % 4
+		net.ThetaCycleStart(etime.Train, false)
+		net.MinusPhaseStart()
+		net.InitExt()
+		inLay.ApplyExt(0, inPats.SubSpace(pi))
+		outLay.ApplyExt(0, inPats.SubSpace(pi))
+		net.ApplyExts()
+		for cyc := range 200 {
+			net.Cycle(false)
+			if cyc == 149 {
+				net.MinusPhaseEnd()
+				net.PlusPhaseStart()
+			}
+		}
+		net.PlusPhaseEnd()
+		net.DWtToWt()
+	}
+}
+
+func TestCheckpointResume(t *testing.T) {
+	net := newTestNet(1)
+	runTestTrials(net, 0, 3)
+
+	var b bytes.Buffer
+	assert.NoError(t, net.SaveCheckpoint(&b))
+	saveCtx := *net.Context()
+
+	runTestTrials(net, 3, 5)
+	contHash := net.WeightsHash()
+	contCtx := *net.Context()
+
+	resNet := newTestNet(1)
+	assert.NoError(t, resNet.LoadCheckpoint(&b))
+	assert.Equal(t, saveCtx, *resNet.Context())
+
+	runTestTrials(resNet, 3, 5)
+	assert.Equal(t, contHash, resNet.WeightsHash())
+	assert.Equal(t, contCtx, *resNet.Context())
+	assert.Equal(t, "", resNet.DiffFrom(resNet.Context(), net, 10))
+}
+
+func TestCheckpointMismatch(t *testing.T) {
+	net := newTestNet(1)
+	var b bytes.Buffer
+	assert.NoError(t, net.SaveCheckpoint(&b))
+
+	fullNet := newTestNetFull(1)
+	assert.Error(t, fullNet.LoadCheckpoint(bytes.NewReader(b.Bytes())))
+
+	dataNet := newTestNet(2)
+	assert.Error(t, dataNet.LoadCheckpoint(bytes.NewReader(b.Bytes())))
+
+	assert.Error(t, net.LoadCheckpoint(bytes.NewReader([]byte("not a checkpoint"))))
+}
+
+// testCheckpointEnv is a minimal environment for [newTestLooper],
+// with the pattern index saved in checkpoints.
+type testCheckpointEnv struct {
+	pat int
+}
+
+func (ev *testCheckpointEnv) SaveCheckpoint(w io.Writer) error {
+	return binary.Write(w, binary.LittleEndian, int64(ev.pat))
+}
+
+func (ev *testCheckpointEnv) LoadCheckpoint(r io.Reader) error {
+	var pat int64
+	err := binary.Read(r, binary.LittleEndian, &pat)
+	ev.pat = int(pat)
+	return err
+}
+
+// newTestLooper returns a standard looper for the test network with
+// 2 runs of 3 epochs of 4 trials, and a NewRun function that
+// counts the number of times it is called in nNewRun.
+func newTestLooper(net *Network, ev *testCheckpointEnv, nNewRun *int) *looper.Stacks {
+	ls := looper.NewStacks()
+	ls.AddStack(etime.Train, etime.Trial).
+		AddLevel(etime.Run, 2).
+		AddLevel(etime.Epoch, 3).
+		AddLevel(etime.Trial, 4).
+		AddLevel(etime.Cycle, 200)
+
+	inPats := newInPats()
+	inLay := net.LayerByName("Input")
+	outLay := net.LayerByName("Output")
+	LooperStandard(ls, net, func(mode enums.Enum) *NetViewUpdate { return nil }, etime.Cycle, etime.Trial, etime.Train,
+		func(mode enums.Enum) { net.ClearInputs() },
+		func(mode enums.Enum) {
+			pi := ev.pat % 4
+			ev.pat++
+			net.InitExt()
+			inLay.ApplyExt(0, inPats.SubSpace(pi))
+			outLay.ApplyExt(0, inPats.SubSpace(pi))
+			net.ApplyExts()
+		},
+	)
+	ls.Loop(etime.Train, etime.Run).OnStart.Add("NewRun", func() {
+		*nNewRun++
+		ev.pat = 0
+		net.Context().Reset()
+		net.InitWeights()
+	})
+	return ls
+}
+
+func TestLooperCheckpointResume(t *testing.T) {
+	fnm := filepath.Join(t.TempDir(), "test.ckpt.gz")
+	var ev testCheckpointEnv
+	nNewRun := 0
+	net := newTestNet(1)
+	ls := newTestLooper(net, &ev, &nNewRun)
+	ls.Loop(etime.Train, etime.Run).Counter.SetCurMaxPlusN(0, 1)
+	epoch := ls.Loop(etime.Train, etime.Epoch)
+	LooperAddCheckpoint(ls, net, etime.Train, etime.Epoch, 1, func() string {
+		if epoch.Counter.Cur != 0 {
+			return ""
+		}
+		return fnm
+	}, &ev)
+	ls.Run(etime.Train)
+	contHash := net.WeightsHash()
+	assert.Equal(t, 1, nNewRun)
+	assert.Equal(t, 12, ev.pat)
+
+	var resEv testCheckpointEnv
+	resNewRun := 0
+	resNet := newTestNet(1)
+	resLs := newTestLooper(resNet, &resEv, &resNewRun)
+	resLs.Loop(etime.Train, etime.Run).Counter.SetCurMaxPlusN(0, 1)
+	assert.NoError(t, LooperOpenCheckpointFile(core.Filename(fnm), resLs, resNet, &resEv))
+	assert.Equal(t, 1, resLs.Loop(etime.Train, etime.Epoch).Counter.Cur)
+	assert.Equal(t, 0, resLs.Loop(etime.Train, etime.Trial).Counter.Cur)
+	assert.Equal(t, 4, resEv.pat)
+	resLs.Run(etime.Train)
+	assert.Equal(t, 0, resNewRun)
+	assert.Equal(t, 12, resEv.pat)
+	assert.Equal(t, contHash, resNet.WeightsHash())
+	assert.Equal(t, *net.Context(), *resNet.Context())
+
+	// the next run starts normally
+	resLs.Loop(etime.Train, etime.Run).Counter.Max = 2
+	resLs.Run(etime.Train)
+	assert.Equal(t, 1, resNewRun)
+}
+
+func TestLoadCheckpointError(t *testing.T) {
+	net := newTestNet(1)
+	runTestTrials(net, 0, 2)
+	var b bytes.Buffer
+	assert.NoError(t, net.SaveCheckpoint(&b))
+	hash := net.WeightsHash()
+
+	// a truncated checkpoint does not change anything
+	otherNet := newTestNet(1)
+	otherHash := otherNet.WeightsHash()
+	assert.NotEqual(t, hash, otherHash)
+	assert.Error(t, otherNet.LoadCheckpoint(bytes.NewReader(b.Bytes()[:b.Len()-100])))
+	assert.Equal(t, otherHash, otherNet.WeightsHash())
+
+	var ev testCheckpointEnv
+	nNewRun := 0
+	ls := newTestLooper(otherNet, &ev, &nNewRun)
+	assert.Error(t, LooperLoadCheckpoint(bytes.NewReader(b.Bytes()), ls, otherNet, &ev))
+	assert.Equal(t, otherHash, otherNet.WeightsHash())
+
+	// a looper checkpoint with a truncated or bad env state
+	// does not change the network, looper or env
+	b.Reset()
+	ev.pat = 5
+	ls.Loop(etime.Train, etime.Epoch).Counter.Cur = 2
+	assert.NoError(t, LooperSaveCheckpoint(&b, ls, net, etime.Epoch, &ev))
+	ev.pat = 1
+	ls.Loop(etime.Train, etime.Epoch).Counter.Cur = 0
+	assert.Error(t, LooperLoadCheckpoint(bytes.NewReader(b.Bytes()[:b.Len()-4]), ls, otherNet, &ev))
+	assert.Equal(t, otherHash, otherNet.WeightsHash())
+	assert.Equal(t, 1, ev.pat)
+	assert.Equal(t, 0, ls.Loop(etime.Train, etime.Epoch).Counter.Cur)
+
+	bad := &testBadCheckpointState{}
+	b.Reset()
+	assert.NoError(t, LooperSaveCheckpoint(&b, ls, net, etime.Epoch, &ev, bad))
+	ev.pat = 1
+	assert.Error(t, LooperLoadCheckpoint(bytes.NewReader(b.Bytes()), ls, otherNet, &ev, bad))
+	assert.Equal(t, otherHash, otherNet.WeightsHash())
+	assert.Equal(t, 1, ev.pat)
+	assert.Equal(t, 0, ls.Loop(etime.Train, etime.Epoch).Counter.Cur)
+}
+
+// testBadCheckpointState is a [CheckpointState] that always fails to load.
+type testBadCheckpointState struct{}
+
+func (bs *testBadCheckpointState) SaveCheckpoint(w io.Writer) error {
+	_, err := w.Write([]byte{1})
+	return err
+}
+
+func (bs *testBadCheckpointState) LoadCheckpoint(r io.Reader) error {
+	return errors.New("testBadCheckpointState: cannot load")
+}
+
+func TestRandCPUCheckpoint(t *testing.T) {
+	net := newTestNet(1)
+	runTestTrials(net, 0, 1)
+	var b bytes.Buffer
+	assert.NoError(t, net.SaveCheckpoint(&b))
+
+	ctx := net.Context()
+	ctr := ctx.RandCounter.Counter
+	rnd := ctx.RandCPU(RandFunStructPlast)
+	assert.Equal(t, ctr+uint64(RandFunCPUN), ctx.RandCounter.Counter)
+	vals := []float32{rnd.Float32(), rnd.Float32(), float32(rnd.Intn(10))}
+	assert.NotEqual(t, vals[0], vals[1])
+	assert.Less(t, vals[2], float32(10))
+	// the next block has different numbers
+	assert.NotEqual(t, vals[0], ctx.RandCPU(RandFunStructPlast).Float32())
+
+	resNet := newTestNet(1)
+	assert.NoError(t, resNet.LoadCheckpoint(&b))
+	rnd = resNet.Context().RandCPU(RandFunStructPlast)
+	assert.Equal(t, vals, []float32{rnd.Float32(), rnd.Float32(), float32(rnd.Intn(10))})
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package axon
+
+import (
+	"testing"
+
+	"cogentcore.org/core/math32"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConsolParams(t *testing.T) {
+	cp := &ConsolParams{}
+	cp.Defaults()
+	cp.Rule = ConsolEWC
+	assert.InDelta(t, -0.1*0.2, cp.Pull(0.6, 0.4, 2.5, 0.04), 1.0e-6)
+	// pull is limited to going all the way back to the anchor
+	assert.InDelta(t, -0.2, cp.Pull(0.6, 0.4, 1000, 0.04), 1.0e-6)
+	assert.Equal(t, float32(0), cp.Pull(0.6, 0.4, 0, 0.04))
+
+	acc := float32(0)
+	cp.Accum(2, 0.1, &acc)
+	assert.InDelta(t, 4*cp.Dt, acc, 1.0e-6)
+	assert.InDelta(t, 0.5+acc, cp.Importance(0.5, acc, 0.1), 1.0e-6)
+
+	cp.Rule = ConsolSI
+	acc = 0
+	cp.Accum(2, 0.1, &acc)
+	cp.Accum(-1, -0.1, &acc)
+	assert.InDelta(t, 0.3, acc, 1.0e-6)
+	assert.InDelta(t, 0.3/(0.04+cp.Xi), cp.Importance(0, acc, 0.2), 1.0e-5)
+	assert.Equal(t, float32(0), cp.Importance(0, -0.3, 0.2))
+	cp.Keep = 0.5
+	assert.InDelta(t, 0.5, cp.Importance(1, -0.3, 0.2), 1.0e-6)
+}
+
+func TestConsolSnapshot(t *testing.T) {
+	// no consolidation state is allocated when not used
+	net := newTestNet(1)
+	assert.Equal(t, 1, SynapseConsol.DimSize(0))
+	net.ConsolSnapshot()
+	assert.Equal(t, 1, net.ConsolTasks)
+
+	for _, rule := range []ConsolRules{ConsolEWC, ConsolSI} {
+		net := newTestNet(1)
+		pt := net.LayerByName("Hidden").RecvPaths[0]
+		pt.Params.Learn.Consol.Rule = rule
+		net.InitWeights()
+		assert.Equal(t, 0, net.ConsolTasks)
+		assert.Equal(t, int(pt.NSyns), SynapseConsol.DimSize(0))
+		lwt := func(syi uint32) float32 {
+			return Synapses.Value(int(pt.SynStIndex+syi), int(LWt))
+		}
+		consol := func(syi uint32, cv ConsolVars) float32 {
+			return SynapseConsol.Value(int(pt.Params.Indexes.ConsolSt+syi), int(cv))
+		}
+		for syi := range pt.NSyns {
+			assert.Equal(t, lwt(syi), consol(syi, ConsolAnchor))
+		}
+
+		runTestTrials(net, 0, 8)
+		acc := float32(0)
+		for syi := range pt.NSyns {
+			acc += math32.Abs(consol(syi, ConsolAcc))
+		}
+		assert.Greater(t, acc, float32(0))
+
+		net.ConsolSnapshot()
+		assert.Equal(t, 1, net.ConsolTasks)
+		mean, mx := pt.ConsolImportance()
+		assert.Greater(t, mx, float32(0))
+		assert.GreaterOrEqual(t, mx, mean)
+		for syi := range pt.NSyns {
+			assert.Equal(t, lwt(syi), consol(syi, ConsolAnchor))
+			assert.Equal(t, float32(0), consol(syi, ConsolAcc))
+		}
+
+		// InitWeights resets everything
+		net.InitWeights()
+		assert.Equal(t, 0, net.ConsolTasks)
+		mean, _ = pt.ConsolImportance()
+		assert.Equal(t, float32(0), mean)
+	}
+}
+
+func TestConsolProtect(t *testing.T) {
+	// with a strong pull, learning a new task moves the weights less
+	// from where they were after the first task.
+	drift := func(strength float32) float32 {
+		net := newTestNet(1)
+		pt := net.LayerByName("Hidden").RecvPaths[0]
+		pt.Params.Learn.Consol.Rule = ConsolEWC
+		pt.Params.Learn.Consol.Strength = strength
+		net.InitWeights()
+		runTestTrials(net, 0, 8)
+		net.ConsolSnapshot()
+		runTestTrials(net, 2, 8)
+		d := float32(0)
+		for syi := range pt.NSyns {
+			d += math32.Abs(Synapses.Value(int(pt.SynStIndex+syi), int(LWt)) - SynapseConsol.Value(int(pt.Params.Indexes.ConsolSt+syi), int(ConsolAnchor)))
+		}
+		return d
+	}
+	assert.Less(t, drift(1.0e6), drift(0))
+}
@@ -0,0 +1,521 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package axon
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"cogentcore.org/core/base/errors"
+	"cogentcore.org/core/enums"
+	"cogentcore.org/lab/base/mpi"
+	"github.com/emer/emergent/v2/looper"
+)
+
+// DWtComm is a communicator for data-parallel learning across multiple
+// processes, each of which learns on a different subset of the inputs,
+// and the weight changes (DWts) are summed across processes before
+// updating the weights, using [Network.AllReduceDWts].
+// [MPIComm] uses MPI, and [LocalComm] uses multiple processes
+// on the local machine, without requiring MPI.
+type DWtComm interface {
+
+	// Rank returns the rank of this process, where 0 is the root.
+	Rank() int
+
+	// Size returns the number of processes.
+	Size() int
+
+	// AllReduceF32 sums the values in orig across all processes into dest,
+	// which then has the same values in all processes.
+	// If orig is nil, the values in dest are summed in place.
+	AllReduceF32(dest, orig []float32) error
+
+	// Close closes the communicator, after which it cannot be used.
+	Close() error
+}
+
+// CommBackends are the backends for data-parallel learning
+// across multiple processes, used in [NewDWtComm].
+type CommBackends int32 //enums:enum
+
+const (
+	// NoComm runs in a single process.
+	NoComm CommBackends = iota
+
+	// CommMPI uses MPI via [MPIComm], with processes launched by mpirun.
+	CommMPI
+
+	// CommLocal uses processes on the local machine via [LocalComm],
+	// which are started automatically by [LocalInit].
+	CommLocal
+)
+
+// NewDWtComm returns a new [DWtComm] for given backend, where nprocs is
+// the number of processes to start for CommLocal (see [LocalInit]),
+// with the same command-line arguments as this process.
+// Returns nil for NoComm.
+func NewDWtComm(backend CommBackends, nprocs int) (DWtComm, error) {
+	switch backend {
+	case CommMPI:
+		cm, err := NewMPIComm()
+		if err != nil {
+			return nil, err
+		}
+		return cm, nil
+	case CommLocal:
+		lc, err := LocalInit(nprocs, nil)
+		if err != nil {
+			return nil, err
+		}
+		return lc, nil
+	}
+	return nil, nil
+}
+
+// localRank is the rank of this process when started by [LocalInit],
+// and -1 otherwise.
+var localRank = -1
+
+// CommRank returns the rank of this process for data-parallel learning
+// across processes: the rank set by [LocalInit] if it has been called,
+// and otherwise the MPI world rank. Only rank 0 should save files.
+func CommRank() int {
+	if localRank >= 0 {
+		return localRank
+	}
+	return mpi.WorldRank()
+}
+
+// CommAllocN allocates n items (e.g., input patterns) evenly across the
+// processes in comm, returning the range of items for this process.
+// If n is not an even multiple of the number of processes, an error
+// is returned, and the remaining items are not allocated.
+// Returns the full range if comm is nil.
+func CommAllocN(comm DWtComm, n int) (st, ed int, err error) {
+	if comm == nil {
+		return 0, n, nil
+	}
+	nproc := comm.Size()
+	if n%nproc != 0 {
+		err = fmt.Errorf("CommAllocN: number: %d is not an even multiple of number of processes: %d", n, nproc)
+	}
+	per := n / nproc
+	st = per * comm.Rank()
+	ed = st + per
+	return
+}
+
+// AllReduceDWts sums the weight changes across all of the data-parallel
+// processes in comm, using [Network.CollectDWts] and [Network.SetDWts]
+// with the given buffer, which is allocated on first use.
+// The activity averages that are also collected are averaged.
+// This must be called after DWt and before WtFromDWt in all processes.
+// Does nothing if comm is nil or has only one process.
+func (nt *Network) AllReduceDWts(comm DWtComm, dwts *[]float32) error {
+	if comm == nil || comm.Size() <= 1 {
+		return nil
+	}
+	nt.FunTimerStart("AllReduceDWts")
+	defer nt.FunTimerStop("AllReduceDWts")
+	nt.CollectDWts(dwts)
+	if err := comm.AllReduceF32(*dwts, nil); err != nil {
+		return err
+	}
+	nt.SetDWts(*dwts, comm.Size())
+	return nil
+}
+
+// LooperDataParallel replaces the UpdateWeights function added by
+// [LooperStandard] in the trainMode stack with one that calls
+// [Network.AllReduceDWts] with given comm and buffer between DWt
+// and WtFromDWt, for data-parallel learning across processes.
+// If there is an error in communicating, it is logged and the
+// trainMode stack is stopped at the trial level.
+func LooperDataParallel(ls *looper.Stacks, net *Network, viewFunc func(mode enums.Enum) *NetViewUpdate, comm DWtComm, dwts *[]float32, cycle, trial, trainMode enums.Enum) {
+	st := ls.Stacks[trainMode]
+	update := func() bool {
+		net.DWt()
+		if view := viewFunc(trainMode); view != nil && view.IsViewingSynapse() {
+			view.RecordSyns()
+		}
+		if err := net.AllReduceDWts(comm, dwts); errors.Log(err) != nil {
+			ls.Stop(trial)
+			return true
+		}
+		net.WtFromDWt()
+		return true
+	}
+	replace := func(funcs *looper.NamedFuncs) bool {
+		i, err := funcs.FuncIndex("UpdateWeights")
+		if err != nil {
+			return false
+		}
+		(*funcs)[i] = looper.NamedFunc{Name: "UpdateWeights", Func: update}
+		return true
+	}
+	if replace(&st.Loops[trial].OnEnd) {
+		return
+	}
+	for _, ev := range st.Loops[cycle].Events {
+		if replace(&ev.OnEvent) {
+			return
+		}
+	}
+}
+
+//////// MPIComm
+
+// MPIComm is a [DWtComm] using MPI, for processes launched with mpirun.
+// The sim must be built with the mpi build tag to actually use MPI,
+// and otherwise it runs as a single process.
+type MPIComm struct {
+	*mpi.Comm
+}
+
+// NewMPIComm initializes MPI and returns a new [MPIComm]
+// for all of the MPI processes.
+func NewMPIComm() (*MPIComm, error) {
+	mpi.Init()
+	cm, err := mpi.NewComm(nil) // use all procs
+	if err != nil {
+		return nil, err
+	}
+	return &MPIComm{Comm: cm}, nil
+}
+
+// AllReduceF32 sums the values in orig across all processes into dest.
+func (cm *MPIComm) AllReduceF32(dest, orig []float32) error {
+	return cm.Comm.AllReduceF32(mpi.OpSum, dest, orig)
+}
+
+// Close finalizes MPI.
+func (cm *MPIComm) Close() error {
+	mpi.Finalize()
+	return nil
+}
+
+//////// LocalComm
+
+// Environment variables used by [LocalInit] to start worker processes.
+const (
+	LocalRankEnv = "AXON_LOCAL_RANK"
+	LocalSizeEnv = "AXON_LOCAL_SIZE"
+	LocalAddrEnv = "AXON_LOCAL_ADDR"
+)
+
+// LocalComm is a [DWtComm] for processes on the local machine,
+// which communicate over a Unix domain socket, without requiring MPI.
+// The root process (rank 0) listens on the socket and each of the other
+// processes connects to it. In AllReduceF32, the root process sums
+// the values from all processes, in rank order so that the results
+// are deterministic, and sends the sum back to each process.
+// Use [LocalInit] to start all of the processes from a single command,
+// or [NewLocalComm] to connect processes started in other ways.
+type LocalComm struct {
+
+	// Addr is the path of the Unix domain socket of the root process.
+	Addr string
+
+	// rank of this process.
+	rank int
+
+	// size is the number of processes.
+	size int
+
+	// listener of the root process.
+	listener net.Listener
+
+	// conns are the connections to each other process for the root,
+	// indexed by rank, and just conns[0] to the root for other processes.
+	conns []net.Conn
+
+	// bufs are the encoding buffers for each connection.
+	bufs [][]byte
+
+	// vals are the values received from each process by the root.
+	vals [][]float32
+
+	// procs are the worker processes started by [LocalInit].
+	procs []*exec.Cmd
+}
+
+// LocalSocketAddr returns a default socket path for [NewLocalComm],
+// in the temporary directory, for given name.
+func LocalSocketAddr(name string) string {
+	return filepath.Join(os.TempDir(), fmt.Sprintf("axon-%s-%d.sock", name, os.Getpid()))
+}
+
+// NewLocalComm returns a new [LocalComm] for given rank out of size
+// processes, using the Unix domain socket at given path. The root
+// process (rank 0) creates the socket and waits for all of the other
+// processes to connect, and the other processes retry connecting
+// until the root is ready, in both cases up to given timeout.
+func NewLocalComm(addr string, rank, size int, timeout time.Duration) (*LocalComm, error) {
+	if size < 1 || rank < 0 || rank >= size {
+		return nil, fmt.Errorf("NewLocalComm: invalid rank: %d for size: %d", rank, size)
+	}
+	lc := &LocalComm{Addr: addr, rank: rank, size: size}
+	if size == 1 {
+		return lc, nil
+	}
+	deadline := time.Now().Add(timeout)
+	if rank > 0 {
+		return lc, lc.connect(deadline)
+	}
+	os.Remove(addr) // in case left over from a crashed run
+	ln, err := net.Listen("unix", addr)
+	if err != nil {
+		return nil, err
+	}
+	lc.listener = ln
+	return lc, lc.accept(deadline)
+}
+
+// connect connects a worker process to the root.
+func (lc *LocalComm) connect(deadline time.Time) error {
+	for {
+		conn, err := net.DialTimeout("unix", lc.Addr, time.Until(deadline))
+		if err == nil {
+			lc.conns = []net.Conn{conn}
+			lc.bufs = make([][]byte, 1)
+			var hdr [8]byte
+			binary.LittleEndian.PutUint32(hdr[:], uint32(lc.rank))
+			binary.LittleEndian.PutUint32(hdr[4:], uint32(lc.size))
+			_, err = conn.Write(hdr[:])
+			return err
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("LocalComm: rank %d could not connect to %s: %w", lc.rank, lc.Addr, err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// accept has the root accept connections from all of the other processes.
+func (lc *LocalComm) accept(deadline time.Time) error {
+	lc.conns = make([]net.Conn, lc.size)
+	lc.bufs = make([][]byte, lc.size)
+	lc.vals = make([][]float32, lc.size)
+	lc.listener.(*net.UnixListener).SetDeadline(deadline)
+	for range lc.size - 1 {
+		conn, err := lc.listener.Accept()
+		if err != nil {
+			lc.Close()
+			return fmt.Errorf("LocalComm: waiting for processes to connect to %s: %w", lc.Addr, err)
+		}
+		var hdr [8]byte
+		if _, err := io.ReadFull(conn, hdr[:]); err != nil {
+			conn.Close()
+			lc.Close()
+			return err
+		}
+		rank := int(binary.LittleEndian.Uint32(hdr[:]))
+		size := int(binary.LittleEndian.Uint32(hdr[4:]))
+		if size != lc.size || rank <= 0 || rank >= lc.size || lc.conns[rank] != nil {
+			conn.Close()
+			lc.Close()
+			return fmt.Errorf("LocalComm: invalid connection from rank: %d of size: %d, for size: %d", rank, size, lc.size)
+		}
+		lc.conns[rank] = conn
+	}
+	return nil
+}
+
+// LocalInit initializes data-parallel learning across n processes on the
+// local machine, returning the [LocalComm] for this process.
+// In the initial process, which becomes the root (rank 0), it starts n-1
+// copies of the current executable with given command-line arguments
+// (os.Args[1:] if nil), and the [LocalRankEnv] etc environment variables,
+// which cause LocalInit to connect these as the other ranks.
+// The standard output of the other processes is discarded, so that
+// only the root prints messages, while the standard error is shared.
+// [CommRank] returns the rank of this process after this call.
+// [LocalComm.Close] waits for all of the other processes to exit.
+func LocalInit(n int, args []string) (*LocalComm, error) {
+	timeout := time.Minute
+	if rs := os.Getenv(LocalRankEnv); rs != "" {
+		rank, err := strconv.Atoi(rs)
+		if err != nil {
+			return nil, err
+		}
+		size, err := strconv.Atoi(os.Getenv(LocalSizeEnv))
+		if err != nil {
+			return nil, err
+		}
+		localRank = rank
+		return NewLocalComm(os.Getenv(LocalAddrEnv), rank, size, timeout)
+	}
+	if n <= 1 {
+		return NewLocalComm("", 0, 1, timeout)
+	}
+	exe, err := os.Executable()
+	if err != nil {
+		return nil, err
+	}
+	if args == nil {
+		args = os.Args[1:]
+	}
+	addr := LocalSocketAddr(filepath.Base(exe))
+	var procs []*exec.Cmd
+	for rank := 1; rank < n; rank++ {
+		cmd := exec.Command(exe, args...)
+		cmd.Env = append(os.Environ(), LocalRankEnv+"="+strconv.Itoa(rank), LocalSizeEnv+"="+strconv.Itoa(n), LocalAddrEnv+"="+addr)
+		cmd.Stderr = os.Stderr
+		if err := cmd.Start(); err != nil {
+			for _, p := range procs {
+				p.Process.Kill()
+				p.Wait()
+			}
+			return nil, err
+		}
+		procs = append(procs, cmd)
+	}
+	localRank = 0
+	lc, err := NewLocalComm(addr, 0, n, timeout)
+	if err != nil {
+		for _, p := range procs {
+			p.Process.Kill()
+			p.Wait()
+		}
+		return nil, err
+	}
+	lc.procs = procs
+	return lc, nil
+}
+
+// Rank returns the rank of this process, where 0 is the root.
+func (lc *LocalComm) Rank() int { return lc.rank }
+
+// Size returns the number of processes.
+func (lc *LocalComm) Size() int { return lc.size }
+
+// AllReduceF32 sums the values in orig across all processes into dest.
+// If orig is nil, the values in dest are summed in place.
+// All processes must call this with the same number of values.
+func (lc *LocalComm) AllReduceF32(dest, orig []float32) error {
+	if orig == nil {
+		orig = dest
+	}
+	if len(orig) != len(dest) {
+		return fmt.Errorf("LocalComm.AllReduceF32: len of dest: %d != orig: %d", len(dest), len(orig))
+	}
+	if lc.size == 1 {
+		copy(dest, orig)
+		return nil
+	}
+	if lc.rank > 0 {
+		if err := lc.send(0, orig); err != nil {
+			return err
+		}
+		return lc.recv(0, dest)
+	}
+	n := len(dest)
+	for r := range lc.size {
+		if len(lc.vals[r]) != n {
+			lc.vals[r] = make([]float32, n)
+		}
+	}
+	if err := lc.each(func(r int) error { return lc.recv(r, lc.vals[r]) }); err != nil {
+		return err
+	}
+	sum := lc.vals[0]
+	copy(sum, orig)
+	for r := 1; r < lc.size; r++ {
+		for i, v := range lc.vals[r] {
+			sum[i] += v
+		}
+	}
+	if err := lc.each(func(r int) error { return lc.send(r, sum) }); err != nil {
+		return err
+	}
+	copy(dest, sum)
+	return nil
+}
+
+// each calls fun in parallel for each of the other processes,
+// for the root, returning any errors.
+func (lc *LocalComm) each(fun func(r int) error) error {
+	errs := make([]error, lc.size)
+	var wg sync.WaitGroup
+	for r := 1; r < lc.size; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[r] = fun(r)
+		}()
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// send sends the values over the connection at index ci.
+func (lc *LocalComm) send(ci int, vals []float32) error {
+	n := 8 + 4*len(vals)
+	if cap(lc.bufs[ci]) < n {
+		lc.bufs[ci] = make([]byte, n)
+	}
+	b := lc.bufs[ci][:n]
+	binary.LittleEndian.PutUint64(b, uint64(len(vals)))
+	for i, v := range vals {
+		binary.LittleEndian.PutUint32(b[8+4*i:], math.Float32bits(v))
+	}
+	_, err := lc.conns[ci].Write(b)
+	return err
+}
+
+// recv receives values over the connection at index ci,
+// which must have the same length as vals.
+func (lc *LocalComm) recv(ci int, vals []float32) error {
+	var hdr [8]byte
+	if _, err := io.ReadFull(lc.conns[ci], hdr[:]); err != nil {
+		return fmt.Errorf("LocalComm: rank %d receiving from connection %d: %w", lc.rank, ci, err)
+	}
+	if nv := int(binary.LittleEndian.Uint64(hdr[:])); nv != len(vals) {
+		return fmt.Errorf("LocalComm: rank %d received %d values from connection %d, expected %d", lc.rank, nv, ci, len(vals))
+	}
+	n := 4 * len(vals)
+	if cap(lc.bufs[ci]) < n {
+		lc.bufs[ci] = make([]byte, n)
+	}
+	b := lc.bufs[ci][:n]
+	if _, err := io.ReadFull(lc.conns[ci], b); err != nil {
+		return err
+	}
+	for i := range vals {
+		vals[i] = math.Float32frombits(binary.LittleEndian.Uint32(b[4*i:]))
+	}
+	return nil
+}
+
+// Close closes the connections, and for the root, the socket,
+// and waits for any processes started by [LocalInit] to exit.
+func (lc *LocalComm) Close() error {
+	var errs []error
+	for _, c := range lc.conns {
+		if c != nil {
+			errs = append(errs, c.Close())
+		}
+	}
+	lc.conns = nil
+	if lc.listener != nil {
+		errs = append(errs, lc.listener.Close())
+		lc.listener = nil
+	}
+	for _, p := range lc.procs {
+		errs = append(errs, p.Wait())
+	}
+	lc.procs = nil
+	return errors.Join(errs...)
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package axon
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testAllReduce runs an AllReduceF32 with rank-specific values in lc,
+// checking that the result is the sum over ranks.
+func testAllReduce(t *testing.T, lc *LocalComm) {
+	n := 1000
+	vals := make([]float32, n)
+	for i := range vals {
+		vals[i] = float32(lc.Rank()*n + i)
+	}
+	dest := make([]float32, n)
+	assert.NoError(t, lc.AllReduceF32(dest, vals))
+	sz := lc.Size()
+	for i, v := range dest {
+		if exp := float32(n*sz*(sz-1)/2 + sz*i); v != exp {
+			t.Fatalf("rank: %d item: %d value: %g != %g", lc.Rank(), i, v, exp)
+		}
+	}
+	// in place
+	assert.NoError(t, lc.AllReduceF32(vals, nil))
+	assert.Equal(t, dest, vals)
+}
+
+func TestLocalComm(t *testing.T) {
+	addr := filepath.Join(t.TempDir(), "test.sock")
+	size := 4
+	var wg sync.WaitGroup
+	for rank := range size {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			lc, err := NewLocalComm(addr, rank, size, 10*time.Second)
+			if !assert.NoError(t, err) {
+				return
+			}
+			defer lc.Close()
+			testAllReduce(t, lc)
+			if rank == 1 {
+				assert.Error(t, lc.AllReduceF32(make([]float32, 3), make([]float32, 2)))
+			}
+		}()
+	}
+	wg.Wait()
+
+	_, err := NewLocalComm(addr, 2, 2, time.Second)
+	assert.Error(t, err)
+	_, err = NewLocalComm(addr, 1, 2, 50*time.Millisecond)
+	assert.Error(t, err)
+}
+
+// TestLocalInit starts worker processes running this test.
+func TestLocalInit(t *testing.T) {
+	if testing.Short() {
+		t.Skip("starts processes")
+	}
+	if os.Getenv(LocalRankEnv) == "" {
+		defer func() { localRank = -1 }()
+	}
+	lc, err := LocalInit(3, []string{"-test.run=^TestLocalInit$"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, 3, lc.Size())
+	assert.Equal(t, lc.Rank(), CommRank())
+	testAllReduce(t, lc)
+	assert.NoError(t, lc.Close())
+}
+
+func TestCommAllocN(t *testing.T) {
+	lc := &LocalComm{rank: 2, size: 4}
+	st, ed, err := CommAllocN(lc, 24)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{12, 18}, []int{st, ed})
+	_, _, err = CommAllocN(lc, 25)
+	assert.Error(t, err)
+	st, ed, err = CommAllocN(nil, 25)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 25}, []int{st, ed})
+}
+
+// doubleComm is a DWtComm for two identical processes.
+type doubleComm struct{}
+
+func (dc *doubleComm) Rank() int    { return 0 }
+func (dc *doubleComm) Size() int    { return 2 }
+func (dc *doubleComm) Close() error { return nil }
+func (dc *doubleComm) AllReduceF32(dest, orig []float32) error {
+	if orig == nil {
+		orig = dest
+	}
+	for i, v := range orig {
+		dest[i] = 2 * v
+	}
+	return nil
+}
+
+func TestAllReduceDWts(t *testing.T) {
+	net := newTestNetFull(1)
+	runTestTrials(net, 0, 2)
+	net.DWt()
+	var dwts0, dwts1, buf []float32
+	net.CollectDWts(&dwts0)
+	assert.NoError(t, net.AllReduceDWts(&doubleComm{}, &buf))
+	net.CollectDWts(&dwts1)
+
+	// DWts are summed and activity averages are averaged
+	ndouble := 0
+	for i, v := range dwts0 {
+		if v != 0 && dwts1[i] == 2*v {
+			ndouble++
+		} else {
+			assert.Equal(t, v, dwts1[i])
+		}
+	}
+	assert.Greater(t, ndouble, 0)
+	assert.Equal(t, dwts0[0], dwts1[0]) // ActMAvg
+
+	assert.NoError(t, net.AllReduceDWts(nil, &buf))
+}
@@ -52,6 +52,9 @@ func (net *Network) AddPulvLayer4D(name string, nPoolsY, nPoolsX, nNeurY, nNeurX
 // and NO Pulv Pulvinar.
 // CT is placed Behind Super.
 func (net *Network) AddSuperCT2D(name, pathClass string, shapeY, shapeX int, space float32, pat paths.Pattern) (super, ct *Layer) {
+	sb := net.startSpecBuilder(&LayerSpec{Name: name, Shape: []int{shapeY, shapeX}, Builder: &BuilderSpec{Type: BuildSuperCT, Space: space, PathClass: pathClass}})
+	defer net.endSpecBuilder(sb)
+	sb.setPattern(pat)
 	super = net.AddSuperLayer2D(name, shapeY, shapeX)
 	ct = net.AddCTLayer2D(name+"CT", shapeY, shapeX)
 	ct.PlaceBehind(super, space)
@@ -66,6 +69,9 @@ func (net *Network) AddSuperCT2D(name, pathClass string, shapeY, shapeX int, spa
 // and NO Pulv Pulvinar.
 // CT is placed Behind Super.
 func (net *Network) AddSuperCT4D(name, pathClass string, nPoolsY, nPoolsX, nNeurY, nNeurX int, space float32, pat paths.Pattern) (super, ct *Layer) {
+	sb := net.startSpecBuilder(&LayerSpec{Name: name, Shape: []int{nPoolsY, nPoolsX, nNeurY, nNeurX}, Builder: &BuilderSpec{Type: BuildSuperCT, Space: space, PathClass: pathClass}})
+	defer net.endSpecBuilder(sb)
+	sb.setPattern(pat)
 	super = net.AddSuperLayer4D(name, nPoolsY, nPoolsX, nNeurY, nNeurX)
 	ct = net.AddCTLayer4D(name+"CT", nPoolsY, nPoolsX, nNeurY, nNeurX)
 	ct.PlaceBehind(super, space)
@@ -81,6 +87,7 @@ func (net *Network) AddSuperCT4D(name, pathClass string, nPoolsY, nPoolsX, nNeur
 // Pulvinar is positioned behind the CT layer.
 func (net *Network) AddPulvForSuper(super *Layer, space float32) *Layer {
 	name := super.Name
+	defer net.endSpecBuilder(net.startSpecBuilder(&LayerSpec{Name: name, Builder: &BuilderSpec{Type: BuildPulvForSuper, Space: space}}))
 	shp := super.Shape
 	var plv *Layer
 	if shp.NumDims() == 2 {
@@ -168,6 +175,7 @@ func (net *Network) ConnectSuperToCT(send, recv *Layer, pat paths.Pattern, pathC
 // The Input layer is set as the Driver of the Layer.
 // Both layers have SetClass(name) called to allow shared params.
 func (net *Network) AddInputPulv2D(name string, nNeurY, nNeurX int, space float32) (*Layer, *Layer) {
+	defer net.endSpecBuilder(net.startSpecBuilder(&LayerSpec{Name: name, Shape: []int{nNeurY, nNeurX}, Builder: &BuilderSpec{Type: BuildInputPulv, Space: space}}))
 	in := net.AddLayer2D(name, InputLayer, nNeurY, nNeurX)
 	pulv := net.AddPulvLayer2D(name+"P", nNeurY, nNeurX)
 	pulv.SetBuildConfig("DriveLayName", name)
@@ -181,6 +189,7 @@ func (net *Network) AddInputPulv2D(name string, nNeurY, nNeurX int, space float3
 // The Input layer is set as the Driver of the Layer.
 // Both layers have SetClass(name) called to allow shared params.
 func (net *Network) AddInputPulv4D(name string, nPoolsY, nPoolsX, nNeurY, nNeurX int, space float32) (*Layer, *Layer) {
+	defer net.endSpecBuilder(net.startSpecBuilder(&LayerSpec{Name: name, Shape: []int{nPoolsY, nPoolsX, nNeurY, nNeurX}, Builder: &BuilderSpec{Type: BuildInputPulv, Space: space}}))
 	in := net.AddLayer4D(name, InputLayer, nPoolsY, nPoolsX, nNeurY, nNeurX)
 	pulv := net.AddPulvLayer4D(name+"P", nPoolsY, nPoolsX, nNeurY, nNeurX)
 	pulv.SetBuildConfig("DriveLayName", name)
@@ -431,6 +440,7 @@ func (net *Network) AddPTPredLayer(ptMaint, ct *Layer, ptToPredPath, ctToPredPat
 // use, e.g., pfcCT.AddDefaultParams(func (ly *LayerParams) {ly.Inhib.Layer.Gi = 2.8} )
 // to change default params.
 func (net *Network) AddPFC4D(name, thalSuffix string, nPoolsY, nPoolsX, nNeurY, nNeurX int, decayOnRew, selfMaint bool, space float32) (pfc, pfcCT, pfcPT, pfcPTp, pfcThal *Layer) {
+	defer net.endSpecBuilder(net.startSpecBuilder(&LayerSpec{Name: name, Shape: []int{nPoolsY, nPoolsX, nNeurY, nNeurX}, Builder: &BuilderSpec{Type: BuildPFC, Space: space, ThalSuffix: thalSuffix, DecayOnRew: decayOnRew, SelfMaint: selfMaint}}))
 	p1to1 := paths.NewPoolOneToOne()
 	// p1to1rnd := paths.NewPoolUniformRand()
 	// p1to1rnd.PCon = 0.5
@@ -512,6 +522,7 @@ func (net *Network) AddPFC4D(name, thalSuffix string, nPoolsY, nPoolsX, nNeurY,
 // instead of lateral connections.
 // CT layer uses the Medium timescale params.
 func (net *Network) AddPFC2D(name, thalSuffix string, nNeurY, nNeurX int, decayOnRew, selfMaint bool, space float32) (pfc, pfcCT, pfcPT, pfcPTp, pfcThal *Layer) {
+	defer net.endSpecBuilder(net.startSpecBuilder(&LayerSpec{Name: name, Shape: []int{nNeurY, nNeurX}, Builder: &BuilderSpec{Type: BuildPFC, Space: space, ThalSuffix: thalSuffix, DecayOnRew: decayOnRew, SelfMaint: selfMaint}}))
 	one2one := paths.NewOneToOne()
 	full := paths.NewFull()
 	// rnd := paths.NewUniformRand()
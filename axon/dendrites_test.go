@@ -0,0 +1,166 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package axon
+
+import (
+	"testing"
+
+	"github.com/emer/emergent/v2/etime"
+	"github.com/emer/emergent/v2/paths"
+	"github.com/stretchr/testify/assert"
+)
+
+// newDendTestNet returns the standard test network with given number of
+// dendritic compartments in the Hidden layer, and the Input to Hidden
+// pathway targeting given compartment.
+func newDendTestNet(dendComps int, inComp int32, gAxial float32) *Network {
+	testNet := NewNetwork("testNetDend")
+	testNet.SetRandSeed(42)
+	testNet.SetMaxData(1)
+
+	inLay := testNet.AddLayer("Input", InputLayer, 4, 1)
+	hidLay := testNet.AddLayer("Hidden", SuperLayer, 4, 1)
+	outLay := testNet.AddLayer("Output", TargetLayer, 4, 1)
+	hidLay.DendComps = dendComps
+
+	one2one := paths.NewOneToOne()
+	inPath := testNet.ConnectLayers(inLay, hidLay, one2one, ForwardPath)
+	testNet.ConnectLayers(hidLay, outLay, one2one, ForwardPath)
+	testNet.ConnectLayers(outLay, hidLay, one2one, BackPath)
+
+	testNet.Rubicon.SetNUSs(4, 3)
+	testNet.Rubicon.Defaults()
+
+	testNet.Build()
+	testNet.Defaults()
+	ApplyParamSheets(testNet, layerParams["Base"], pathParams["Base"])
+	hidLay.Params.Acts.Dend.GAxial = gAxial
+	inPath.Params.DendComp = inComp
+	testNet.InitWeights()
+	testNet.ThetaCycleStart(etime.Train, false)
+	return testNet
+}
+
+func TestDendCompsSingle(t *testing.T) {
+	net := newDendTestNet(0, 0, 10)
+	runTestTrials(net, 0, 4)
+
+	// a pathway targeting a compartment that does not exist reverts to 0,
+	// and a single compartment is identical to the default.
+	oneNet := newDendTestNet(1, 1, 10)
+	assert.Equal(t, uint32(0), oneNet.LayerByName("Hidden").Params.Indexes.DendN)
+	runTestTrials(oneNet, 0, 4)
+	assert.Equal(t, net.WeightsHash(), oneNet.WeightsHash())
+}
+
+// dendPeakVms returns the peak VmDend and DendVm of the additional compartments
+// for the first Hidden neuron, over the first nCyc cycles of a trial.
+func dendPeakVms(net *Network, nCyc int) []float32 {
+	inLay := net.LayerByName("Input")
+	hid := net.LayerByName("Hidden")
+	net.ThetaCycleStart(etime.Train, false)
+	net.MinusPhaseStart()
+	net.InitExt()
+	inLay.ApplyExt(0, newInPats().SubSpace(0))
+	net.ApplyExts()
+	ni := hid.NeurStIndex
+	dn := hid.Params.Indexes.DendN
+	peaks := make([]float32, dn+1)
+	for i := range peaks {
+		peaks[i] = -100
+	}
+	for range nCyc {
+		net.Cycle(false)
+		peaks[0] = max(peaks[0], Neurons.Value(int(ni), 0, int(VmDend)))
+		for dc := uint32(1); dc <= dn; dc++ {
+			dci := hid.Params.DendIndex(ni, dc)
+			peaks[dc] = max(peaks[dc], Dendrites.Value(int(dci), 0, int(DendVm)))
+		}
+	}
+	return peaks
+}
+
+func TestDendCompsDistal(t *testing.T) {
+	net := newDendTestNet(3, 2, 10)
+	assert.Equal(t, uint32(2), net.LayerByName("Hidden").Params.Indexes.DendN)
+	assert.Equal(t, 4*2, net.Dendrites.DimSize(0))
+	peaks := dendPeakVms(net, 50)
+	// input arrives at the distal compartment and attenuates toward the soma
+	assert.Greater(t, peaks[2], peaks[1])
+
+	// without axial coupling, distal input does not spread toward the soma,
+	// and the isolated distal compartment is more depolarized.
+	isoNet := newDendTestNet(3, 2, 0)
+	isoPeaks := dendPeakVms(isoNet, 50)
+	assert.Greater(t, peaks[1], isoPeaks[1])
+	assert.Greater(t, peaks[0], isoPeaks[0])
+	assert.Greater(t, isoPeaks[2], peaks[2])
+}
+
+// TestDendCompsSteadyState checks the two-compartment steady state of the
+// first additional compartment against the analytic solution, with VmDend
+// held fixed, and that the axial current flows only into VmDend.
+func TestDendCompsSteadyState(t *testing.T) {
+	net := newDendTestNet(2, 1, 10)
+	hid := net.LayerByName("Hidden")
+	ly := hid.Params
+	ctx := net.Context()
+	ni := hid.NeurStIndex
+	dci := ly.DendIndex(ni, 1)
+	for _, vr := range []NeuronVars{Gi, SSGiDend, GgabaB} {
+		Neurons.Set(0, int(ni), 0, int(vr))
+	}
+	ge := float32(0.2)
+	Dendrites.Set(ge, int(dci), 0, int(DendGeSyn))
+	Dendrites.Set(0, int(dci), 0, int(DendGnmda))
+	Dendrites.Set(0, int(dci), 0, int(DendGvgcc))
+
+	v0 := float32(-50)
+	vm := Neurons.Value(int(ni), 0, int(Vm))
+	for range 2000 {
+		Neurons.Set(v0, int(ni), 0, int(VmDend))
+		ly.DendVmFromG(ctx, ni, 0)
+	}
+	ac := &ly.Acts
+	gax := ac.Dend.GAxial
+	gE := ge * ac.Gbar.E
+	gL := ac.Gbar.L
+	v1 := (gE*ac.Erev.E + gL*ac.Erev.L + gax*v0) / (gE + gL + gax)
+	assert.InDelta(t, v1, Dendrites.Value(int(dci), 0, int(DendVm)), 1.0e-3)
+
+	// VmDend receives one axial current step, and Vm is unaffected.
+	Neurons.Set(v0, int(ni), 0, int(VmDend))
+	ly.DendVmFromG(ctx, ni, 0)
+	vmd := ac.VmFromInet(v0, ac.Dt.VmDendDt, gax*(v1-v0))
+	assert.InDelta(t, vmd, Neurons.Value(int(ni), 0, int(VmDend)), 1.0e-3)
+	assert.Equal(t, vm, Neurons.Value(int(ni), 0, int(Vm)))
+}
+
+// TestDendDecayLearnCa checks that the learning Ca variables of the
+// additional compartments are only decayed by Decay.LearnCa, as for the soma.
+func TestDendDecayLearnCa(t *testing.T) {
+	net := newDendTestNet(2, 1, 10)
+	hid := net.LayerByName("Hidden")
+	ly := hid.Params
+	ctx := net.Context()
+	ni := hid.NeurStIndex
+	dci := ly.DendIndex(ni, 1)
+	caVars := []DendVars{DendGnmdaLrn, DendNmdaCa, DendVgccCa}
+	decayCa := func() {
+		for _, vr := range caVars {
+			Dendrites.Set(1, int(dci), 0, int(vr))
+		}
+		ly.DendDecayState(ctx, ni, 0, 1, 1)
+	}
+	decayCa()
+	for _, vr := range caVars {
+		assert.Equal(t, float32(1), Dendrites.Value(int(dci), 0, int(vr)))
+	}
+	ly.Acts.Decay.LearnCa = 0.5
+	decayCa()
+	for _, vr := range caVars {
+		assert.Equal(t, float32(0.5), Dendrites.Value(int(dci), 0, int(vr)))
+	}
+}
@@ -6,20 +6,20 @@ import (
 	"cogentcore.org/core/enums"
 )
 
-var _PathGTypesValues = []PathGTypes{0, 1, 2, 3, 4}
+var _PathGTypesValues = []PathGTypes{0, 1, 2, 3, 4, 5}
 
 // PathGTypesN is the highest valid value for type PathGTypes, plus one.
 //
 //gosl:start
-const PathGTypesN PathGTypes = 5
+const PathGTypesN PathGTypes = 6
 
 //gosl:end
 
-var _PathGTypesValueMap = map[string]PathGTypes{`ExcitatoryG`: 0, `InhibitoryG`: 1, `ModulatoryG`: 2, `MaintG`: 3, `ContextG`: 4}
+var _PathGTypesValueMap = map[string]PathGTypes{`ExcitatoryG`: 0, `InhibitoryG`: 1, `ModulatoryG`: 2, `MaintG`: 3, `ContextG`: 4, `DendInhibitoryG`: 5}
 
-var _PathGTypesDescMap = map[PathGTypes]string{0: `Excitatory pathways drive Ge conductance on receiving neurons, which send to GiRaw and GiSyn neuron variables.`, 1: `Inhibitory pathways drive Gi inhibitory conductance, which send to GiRaw and GiSyn neuron variables.`, 2: `Modulatory pathways have a multiplicative effect on other inputs, which send to GModRaw and GModSyn neuron variables.`, 3: `Maintenance pathways drive unique set of NMDA channels that support strong active maintenance abilities. Send to GMaintRaw and GMaintSyn neuron variables.`, 4: `Context pathways are for inputs to CT layers, which update only at the end of the plus phase, and send to CtxtGe.`}
+var _PathGTypesDescMap = map[PathGTypes]string{0: `Excitatory pathways drive Ge conductance on receiving neurons, which send to GiRaw and GiSyn neuron variables.`, 1: `Inhibitory pathways drive Gi inhibitory conductance, which send to GiRaw and GiSyn neuron variables.`, 2: `Modulatory pathways have a multiplicative effect on other inputs, which send to GModRaw and GModSyn neuron variables.`, 3: `Maintenance pathways drive unique set of NMDA channels that support strong active maintenance abilities. Send to GMaintRaw and GMaintSyn neuron variables.`, 4: `Context pathways are for inputs to CT layers, which update only at the end of the plus phase, and send to CtxtGe.`, 5: `DendInhibitoryG pathways drive GABA-A inhibition on the dendrite (VmDend) instead of the soma, as from SST+ interneurons. Send to the GiDendSyn neuron variable, which is added to SSGiDend.`}
 
-var _PathGTypesMap = map[PathGTypes]string{0: `ExcitatoryG`, 1: `InhibitoryG`, 2: `ModulatoryG`, 3: `MaintG`, 4: `ContextG`}
+var _PathGTypesMap = map[PathGTypes]string{0: `ExcitatoryG`, 1: `InhibitoryG`, 2: `ModulatoryG`, 3: `MaintG`, 4: `ContextG`, 5: `DendInhibitoryG`}
 
 // String returns the string representation of this PathGTypes value.
 func (i PathGTypes) String() string { return enums.String(i, _PathGTypesMap) }
@@ -64,7 +64,7 @@ const GlobalScalarVarsN GlobalScalarVars = 58
 
 var _GlobalScalarVarsValueMap = map[string]GlobalScalarVars{`GvRew`: 0, `GvHasRew`: 1, `GvRewPred`: 2, `GvPrevPred`: 3, `GvHadRew`: 4, `GvDA`: 5, `GvDAtonic`: 6, `GvACh`: 7, `GvNE`: 8, `GvSer`: 9, `GvAChRaw`: 10, `GvGoalMaint`: 11, `GvVSMatrixJustGated`: 12, `GvVSMatrixHasGated`: 13, `GvCuriosityPoolGated`: 14, `GvTime`: 15, `GvEffort`: 16, `GvUrgencyRaw`: 17, `GvUrgency`: 18, `GvHasPosUS`: 19, `GvHadPosUS`: 20, `GvNegUSOutcome`: 21, `GvHadNegUSOutcome`: 22, `GvPVposSum`: 23, `GvPVpos`: 24, `GvPVnegSum`: 25, `GvPVneg`: 26, `GvPVposEst`: 27, `GvPVposVar`: 28, `GvPVnegEst`: 29, `GvPVnegVar`: 30, `GvGoalDistEst`: 31, `GvGoalDistPrev`: 32, `GvProgressRate`: 33, `GvGiveUpUtility`: 34, `GvContUtility`: 35, `GvGiveUpTiming`: 36, `GvContTiming`: 37, `GvGiveUpProgress`: 38, `GvContProgress`: 39, `GvGiveUpSum`: 40, `GvContSum`: 41, `GvGiveUpProb`: 42, `GvGiveUp`: 43, `GvGaveUp`: 44, `GvVSPatchPos`: 45, `GvVSPatchPosThr`: 46, `GvVSPatchPosRPE`: 47, `GvVSPatchPosSum`: 48, `GvVSPatchPosPrev`: 49, `GvVSPatchPosVar`: 50, `GvLHbDip`: 51, `GvLHbBurst`: 52, `GvLHbPVDA`: 53, `GvCeMpos`: 54, `GvCeMneg`: 55, `GvVtaDA`: 56, `GvSynCaWts`: 57}
 
-var _GlobalScalarVarsDescMap = map[GlobalScalarVars]string{0: `Rew is the external reward value. Must also set HasRew flag when Rew is set, otherwise it is ignored. This is computed by the Rubicon algorithm from US inputs set by Net.Rubicon methods, and can be directly set in simpler RL cases.`, 1: `HasRew must be set to true (1) when an external reward / US input is present, otherwise Rew is ignored. This is also set when Rubicon BOA model gives up. This drives ACh release in the Rubicon model.`, 2: `RewPred is the reward prediction, computed by a special reward prediction layer, e.g., the VSPatch layer in the Rubicon algorithm.`, 3: `PrevPred is previous time step reward prediction, e.g., for TDPredLayer`, 4: `HadRew is HasRew state from the previous trial, copied from HasRew in NewState. Used for updating Effort, Urgency at start of new trial.`, 5: `DA is phasic dopamine that drives learning moreso than performance, representing reward prediction error, signaled as phasic increases or decreases in activity relative to a tonic baseline, which is represented by a value of 0. Released by the VTA (ventral tegmental area), or SNc (substantia nigra pars compacta).`, 6: `DAtonic is tonic dopamine, which has modulatory instead of learning effects. Increases can drive greater propensity to engage in activities by biasing Go vs No pathways in the basal ganglia, for example as a function of Urgency.`, 7: `ACh is acetylcholine, activated by salient events, particularly at the onset of a reward / punishment outcome (US), or onset of a conditioned stimulus (CS). Driven by BLA -&gt; PPtg that detects changes in BLA activity, via LDTLayer type.`, 8: `NE is norepinepherine -- not yet in use`, 9: `Ser is serotonin -- not yet in use`, 10: `AChRaw is raw ACh value used in updating global ACh value by LDTLayer.`, 11: `GoalMaint is the normalized (0-1) goal maintenance activity, set in ApplyRubicon function at start of trial. Drives top-down inhibition of LDT layer / ACh activity.`, 12: `VSMatrixJustGated is VSMatrix just gated (to engage goal maintenance in PFC areas), set at end of plus phase. This excludes any gating happening at time of US.`, 13: `VSMatrixHasGated is VSMatrix has gated since the last time HasRew was set (US outcome received or expected one failed to be received).`, 14: `CuriosityPoolGated is true if VSMatrixJustGated and the first pool representing the curiosity / novelty drive gated. This can change the giving up Effort.Max parameter.`, 15: `Time is the raw time counter, incrementing upward during goal engaged window. This is also copied directly into NegUS[0] which tracks time, but we maintain a separate effort value to make it clearer.`, 16: `Effort is the raw effort counter, incrementing upward for each effort step during goal engaged window. This is also copied directly into NegUS[1] which tracks effort, but we maintain a separate effort value to make it clearer.`, 17: `UrgencyRaw is the raw effort for urgency, incrementing upward from effort increments per step when _not_ goal engaged.`, 18: `Urgency is the overall urgency activity level (normalized 0-1), computed from logistic function of GvUrgencyRaw. This drives DAtonic activity to increasingly bias Go firing.`, 19: `HasPosUS indicates has positive US on this trial, drives goal accomplishment logic and gating.`, 20: `HadPosUS is state from the previous trial (copied from HasPosUS in NewState).`, 21: `NegUSOutcome indicates that a phasic negative US stimulus was experienced, driving phasic ACh, VSMatrix gating to reset current goal engaged plan (if any), and phasic dopamine based on the outcome.`, 22: `HadNegUSOutcome is state from the previous trial (copied from NegUSOutcome in NewState)`, 23: `PVposSum is the total weighted positive valence primary value = sum of Weight * USpos * Drive`, 24: `PVpos is the normalized positive valence primary value = (1 - 1/(1+PVposGain * PVposSum))`, 25: `PVnegSum is the total weighted negative valence primary values including costs = sum of Weight * Cost + Weight * USneg`, 26: `PVpos is the normalized negative valence primary values, including costs = (1 - 1/(1+PVnegGain * PVnegSum))`, 27: `PVposEst is the estimated PVpos final outcome value decoded from the network PVposFinal layer`, 28: `PVposVar is the estimated variance or uncertainty in the PVpos final outcome value decoded from the network PVposFinal layer.`, 29: `PVnegEst is the estimated PVneg final outcome value decoded from the network PVnegFinal layer.`, 30: `PVnegVar is the estimated variance or uncertainty in the PVneg final outcome value decoded from the network PVnegFinal layer.`, 31: `GoalDistEst is the estimate of distance to the goal, in trial step units, decreasing down to 0 as the goal approaches.`, 32: `GoalDistPrev is the previous estimate of distance to the goal, in trial step units, decreasing down to 0 as the goal approaches.`, 33: `ProgressRate is the negative time average change in GoalDistEst, i.e., positive values indicate continued approach to the goal, while negative values represent moving away from the goal.`, 34: `GiveUpUtility is total GiveUp weight as a function of Cost.`, 35: `ContUtility is total Continue weight as a function of expected positive outcome PVposEst.`, 36: `GiveUpTiming is total GiveUp weight as a function of VSPatchPosSum * (1 - VSPatchPosVar).`, 37: `ContTiming is total Continue weight as a function of (1 - VSPatchPosSum) * VSPatchPosVar.`, 38: `GiveUpProgress is total GiveUp weight as a function of ProgressRate.`, 39: `ContProgress is total Continue weight as a function of ProgressRate.`, 40: `GiveUpSum is total GiveUp weight: Utility + Timing + Progress.`, 41: `ContSum is total Continue weight: Utility + Timing + Progress.`, 42: `GiveUpProb is the probability of giving up: 1 / (1 + (GvContSum / GvGiveUpSum))`, 43: `GiveUp is true if a reset was triggered probabilistically based on GiveUpProb.`, 44: `GaveUp is copy of GiveUp from previous trial.`, 45: `VSPatchPos is the net shunting input from VSPatch (PosD1, named PVi in original Rubicon) computed as the Max of US-specific VSPatch saved values, subtracting D1 - D2. This is also stored as GvRewPred.`, 46: `VSPatchPosThr is a thresholded version of GvVSPatchPos, applying Rubicon.LHb.VSPatchNonRewThr threshold for non-reward trials. This is the version used for computing DA.`, 47: `VSPatchPosRPE is the reward prediction error for the VSPatchPos reward prediction without any thresholding applied, and only for PV events. This is used to train the VSPatch, assuming a local feedback circuit that does not have the effective thresholding used for the broadcast critic signal that trains the rest of the network.`, 48: `VSPatchPosSum is the sum of VSPatchPos over goal engaged trials, representing the integrated prediction that the US is going to occur`, 49: `VSPatchPosPrev is the previous trial VSPatchPosSum`, 50: `VSPatchPosVar is the integrated temporal variance of VSPatchPos over goal engaged trials, which determines when the VSPatchPosSum has stabilized`, 51: `computed LHb activity level that drives dipping / pausing of DA firing, when VSPatch pos prediction &gt; actual PV reward drive or PVneg &gt; PVpos`, 52: `LHbBurst is computed LHb activity level that drives bursts of DA firing, when actual PV reward drive &gt; VSPatch pos prediction`, 53: `LHbPVDA is GvLHbBurst - GvLHbDip -- the LHb contribution to DA, reflecting PV and VSPatch (PVi), but not the CS (LV) contributions`, 54: `CeMpos is positive valence central nucleus of the amygdala (CeM) LV (learned value) activity, reflecting |BLAposAcqD1 - BLAposExtD2|_+ positively rectified. CeM sets Raw directly. Note that a positive US onset even with no active Drive will be reflected here, enabling learning about unexpected outcomes.`, 55: `CeMneg is negative valence central nucleus of the amygdala (CeM) LV (learned value) activity, reflecting |BLAnegAcqD2 - BLAnegExtD1|_+ positively rectified. CeM sets Raw directly`, 56: `VtaDA is overall dopamine value reflecting all of the different inputs.`, 57: `SynCaWts are a vector of weights starting here for integrating binned spikes to compute synaptic calcium values that drive the trace factor in learning. These are only stored for the first parallel data index di = 0.`}
+var _GlobalScalarVarsDescMap = map[GlobalScalarVars]string{0: `Rew is the external reward value. Must also set HasRew flag when Rew is set, otherwise it is ignored. This is computed by the Rubicon algorithm from US inputs set by Net.Rubicon methods, and can be directly set in simpler RL cases.`, 1: `HasRew must be set to true (1) when an external reward / US input is present, otherwise Rew is ignored. This is also set when Rubicon BOA model gives up. This drives ACh release in the Rubicon model.`, 2: `RewPred is the reward prediction, computed by a special reward prediction layer, e.g., the VSPatch layer in the Rubicon algorithm.`, 3: `PrevPred is previous time step reward prediction, e.g., for TDPredLayer`, 4: `HadRew is HasRew state from the previous trial, copied from HasRew in NewState. Used for updating Effort, Urgency at start of new trial.`, 5: `DA is phasic dopamine that drives learning moreso than performance, representing reward prediction error, signaled as phasic increases or decreases in activity relative to a tonic baseline, which is represented by a value of 0. Released by the VTA (ventral tegmental area), or SNc (substantia nigra pars compacta).`, 6: `DAtonic is tonic dopamine, which has modulatory instead of learning effects. Increases can drive greater propensity to engage in activities by biasing Go vs No pathways in the basal ganglia, for example as a function of Urgency.`, 7: `ACh is acetylcholine, activated by salient events, particularly at the onset of a reward / punishment outcome (US), or onset of a conditioned stimulus (CS). Driven by BLA -&gt; PPtg that detects changes in BLA activity, via LDTLayer type.`, 8: `NE is norepinephrine, computed by the LCLayer (locus coeruleus) from surprise and uncertainty. Modulates gain, learning rate and exploration noise via NeuroModParams.`, 9: `Ser is serotonin, computed by the DRNLayer (dorsal raphe nucleus) from aversive outcomes and patient waiting. Modulates learning rate via NeuroModParams, and costs and urgency in Rubicon.`, 10: `AChRaw is raw ACh value used in updating global ACh value by LDTLayer.`, 11: `GoalMaint is the normalized (0-1) goal maintenance activity, set in ApplyRubicon function at start of trial. Drives top-down inhibition of LDT layer / ACh activity.`, 12: `VSMatrixJustGated is VSMatrix just gated (to engage goal maintenance in PFC areas), set at end of plus phase. This excludes any gating happening at time of US.`, 13: `VSMatrixHasGated is VSMatrix has gated since the last time HasRew was set (US outcome received or expected one failed to be received).`, 14: `CuriosityPoolGated is true if VSMatrixJustGated and the first pool representing the curiosity / novelty drive gated. This can change the giving up Effort.Max parameter.`, 15: `Time is the raw time counter, incrementing upward during goal engaged window. This is also copied directly into NegUS[0] which tracks time, but we maintain a separate effort value to make it clearer.`, 16: `Effort is the raw effort counter, incrementing upward for each effort step during goal engaged window. This is also copied directly into NegUS[1] which tracks effort, but we maintain a separate effort value to make it clearer.`, 17: `UrgencyRaw is the raw effort for urgency, incrementing upward from effort increments per step when _not_ goal engaged.`, 18: `Urgency is the overall urgency activity level (normalized 0-1), computed from logistic function of GvUrgencyRaw. This drives DAtonic activity to increasingly bias Go firing.`, 19: `HasPosUS indicates has positive US on this trial, drives goal accomplishment logic and gating.`, 20: `HadPosUS is state from the previous trial (copied from HasPosUS in NewState).`, 21: `NegUSOutcome indicates that a phasic negative US stimulus was experienced, driving phasic ACh, VSMatrix gating to reset current goal engaged plan (if any), and phasic dopamine based on the outcome.`, 22: `HadNegUSOutcome is state from the previous trial (copied from NegUSOutcome in NewState)`, 23: `PVposSum is the total weighted positive valence primary value = sum of Weight * USpos * Drive`, 24: `PVpos is the normalized positive valence primary value = (1 - 1/(1+PVposGain * PVposSum))`, 25: `PVnegSum is the total weighted negative valence primary values including costs = sum of Weight * Cost + Weight * USneg`, 26: `PVpos is the normalized negative valence primary values, including costs = (1 - 1/(1+PVnegGain * PVnegSum))`, 27: `PVposEst is the estimated PVpos final outcome value decoded from the network PVposFinal layer`, 28: `PVposVar is the estimated variance or uncertainty in the PVpos final outcome value decoded from the network PVposFinal layer.`, 29: `PVnegEst is the estimated PVneg final outcome value decoded from the network PVnegFinal layer.`, 30: `PVnegVar is the estimated variance or uncertainty in the PVneg final outcome value decoded from the network PVnegFinal layer.`, 31: `GoalDistEst is the estimate of distance to the goal, in trial step units, decreasing down to 0 as the goal approaches.`, 32: `GoalDistPrev is the previous estimate of distance to the goal, in trial step units, decreasing down to 0 as the goal approaches.`, 33: `ProgressRate is the negative time average change in GoalDistEst, i.e., positive values indicate continued approach to the goal, while negative values represent moving away from the goal.`, 34: `GiveUpUtility is total GiveUp weight as a function of Cost.`, 35: `ContUtility is total Continue weight as a function of expected positive outcome PVposEst.`, 36: `GiveUpTiming is total GiveUp weight as a function of VSPatchPosSum * (1 - VSPatchPosVar).`, 37: `ContTiming is total Continue weight as a function of (1 - VSPatchPosSum) * VSPatchPosVar.`, 38: `GiveUpProgress is total GiveUp weight as a function of ProgressRate.`, 39: `ContProgress is total Continue weight as a function of ProgressRate.`, 40: `GiveUpSum is total GiveUp weight: Utility + Timing + Progress.`, 41: `ContSum is total Continue weight: Utility + Timing + Progress.`, 42: `GiveUpProb is the probability of giving up: 1 / (1 + (GvContSum / GvGiveUpSum))`, 43: `GiveUp is true if a reset was triggered probabilistically based on GiveUpProb.`, 44: `GaveUp is copy of GiveUp from previous trial.`, 45: `VSPatchPos is the net shunting input from VSPatch (PosD1, named PVi in original Rubicon) computed as the Max of US-specific VSPatch saved values, subtracting D1 - D2. This is also stored as GvRewPred.`, 46: `VSPatchPosThr is a thresholded version of GvVSPatchPos, applying Rubicon.LHb.VSPatchNonRewThr threshold for non-reward trials. This is the version used for computing DA.`, 47: `VSPatchPosRPE is the reward prediction error for the VSPatchPos reward prediction without any thresholding applied, and only for PV events. This is used to train the VSPatch, assuming a local feedback circuit that does not have the effective thresholding used for the broadcast critic signal that trains the rest of the network.`, 48: `VSPatchPosSum is the sum of VSPatchPos over goal engaged trials, representing the integrated prediction that the US is going to occur`, 49: `VSPatchPosPrev is the previous trial VSPatchPosSum`, 50: `VSPatchPosVar is the integrated temporal variance of VSPatchPos over goal engaged trials, which determines when the VSPatchPosSum has stabilized`, 51: `computed LHb activity level that drives dipping / pausing of DA firing, when VSPatch pos prediction &gt; actual PV reward drive or PVneg &gt; PVpos`, 52: `LHbBurst is computed LHb activity level that drives bursts of DA firing, when actual PV reward drive &gt; VSPatch pos prediction`, 53: `LHbPVDA is GvLHbBurst - GvLHbDip -- the LHb contribution to DA, reflecting PV and VSPatch (PVi), but not the CS (LV) contributions`, 54: `CeMpos is positive valence central nucleus of the amygdala (CeM) LV (learned value) activity, reflecting |BLAposAcqD1 - BLAposExtD2|_+ positively rectified. CeM sets Raw directly. Note that a positive US onset even with no active Drive will be reflected here, enabling learning about unexpected outcomes.`, 55: `CeMneg is negative valence central nucleus of the amygdala (CeM) LV (learned value) activity, reflecting |BLAnegAcqD2 - BLAnegExtD1|_+ positively rectified. CeM sets Raw directly`, 56: `VtaDA is overall dopamine value reflecting all of the different inputs.`, 57: `SynCaWts are a vector of weights starting here for integrating binned spikes to compute synaptic calcium values that drive the trace factor in learning. These are only stored for the first parallel data index di = 0.`}
 
 var _GlobalScalarVarsMap = map[GlobalScalarVars]string{0: `GvRew`, 1: `GvHasRew`, 2: `GvRewPred`, 3: `GvPrevPred`, 4: `GvHadRew`, 5: `GvDA`, 6: `GvDAtonic`, 7: `GvACh`, 8: `GvNE`, 9: `GvSer`, 10: `GvAChRaw`, 11: `GvGoalMaint`, 12: `GvVSMatrixJustGated`, 13: `GvVSMatrixHasGated`, 14: `GvCuriosityPoolGated`, 15: `GvTime`, 16: `GvEffort`, 17: `GvUrgencyRaw`, 18: `GvUrgency`, 19: `GvHasPosUS`, 20: `GvHadPosUS`, 21: `GvNegUSOutcome`, 22: `GvHadNegUSOutcome`, 23: `GvPVposSum`, 24: `GvPVpos`, 25: `GvPVnegSum`, 26: `GvPVneg`, 27: `GvPVposEst`, 28: `GvPVposVar`, 29: `GvPVnegEst`, 30: `GvPVnegVar`, 31: `GvGoalDistEst`, 32: `GvGoalDistPrev`, 33: `GvProgressRate`, 34: `GvGiveUpUtility`, 35: `GvContUtility`, 36: `GvGiveUpTiming`, 37: `GvContTiming`, 38: `GvGiveUpProgress`, 39: `GvContProgress`, 40: `GvGiveUpSum`, 41: `GvContSum`, 42: `GvGiveUpProb`, 43: `GvGiveUp`, 44: `GvGaveUp`, 45: `GvVSPatchPos`, 46: `GvVSPatchPosThr`, 47: `GvVSPatchPosRPE`, 48: `GvVSPatchPosSum`, 49: `GvVSPatchPosPrev`, 50: `GvVSPatchPosVar`, 51: `GvLHbDip`, 52: `GvLHbBurst`, 53: `GvLHbPVDA`, 54: `GvCeMpos`, 55: `GvCeMneg`, 56: `GvVtaDA`, 57: `GvSynCaWts`}
 
@@ -147,20 +147,20 @@ func (i *GlobalVectorVars) UnmarshalText(text []byte) error {
 	return enums.UnmarshalText(i, text, "GlobalVectorVars")
 }
 
-var _GPUVarsValues = []GPUVars{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22}
+var _GPUVarsValues = []GPUVars{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27}
 
 // GPUVarsN is the highest valid value for type GPUVars, plus one.
 //
 //gosl:start
-const GPUVarsN GPUVars = 23
+const GPUVarsN GPUVars = 28
 
 //gosl:end
 
-var _GPUVarsValueMap = map[string]GPUVars{`LayersVar`: 0, `PathsVar`: 1, `NetworkIxsVar`: 2, `PoolIxsVar`: 3, `NeuronIxsVar`: 4, `SynapseIxsVar`: 5, `PathSendConVar`: 6, `RecvPathIxsVar`: 7, `PathRecvConVar`: 8, `RecvSynIxsVar`: 9, `CtxVar`: 10, `NeuronsVar`: 11, `NeuronAvgsVar`: 12, `LayerStatesVar`: 13, `GlobalScalarsVar`: 14, `GlobalVectorsVar`: 15, `ExtsVar`: 16, `PoolsVar`: 17, `PoolsIntVar`: 18, `PathGBufVar`: 19, `PathGSynsVar`: 20, `SynapsesVar`: 21, `SynapseTracesVar`: 22}
+var _GPUVarsValueMap = map[string]GPUVars{`LayersVar`: 0, `PathsVar`: 1, `NetworkIxsVar`: 2, `PoolIxsVar`: 3, `NeuronIxsVar`: 4, `SynapseIxsVar`: 5, `PathSendConVar`: 6, `RecvPathIxsVar`: 7, `PathRecvConVar`: 8, `RecvSynIxsVar`: 9, `CtxVar`: 10, `NeuronsVar`: 11, `NeuronAvgsVar`: 12, `LayerStatesVar`: 13, `GlobalScalarsVar`: 14, `GlobalVectorsVar`: 15, `ExtsVar`: 16, `PoolsVar`: 17, `PoolsIntVar`: 18, `DendritesVar`: 19, `PathGBufVar`: 20, `PathGSynsVar`: 21, `SynapsesVar`: 22, `SynapseTracesVar`: 23, `PathSTPVar`: 24, `STDPSpikesVar`: 25, `SynapseSTDPVar`: 26, `SynapseConsolVar`: 27}
 
-var _GPUVarsDescMap = map[GPUVars]string{0: ``, 1: ``, 2: ``, 3: ``, 4: ``, 5: ``, 6: ``, 7: ``, 8: ``, 9: ``, 10: ``, 11: ``, 12: ``, 13: ``, 14: ``, 15: ``, 16: ``, 17: ``, 18: ``, 19: ``, 20: ``, 21: ``, 22: ``}
+var _GPUVarsDescMap = map[GPUVars]string{0: ``, 1: ``, 2: ``, 3: ``, 4: ``, 5: ``, 6: ``, 7: ``, 8: ``, 9: ``, 10: ``, 11: ``, 12: ``, 13: ``, 14: ``, 15: ``, 16: ``, 17: ``, 18: ``, 19: ``, 20: ``, 21: ``, 22: ``, 23: ``, 24: ``, 25: ``, 26: ``, 27: ``}
 
-var _GPUVarsMap = map[GPUVars]string{0: `LayersVar`, 1: `PathsVar`, 2: `NetworkIxsVar`, 3: `PoolIxsVar`, 4: `NeuronIxsVar`, 5: `SynapseIxsVar`, 6: `PathSendConVar`, 7: `RecvPathIxsVar`, 8: `PathRecvConVar`, 9: `RecvSynIxsVar`, 10: `CtxVar`, 11: `NeuronsVar`, 12: `NeuronAvgsVar`, 13: `LayerStatesVar`, 14: `GlobalScalarsVar`, 15: `GlobalVectorsVar`, 16: `ExtsVar`, 17: `PoolsVar`, 18: `PoolsIntVar`, 19: `PathGBufVar`, 20: `PathGSynsVar`, 21: `SynapsesVar`, 22: `SynapseTracesVar`}
+var _GPUVarsMap = map[GPUVars]string{0: `LayersVar`, 1: `PathsVar`, 2: `NetworkIxsVar`, 3: `PoolIxsVar`, 4: `NeuronIxsVar`, 5: `SynapseIxsVar`, 6: `PathSendConVar`, 7: `RecvPathIxsVar`, 8: `PathRecvConVar`, 9: `RecvSynIxsVar`, 10: `CtxVar`, 11: `NeuronsVar`, 12: `NeuronAvgsVar`, 13: `LayerStatesVar`, 14: `GlobalScalarsVar`, 15: `GlobalVectorsVar`, 16: `ExtsVar`, 17: `PoolsVar`, 18: `PoolsIntVar`, 19: `DendritesVar`, 20: `PathGBufVar`, 21: `PathGSynsVar`, 22: `SynapsesVar`, 23: `SynapseTracesVar`, 24: `PathSTPVar`, 25: `STDPSpikesVar`, 26: `SynapseSTDPVar`, 27: `SynapseConsolVar`}
 
 // String returns the string representation of this GPUVars value.
 func (i GPUVars) String() string { return enums.String(i, _GPUVarsMap) }
@@ -190,22 +190,24 @@ func (i GPUVars) Values() []enums.Enum { return enums.Values(_GPUVarsValues) }
 func (i GPUVars) MarshalText() ([]byte, error) { return []byte(i.String()), nil }
 
 // UnmarshalText implements the [encoding.TextUnmarshaler] interface.
-func (i *GPUVars) UnmarshalText(text []byte) error { return enums.UnmarshalText(i, text, "GPUVars") }
+func (i *GPUVars) UnmarshalText(text []byte) error {
+	return enums.UnmarshalText(i, text, "GPUVars")
+}
 
-var _LayerTypesValues = []LayerTypes{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36}
+var _LayerTypesValues = []LayerTypes{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41}
 
 // LayerTypesN is the highest valid value for type LayerTypes, plus one.
 //
 //gosl:start
-const LayerTypesN LayerTypes = 37
+const LayerTypesN LayerTypes = 42
 
 //gosl:end
 
-var _LayerTypesValueMap = map[string]LayerTypes{`SuperLayer`: 0, `InputLayer`: 1, `TargetLayer`: 2, `CompareLayer`: 3, `CTLayer`: 4, `PulvinarLayer`: 5, `TRNLayer`: 6, `PTMaintLayer`: 7, `PTPredLayer`: 8, `DSMatrixLayer`: 9, `VSMatrixLayer`: 10, `DSPatchLayer`: 11, `STNLayer`: 12, `GPLayer`: 13, `BGThalLayer`: 14, `VSGatedLayer`: 15, `IOLayer`: 16, `CNiIOLayer`: 17, `CNiUpLayer`: 18, `CNeUpLayer`: 19, `CNeDnLayer`: 20, `BLALayer`: 21, `CeMLayer`: 22, `VSPatchLayer`: 23, `LHbLayer`: 24, `DrivesLayer`: 25, `UrgencyLayer`: 26, `USLayer`: 27, `PVLayer`: 28, `LDTLayer`: 29, `VTALayer`: 30, `RewLayer`: 31, `RWPredLayer`: 32, `RWDaLayer`: 33, `TDPredLayer`: 34, `TDIntegLayer`: 35, `TDDaLayer`: 36}
+var _LayerTypesValueMap = map[string]LayerTypes{`SuperLayer`: 0, `InputLayer`: 1, `TargetLayer`: 2, `CompareLayer`: 3, `CTLayer`: 4, `PulvinarLayer`: 5, `TRNLayer`: 6, `PTMaintLayer`: 7, `PTPredLayer`: 8, `DSMatrixLayer`: 9, `VSMatrixLayer`: 10, `DSPatchLayer`: 11, `STNLayer`: 12, `GPLayer`: 13, `BGThalLayer`: 14, `VSGatedLayer`: 15, `IOLayer`: 16, `CNiIOLayer`: 17, `CNiUpLayer`: 18, `CNeUpLayer`: 19, `CNeDnLayer`: 20, `BLALayer`: 21, `CeMLayer`: 22, `VSPatchLayer`: 23, `LHbLayer`: 24, `DrivesLayer`: 25, `UrgencyLayer`: 26, `USLayer`: 27, `PVLayer`: 28, `LDTLayer`: 29, `VTALayer`: 30, `RewLayer`: 31, `RWPredLayer`: 32, `RWDaLayer`: 33, `TDPredLayer`: 34, `TDIntegLayer`: 35, `TDDaLayer`: 36, `InhibPVLayer`: 37, `InhibSSTLayer`: 38, `InhibVIPLayer`: 39, `LCLayer`: 40, `DRNLayer`: 41}
 
-var _LayerTypesDescMap = map[LayerTypes]string{0: `Super is a superficial cortical layer (lamina 2-3-4) which does not receive direct input or targets. In more generic models, it should be used as a Hidden layer.`, 1: `Input is a layer that receives direct external input in its Ext inputs. Biologically, it can be a primary sensory layer, or a thalamic layer.`, 2: `Target is a layer that receives direct external target inputs used for driving plus-phase learning. Simple target layers are generally not used in more biological models, which instead use predictive learning via Pulvinar or related mechanisms.`, 3: `Compare is a layer that receives external comparison inputs, which drive statistics but do NOT drive activation or learning directly. It is rarely used in axon.`, 4: `CT are layer 6 corticothalamic projecting neurons, which drive &#34;top down&#34; predictions in Pulvinar layers. They maintain information over time via stronger NMDA channels and use maintained prior state information to generate predictions about current states forming on Super layers that then drive PT (5IB) bursting activity, which are the plus-phase drivers of Pulvinar activity.`, 5: `Pulvinar are thalamic relay cell neurons in the higher-order Pulvinar nucleus of the thalamus, and functionally isomorphic neurons in the MD thalamus, and potentially other areas. These cells alternately reflect predictions driven by CT pathways, and actual outcomes driven by 5IB Burst activity from corresponding PT or Super layer neurons that provide strong driving inputs.`, 6: `TRNLayer is thalamic reticular nucleus layer for inhibitory competition within the thalamus.`, 7: `PTMaintLayer implements the subset of pyramidal tract (PT) layer 5 intrinsic bursting (5IB) deep neurons that exhibit robust, stable maintenance of activity over the duration of a goal engaged window, modulated by basal ganglia (BG) disinhibitory gating, supported by strong MaintNMDA channels and recurrent excitation. The lateral PTSelfMaint pathway uses MaintG to drive GMaintRaw input that feeds into the stronger, longer MaintNMDA channels, and the ThalToPT ModulatoryG pathway from BGThalamus multiplicatively modulates the strength of other inputs, such that only at the time of BG gating are these strong enough to drive sustained active maintenance. Use Act.Dend.ModGain to parameterize.`, 8: `PTPredLayer implements the subset of pyramidal tract (PT) layer 5 intrinsic bursting (5IB) deep neurons that combine modulatory input from PTMaintLayer sustained maintenance and CTLayer dynamic predictive learning that helps to predict state changes during the period of active goal maintenance. This layer provides the primary input to VSPatch US-timing prediction layers, and other layers that require predictive dynamic`, 9: `DSMatrixLayer represents the matrisome spiny projection neurons (SPNs, MSNs) that are the main Go / No gating units in BG, and are modulated by phasic dopamine: D1 = Go, D2 = No. These are for dorsal striatum, which interact with matrisomes and receive PF (parafasciculus) feedback signals.`, 10: `VSMatrixLayer represents the matrisome spiny projection neurons (SPNs, MSNs) that are the main Go / No gating units in BG, and are modulated by phasic dopamine: D1 = Go, D2 = No. These are for ventral striatum, which drive goal-selection gating signals through the MD thalamus, and activate instinctive behaviors based on learned inputs projecting to various output pathways.`, 11: `DSPatchLayer represents the dorsolateral striosomal spiny neurons that modulate the activity of SNc dopamine to a given Pool.`, 12: `STNLayer represents subthalamic nucleus neurons, with two subtypes: STNp are more strongly driven and get over bursting threshold, driving strong, rapid activation of the KCa channels, causing a long pause in firing, which creates a window during which GPe dynamics resolve Go vs. No balance. STNs are more weakly driven and thus more slowly activate KCa, resulting in a longer period of activation, during which the GPi is inhibited to prevent premature gating based only MtxGo inhibition -- gating only occurs when GPePr signal has had a chance to integrate its MtxNo inputs.`, 13: `GPLayer represents a globus pallidus layer in the BG, including: GPePr, GPeAk (arkypallidal), and GPi / SNr. Has intrinsic activity.`, 14: `BGThalLayer represents a BG gated thalamic layer, which receives BG gating in the form of an inhibitory pathway from GPi. Located mainly in the Ventral thalamus: VA / VM / VL, and also parts of MD mediodorsal thalamus.`, 15: `VSGated represents explicit coding of VS gating status: JustGated and HasGated (since last US or failed predicted US), For visualization and / or motor action signaling.`, 16: `IOLayer represents a cerebellum inferior olive (IO) layer, which drive learning in associated cerebellar nuclei and Purkinje cells. Receives paired input from the CNiIOLayer inhibitory prediction neurons and specific sensory channels that are being predicted, and a maint input from the efferent copy of motor action to initiate it. GaP = integrated GeSyn, GaM = integrated GiSyn, GaD = offset GiSyn, TimeDiff = GaP - GaD, TimePeak = 1 if error spike, else baseline. MinusCycle = afferent envelope start cycle;`, 17: `CNiIOLayer represents the cerebellar nuclei inhibitory prediction neurons, which learn to predict the activity of a specific sensory input, and inhibit it in the corresponding CNeUpLayer`, 18: `CNiUpLayer represents the cerebellar nuclei inhibitory upbound output neurons, which learn from IOLayer error signals to predict specific sensory inputs based on motor commands, thereby cancelling the effects of self-generated motor commands.`, 19: `CNeUpLayer represents the cerebellar nuclei excitatory neurons, which have slow learning to maintain a target average firing rate, for the upbound microzones, with specialized mechanisms for direct inhibitory shunting (from [CNiUpLayer]) of excitatory synaptic input from specific sensory inputs.`, 20: `CNeDnLayer represents the cerebellar nuclei excitatory neurons, which have slow learning to maintain a target average firing rate, for the downbound microzones.`, 21: `BLALayer represents a basolateral amygdala layer which learns to associate arbitrary stimuli (CSs) with behaviorally salient outcomes (USs)`, 22: `CeMLayer represents a central nucleus of the amygdala layer.`, 23: `VSPatchLayer represents a ventral striatum patch layer, which learns to represent the expected amount of dopamine reward and projects both directly with shunting inhibition to the VTA and indirectly via the LHb / RMTg to cancel phasic dopamine firing to expected rewards (i.e., reward prediction error).`, 24: `LHbLayer represents the lateral habenula, which drives dipping in the VTA. It tracks the Global LHb values for visualization purposes -- updated by VTALayer.`, 25: `DrivesLayer represents the Drives in .Rubicon framework. It tracks the Global Drives values for visualization and predictive learning purposes.`, 26: `UrgencyLayer represents the Urgency factor in Rubicon framework. It tracks the Global Urgency.Urge value for visualization and predictive learning purposes.`, 27: `USLayer represents a US unconditioned stimulus layer (USpos or USneg). It tracks the Global USpos or USneg, for visualization and predictive learning purposes. Actual US inputs are set in Rubicon.`, 28: `PVLayer represents a PV primary value layer (PVpos or PVneg) representing the total primary value as a function of US inputs, drives, and effort. It tracks the Global VTA.PVpos, PVneg values for visualization and predictive learning purposes.`, 29: `LDTLayer represents the laterodorsal tegmentum layer, which is the primary limbic ACh (acetylcholine) driver to other ACh: BG cholinergic interneurons (CIN) and nucleus basalis ACh areas. The phasic ACh release signals reward salient inputs from CS, US and US omssion, and it drives widespread disinhibition of BG gating and VTA DA firing. It receives excitation from superior colliculus which computes a temporal derivative (stimulus specific adaptation, SSA) of sensory inputs, and inhibitory input from OFC, ACC driving suppression of distracting inputs during goal-engaged states.`, 30: `VTALayer represents the ventral tegmental area, which releases dopamine. It computes final DA value from Rubicon-computed LHb PVDA (primary value DA), updated at start of each trial from updated US, Effort, etc state, and cycle-by-cycle LV learned value state reflecting CS inputs, in the Amygdala (CeM). Its activity reflects this DA level, which is effectively broadcast vial Global state values to all layers.`, 31: `RewLayer represents positive (first unit) or negative (second unit) reward values, showing spiking rates for each, and Act always represents the signed value.`, 32: `RWPredLayer computes reward prediction for a simple Rescorla-Wagner learning dynamic (i.e., PV learning in the Rubicon framework). Activity is computed as linear function of excitatory conductance. The first unit in the layer represents positive reward, second negative. Use with RWPath which does simple delta-rule learning on minus-plus.`, 33: `RWDaLayer computes a dopamine (DA) signal based on a simple Rescorla-Wagner learning dynamic (i.e., PV learning in the Rubicon framework). It computes difference between r(t) and RWPred values. r(t) is accessed directly from a Rew layer -- if no external input then no DA is computed -- critical for effective use of RW only for PV cases. RWPred prediction is also accessed directly from Rew layer to avoid any issues.`, 34: `TDPredLayer is the temporal differences reward prediction layer. It represents estimated value V(t) in the minus phase, and computes estimated V(t+1) based on its learned weights in plus phase, using the TDPredPath pathway type for DA modulated learning. The first unit in the layer represents positive reward, second negative.`, 35: `TDIntegLayer is the temporal differences reward integration layer. It represents estimated value V(t) from prior time step in the minus phase, and estimated discount * V(t+1) + r(t) in the plus phase. It gets Rew, PrevPred from Context.NeuroMod, and Special LayerValues from TDPredLayer. The first unit in the layer represents positive reward, second negative.`, 36: `TDDaLayer computes a dopamine (DA) signal as the temporal difference (TD) between the TDIntegLayer activations in the minus and plus phase. These are retrieved from Special LayerValues.`}
+var _LayerTypesDescMap = map[LayerTypes]string{0: `Super is a superficial cortical layer (lamina 2-3-4) which does not receive direct input or targets. In more generic models, it should be used as a Hidden layer.`, 1: `Input is a layer that receives direct external input in its Ext inputs. Biologically, it can be a primary sensory layer, or a thalamic layer.`, 2: `Target is a layer that receives direct external target inputs used for driving plus-phase learning. Simple target layers are generally not used in more biological models, which instead use predictive learning via Pulvinar or related mechanisms.`, 3: `Compare is a layer that receives external comparison inputs, which drive statistics but do NOT drive activation or learning directly. It is rarely used in axon.`, 4: `CT are layer 6 corticothalamic projecting neurons, which drive &#34;top down&#34; predictions in Pulvinar layers. They maintain information over time via stronger NMDA channels and use maintained prior state information to generate predictions about current states forming on Super layers that then drive PT (5IB) bursting activity, which are the plus-phase drivers of Pulvinar activity.`, 5: `Pulvinar are thalamic relay cell neurons in the higher-order Pulvinar nucleus of the thalamus, and functionally isomorphic neurons in the MD thalamus, and potentially other areas. These cells alternately reflect predictions driven by CT pathways, and actual outcomes driven by 5IB Burst activity from corresponding PT or Super layer neurons that provide strong driving inputs.`, 6: `TRNLayer is thalamic reticular nucleus layer for inhibitory competition within the thalamus.`, 7: `PTMaintLayer implements the subset of pyramidal tract (PT) layer 5 intrinsic bursting (5IB) deep neurons that exhibit robust, stable maintenance of activity over the duration of a goal engaged window, modulated by basal ganglia (BG) disinhibitory gating, supported by strong MaintNMDA channels and recurrent excitation. The lateral PTSelfMaint pathway uses MaintG to drive GMaintRaw input that feeds into the stronger, longer MaintNMDA channels, and the ThalToPT ModulatoryG pathway from BGThalamus multiplicatively modulates the strength of other inputs, such that only at the time of BG gating are these strong enough to drive sustained active maintenance. Use Act.Dend.ModGain to parameterize.`, 8: `PTPredLayer implements the subset of pyramidal tract (PT) layer 5 intrinsic bursting (5IB) deep neurons that combine modulatory input from PTMaintLayer sustained maintenance and CTLayer dynamic predictive learning that helps to predict state changes during the period of active goal maintenance. This layer provides the primary input to VSPatch US-timing prediction layers, and other layers that require predictive dynamic`, 9: `DSMatrixLayer represents the matrisome spiny projection neurons (SPNs, MSNs) that are the main Go / No gating units in BG, and are modulated by phasic dopamine: D1 = Go, D2 = No. These are for dorsal striatum, which interact with matrisomes and receive PF (parafasciculus) feedback signals.`, 10: `VSMatrixLayer represents the matrisome spiny projection neurons (SPNs, MSNs) that are the main Go / No gating units in BG, and are modulated by phasic dopamine: D1 = Go, D2 = No. These are for ventral striatum, which drive goal-selection gating signals through the MD thalamus, and activate instinctive behaviors based on learned inputs projecting to various output pathways.`, 11: `DSPatchLayer represents the dorsolateral striosomal spiny neurons that modulate the activity of SNc dopamine to a given Pool.`, 12: `STNLayer represents subthalamic nucleus neurons, with two subtypes: STNp are more strongly driven and get over bursting threshold, driving strong, rapid activation of the KCa channels, causing a long pause in firing, which creates a window during which GPe dynamics resolve Go vs. No balance. STNs are more weakly driven and thus more slowly activate KCa, resulting in a longer period of activation, during which the GPi is inhibited to prevent premature gating based only MtxGo inhibition -- gating only occurs when GPePr signal has had a chance to integrate its MtxNo inputs.`, 13: `GPLayer represents a globus pallidus layer in the BG, including: GPePr, GPeAk (arkypallidal), and GPi / SNr. Has intrinsic activity.`, 14: `BGThalLayer represents a BG gated thalamic layer, which receives BG gating in the form of an inhibitory pathway from GPi. Located mainly in the Ventral thalamus: VA / VM / VL, and also parts of MD mediodorsal thalamus.`, 15: `VSGated represents explicit coding of VS gating status: JustGated and HasGated (since last US or failed predicted US), For visualization and / or motor action signaling.`, 16: `IOLayer represents a cerebellum inferior olive (IO) layer, which drive learning in associated cerebellar nuclei and Purkinje cells. Receives paired input from the CNiIOLayer inhibitory prediction neurons and specific sensory channels that are being predicted, and a maint input from the efferent copy of motor action to initiate it. GaP = integrated GeSyn, GaM = integrated GiSyn, GaD = offset GiSyn, TimeDiff = GaP - GaD, TimePeak = 1 if error spike, else baseline. MinusCycle = afferent envelope start cycle;`, 17: `CNiIOLayer represents the cerebellar nuclei inhibitory prediction neurons, which learn to predict the activity of a specific sensory input, and inhibit it in the corresponding CNeUpLayer`, 18: `CNiUpLayer represents the cerebellar nuclei inhibitory upbound output neurons, which learn from IOLayer error signals to predict specific sensory inputs based on motor commands, thereby cancelling the effects of self-generated motor commands.`, 19: `CNeUpLayer represents the cerebellar nuclei excitatory neurons, which have slow learning to maintain a target average firing rate, for the upbound microzones, with specialized mechanisms for direct inhibitory shunting (from [CNiUpLayer]) of excitatory synaptic input from specific sensory inputs.`, 20: `CNeDnLayer represents the cerebellar nuclei excitatory neurons, which have slow learning to maintain a target average firing rate, for the downbound microzones.`, 21: `BLALayer represents a basolateral amygdala layer which learns to associate arbitrary stimuli (CSs) with behaviorally salient outcomes (USs)`, 22: `CeMLayer represents a central nucleus of the amygdala layer.`, 23: `VSPatchLayer represents a ventral striatum patch layer, which learns to represent the expected amount of dopamine reward and projects both directly with shunting inhibition to the VTA and indirectly via the LHb / RMTg to cancel phasic dopamine firing to expected rewards (i.e., reward prediction error).`, 24: `LHbLayer represents the lateral habenula, which drives dipping in the VTA. It tracks the Global LHb values for visualization purposes -- updated by VTALayer.`, 25: `DrivesLayer represents the Drives in .Rubicon framework. It tracks the Global Drives values for visualization and predictive learning purposes.`, 26: `UrgencyLayer represents the Urgency factor in Rubicon framework. It tracks the Global Urgency.Urge value for visualization and predictive learning purposes.`, 27: `USLayer represents a US unconditioned stimulus layer (USpos or USneg). It tracks the Global USpos or USneg, for visualization and predictive learning purposes. Actual US inputs are set in Rubicon.`, 28: `PVLayer represents a PV primary value layer (PVpos or PVneg) representing the total primary value as a function of US inputs, drives, and effort. It tracks the Global VTA.PVpos, PVneg values for visualization and predictive learning purposes.`, 29: `LDTLayer represents the laterodorsal tegmentum layer, which is the primary limbic ACh (acetylcholine) driver to other ACh: BG cholinergic interneurons (CIN) and nucleus basalis ACh areas. The phasic ACh release signals reward salient inputs from CS, US and US omssion, and it drives widespread disinhibition of BG gating and VTA DA firing. It receives excitation from superior colliculus which computes a temporal derivative (stimulus specific adaptation, SSA) of sensory inputs, and inhibitory input from OFC, ACC driving suppression of distracting inputs during goal-engaged states.`, 30: `VTALayer represents the ventral tegmental area, which releases dopamine. It computes final DA value from Rubicon-computed LHb PVDA (primary value DA), updated at start of each trial from updated US, Effort, etc state, and cycle-by-cycle LV learned value state reflecting CS inputs, in the Amygdala (CeM). Its activity reflects this DA level, which is effectively broadcast vial Global state values to all layers.`, 31: `RewLayer represents positive (first unit) or negative (second unit) reward values, showing spiking rates for each, and Act always represents the signed value.`, 32: `RWPredLayer computes reward prediction for a simple Rescorla-Wagner learning dynamic (i.e., PV learning in the Rubicon framework). Activity is computed as linear function of excitatory conductance. The first unit in the layer represents positive reward, second negative. Use with RWPath which does simple delta-rule learning on minus-plus.`, 33: `RWDaLayer computes a dopamine (DA) signal based on a simple Rescorla-Wagner learning dynamic (i.e., PV learning in the Rubicon framework). It computes difference between r(t) and RWPred values. r(t) is accessed directly from a Rew layer -- if no external input then no DA is computed -- critical for effective use of RW only for PV cases. RWPred prediction is also accessed directly from Rew layer to avoid any issues.`, 34: `TDPredLayer is the temporal differences reward prediction layer. It represents estimated value V(t) in the minus phase, and computes estimated V(t+1) based on its learned weights in plus phase, using the TDPredPath pathway type for DA modulated learning. The first unit in the layer represents positive reward, second negative.`, 35: `TDIntegLayer is the temporal differences reward integration layer. It represents estimated value V(t) from prior time step in the minus phase, and estimated discount * V(t+1) + r(t) in the plus phase. It gets Rew, PrevPred from Context.NeuroMod, and Special LayerValues from TDPredLayer. The first unit in the layer represents positive reward, second negative.`, 36: `TDDaLayer computes a dopamine (DA) signal as the temporal difference (TD) between the TDIntegLayer activations in the minus and plus phase. These are retrieved from Special LayerValues.`, 37: `InhibPVLayer represents parvalbumin positive (PV+) fast-spiking basket cell interneurons, which receive feedforward and feedback excitation from the principal cells of a layer, and provide fast GABA-A inhibition onto their soma (perisomatic), along with mutual inhibition among themselves. This corresponds to the FS fast-spiking component of the FS-FFFB inhibition function. See [Network.AddInterneurons].`, 38: `InhibSSTLayer represents somatostatin positive (SST+) interneurons, such as Martinotti cells, which receive facilitating excitation from the principal cells of a layer, and provide slower GABA-A inhibition onto their dendrites (VmDend) via [DendInhibitoryG] pathways. This corresponds to the SS slow-spiking component of FS-FFFB.`, 39: `InhibVIPLayer represents vasoactive intestinal peptide positive (VIP+) interneurons, which are driven by top-down and neuromodulatory inputs, and inhibit the SST interneurons, thereby disinhibiting the dendrites of the principal cells.`, 40: `LCLayer represents the locus coeruleus, which releases norepinephrine (NE). It computes the NE global value from surprise (the magnitude of phasic dopamine) and uncertainty in the expected outcome (PVposVar), which modulates gain, learning rate and exploration noise via NeuroModParams, and giving up in Rubicon. Its activity reflects the NE level.`, 41: `DRNLayer represents the dorsal raphe nucleus, which releases serotonin (Ser, 5-HT). It computes the Ser global value from aversive outcomes and patient waiting for expected positive outcomes, suppressed by urgency, which modulates learning rate via NeuroModParams, and reduces cost and urgency effects in Rubicon. Its activity reflects the Ser level.`}
 
-var _LayerTypesMap = map[LayerTypes]string{0: `SuperLayer`, 1: `InputLayer`, 2: `TargetLayer`, 3: `CompareLayer`, 4: `CTLayer`, 5: `PulvinarLayer`, 6: `TRNLayer`, 7: `PTMaintLayer`, 8: `PTPredLayer`, 9: `DSMatrixLayer`, 10: `VSMatrixLayer`, 11: `DSPatchLayer`, 12: `STNLayer`, 13: `GPLayer`, 14: `BGThalLayer`, 15: `VSGatedLayer`, 16: `IOLayer`, 17: `CNiIOLayer`, 18: `CNiUpLayer`, 19: `CNeUpLayer`, 20: `CNeDnLayer`, 21: `BLALayer`, 22: `CeMLayer`, 23: `VSPatchLayer`, 24: `LHbLayer`, 25: `DrivesLayer`, 26: `UrgencyLayer`, 27: `USLayer`, 28: `PVLayer`, 29: `LDTLayer`, 30: `VTALayer`, 31: `RewLayer`, 32: `RWPredLayer`, 33: `RWDaLayer`, 34: `TDPredLayer`, 35: `TDIntegLayer`, 36: `TDDaLayer`}
+var _LayerTypesMap = map[LayerTypes]string{0: `SuperLayer`, 1: `InputLayer`, 2: `TargetLayer`, 3: `CompareLayer`, 4: `CTLayer`, 5: `PulvinarLayer`, 6: `TRNLayer`, 7: `PTMaintLayer`, 8: `PTPredLayer`, 9: `DSMatrixLayer`, 10: `VSMatrixLayer`, 11: `DSPatchLayer`, 12: `STNLayer`, 13: `GPLayer`, 14: `BGThalLayer`, 15: `VSGatedLayer`, 16: `IOLayer`, 17: `CNiIOLayer`, 18: `CNiUpLayer`, 19: `CNeUpLayer`, 20: `CNeDnLayer`, 21: `BLALayer`, 22: `CeMLayer`, 23: `VSPatchLayer`, 24: `LHbLayer`, 25: `DrivesLayer`, 26: `UrgencyLayer`, 27: `USLayer`, 28: `PVLayer`, 29: `LDTLayer`, 30: `VTALayer`, 31: `RewLayer`, 32: `RWPredLayer`, 33: `RWDaLayer`, 34: `TDPredLayer`, 35: `TDIntegLayer`, 36: `TDDaLayer`, 37: `InhibPVLayer`, 38: `InhibSSTLayer`, 39: `InhibVIPLayer`, 40: `LCLayer`, 41: `DRNLayer`}
 
 // String returns the string representation of this LayerTypes value.
 func (i LayerTypes) String() string { return enums.String(i, _LayerTypesMap) }
@@ -474,20 +476,20 @@ func (i *NeuronFlags) UnmarshalText(text []byte) error {
 	return enums.UnmarshalText(i, text, "NeuronFlags")
 }
 
-var _NeuronVarsValues = []NeuronVars{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64, 65, 66, 67, 68, 69, 70, 71, 72, 73, 74, 75, 76, 77, 78, 79, 80, 81, 82, 83, 84, 85, 86, 87, 88, 89, 90, 91, 92, 93, 94, 95, 96, 97, 98, 99}
+var _NeuronVarsValues = []NeuronVars{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64, 65, 66, 67, 68, 69, 70, 71, 72, 73, 74, 75, 76, 77, 78, 79, 80, 81, 82, 83, 84, 85, 86, 87, 88, 89, 90, 91, 92, 93, 94, 95, 96, 97, 98, 99, 100, 101, 102, 103, 104, 105, 106, 107, 108, 109, 110, 111}
 
 // NeuronVarsN is the highest valid value for type NeuronVars, plus one.
 //
 //gosl:start
-const NeuronVarsN NeuronVars = 100
+const NeuronVarsN NeuronVars = 112
 
 //gosl:end
 
-var _NeuronVarsValueMap = map[string]NeuronVars{`Spike`: 0, `Spiked`: 1, `Act`: 2, `ActInt`: 3, `Ge`: 4, `Gi`: 5, `Gk`: 6, `Inet`: 7, `Vm`: 8, `VmDend`: 9, `ISI`: 10, `ISIAvg`: 11, `Ext`: 12, `Target`: 13, `CaM`: 14, `CaP`: 15, `CaD`: 16, `CaDPrev`: 17, `CaSyn`: 18, `LearnCa`: 19, `LearnCaM`: 20, `LearnCaP`: 21, `LearnCaD`: 22, `CaDiff`: 23, `GaM`: 24, `GaP`: 25, `GaD`: 26, `TimeDiff`: 27, `TimePeak`: 28, `TPeakCycle`: 29, `PeakUps`: 30, `MinusPeak`: 31, `MinusCycle`: 32, `MinusWindow`: 33, `Enabled`: 34, `EnabledPrev`: 35, `LearnNow`: 36, `RLRate`: 37, `ETrace`: 38, `ETrLearn`: 39, `PoolDAD1`: 40, `PoolDAD2`: 41, `GnmdaSyn`: 42, `Gnmda`: 43, `GnmdaLrn`: 44, `GnmdaMaint`: 45, `NmdaCa`: 46, `Gvgcc`: 47, `VgccM`: 48, `VgccH`: 49, `VgccCa`: 50, `VgccCaInt`: 51, `Burst`: 52, `BurstPrv`: 53, `CtxtGe`: 54, `CtxtGeRaw`: 55, `CtxtGeOrig`: 56, `GgabaB`: 57, `GababM`: 58, `GababX`: 59, `Gak`: 60, `SSGiDend`: 61, `GknaMed`: 62, `GknaSlow`: 63, `Gkir`: 64, `KirM`: 65, `Gsk`: 66, `SKCaIn`: 67, `SKCaR`: 68, `SKCaM`: 69, `Gmahp`: 70, `MahpN`: 71, `Gsahp`: 72, `SahpCa`: 73, `SahpN`: 74, `ActM`: 75, `ActP`: 76, `Beta1`: 77, `Beta2`: 78, `CaPMax`: 79, `CaPMaxCa`: 80, `GeNoise`: 81, `GeNoiseP`: 82, `GiNoise`: 83, `GiNoiseP`: 84, `GeExt`: 85, `GeRaw`: 86, `GeSyn`: 87, `GiRaw`: 88, `GiSyn`: 89, `GeInt`: 90, `GeIntNorm`: 91, `GiInt`: 92, `GModRaw`: 93, `GModSyn`: 94, `SMaintP`: 95, `GMaintRaw`: 96, `GMaintSyn`: 97, `NeurFlags`: 98, `NeuronTraces`: 99}
+var _NeuronVarsValueMap = map[string]NeuronVars{`Spike`: 0, `Spiked`: 1, `Act`: 2, `ActInt`: 3, `Ge`: 4, `Gi`: 5, `Gk`: 6, `Inet`: 7, `Vm`: 8, `VmDend`: 9, `ISI`: 10, `ISIAvg`: 11, `Ext`: 12, `Target`: 13, `CaM`: 14, `CaP`: 15, `CaD`: 16, `CaDPrev`: 17, `CaSyn`: 18, `LearnCa`: 19, `LearnCaM`: 20, `LearnCaP`: 21, `LearnCaD`: 22, `CaDiff`: 23, `GaM`: 24, `GaP`: 25, `GaD`: 26, `TimeDiff`: 27, `TimePeak`: 28, `TPeakCycle`: 29, `PeakUps`: 30, `MinusPeak`: 31, `MinusCycle`: 32, `MinusWindow`: 33, `Enabled`: 34, `EnabledPrev`: 35, `LearnNow`: 36, `RLRate`: 37, `ETrace`: 38, `ETrLearn`: 39, `PoolDAD1`: 40, `PoolDAD2`: 41, `GnmdaSyn`: 42, `Gnmda`: 43, `GnmdaLrn`: 44, `GnmdaMaint`: 45, `NmdaCa`: 46, `Gvgcc`: 47, `VgccM`: 48, `VgccH`: 49, `VgccCa`: 50, `VgccCaInt`: 51, `Gcat`: 52, `CatM`: 53, `CatH`: 54, `CatCa`: 55, `Gnap`: 56, `NapM`: 57, `NapH`: 58, `Igap`: 59, `GapVm`: 60, `Burst`: 61, `BurstPrv`: 62, `CtxtGe`: 63, `CtxtGeRaw`: 64, `CtxtGeOrig`: 65, `GgabaB`: 66, `GababM`: 67, `GababX`: 68, `Gak`: 69, `SSGiDend`: 70, `GiDendSyn`: 71, `GknaMed`: 72, `GknaSlow`: 73, `Gkir`: 74, `KirM`: 75, `Gh`: 76, `HcnM`: 77, `Gsk`: 78, `SKCaIn`: 79, `SKCaR`: 80, `SKCaM`: 81, `Gmahp`: 82, `MahpN`: 83, `Gsahp`: 84, `SahpCa`: 85, `SahpN`: 86, `ActM`: 87, `ActP`: 88, `Beta1`: 89, `Beta2`: 90, `CaPMax`: 91, `CaPMaxCa`: 92, `GeNoise`: 93, `GeNoiseP`: 94, `GiNoise`: 95, `GiNoiseP`: 96, `GeExt`: 97, `GeRaw`: 98, `GeSyn`: 99, `GiRaw`: 100, `GiSyn`: 101, `GeInt`: 102, `GeIntNorm`: 103, `GiInt`: 104, `GModRaw`: 105, `GModSyn`: 106, `SMaintP`: 107, `GMaintRaw`: 108, `GMaintSyn`: 109, `NeurFlags`: 110, `NeuronTraces`: 111}
 
-var _NeuronVarsDescMap = map[NeuronVars]string{0: `Spike is whether neuron has spiked or not on this cycle (0 or 1).`, 1: `Spiked is 1 if neuron has spiked within the last 10 cycles (msecs), corresponding to a nominal max spiking rate of 100 Hz, 0 otherwise. Useful for visualization and computing activity levels in terms of average spiked levels.`, 2: `Act is rate-coded activation value reflecting instantaneous estimated rate of spiking, based on 1 / ISIAvg. It is integrated over time for ActInt which is then used for performance statistics and layer average activations, etc. Should not be used for learning or other computations: just for stats / display.`, 3: `ActInt is integrated running-average activation value computed from Act with time constant Act.Dt.IntTau, to produce a longer-term integrated value reflecting the overall activation state across the ThetaCycle time scale, as the overall response of network to current input state. This is copied to ActM and ActP at the ends of the minus and plus phases, respectively, and used in computing some performance-level statistics (based on ActM). Should not be used for learning or other computations.`, 4: `Ge is total excitatory conductance, including all forms of excitation (e.g., NMDA). Does *not* include the Gbar.E factor.`, 5: `Gi is total inhibitory synaptic conductance, i.e., the net inhibitory input to the neuron. Does *not* include the Gbar.I factor.`, 6: `Gk is total potassium conductance, typically reflecting sodium-gated potassium currents involved in adaptation effects. Does *not* include the Gbar.K factor.`, 7: `Inet is net current produced by all channels, which drives update of Vm.`, 8: `Vm is the membrane potential at the cell body, which integrates Inet current over time, and drives spiking at the axon initial segment of the neuron.`, 9: `VmDend is the dendritic membrane potential, which has a slower time constant than Vm and is not subject to the VmR reset after spiking.`, 10: `ISI is the current inter-spike-interval, which counts up since last spike. Starts at -1 when initialized.`, 11: `ISIAvg is the average inter-spike-interval, i.e., the average time interval between spikes, integrated with ISITau rate constant (relatively fast) to capture something close to an instantaneous spiking rate. Starts at -1 when initialized, and goes to -2 after first spike, and is only valid after the second spike post-initialization.`, 12: `Ext is the external input: drives activation of unit from outside influences (e.g., sensory input).`, 13: `Target is the target value: drives learning to produce this activation value.`, 14: `CaM is the spike-driven calcium trace at the neuron level, which then drives longer time-integrated variables: [CaP] and [CaD]. These variables are used for statistics and display to capture spiking activity at different timescales. They fluctuate more than [Act] and [ActInt], but are closer to the biological variables driving learning. CaM is the exponential integration of SpikeG * Spike using the MTau time constant (typically 5), and simulates a calmodulin (CaM) like signal, at an abstract level.`, 15: `CaP is the continuous cascaded integration of [CaM] using the PTau time constant (typically 40), representing a neuron-level, purely spiking version of the plus, LTP direction of weight change in the Kinase learning rule, dependent on CaMKII. This is not used for learning (see [LearnCaP]), but instead for statistics as a representation of recent activity.`, 16: `CaD is the continuous cascaded integration [CaP] using the DTau time constant (typically 40), representing a neuron-level, purely spiking version of the minus, LTD direction of weight change in the Kinase learning rule, dependent on DAPK1. This is not used for learning (see [LearnCaD]), but instead for statistics as a representation of trial-level activity.`, 17: `CaDPrev is the final [CaD] activation state at the end of previous theta cycle. This is used for specialized learning mechanisms that operate on delayed sending activations.`, 18: `CaSyn is the neuron-level integration of spike-driven calcium, used to approximate synaptic calcium influx as a product of sender and receiver neuron CaSyn values, which are integrated separately because it is computationally much more efficient. CaSyn enters into a Sender * Receiver product at each synapse to give the effective credit assignment factor for learning. This value is driven directly by spikes, with an exponential integration time constant of 30 msec (default), which captures the coincidence window for pre*post firing on NMDA receptor opening. The neuron [NeuronTraces] values record the temporal trajectory of CaSyn over the course of the theta cycle window, and then the pre*post product is integrated over these bins at the synaptic level.`, 19: `LearnCa is the receiving neuron calcium signal, which is integrated up to [LearnCaP] and [LearnCaD], the difference of which is the temporal error component of the kinase cortical learning rule. LearnCa combines NMDA via [NmdaCa] and spiking-driven VGCC [VgccCaInt] calcium sources. The NMDA signal reflects both sending and receiving activity, while the VGCC signal is purely receiver spiking, and a balance of both works best.`, 20: `LearnCaM is the integrated [LearnCa] at the MTau timescale (typically 5), simulating a calmodulin (CaM) like signal, which then drives [LearnCaP], and [LearnCaD] for the delta signal for error-driven learning.`, 21: `LearnCaP is the cascaded integration of [LearnCaM] using the PTau time constant (typically 40), representing the plus, LTP direction of weight change, capturing the function of CaMKII in the Kinase learning rule.`, 22: `LearnCaD is the cascaded integration of [LearnCaP] using the DTau time constant (typically 40), representing the minus, LTD direction of weight change, capturing the function of DAPK1 in the Kinase learning rule.`, 23: `CaDiff is difference between [LearnCaP] - [LearnCaD]. This is the error signal that drives error-driven learning.`, 24: `GaM is first-level integration of all input conductances g_a, which then drives longer time-integrated variables: [GaP] and [GaD]. These variables are used for timing of learning based on bursts of activity change over time: at the minus and plus phases.`, 25: `GaP is the continuous cascaded integration of [GaM] using the PTau time constant (typically 40), representing a neuron-level, all-conductance-based version of the plus, LTP direction of weight change in the Kinase learning rule.`, 26: `GaD is the continuous cascaded integration of [GaP] using the DTau time constant (typically 40), representing a neuron-level, all-conductance-based version of the minus, LTD direction of weight change in the Kinase learning rule.`, 27: `TimeDiff is the running time-average of |P - D| (absolute value), used for determining the timing of learning in terms of onsets of peaks. See [TPeakCycle]. GaP - GaD is used, as it is smoother and more reliable than LearnCaP - D.`, 28: `TimePeak is the current peak value of TimeDiff, used for computing [TPeakCycle] when [TimeDiff] &gt; [TimePeak], which in turn determines [MinusPeak] after the enabling time window has passed.`, 29: `TPeakCycle is the absolute cycle (ms, CyclesTotal) when the last [TimePeak] value was updated.`, 30: `PeakUps is the number of consecutive increases in peak value.`, 31: `MinusPeak is the value of the last detected minus-phase peak, from [TimePeak], This typically occurs at the onset of the minus phase, and drives the timing of learning a given number of cycles after that.`, 32: `MinusCycle is the absolute cycle (ms, CyclesTotal) when the minus-phase peak occurred, copied from [TPeakCycle] for that peak.`, 33: `MinusWindow is the absolute cycle (ms, CyclesTotal) when the minus-phase peak detection window was reached, and the minus phase was detected. After this, there are additional cycles where the neuron could get over the CaD threshold for learning, or not. LearnNow is relative to this point.`, 34: `Enabled is the absolute cycle (ms, CyclesTotal) when the receiving neuron is above threshold for learning, and a minus-phase peak has been detected. For neocortex, this is after [MinusWindow], and the neuron CaD level has gone above the learning threshold, within a minimum number of cycles. If not using flexible learning timing, this is set to the end of the theta cycle. See [LearnTimingParams] for details.`, 35: `EnabledPrev is the absolute cycle (ms, CyclesTotal) for the previous [Enabled] value, if set. This is used for learning that is triggered by a minus phase subsequent to being enabled.`, 36: `LearnNow is the absolute cycle (ms, CyclesTotal) when the receiving neuron actually learns. See [Enabled] for enabling conditions, and [LearnTimingParams] for parameters. For neocortex, this can be based on going back from the subsequent minus phase peak, after being enabled (see [EnabledPrev]).`, 37: `RLRate is recv-unit based learning rate multiplier, reflecting the sigmoid derivative computed from [CaD] of recv unit, and the normalized difference (CaP - CaD) / MAX(CaP - CaD).`, 38: `ETrace is the eligibility trace for this neuron.`, 39: `ETrLearn is the learning factor for the eligibility trace for this neuron. 1 + ETraceScale * [ETrace]`, 40: `PoolDAD1 is the value of this neuron&#39;s sub-pool DAD1 dopamine D1 receptor activation, for Basal Ganglia (PCore) Patch neurons in dorsal striatum.`, 41: `PoolDAD2 is the value of this neuron&#39;s sub-pool DAD2 dopamine D2 receptor activation, for Basal Ganglia (PCore) Patch neurons in dorsal striatum.`, 42: `GnmdaSyn is the integrated NMDA synaptic current on the receiving neuron. It adds GeRaw and decays with a time constant.`, 43: `Gnmda is the net postsynaptic (receiving) NMDA conductance, after Mg V-gating and Gbar. This is added directly to Ge as it has the same reversal potential.`, 44: `GnmdaLrn is learning version of integrated NMDA recv synaptic current. It adds [GeRaw] and decays with a time constant. This drives [NmdaCa] that then drives [LearnCa] for learning.`, 45: `GnmdaMaint is net postsynaptic maintenance NMDA conductance, computed from [GMaintSyn] and [GMaintRaw], after Mg V-gating and Gbar. This is added directly to Ge as it has the same reversal potential.`, 46: `NmdaCa is NMDA calcium computed from GnmdaLrn, drives learning via CaM.`, 47: `Gvgcc is conductance (via Ca) for VGCC voltage gated calcium channels.`, 48: `VgccM is activation gate of VGCC channels.`, 49: `VgccH inactivation gate of VGCC channels.`, 50: `VgccCa is the instantaneous VGCC calcium flux: can be driven by spiking or directly from Gvgcc.`, 51: `VgccCaInt is the time-integrated VGCC calcium flux. This is actually what drives learning.`, 52: `Burst is the layer 5 IB intrinsic bursting neural activation value, computed by thresholding the [CaP] value in Super superficial layers.`, 53: `BurstPrv is previous Burst bursting activation from prior time step. Used for context-based learning.`, 54: `CtxtGe is context (temporally delayed) excitatory conductance, driven by deep bursting at end of the plus phase, for CT layers.`, 55: `CtxtGeRaw is raw update of context (temporally delayed) excitatory conductance, driven by deep bursting at end of the plus phase, for CT layers.`, 56: `CtxtGeOrig is original CtxtGe value prior to any decay factor. Updates at end of plus phase.`, 57: `GgabaB is net GABA-B conductance, after Vm gating and Gk + Gbase. Applies to Gk, not Gi, for GIRK, with .1 reversal potential.`, 58: `GababM is the GABA-B / GIRK activation, which is a time-integrated value with rise and decay time constants.`, 59: `GababX is GABA-B / GIRK internal drive variable. This gets the raw activation and decays.`, 60: `Gak is the conductance of A-type K potassium channels.`, 61: `SSGiDend is the amount of SST+ somatostatin positive slow spiking inhibition applied to dendritic Vm (VmDend).`, 62: `GknaMed is the conductance of sodium-gated potassium channel (KNa) medium dynamics (Slick), which produces accommodation / adaptation.`, 63: `GknaSlow is the conductance of sodium-gated potassium channel (KNa) slow dynamics (Slack), which produces accommodation / adaptation.`, 64: `Gkir is the conductance of the potassium (K) inwardly rectifying channel, which is strongest at low membrane potentials. Can be modulated by DA.`, 65: `KirM is the Kir potassium (K) inwardly rectifying gating value.`, 66: `Gsk is Calcium-gated potassium channel conductance as a function of Gbar * SKCaM.`, 67: `SKCaIn is intracellular calcium store level, available to be released with spiking as SKCaR, which can bind to SKCa receptors and drive K current. replenishment is a function of spiking activity being below a threshold.`, 68: `SKCaR is the released amount of intracellular calcium, from SKCaIn, as a function of spiking events. This can bind to SKCa channels and drive K currents.`, 69: `SKCaM is the Calcium-gated potassium channel gating factor, driven by SKCaR via a Hill equation as in chans.SKPCaParams.`, 70: `Gmahp is medium time scale AHP conductance.`, 71: `MahpN is accumulating voltage-gated gating value for the medium time scale AHP.`, 72: `Gsahp is slow time scale AHP conductance.`, 73: `SahpCa is slowly accumulating calcium value that drives the slow AHP.`, 74: `SahpN is the sAHP gating value.`, 75: `ActM is ActInt activation state at end of third quarter, representing the posterior-cortical minus phase activation. This is used for statistics and monitoring network performance. Should not be used for learning or other computations.`, 76: `ActP is ActInt activation state at end of fourth quarter, representing the posterior-cortical plus_phase activation. This is used for statistics and monitoring network performance. Should not be used for learning or other computations.`, 77: `Beta1 is the activation state at the first beta cycle within current state processing window (i.e., at 50 msec), as saved by Beta1() function. Used for example in hippocampus for CA3, CA1 learning.`, 78: `Beta2 is the activation state at the second beta cycle within current state processing window (i.e., at 100 msec), as saved by Beta2() function. Used for example in hippocampus for CA3, CA1 learning.`, 79: `CaPMax is the maximum [CaP] across one theta cycle time window (max of CaPMaxCa). It is used for specialized algorithms that have more phasic behavior within a single trial, e.g., BG Matrix layer gating. Also useful for visualization of peak activity of neurons.`, 80: `CaPMaxCa is the Ca integrated like [CaP] but only starting at the MaxCycStart cycle, to prevent inclusion of carryover spiking from prior theta cycle trial. The PTau time constant otherwise results in significant carryover. This is the input to CaPMax.`, 81: `GeNoise is integrated noise excitatory conductance, added into Ge.`, 82: `GeNoiseP is accumulating poisson probability factor for driving excitatory noise spiking. Multiply times uniform random deviate at each time step, until it gets below the target threshold based on poisson lambda as function of noise firing rate.`, 83: `GiNoise is integrated noise inhibitory conductance, added into Gi.`, 84: `GiNoiseP is accumulating poisson probability factor for driving inhibitory noise spiking. Multiply times uniform random deviate at each time step, until it gets below the target threshold based on poisson lambda as a function of noise firing rate.`, 85: `GeExt is extra excitatory conductance added to Ge, from Ext input, GeCtxt etc.`, 86: `GeRaw is the raw excitatory conductance (net input) received from senders = current raw spiking drive.`, 87: `GeSyn is the time-integrated total excitatory (AMPA) synaptic conductance, with an instantaneous rise time from each spike (in GeRaw) and exponential decay with Dt.GeTau, aggregated over pathways. Does *not* include Gbar.E.`, 88: `GiRaw is the raw inhibitory conductance (net input) received from senders = current raw spiking drive.`, 89: `GiSyn is time-integrated total inhibitory synaptic conductance, with an instantaneous rise time from each spike (in GiRaw) and exponential decay with Dt.GiTau, aggregated over pathways -- does *not* include Gbar.I. This is added with computed FFFB inhibition to get the full inhibition in Gi.`, 90: `GeInt is integrated running-average activation value computed from Ge with time constant Act.Dt.IntTau, to produce a longer-term integrated value reflecting the overall Ge level across the ThetaCycle time scale (Ge itself fluctuates considerably). This is useful for stats to set strength of connections etc to get neurons into right range of overall excitatory drive.`, 91: `GeIntNorm is normalized GeInt value (divided by the layer maximum). This is used for learning in layers that require learning on subthreshold activity.`, 92: `GiInt is integrated running-average activation value computed from GiSyn with time constant Act.Dt.IntTau, to produce a longer-term integrated value reflecting the overall synaptic Gi level across the ThetaCycle time scale (Gi itself fluctuates considerably). Useful for stats to set strength of connections etc to get neurons into right range of overall inhibitory drive.`, 93: `GModRaw is raw modulatory conductance, received from GType = ModulatoryG pathways.`, 94: `GModSyn is syn integrated modulatory conductance, received from GType = ModulatoryG pathways.`, 95: `SMaintP is accumulating poisson probability factor for driving self-maintenance by simulating a population of mutually interconnected neurons. Multiply times uniform random deviate at each time step, until it gets below the target threshold based on poisson lambda based on accumulating self maint factor.`, 96: `GMaintRaw is raw maintenance conductance, received from GType = MaintG pathways.`, 97: `GMaintSyn is syn integrated maintenance conductance, integrated using MaintNMDA params.`, 98: `NeurFlags are bit flags for binary state variables, which are converted to / from uint32. These need to be in Vars because they can be differential per data (for ext inputs) and are writable (indexes are read only).`, 99: `NeuronTraces is a vector of values starting here, with aggregated [CaSyn] values in time bins of [NeuronTraceCycles] across two theta cycles, for computing synaptic calcium efficiently. Each bin = Sum(CaSyn / NeuronTraceCycles). Total number of bins = 2 * [Context.ThetaCycles] / NeuronTraceCycles. Use [NeuronTraceIndex] to access. Synaptic calcium is integrated from sender * receiver NeuronTraces values, with weights for CaP vs CaD that reflect their faster vs. slower time constants, respectively. CaD is used for the credit assignment factor, while CaP - CaD is used directly for error-driven learning at Target layers.`}
+var _NeuronVarsDescMap = map[NeuronVars]string{0: `Spike is whether neuron has spiked or not on this cycle (0 or 1).`, 1: `Spiked is 1 if neuron has spiked within the last 10 cycles (msecs), corresponding to a nominal max spiking rate of 100 Hz, 0 otherwise. Useful for visualization and computing activity levels in terms of average spiked levels.`, 2: `Act is rate-coded activation value reflecting instantaneous estimated rate of spiking, based on 1 / ISIAvg. It is integrated over time for ActInt which is then used for performance statistics and layer average activations, etc. Should not be used for learning or other computations: just for stats / display.`, 3: `ActInt is integrated running-average activation value computed from Act with time constant Act.Dt.IntTau, to produce a longer-term integrated value reflecting the overall activation state across the ThetaCycle time scale, as the overall response of network to current input state. This is copied to ActM and ActP at the ends of the minus and plus phases, respectively, and used in computing some performance-level statistics (based on ActM). Should not be used for learning or other computations.`, 4: `Ge is total excitatory conductance, including all forms of excitation (e.g., NMDA). Does *not* include the Gbar.E factor.`, 5: `Gi is total inhibitory synaptic conductance, i.e., the net inhibitory input to the neuron. Does *not* include the Gbar.I factor.`, 6: `Gk is total potassium conductance, typically reflecting sodium-gated potassium currents involved in adaptation effects. Does *not* include the Gbar.K factor.`, 7: `Inet is net current produced by all channels, which drives update of Vm.`, 8: `Vm is the membrane potential at the cell body, which integrates Inet current over time, and drives spiking at the axon initial segment of the neuron.`, 9: `VmDend is the dendritic membrane potential, which has a slower time constant than Vm and is not subject to the VmR reset after spiking.`, 10: `ISI is the current inter-spike-interval, which counts up since last spike. Starts at -1 when initialized.`, 11: `ISIAvg is the average inter-spike-interval, i.e., the average time interval between spikes, integrated with ISITau rate constant (relatively fast) to capture something close to an instantaneous spiking rate. Starts at -1 when initialized, and goes to -2 after first spike, and is only valid after the second spike post-initialization.`, 12: `Ext is the external input: drives activation of unit from outside influences (e.g., sensory input).`, 13: `Target is the target value: drives learning to produce this activation value.`, 14: `CaM is the spike-driven calcium trace at the neuron level, which then drives longer time-integrated variables: [CaP] and [CaD]. These variables are used for statistics and display to capture spiking activity at different timescales. They fluctuate more than [Act] and [ActInt], but are closer to the biological variables driving learning. CaM is the exponential integration of SpikeG * Spike using the MTau time constant (typically 5), and simulates a calmodulin (CaM) like signal, at an abstract level.`, 15: `CaP is the continuous cascaded integration of [CaM] using the PTau time constant (typically 40), representing a neuron-level, purely spiking version of the plus, LTP direction of weight change in the Kinase learning rule, dependent on CaMKII. This is not used for learning (see [LearnCaP]), but instead for statistics as a representation of recent activity.`, 16: `CaD is the continuous cascaded integration [CaP] using the DTau time constant (typically 40), representing a neuron-level, purely spiking version of the minus, LTD direction of weight change in the Kinase learning rule, dependent on DAPK1. This is not used for learning (see [LearnCaD]), but instead for statistics as a representation of trial-level activity.`, 17: `CaDPrev is the final [CaD] activation state at the end of previous theta cycle. This is used for specialized learning mechanisms that operate on delayed sending activations.`, 18: `CaSyn is the neuron-level integration of spike-driven calcium, used to approximate synaptic calcium influx as a product of sender and receiver neuron CaSyn values, which are integrated separately because it is computationally much more efficient. CaSyn enters into a Sender * Receiver product at each synapse to give the effective credit assignment factor for learning. This value is driven directly by spikes, with an exponential integration time constant of 30 msec (default), which captures the coincidence window for pre*post firing on NMDA receptor opening. The neuron [NeuronTraces] values record the temporal trajectory of CaSyn over the course of the theta cycle window, and then the pre*post product is integrated over these bins at the synaptic level.`, 19: `LearnCa is the receiving neuron calcium signal, which is integrated up to [LearnCaP] and [LearnCaD], the difference of which is the temporal error component of the kinase cortical learning rule. LearnCa combines NMDA via [NmdaCa] and spiking-driven VGCC [VgccCaInt] calcium sources. The NMDA signal reflects both sending and receiving activity, while the VGCC signal is purely receiver spiking, and a balance of both works best.`, 20: `LearnCaM is the integrated [LearnCa] at the MTau timescale (typically 5), simulating a calmodulin (CaM) like signal, which then drives [LearnCaP], and [LearnCaD] for the delta signal for error-driven learning.`, 21: `LearnCaP is the cascaded integration of [LearnCaM] using the PTau time constant (typically 40), representing the plus, LTP direction of weight change, capturing the function of CaMKII in the Kinase learning rule.`, 22: `LearnCaD is the cascaded integration of [LearnCaP] using the DTau time constant (typically 40), representing the minus, LTD direction of weight change, capturing the function of DAPK1 in the Kinase learning rule.`, 23: `CaDiff is difference between [LearnCaP] - [LearnCaD]. This is the error signal that drives error-driven learning.`, 24: `GaM is first-level integration of all input conductances g_a, which then drives longer time-integrated variables: [GaP] and [GaD]. These variables are used for timing of learning based on bursts of activity change over time: at the minus and plus phases.`, 25: `GaP is the continuous cascaded integration of [GaM] using the PTau time constant (typically 40), representing a neuron-level, all-conductance-based version of the plus, LTP direction of weight change in the Kinase learning rule.`, 26: `GaD is the continuous cascaded integration of [GaP] using the DTau time constant (typically 40), representing a neuron-level, all-conductance-based version of the minus, LTD direction of weight change in the Kinase learning rule.`, 27: `TimeDiff is the running time-average of |P - D| (absolute value), used for determining the timing of learning in terms of onsets of peaks. See [TPeakCycle]. GaP - GaD is used, as it is smoother and more reliable than LearnCaP - D.`, 28: `TimePeak is the current peak value of TimeDiff, used for computing [TPeakCycle] when [TimeDiff] &gt; [TimePeak], which in turn determines [MinusPeak] after the enabling time window has passed.`, 29: `TPeakCycle is the absolute cycle (ms, CyclesTotal) when the last [TimePeak] value was updated.`, 30: `PeakUps is the number of consecutive increases in peak value.`, 31: `MinusPeak is the value of the last detected minus-phase peak, from [TimePeak], This typically occurs at the onset of the minus phase, and drives the timing of learning a given number of cycles after that.`, 32: `MinusCycle is the absolute cycle (ms, CyclesTotal) when the minus-phase peak occurred, copied from [TPeakCycle] for that peak.`, 33: `MinusWindow is the absolute cycle (ms, CyclesTotal) when the minus-phase peak detection window was reached, and the minus phase was detected. After this, there are additional cycles where the neuron could get over the CaD threshold for learning, or not. LearnNow is relative to this point.`, 34: `Enabled is the absolute cycle (ms, CyclesTotal) when the receiving neuron is above threshold for learning, and a minus-phase peak has been detected. For neocortex, this is after [MinusWindow], and the neuron CaD level has gone above the learning threshold, within a minimum number of cycles. If not using flexible learning timing, this is set to the end of the theta cycle. See [LearnTimingParams] for details.`, 35: `EnabledPrev is the absolute cycle (ms, CyclesTotal) for the previous [Enabled] value, if set. This is used for learning that is triggered by a minus phase subsequent to being enabled.`, 36: `LearnNow is the absolute cycle (ms, CyclesTotal) when the receiving neuron actually learns. See [Enabled] for enabling conditions, and [LearnTimingParams] for parameters. For neocortex, this can be based on going back from the subsequent minus phase peak, after being enabled (see [EnabledPrev]).`, 37: `RLRate is recv-unit based learning rate multiplier, reflecting the sigmoid derivative computed from [CaD] of recv unit, and the normalized difference (CaP - CaD) / MAX(CaP - CaD).`, 38: `ETrace is the eligibility trace for this neuron.`, 39: `ETrLearn is the learning factor for the eligibility trace for this neuron. 1 + ETraceScale * [ETrace]`, 40: `PoolDAD1 is the value of this neuron&#39;s sub-pool DAD1 dopamine D1 receptor activation, for Basal Ganglia (PCore) Patch neurons in dorsal striatum.`, 41: `PoolDAD2 is the value of this neuron&#39;s sub-pool DAD2 dopamine D2 receptor activation, for Basal Ganglia (PCore) Patch neurons in dorsal striatum.`, 42: `GnmdaSyn is the integrated NMDA synaptic current on the receiving neuron. It adds GeRaw and decays with a time constant.`, 43: `Gnmda is the net postsynaptic (receiving) NMDA conductance, after Mg V-gating and Gbar. This is added directly to Ge as it has the same reversal potential.`, 44: `GnmdaLrn is learning version of integrated NMDA recv synaptic current. It adds [GeRaw] and decays with a time constant. This drives [NmdaCa] that then drives [LearnCa] for learning.`, 45: `GnmdaMaint is net postsynaptic maintenance NMDA conductance, computed from [GMaintSyn] and [GMaintRaw], after Mg V-gating and Gbar. This is added directly to Ge as it has the same reversal potential.`, 46: `NmdaCa is NMDA calcium computed from GnmdaLrn, drives learning via CaM.`, 47: `Gvgcc is conductance (via Ca) for VGCC voltage gated calcium channels.`, 48: `VgccM is activation gate of VGCC channels.`, 49: `VgccH inactivation gate of VGCC channels.`, 50: `VgccCa is the instantaneous VGCC calcium flux: can be driven by spiking or directly from Gvgcc.`, 51: `VgccCaInt is the time-integrated VGCC calcium flux. This is actually what drives learning. It also integrates [CatCa] from CaT channels.`, 52: `Gcat is the conductance of the low-threshold T-type calcium channel, which drives rebound bursting after hyperpolarization.`, 53: `CatM is the activation gate of the CaT channel.`, 54: `CatH is the inactivation gate of the CaT channel, which is de-inactivated by hyperpolarization.`, 55: `CatCa is the instantaneous CaT calcium flux, which is integrated into [VgccCaInt] to contribute to [LearnCa].`, 56: `Gnap is the conductance of the persistent sodium channel.`, 57: `NapM is the activation gate of the NaP channel.`, 58: `NapH is the slow inactivation gate of the NaP channel.`, 59: `Igap is the total gap junction current from [GapJunctionPath] pathways, proportional to the Vm difference with coupled neurons.`, 60: `GapVm is the Vm at the end of the previous cycle, which is used for computing gap junction currents between coupled neurons.`, 61: `Burst is the layer 5 IB intrinsic bursting neural activation value, computed by thresholding the [CaP] value in Super superficial layers.`, 62: `BurstPrv is previous Burst bursting activation from prior time step. Used for context-based learning.`, 63: `CtxtGe is context (temporally delayed) excitatory conductance, driven by deep bursting at end of the plus phase, for CT layers.`, 64: `CtxtGeRaw is raw update of context (temporally delayed) excitatory conductance, driven by deep bursting at end of the plus phase, for CT layers.`, 65: `CtxtGeOrig is original CtxtGe value prior to any decay factor. Updates at end of plus phase.`, 66: `GgabaB is net GABA-B conductance, after Vm gating and Gk + Gbase. Applies to Gk, not Gi, for GIRK, with .1 reversal potential.`, 67: `GababM is the GABA-B / GIRK activation, which is a time-integrated value with rise and decay time constants.`, 68: `GababX is GABA-B / GIRK internal drive variable. This gets the raw activation and decays.`, 69: `Gak is the conductance of A-type K potassium channels.`, 70: `SSGiDend is the amount of SST+ somatostatin positive slow spiking inhibition applied to dendritic Vm (VmDend). This includes GiDendSyn from explicit SST interneurons.`, 71: `GiDendSyn is the time-integrated inhibitory synaptic conductance from pathways with [DendInhibitoryG] conductance type, e.g., from explicit SST+ interneurons, which is added to SSGiDend to inhibit the dendrite instead of the soma. Does *not* include Gbar.I.`, 72: `GknaMed is the conductance of sodium-gated potassium channel (KNa) medium dynamics (Slick), which produces accommodation / adaptation.`, 73: `GknaSlow is the conductance of sodium-gated potassium channel (KNa) slow dynamics (Slack), which produces accommodation / adaptation.`, 74: `Gkir is the conductance of the potassium (K) inwardly rectifying channel, which is strongest at low membrane potentials. Can be modulated by DA.`, 75: `KirM is the Kir potassium (K) inwardly rectifying gating value.`, 76: `Gh is the conductance of the HCN hyperpolarization-activated cation channel (Ih current), including the HCN.Gbar factor. This drives a depolarizing current after hyperpolarization.`, 77: `HcnM is the HCN channel gating value, which opens slowly with hyperpolarization.`, 78: `Gsk is Calcium-gated potassium channel conductance as a function of Gbar * SKCaM.`, 79: `SKCaIn is intracellular calcium store level, available to be released with spiking as SKCaR, which can bind to SKCa receptors and drive K current. replenishment is a function of spiking activity being below a threshold.`, 80: `SKCaR is the released amount of intracellular calcium, from SKCaIn, as a function of spiking events. This can bind to SKCa channels and drive K currents.`, 81: `SKCaM is the Calcium-gated potassium channel gating factor, driven by SKCaR via a Hill equation as in chans.SKPCaParams.`, 82: `Gmahp is medium time scale AHP conductance.`, 83: `MahpN is accumulating voltage-gated gating value for the medium time scale AHP.`, 84: `Gsahp is slow time scale AHP conductance.`, 85: `SahpCa is slowly accumulating calcium value that drives the slow AHP.`, 86: `SahpN is the sAHP gating value.`, 87: `ActM is ActInt activation state at end of third quarter, representing the posterior-cortical minus phase activation. This is used for statistics and monitoring network performance. Should not be used for learning or other computations.`, 88: `ActP is ActInt activation state at end of fourth quarter, representing the posterior-cortical plus_phase activation. This is used for statistics and monitoring network performance. Should not be used for learning or other computations.`, 89: `Beta1 is the activation state at the first beta cycle within current state processing window (i.e., at 50 msec), as saved by Beta1() function. Used for example in hippocampus for CA3, CA1 learning.`, 90: `Beta2 is the activation state at the second beta cycle within current state processing window (i.e., at 100 msec), as saved by Beta2() function. Used for example in hippocampus for CA3, CA1 learning.`, 91: `CaPMax is the maximum [CaP] across one theta cycle time window (max of CaPMaxCa). It is used for specialized algorithms that have more phasic behavior within a single trial, e.g., BG Matrix layer gating. Also useful for visualization of peak activity of neurons.`, 92: `CaPMaxCa is the Ca integrated like [CaP] but only starting at the MaxCycStart cycle, to prevent inclusion of carryover spiking from prior theta cycle trial. The PTau time constant otherwise results in significant carryover. This is the input to CaPMax.`, 93: `GeNoise is integrated noise excitatory conductance, added into Ge.`, 94: `GeNoiseP is accumulating poisson probability factor for driving excitatory noise spiking. Multiply times uniform random deviate at each time step, until it gets below the target threshold based on poisson lambda as function of noise firing rate.`, 95: `GiNoise is integrated noise inhibitory conductance, added into Gi.`, 96: `GiNoiseP is accumulating poisson probability factor for driving inhibitory noise spiking. Multiply times uniform random deviate at each time step, until it gets below the target threshold based on poisson lambda as a function of noise firing rate.`, 97: `GeExt is extra excitatory conductance added to Ge, from Ext input, GeCtxt etc.`, 98: `GeRaw is the raw excitatory conductance (net input) received from senders = current raw spiking drive.`, 99: `GeSyn is the time-integrated total excitatory (AMPA) synaptic conductance, with an instantaneous rise time from each spike (in GeRaw) and exponential decay with Dt.GeTau, aggregated over pathways. Does *not* include Gbar.E.`, 100: `GiRaw is the raw inhibitory conductance (net input) received from senders = current raw spiking drive.`, 101: `GiSyn is time-integrated total inhibitory synaptic conductance, with an instantaneous rise time from each spike (in GiRaw) and exponential decay with Dt.GiTau, aggregated over pathways -- does *not* include Gbar.I. This is added with computed FFFB inhibition to get the full inhibition in Gi.`, 102: `GeInt is integrated running-average activation value computed from Ge with time constant Act.Dt.IntTau, to produce a longer-term integrated value reflecting the overall Ge level across the ThetaCycle time scale (Ge itself fluctuates considerably). This is useful for stats to set strength of connections etc to get neurons into right range of overall excitatory drive.`, 103: `GeIntNorm is normalized GeInt value (divided by the layer maximum). This is used for learning in layers that require learning on subthreshold activity.`, 104: `GiInt is integrated running-average activation value computed from GiSyn with time constant Act.Dt.IntTau, to produce a longer-term integrated value reflecting the overall synaptic Gi level across the ThetaCycle time scale (Gi itself fluctuates considerably). Useful for stats to set strength of connections etc to get neurons into right range of overall inhibitory drive.`, 105: `GModRaw is raw modulatory conductance, received from GType = ModulatoryG pathways.`, 106: `GModSyn is syn integrated modulatory conductance, received from GType = ModulatoryG pathways.`, 107: `SMaintP is accumulating poisson probability factor for driving self-maintenance by simulating a population of mutually interconnected neurons. Multiply times uniform random deviate at each time step, until it gets below the target threshold based on poisson lambda based on accumulating self maint factor.`, 108: `GMaintRaw is raw maintenance conductance, received from GType = MaintG pathways.`, 109: `GMaintSyn is syn integrated maintenance conductance, integrated using MaintNMDA params.`, 110: `NeurFlags are bit flags for binary state variables, which are converted to / from uint32. These need to be in Vars because they can be differential per data (for ext inputs) and are writable (indexes are read only).`, 111: `NeuronTraces is a vector of values starting here, with aggregated [CaSyn] values in time bins of [NeuronTraceCycles] across two theta cycles, for computing synaptic calcium efficiently. Each bin = Sum(CaSyn / NeuronTraceCycles). Total number of bins = 2 * [Context.ThetaCycles] / NeuronTraceCycles. Use [NeuronTraceIndex] to access. Synaptic calcium is integrated from sender * receiver NeuronTraces values, with weights for CaP vs CaD that reflect their faster vs. slower time constants, respectively. CaD is used for the credit assignment factor, while CaP - CaD is used directly for error-driven learning at Target layers.`}
 
-var _NeuronVarsMap = map[NeuronVars]string{0: `Spike`, 1: `Spiked`, 2: `Act`, 3: `ActInt`, 4: `Ge`, 5: `Gi`, 6: `Gk`, 7: `Inet`, 8: `Vm`, 9: `VmDend`, 10: `ISI`, 11: `ISIAvg`, 12: `Ext`, 13: `Target`, 14: `CaM`, 15: `CaP`, 16: `CaD`, 17: `CaDPrev`, 18: `CaSyn`, 19: `LearnCa`, 20: `LearnCaM`, 21: `LearnCaP`, 22: `LearnCaD`, 23: `CaDiff`, 24: `GaM`, 25: `GaP`, 26: `GaD`, 27: `TimeDiff`, 28: `TimePeak`, 29: `TPeakCycle`, 30: `PeakUps`, 31: `MinusPeak`, 32: `MinusCycle`, 33: `MinusWindow`, 34: `Enabled`, 35: `EnabledPrev`, 36: `LearnNow`, 37: `RLRate`, 38: `ETrace`, 39: `ETrLearn`, 40: `PoolDAD1`, 41: `PoolDAD2`, 42: `GnmdaSyn`, 43: `Gnmda`, 44: `GnmdaLrn`, 45: `GnmdaMaint`, 46: `NmdaCa`, 47: `Gvgcc`, 48: `VgccM`, 49: `VgccH`, 50: `VgccCa`, 51: `VgccCaInt`, 52: `Burst`, 53: `BurstPrv`, 54: `CtxtGe`, 55: `CtxtGeRaw`, 56: `CtxtGeOrig`, 57: `GgabaB`, 58: `GababM`, 59: `GababX`, 60: `Gak`, 61: `SSGiDend`, 62: `GknaMed`, 63: `GknaSlow`, 64: `Gkir`, 65: `KirM`, 66: `Gsk`, 67: `SKCaIn`, 68: `SKCaR`, 69: `SKCaM`, 70: `Gmahp`, 71: `MahpN`, 72: `Gsahp`, 73: `SahpCa`, 74: `SahpN`, 75: `ActM`, 76: `ActP`, 77: `Beta1`, 78: `Beta2`, 79: `CaPMax`, 80: `CaPMaxCa`, 81: `GeNoise`, 82: `GeNoiseP`, 83: `GiNoise`, 84: `GiNoiseP`, 85: `GeExt`, 86: `GeRaw`, 87: `GeSyn`, 88: `GiRaw`, 89: `GiSyn`, 90: `GeInt`, 91: `GeIntNorm`, 92: `GiInt`, 93: `GModRaw`, 94: `GModSyn`, 95: `SMaintP`, 96: `GMaintRaw`, 97: `GMaintSyn`, 98: `NeurFlags`, 99: `NeuronTraces`}
+var _NeuronVarsMap = map[NeuronVars]string{0: `Spike`, 1: `Spiked`, 2: `Act`, 3: `ActInt`, 4: `Ge`, 5: `Gi`, 6: `Gk`, 7: `Inet`, 8: `Vm`, 9: `VmDend`, 10: `ISI`, 11: `ISIAvg`, 12: `Ext`, 13: `Target`, 14: `CaM`, 15: `CaP`, 16: `CaD`, 17: `CaDPrev`, 18: `CaSyn`, 19: `LearnCa`, 20: `LearnCaM`, 21: `LearnCaP`, 22: `LearnCaD`, 23: `CaDiff`, 24: `GaM`, 25: `GaP`, 26: `GaD`, 27: `TimeDiff`, 28: `TimePeak`, 29: `TPeakCycle`, 30: `PeakUps`, 31: `MinusPeak`, 32: `MinusCycle`, 33: `MinusWindow`, 34: `Enabled`, 35: `EnabledPrev`, 36: `LearnNow`, 37: `RLRate`, 38: `ETrace`, 39: `ETrLearn`, 40: `PoolDAD1`, 41: `PoolDAD2`, 42: `GnmdaSyn`, 43: `Gnmda`, 44: `GnmdaLrn`, 45: `GnmdaMaint`, 46: `NmdaCa`, 47: `Gvgcc`, 48: `VgccM`, 49: `VgccH`, 50: `VgccCa`, 51: `VgccCaInt`, 52: `Gcat`, 53: `CatM`, 54: `CatH`, 55: `CatCa`, 56: `Gnap`, 57: `NapM`, 58: `NapH`, 59: `Igap`, 60: `GapVm`, 61: `Burst`, 62: `BurstPrv`, 63: `CtxtGe`, 64: `CtxtGeRaw`, 65: `CtxtGeOrig`, 66: `GgabaB`, 67: `GababM`, 68: `GababX`, 69: `Gak`, 70: `SSGiDend`, 71: `GiDendSyn`, 72: `GknaMed`, 73: `GknaSlow`, 74: `Gkir`, 75: `KirM`, 76: `Gh`, 77: `HcnM`, 78: `Gsk`, 79: `SKCaIn`, 80: `SKCaR`, 81: `SKCaM`, 82: `Gmahp`, 83: `MahpN`, 84: `Gsahp`, 85: `SahpCa`, 86: `SahpN`, 87: `ActM`, 88: `ActP`, 89: `Beta1`, 90: `Beta2`, 91: `CaPMax`, 92: `CaPMaxCa`, 93: `GeNoise`, 94: `GeNoiseP`, 95: `GiNoise`, 96: `GiNoiseP`, 97: `GeExt`, 98: `GeRaw`, 99: `GeSyn`, 100: `GiRaw`, 101: `GiSyn`, 102: `GeInt`, 103: `GeIntNorm`, 104: `GiInt`, 105: `GModRaw`, 106: `GModSyn`, 107: `SMaintP`, 108: `GMaintRaw`, 109: `GMaintSyn`, 110: `NeurFlags`, 111: `NeuronTraces`}
 
 // String returns the string representation of this NeuronVars value.
 func (i NeuronVars) String() string { return enums.String(i, _NeuronVarsMap) }
@@ -568,20 +570,20 @@ func (i *NeuronTracesVars) UnmarshalText(text []byte) error {
 	return enums.UnmarshalText(i, text, "NeuronTracesVars")
 }
 
-var _NeuronAvgVarsValues = []NeuronAvgVars{0, 1, 2, 3, 4, 5, 6}
+var _NeuronAvgVarsValues = []NeuronAvgVars{0, 1, 2, 3, 4, 5, 6, 7, 8}
 
 // NeuronAvgVarsN is the highest valid value for type NeuronAvgVars, plus one.
 //
 //gosl:start
-const NeuronAvgVarsN NeuronAvgVars = 7
+const NeuronAvgVarsN NeuronAvgVars = 9
 
 //gosl:end
 
-var _NeuronAvgVarsValueMap = map[string]NeuronAvgVars{`ActAvg`: 0, `AvgPct`: 1, `TrgAvg`: 2, `DTrgAvg`: 3, `AvgDif`: 4, `GeBase`: 5, `GiBase`: 6}
+var _NeuronAvgVarsValueMap = map[string]NeuronAvgVars{`ActAvg`: 0, `AvgPct`: 1, `TrgAvg`: 2, `DTrgAvg`: 3, `AvgDif`: 4, `GeBase`: 5, `GiBase`: 6, `IPThr`: 7, `IPGl`: 8}
 
-var _NeuronAvgVarsDescMap = map[NeuronAvgVars]string{0: `ActAvg is average activation (of minus phase activation state) over long time intervals (time constant = Dt.LongAvgTau). Useful for finding hog units and seeing overall distribution of activation.`, 1: `AvgPct is ActAvg as a proportion of overall layer activation. This is used for synaptic scaling to match TrgAvg activation, updated at SlowInterval intervals.`, 2: `TrgAvg is neuron&#39;s target average activation as a proportion of overall layer activation, assigned during weight initialization, driving synaptic scaling relative to AvgPct.`, 3: `DTrgAvg is change in neuron&#39;s target average activation as a result of unit-wise error gradient. Acts like a bias weight. MPI needs to share these across processors.`, 4: `AvgDif is AvgPct - TrgAvg, i.e., the error in overall activity level relative to set point for this neuron, which drives synaptic scaling. Updated at SlowInterval intervals.`, 5: `GeBase is baseline level of Ge, added to GeRaw, for intrinsic excitability.`, 6: `GiBase is baseline level of Gi, added to GiRaw, for intrinsic excitability.`}
+var _NeuronAvgVarsDescMap = map[NeuronAvgVars]string{0: `ActAvg is average activation (of minus phase activation state) over long time intervals (time constant = Dt.LongAvgTau). Useful for finding hog units and seeing overall distribution of activation.`, 1: `AvgPct is ActAvg as a proportion of overall layer activation. This is used for synaptic scaling to match TrgAvg activation, updated at SlowInterval intervals.`, 2: `TrgAvg is neuron&#39;s target average activation as a proportion of overall layer activation, assigned during weight initialization, driving synaptic scaling relative to AvgPct.`, 3: `DTrgAvg is change in neuron&#39;s target average activation as a result of unit-wise error gradient. Acts like a bias weight. MPI needs to share these across processors.`, 4: `AvgDif is AvgPct - TrgAvg, i.e., the error in overall activity level relative to set point for this neuron, which drives synaptic scaling. Updated at SlowInterval intervals.`, 5: `GeBase is baseline level of Ge, added to GeRaw, for intrinsic excitability.`, 6: `GiBase is baseline level of Gi, added to GiRaw, for intrinsic excitability.`, 7: `IPThr is the intrinsic plasticity offset on the spike threshold (mV), adapted at SlowInterval intervals to drive ActAvg toward the Acts.Intrinsic.TrgHz target firing rate.`, 8: `IPGl is the intrinsic plasticity offset on the leak conductance, as a proportion of Gbar.L, adapted at SlowInterval intervals to drive ActAvg toward the Acts.Intrinsic.TrgHz target firing rate.`}
 
-var _NeuronAvgVarsMap = map[NeuronAvgVars]string{0: `ActAvg`, 1: `AvgPct`, 2: `TrgAvg`, 3: `DTrgAvg`, 4: `AvgDif`, 5: `GeBase`, 6: `GiBase`}
+var _NeuronAvgVarsMap = map[NeuronAvgVars]string{0: `ActAvg`, 1: `AvgPct`, 2: `TrgAvg`, 3: `DTrgAvg`, 4: `AvgDif`, 5: `GeBase`, 6: `GiBase`, 7: `IPThr`, 8: `IPGl`}
 
 // String returns the string representation of this NeuronAvgVars value.
 func (i NeuronAvgVars) String() string { return enums.String(i, _NeuronAvgVarsMap) }
@@ -662,20 +664,20 @@ func (i *NeuronIndexVars) UnmarshalText(text []byte) error {
 	return enums.UnmarshalText(i, text, "NeuronIndexVars")
 }
 
-var _PathTypesValues = []PathTypes{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13}
+var _PathTypesValues = []PathTypes{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
 
 // PathTypesN is the highest valid value for type PathTypes, plus one.
 //
 //gosl:start
-const PathTypesN PathTypes = 14
+const PathTypesN PathTypes = 16
 
 //gosl:end
 
-var _PathTypesValueMap = map[string]PathTypes{`ForwardPath`: 0, `BackPath`: 1, `LateralPath`: 2, `InhibPath`: 3, `CTCtxtPath`: 4, `DSPatchPath`: 5, `VSPatchPath`: 6, `VSMatrixPath`: 7, `DSMatrixPath`: 8, `CNIOPath`: 9, `RWPath`: 10, `TDPredPath`: 11, `BLAPath`: 12, `HipPath`: 13}
+var _PathTypesValueMap = map[string]PathTypes{`ForwardPath`: 0, `BackPath`: 1, `LateralPath`: 2, `InhibPath`: 3, `CTCtxtPath`: 4, `DSPatchPath`: 5, `VSPatchPath`: 6, `VSMatrixPath`: 7, `DSMatrixPath`: 8, `CNIOPath`: 9, `RWPath`: 10, `TDPredPath`: 11, `BLAPath`: 12, `HipPath`: 13, `GapJunctionPath`: 14, `ActorPath`: 15}
 
-var _PathTypesDescMap = map[PathTypes]string{0: `Forward is a feedforward, bottom-up pathway from sensory inputs to higher layers`, 1: `Back is a feedback, top-down pathway from higher layers back to lower layers`, 2: `Lateral is a lateral pathway within the same layer / area`, 3: `Inhib is an inhibitory pathway that drives inhibitory synaptic conductances instead of the default excitatory ones.`, 4: `CTCtxt are pathways from Superficial layers to CT layers that send Burst activations drive updating of CtxtGe excitatory conductance, at end of plus (51B Bursting) phase. Biologically, this pathway comes from the PT layer 5IB neurons, but it is simpler to use the Super neurons directly, and PT are optional for most network types. These pathways also use a special learning rule that takes into account the temporal delays in the activation states. Can also add self context from CT for deeper temporal context.`, 5: `DSPatchPath implements the DSPatch learning rule: dW = ACh * DA * X * Y where DA is D1 vs. D2 modulated DA level, X = sending activity factor, Y = receiving activity factor, and ACh provides overall modulation.`, 6: `VSPatchPath implements the VSPatch learning rule: dW = ACh * DA * X * Y where DA is D1 vs. D2 modulated DA level, X = sending activity factor, Y = receiving activity factor, and ACh provides overall modulation.`, 7: `VSMatrixPath is for ventral striatum matrix (SPN / MSN) neurons supporting trace-based learning, where an initial trace of synaptic co-activity is formed, and then modulated by subsequent phasic dopamine &amp; ACh when an outcome occurs. This bridges the temporal gap between gating activity and subsequent outcomes, and is based biologically on synaptic tags. Trace is reset at time of reward based on ACh level (from CINs in biology).`, 8: `DSMatrixPath is for dorsal striatum matrix (SPN / MSN) neurons supporting trace-based learning, where an initial trace of synaptic co-activity is formed, and then modulated by subsequent phasic dopamine &amp; ACh when an outcome occurs. This bridges the temporal gap between gating activity and subsequent outcomes, and is based biologically on synaptic tags. Trace is reset at time of reward based on ACh level (from CINs in biology).`, 9: `CNIOPath is a cerebellar nucleus mossy fiber sensory input pathway trained by IO error signals. It is used for inputs to CNiIO layers, CNiUp, and CNeDn.`, 10: `RWPath does dopamine-modulated learning for reward prediction: Da * Send.CaP (integrated current spiking activity). Uses RLPredPath parameters. Use in RWPredLayer typically to generate reward predictions. If the Da sign is positive, the first recv unit learns fully; for negative, second one learns fully. Lower lrate applies for opposite cases. Weights are positive-only.`, 11: `TDPredPath does dopamine-modulated learning for reward prediction: DWt = Da * Send.CaDPrev (activity on *previous* timestep) Uses RLPredPath parameters. Use in TDPredLayer typically to generate reward predictions. If the Da sign is positive, the first recv unit learns fully; for negative, second one learns fully. Lower lrate applies for opposite cases. Weights are positive-only.`, 12: `BLAPath implements the Rubicon BLA learning rule: dW = ACh * X_t-1 * (Y_t - Y_t-1) The recv delta is across trials, where the US should activate on trial boundary, to enable sufficient time for gating through to OFC, so BLA initially learns based on US present - US absent. It can also learn based on CS onset if there is a prior CS that predicts that.`, 13: `HipPath is a special pathway for the hippocampus. TODO: fixme.`}
+var _PathTypesDescMap = map[PathTypes]string{0: `Forward is a feedforward, bottom-up pathway from sensory inputs to higher layers`, 1: `Back is a feedback, top-down pathway from higher layers back to lower layers`, 2: `Lateral is a lateral pathway within the same layer / area`, 3: `Inhib is an inhibitory pathway that drives inhibitory synaptic conductances instead of the default excitatory ones.`, 4: `CTCtxt are pathways from Superficial layers to CT layers that send Burst activations drive updating of CtxtGe excitatory conductance, at end of plus (51B Bursting) phase. Biologically, this pathway comes from the PT layer 5IB neurons, but it is simpler to use the Super neurons directly, and PT are optional for most network types. These pathways also use a special learning rule that takes into account the temporal delays in the activation states. Can also add self context from CT for deeper temporal context.`, 5: `DSPatchPath implements the DSPatch learning rule: dW = ACh * DA * X * Y where DA is D1 vs. D2 modulated DA level, X = sending activity factor, Y = receiving activity factor, and ACh provides overall modulation.`, 6: `VSPatchPath implements the VSPatch learning rule: dW = ACh * DA * X * Y where DA is D1 vs. D2 modulated DA level, X = sending activity factor, Y = receiving activity factor, and ACh provides overall modulation.`, 7: `VSMatrixPath is for ventral striatum matrix (SPN / MSN) neurons supporting trace-based learning, where an initial trace of synaptic co-activity is formed, and then modulated by subsequent phasic dopamine &amp; ACh when an outcome occurs. This bridges the temporal gap between gating activity and subsequent outcomes, and is based biologically on synaptic tags. Trace is reset at time of reward based on ACh level (from CINs in biology).`, 8: `DSMatrixPath is for dorsal striatum matrix (SPN / MSN) neurons supporting trace-based learning, where an initial trace of synaptic co-activity is formed, and then modulated by subsequent phasic dopamine &amp; ACh when an outcome occurs. This bridges the temporal gap between gating activity and subsequent outcomes, and is based biologically on synaptic tags. Trace is reset at time of reward based on ACh level (from CINs in biology).`, 9: `CNIOPath is a cerebellar nucleus mossy fiber sensory input pathway trained by IO error signals. It is used for inputs to CNiIO layers, CNiUp, and CNeDn.`, 10: `RWPath does dopamine-modulated learning for reward prediction: Da * Send.CaP (integrated current spiking activity). Uses RLPredPath parameters. Use in RWPredLayer typically to generate reward predictions. If the Da sign is positive, the first recv unit learns fully; for negative, second one learns fully. Lower lrate applies for opposite cases. Weights are positive-only.`, 11: `TDPredPath does dopamine-modulated learning for reward prediction: DWt = Da * Send.CaDPrev (activity on *previous* timestep) If RLPred.Lambda &gt; 0, an eligibility trace of Send.CaDPrev is used instead, for TD(lambda) learning. Uses RLPredPath parameters. Use in TDPredLayer typically to generate reward predictions. If the Da sign is positive, the first recv unit learns fully; for negative, second one learns fully. Lower lrate applies for opposite cases. Weights are positive-only.`, 12: `BLAPath implements the Rubicon BLA learning rule: dW = ACh * X_t-1 * (Y_t - Y_t-1) The recv delta is across trials, where the US should activate on trial boundary, to enable sufficient time for gating through to OFC, so BLA initially learns based on US present - US absent. It can also learn based on CS onset if there is a prior CS that predicts that.`, 13: `HipPath is a special pathway for the hippocampus. TODO: fixme.`, 14: `GapJunctionPath is an electrical coupling pathway via gap junctions, which produces a current in each receiving neuron proportional to the Vm difference with each of its coupled sending neurons, computed every cycle, instead of sending spikes. Connectivity is made symmetric at Build, and the sending and receiving layers must be the same. Gap junctions are important for synchrony in the inferior olive (IO), thalamic reticular nucleus (TRN), and interneuron networks.`, 15: `ActorPath does dopamine-modulated policy-gradient learning for the actor layer in an actor-critic pairing (see [Network.AddActorCritic]): DWt = Da * Tr, where the eligibility trace Tr accumulates Send.CaDPrev * (Recv.CaDPrev - ActAvg.Nominal) over time steps, decaying by RLPred.Lambda. The Da signal reflects the TD error for the state on the *previous* time step, so it reinforces the actions taken then. Uses RLPredPath parameters.`}
 
-var _PathTypesMap = map[PathTypes]string{0: `ForwardPath`, 1: `BackPath`, 2: `LateralPath`, 3: `InhibPath`, 4: `CTCtxtPath`, 5: `DSPatchPath`, 6: `VSPatchPath`, 7: `VSMatrixPath`, 8: `DSMatrixPath`, 9: `CNIOPath`, 10: `RWPath`, 11: `TDPredPath`, 12: `BLAPath`, 13: `HipPath`}
+var _PathTypesMap = map[PathTypes]string{0: `ForwardPath`, 1: `BackPath`, 2: `LateralPath`, 3: `InhibPath`, 4: `CTCtxtPath`, 5: `DSPatchPath`, 6: `VSPatchPath`, 7: `VSMatrixPath`, 8: `DSMatrixPath`, 9: `CNIOPath`, 10: `RWPath`, 11: `TDPredPath`, 12: `BLAPath`, 13: `HipPath`, 14: `GapJunctionPath`, 15: `ActorPath`}
 
 // String returns the string representation of this PathTypes value.
 func (i PathTypes) String() string { return enums.String(i, _PathTypesMap) }
@@ -1127,3 +1129,731 @@ func (i SynapseIndexVars) MarshalText() ([]byte, error) { return []byte(i.String
 func (i *SynapseIndexVars) UnmarshalText(text []byte) error {
 	return enums.UnmarshalText(i, text, "SynapseIndexVars")
 }
+
+var _BuilderTypesValues = []BuilderTypes{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+
+// BuilderTypesN is the highest valid value for type BuilderTypes, plus one.
+const BuilderTypesN BuilderTypes = 10
+
+var _BuilderTypesValueMap = map[string]BuilderTypes{`SuperCT`: 0, `PulvForSuper`: 1, `InputPulv`: 2, `PFC`: 3, `VentralBG`: 4, `DorsalBG`: 5, `Rubicon`: 6, `Hip`: 7, `TDLayers`: 8, `RWLayers`: 9}
+
+var _BuilderTypesDescMap = map[BuilderTypes]string{0: `BuildSuperCT calls [Network.AddSuperCT2D] or [Network.AddSuperCT4D] depending on the number of Shape dimensions, with PathClass and Pattern.`, 1: `BuildPulvForSuper calls [Network.AddPulvForSuper] for the Super layer with the Name of the LayerSpec.`, 2: `BuildInputPulv calls [Network.AddInputPulv2D] or [Network.AddInputPulv4D] depending on the number of Shape dimensions.`, 3: `BuildPFC calls [Network.AddPFC2D] or [Network.AddPFC4D] depending on the number of Shape dimensions, with ThalSuffix, DecayOnRew and SelfMaint.`, 4: `BuildVentralBG calls [Network.AddVentralBG] with the 4D Shape and the 2D GPShape, using the Name as the prefix.`, 5: `BuildDorsalBG calls [Network.AddDorsalBG] with the 4D Shape, the 2D GPShape and PoolSTN, using the Name as the prefix.`, 6: `BuildRubicon calls [Network.AddRubicon] with NYneur and the 2D PopShape, BGShape and PFCShape. The Name is not used. The [NetSpec] RubiconPosUSs must be set.`, 7: `BuildHip calls [Network.AddHip] with the Hip config. The Name is not used.`, 8: `BuildTDLayers calls [Network.AddTDLayers] with Rel, using the Name as the prefix.`, 9: `BuildRWLayers calls [Network.AddRWLayers] with Rel, using the Name as the prefix.`}
+
+var _BuilderTypesMap = map[BuilderTypes]string{0: `SuperCT`, 1: `PulvForSuper`, 2: `InputPulv`, 3: `PFC`, 4: `VentralBG`, 5: `DorsalBG`, 6: `Rubicon`, 7: `Hip`, 8: `TDLayers`, 9: `RWLayers`}
+
+// String returns the string representation of this BuilderTypes value.
+func (i BuilderTypes) String() string { return enums.String(i, _BuilderTypesMap) }
+
+// SetString sets the BuilderTypes value from its string representation,
+// and returns an error if the string is invalid.
+func (i *BuilderTypes) SetString(s string) error {
+	return enums.SetString(i, s, _BuilderTypesValueMap, "BuilderTypes")
+}
+
+// Int64 returns the BuilderTypes value as an int64.
+func (i BuilderTypes) Int64() int64 { return int64(i) }
+
+// SetInt64 sets the BuilderTypes value from an int64.
+func (i *BuilderTypes) SetInt64(in int64) { *i = BuilderTypes(in) }
+
+// Desc returns the description of the BuilderTypes value.
+func (i BuilderTypes) Desc() string { return enums.Desc(i, _BuilderTypesDescMap) }
+
+// BuilderTypesValues returns all possible values for the type BuilderTypes.
+func BuilderTypesValues() []BuilderTypes { return _BuilderTypesValues }
+
+// Values returns all possible values for the type BuilderTypes.
+func (i BuilderTypes) Values() []enums.Enum { return enums.Values(_BuilderTypesValues) }
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (i BuilderTypes) MarshalText() ([]byte, error) { return []byte(i.String()), nil }
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+func (i *BuilderTypes) UnmarshalText(text []byte) error {
+	return enums.UnmarshalText(i, text, "BuilderTypes")
+}
+
+var _STPVarsValues = []STPVars{0, 1}
+
+// STPVarsN is the highest valid value for type STPVars, plus one.
+//
+//gosl:start
+const STPVarsN STPVars = 2
+
+//gosl:end
+
+var _STPVarsValueMap = map[string]STPVars{`STPUtil`: 0, `STPRes`: 1}
+
+var _STPVarsDescMap = map[STPVars]string{0: `STPUtil is the current utilization, i.e., the probability of release of the available synaptic resources, which is incremented at each spike and decays back to 0 with [STPParams.TauF].`, 1: `STPRes is the current fraction of available synaptic resources, which is decremented by the amount released at each spike, and recovers back to 1 with [STPParams.TauD].`}
+
+var _STPVarsMap = map[STPVars]string{0: `STPUtil`, 1: `STPRes`}
+
+// String returns the string representation of this STPVars value.
+func (i STPVars) String() string { return enums.String(i, _STPVarsMap) }
+
+// SetString sets the STPVars value from its string representation,
+// and returns an error if the string is invalid.
+func (i *STPVars) SetString(s string) error {
+	return enums.SetString(i, s, _STPVarsValueMap, "STPVars")
+}
+
+// Int64 returns the STPVars value as an int64.
+func (i STPVars) Int64() int64 { return int64(i) }
+
+// SetInt64 sets the STPVars value from an int64.
+func (i *STPVars) SetInt64(in int64) { *i = STPVars(in) }
+
+// Desc returns the description of the STPVars value.
+func (i STPVars) Desc() string { return enums.Desc(i, _STPVarsDescMap) }
+
+// STPVarsValues returns all possible values for the type STPVars.
+func STPVarsValues() []STPVars { return _STPVarsValues }
+
+// Values returns all possible values for the type STPVars.
+func (i STPVars) Values() []enums.Enum { return enums.Values(_STPVarsValues) }
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (i STPVars) MarshalText() ([]byte, error) { return []byte(i.String()), nil }
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+func (i *STPVars) UnmarshalText(text []byte) error {
+	return enums.UnmarshalText(i, text, "STPVars")
+}
+
+var _DendVarsValues = []DendVars{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
+// DendVarsN is the highest valid value for type DendVars, plus one.
+//
+//gosl:start
+const DendVarsN DendVars = 11
+
+//gosl:end
+
+var _DendVarsValueMap = map[string]DendVars{`DendVm`: 0, `DendGeRaw`: 1, `DendGeSyn`: 2, `DendGnmdaSyn`: 3, `DendGnmda`: 4, `DendGnmdaLrn`: 5, `DendNmdaCa`: 6, `DendGvgcc`: 7, `DendVgccM`: 8, `DendVgccH`: 9, `DendVgccCa`: 10}
+
+var _DendVarsDescMap = map[DendVars]string{0: `DendVm is the membrane potential of the compartment, which is integrated with the VmDend time constant.`, 1: `DendGeRaw is the raw excitatory conductance (net input) received from pathways targeting this compartment via [PathParams.DendComp].`, 2: `DendGeSyn is the time-integrated total excitatory (AMPA) synaptic conductance from pathways targeting this compartment.`, 3: `DendGnmdaSyn is the integrated NMDA synaptic current in this compartment, prior to the voltage-dependent Mg block.`, 4: `DendGnmda is the net postsynaptic NMDA conductance in this compartment, after the Mg V-gating based on DendVm, contributing to its Ge.`, 5: `DendGnmdaLrn is the learning version of the integrated NMDA in this compartment, using [LearnNeuronParams.LearnNMDA].`, 6: `DendNmdaCa is the NMDA calcium in this compartment, which is added to NmdaCa for learning.`, 7: `DendGvgcc is the conductance of the VGCC voltage-gated calcium channels in this compartment, driven by DendVm.`, 8: `DendVgccM is the activation gate of the VGCC channels in this compartment.`, 9: `DendVgccH is the inactivation gate of the VGCC channels in this compartment.`, 10: `DendVgccCa is the instantaneous VGCC calcium flux in this compartment, which is added to VgccCaInt for learning.`}
+
+var _DendVarsMap = map[DendVars]string{0: `DendVm`, 1: `DendGeRaw`, 2: `DendGeSyn`, 3: `DendGnmdaSyn`, 4: `DendGnmda`, 5: `DendGnmdaLrn`, 6: `DendNmdaCa`, 7: `DendGvgcc`, 8: `DendVgccM`, 9: `DendVgccH`, 10: `DendVgccCa`}
+
+// String returns the string representation of this DendVars value.
+func (i DendVars) String() string { return enums.String(i, _DendVarsMap) }
+
+// SetString sets the DendVars value from its string representation,
+// and returns an error if the string is invalid.
+func (i *DendVars) SetString(s string) error {
+	return enums.SetString(i, s, _DendVarsValueMap, "DendVars")
+}
+
+// Int64 returns the DendVars value as an int64.
+func (i DendVars) Int64() int64 { return int64(i) }
+
+// SetInt64 sets the DendVars value from an int64.
+func (i *DendVars) SetInt64(in int64) { *i = DendVars(in) }
+
+// Desc returns the description of the DendVars value.
+func (i DendVars) Desc() string { return enums.Desc(i, _DendVarsDescMap) }
+
+// DendVarsValues returns all possible values for the type DendVars.
+func DendVarsValues() []DendVars { return _DendVarsValues }
+
+// Values returns all possible values for the type DendVars.
+func (i DendVars) Values() []enums.Enum { return enums.Values(_DendVarsValues) }
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (i DendVars) MarshalText() ([]byte, error) { return []byte(i.String()), nil }
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+func (i *DendVars) UnmarshalText(text []byte) error {
+	return enums.UnmarshalText(i, text, "DendVars")
+}
+
+var _RegrowModesValues = []RegrowModes{0, 1}
+
+// RegrowModesN is the highest valid value for type RegrowModes, plus one.
+//
+//gosl:start
+const RegrowModesN RegrowModes = 2
+
+//gosl:end
+
+var _RegrowModesValueMap = map[string]RegrowModes{`RegrowRandom`: 0, `RegrowCorrel`: 1}
+
+var _RegrowModesDescMap = map[RegrowModes]string{0: `RegrowRandom selects the sending neuron uniformly at random from among those not already connected to the receiving neuron.`, 1: `RegrowCorrel selects the sending neuron with a probability proportional to its co-activity with the receiving neuron, as the product of their CaD values summed over data parallel items, from among those not already connected. If there is no co-activity, it reverts to RegrowRandom.`}
+
+var _RegrowModesMap = map[RegrowModes]string{0: `RegrowRandom`, 1: `RegrowCorrel`}
+
+// String returns the string representation of this RegrowModes value.
+func (i RegrowModes) String() string { return enums.String(i, _RegrowModesMap) }
+
+// SetString sets the RegrowModes value from its string representation,
+// and returns an error if the string is invalid.
+func (i *RegrowModes) SetString(s string) error {
+	return enums.SetString(i, s, _RegrowModesValueMap, "RegrowModes")
+}
+
+// Int64 returns the RegrowModes value as an int64.
+func (i RegrowModes) Int64() int64 { return int64(i) }
+
+// SetInt64 sets the RegrowModes value from an int64.
+func (i *RegrowModes) SetInt64(in int64) { *i = RegrowModes(in) }
+
+// Desc returns the description of the RegrowModes value.
+func (i RegrowModes) Desc() string { return enums.Desc(i, _RegrowModesDescMap) }
+
+// RegrowModesValues returns all possible values for the type RegrowModes.
+func RegrowModesValues() []RegrowModes { return _RegrowModesValues }
+
+// Values returns all possible values for the type RegrowModes.
+func (i RegrowModes) Values() []enums.Enum { return enums.Values(_RegrowModesValues) }
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (i RegrowModes) MarshalText() ([]byte, error) { return []byte(i.String()), nil }
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+func (i *RegrowModes) UnmarshalText(text []byte) error {
+	return enums.UnmarshalText(i, text, "RegrowModes")
+}
+
+var _STDPRulesValues = []STDPRules{0, 1, 2}
+
+// STDPRulesN is the highest valid value for type STDPRules, plus one.
+//
+//gosl:start
+const STDPRulesN STDPRules = 3
+
+//gosl:end
+
+var _STDPRulesValueMap = map[string]STDPRules{`NoSTDP`: 0, `PairSTDP`: 1, `TripletSTDP`: 2}
+
+var _STDPRulesDescMap = map[STDPRules]string{0: `NoSTDP does not use STDP: the default learning rule is used.`, 1: `PairSTDP is the standard pair-based additive STDP rule with all-to-all spike interactions, where each post spike produces LTP according to APlus times the presynaptic trace (decaying with TauPlus), and each pre spike produces LTD according to AMinus times the postsynaptic trace (decaying with TauMinus). This produces the canonical exponential STDP window.`, 2: `TripletSTDP is the Pfister &amp; Gerstner (2006) triplet rule, which adds slower pre (TauX) and post (TauY) traces that modulate LTD (A3Minus) and LTP (A3Plus) respectively, capturing the frequency dependence of plasticity.`}
+
+var _STDPRulesMap = map[STDPRules]string{0: `NoSTDP`, 1: `PairSTDP`, 2: `TripletSTDP`}
+
+// String returns the string representation of this STDPRules value.
+func (i STDPRules) String() string { return enums.String(i, _STDPRulesMap) }
+
+// SetString sets the STDPRules value from its string representation,
+// and returns an error if the string is invalid.
+func (i *STDPRules) SetString(s string) error {
+	return enums.SetString(i, s, _STDPRulesValueMap, "STDPRules")
+}
+
+// Int64 returns the STDPRules value as an int64.
+func (i STDPRules) Int64() int64 { return int64(i) }
+
+// SetInt64 sets the STDPRules value from an int64.
+func (i *STDPRules) SetInt64(in int64) { *i = STDPRules(in) }
+
+// Desc returns the description of the STDPRules value.
+func (i STDPRules) Desc() string { return enums.Desc(i, _STDPRulesDescMap) }
+
+// STDPRulesValues returns all possible values for the type STDPRules.
+func STDPRulesValues() []STDPRules { return _STDPRulesValues }
+
+// Values returns all possible values for the type STDPRules.
+func (i STDPRules) Values() []enums.Enum { return enums.Values(_STDPRulesValues) }
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (i STDPRules) MarshalText() ([]byte, error) { return []byte(i.String()), nil }
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+func (i *STDPRules) UnmarshalText(text []byte) error {
+	return enums.UnmarshalText(i, text, "STDPRules")
+}
+
+var _STDPVarsValues = []STDPVars{0, 1, 2, 3}
+
+// STDPVarsN is the highest valid value for type STDPVars, plus one.
+//
+//gosl:start
+const STDPVarsN STDPVars = 4
+
+//gosl:end
+
+var _STDPVarsValueMap = map[string]STDPVars{`STDPPre`: 0, `STDPPre2`: 1, `STDPPost`: 2, `STDPPost2`: 3}
+
+var _STDPVarsDescMap = map[STDPVars]string{0: `STDPPre is the fast presynaptic trace, decaying with TauPlus.`, 1: `STDPPre2 is the slow presynaptic trace, decaying with TauX (triplet only).`, 2: `STDPPost is the fast postsynaptic trace, decaying with TauMinus.`, 3: `STDPPost2 is the slow postsynaptic trace, decaying with TauY (triplet only).`}
+
+var _STDPVarsMap = map[STDPVars]string{0: `STDPPre`, 1: `STDPPre2`, 2: `STDPPost`, 3: `STDPPost2`}
+
+// String returns the string representation of this STDPVars value.
+func (i STDPVars) String() string { return enums.String(i, _STDPVarsMap) }
+
+// SetString sets the STDPVars value from its string representation,
+// and returns an error if the string is invalid.
+func (i *STDPVars) SetString(s string) error {
+	return enums.SetString(i, s, _STDPVarsValueMap, "STDPVars")
+}
+
+// Int64 returns the STDPVars value as an int64.
+func (i STDPVars) Int64() int64 { return int64(i) }
+
+// SetInt64 sets the STDPVars value from an int64.
+func (i *STDPVars) SetInt64(in int64) { *i = STDPVars(in) }
+
+// Desc returns the description of the STDPVars value.
+func (i STDPVars) Desc() string { return enums.Desc(i, _STDPVarsDescMap) }
+
+// STDPVarsValues returns all possible values for the type STDPVars.
+func STDPVarsValues() []STDPVars { return _STDPVarsValues }
+
+// Values returns all possible values for the type STDPVars.
+func (i STDPVars) Values() []enums.Enum { return enums.Values(_STDPVarsValues) }
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (i STDPVars) MarshalText() ([]byte, error) { return []byte(i.String()), nil }
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+func (i *STDPVars) UnmarshalText(text []byte) error {
+	return enums.UnmarshalText(i, text, "STDPVars")
+}
+
+var _SleepModesValues = []SleepModes{0, 1}
+
+// SleepModesN is the highest valid value for type SleepModes, plus one.
+const SleepModesN SleepModes = 2
+
+var _SleepModesValueMap = map[string]SleepModes{`SleepSpontaneous`: 0, `SleepReplay`: 1}
+
+var _SleepModesDescMap = map[SleepModes]string{0: `SleepSpontaneous runs spontaneous activity driven only by the Sleep.Noise background spiking, without any external input.`, 1: `SleepReplay replays patterns recorded in the Sleep.Replay buffer during training, as partial cues to the recorded layers, on top of the Sleep.Noise background spiking. For a hippocampal model ([Network.AddHip]), recording the cortical Input layer patterns allows the hippocampus to complete and reinstate the full patterns in cortex.`}
+
+var _SleepModesMap = map[SleepModes]string{0: `SleepSpontaneous`, 1: `SleepReplay`}
+
+// String returns the string representation of this SleepModes value.
+func (i SleepModes) String() string { return enums.String(i, _SleepModesMap) }
+
+// SetString sets the SleepModes value from its string representation,
+// and returns an error if the string is invalid.
+func (i *SleepModes) SetString(s string) error {
+	return enums.SetString(i, s, _SleepModesValueMap, "SleepModes")
+}
+
+// Int64 returns the SleepModes value as an int64.
+func (i SleepModes) Int64() int64 { return int64(i) }
+
+// SetInt64 sets the SleepModes value from an int64.
+func (i *SleepModes) SetInt64(in int64) { *i = SleepModes(in) }
+
+// Desc returns the description of the SleepModes value.
+func (i SleepModes) Desc() string { return enums.Desc(i, _SleepModesDescMap) }
+
+// SleepModesValues returns all possible values for the type SleepModes.
+func SleepModesValues() []SleepModes { return _SleepModesValues }
+
+// Values returns all possible values for the type SleepModes.
+func (i SleepModes) Values() []enums.Enum { return enums.Values(_SleepModesValues) }
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (i SleepModes) MarshalText() ([]byte, error) { return []byte(i.String()), nil }
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+func (i *SleepModes) UnmarshalText(text []byte) error {
+	return enums.UnmarshalText(i, text, "SleepModes")
+}
+
+var _ConsolRulesValues = []ConsolRules{0, 1, 2}
+
+// ConsolRulesN is the highest valid value for type ConsolRules, plus one.
+//
+//gosl:start
+const ConsolRulesN ConsolRules = 3
+
+//gosl:end
+
+var _ConsolRulesValueMap = map[string]ConsolRules{`NoConsol`: 0, `ConsolEWC`: 1, `ConsolSI`: 2}
+
+var _ConsolRulesDescMap = map[ConsolRules]string{0: `NoConsol does not use weight consolidation.`, 1: `ConsolEWC is elastic weight consolidation (Kirkpatrick et al, 2017), where the importance of each synapse is the diagonal of the Fisher information, estimated as the running average of the squared weight gradient over the course of learning each task.`, 2: `ConsolSI is synaptic intelligence (Zenke et al, 2017), where the importance of each synapse is the path integral of the gradient times the weight change over the course of learning each task, normalized by the total squared weight change over the task.`}
+
+var _ConsolRulesMap = map[ConsolRules]string{0: `NoConsol`, 1: `ConsolEWC`, 2: `ConsolSI`}
+
+// String returns the string representation of this ConsolRules value.
+func (i ConsolRules) String() string { return enums.String(i, _ConsolRulesMap) }
+
+// SetString sets the ConsolRules value from its string representation,
+// and returns an error if the string is invalid.
+func (i *ConsolRules) SetString(s string) error {
+	return enums.SetString(i, s, _ConsolRulesValueMap, "ConsolRules")
+}
+
+// Int64 returns the ConsolRules value as an int64.
+func (i ConsolRules) Int64() int64 { return int64(i) }
+
+// SetInt64 sets the ConsolRules value from an int64.
+func (i *ConsolRules) SetInt64(in int64) { *i = ConsolRules(in) }
+
+// Desc returns the description of the ConsolRules value.
+func (i ConsolRules) Desc() string { return enums.Desc(i, _ConsolRulesDescMap) }
+
+// ConsolRulesValues returns all possible values for the type ConsolRules.
+func ConsolRulesValues() []ConsolRules { return _ConsolRulesValues }
+
+// Values returns all possible values for the type ConsolRules.
+func (i ConsolRules) Values() []enums.Enum { return enums.Values(_ConsolRulesValues) }
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (i ConsolRules) MarshalText() ([]byte, error) { return []byte(i.String()), nil }
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+func (i *ConsolRules) UnmarshalText(text []byte) error {
+	return enums.UnmarshalText(i, text, "ConsolRules")
+}
+
+var _ConsolVarsValues = []ConsolVars{0, 1, 2}
+
+// ConsolVarsN is the highest valid value for type ConsolVars, plus one.
+//
+//gosl:start
+const ConsolVarsN ConsolVars = 3
+
+//gosl:end
+
+var _ConsolVarsValueMap = map[string]ConsolVars{`ConsolAnchor`: 0, `ConsolImp`: 1, `ConsolAcc`: 2}
+
+var _ConsolVarsDescMap = map[ConsolVars]string{0: `ConsolAnchor is the anchor [LWt] value at the end of the previous task, set by [Network.ConsolSnapshot], toward which the weight is pulled in proportion to its importance.`, 1: `ConsolImp is the consolidated importance of the synapse for all previous tasks, updated by [Network.ConsolSnapshot].`, 2: `ConsolAcc accumulates the importance over the current task: the running average squared gradient for ConsolEWC, or the gradient times weight change path integral for ConsolSI.`}
+
+var _ConsolVarsMap = map[ConsolVars]string{0: `ConsolAnchor`, 1: `ConsolImp`, 2: `ConsolAcc`}
+
+// String returns the string representation of this ConsolVars value.
+func (i ConsolVars) String() string { return enums.String(i, _ConsolVarsMap) }
+
+// SetString sets the ConsolVars value from its string representation,
+// and returns an error if the string is invalid.
+func (i *ConsolVars) SetString(s string) error {
+	return enums.SetString(i, s, _ConsolVarsValueMap, "ConsolVars")
+}
+
+// Int64 returns the ConsolVars value as an int64.
+func (i ConsolVars) Int64() int64 { return int64(i) }
+
+// SetInt64 sets the ConsolVars value from an int64.
+func (i *ConsolVars) SetInt64(in int64) { *i = ConsolVars(in) }
+
+// Desc returns the description of the ConsolVars value.
+func (i ConsolVars) Desc() string { return enums.Desc(i, _ConsolVarsDescMap) }
+
+// ConsolVarsValues returns all possible values for the type ConsolVars.
+func ConsolVarsValues() []ConsolVars { return _ConsolVarsValues }
+
+// Values returns all possible values for the type ConsolVars.
+func (i ConsolVars) Values() []enums.Enum { return enums.Values(_ConsolVarsValues) }
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (i ConsolVars) MarshalText() ([]byte, error) { return []byte(i.String()), nil }
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+func (i *ConsolVars) UnmarshalText(text []byte) error {
+	return enums.UnmarshalText(i, text, "ConsolVars")
+}
+
+var _LRateSchedTypesValues = []LRateSchedTypes{0, 1, 2, 3, 4}
+
+// LRateSchedTypesN is the highest valid value for type LRateSchedTypes, plus one.
+const LRateSchedTypesN LRateSchedTypes = 5
+
+var _LRateSchedTypesValueMap = map[string]LRateSchedTypes{`LRateStep`: 0, `LRateExp`: 1, `LRateCosine`: 2, `LRateWarmup`: 3, `LRatePlateau`: 4}
+
+var _LRateSchedTypesDescMap = map[LRateSchedTypes]string{0: `LRateStep multiplies the learning rate by Factor every Interval steps: Factor^floor(step / Interval).`, 1: `LRateExp decays the learning rate exponentially, by Factor per Interval steps: Factor^(step / Interval).`, 2: `LRateCosine decreases the learning rate from 1 to Min over Interval steps, following a half cosine wave, and stays at Min thereafter.`, 3: `LRateWarmup only has the initial Warmup period, and stays at 1 thereafter.`, 4: `LRatePlateau multiplies the learning rate by Factor each time the epoch-level Stat has not improved by at least Threshold for Patience epochs.`}
+
+var _LRateSchedTypesMap = map[LRateSchedTypes]string{0: `LRateStep`, 1: `LRateExp`, 2: `LRateCosine`, 3: `LRateWarmup`, 4: `LRatePlateau`}
+
+// String returns the string representation of this LRateSchedTypes value.
+func (i LRateSchedTypes) String() string { return enums.String(i, _LRateSchedTypesMap) }
+
+// SetString sets the LRateSchedTypes value from its string representation,
+// and returns an error if the string is invalid.
+func (i *LRateSchedTypes) SetString(s string) error {
+	return enums.SetString(i, s, _LRateSchedTypesValueMap, "LRateSchedTypes")
+}
+
+// Int64 returns the LRateSchedTypes value as an int64.
+func (i LRateSchedTypes) Int64() int64 { return int64(i) }
+
+// SetInt64 sets the LRateSchedTypes value from an int64.
+func (i *LRateSchedTypes) SetInt64(in int64) { *i = LRateSchedTypes(in) }
+
+// Desc returns the description of the LRateSchedTypes value.
+func (i LRateSchedTypes) Desc() string { return enums.Desc(i, _LRateSchedTypesDescMap) }
+
+// LRateSchedTypesValues returns all possible values for the type LRateSchedTypes.
+func LRateSchedTypesValues() []LRateSchedTypes { return _LRateSchedTypesValues }
+
+// Values returns all possible values for the type LRateSchedTypes.
+func (i LRateSchedTypes) Values() []enums.Enum { return enums.Values(_LRateSchedTypesValues) }
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (i LRateSchedTypes) MarshalText() ([]byte, error) { return []byte(i.String()), nil }
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+func (i *LRateSchedTypes) UnmarshalText(text []byte) error {
+	return enums.UnmarshalText(i, text, "LRateSchedTypes")
+}
+
+var _ThreeFactorRulesValues = []ThreeFactorRules{0, 1, 2}
+
+// ThreeFactorRulesN is the highest valid value for type ThreeFactorRules, plus one.
+//
+//gosl:start
+const ThreeFactorRulesN ThreeFactorRules = 3
+
+//gosl:end
+
+var _ThreeFactorRulesValueMap = map[string]ThreeFactorRules{`NoThreeFactor`: 0, `HebbThreeFactor`: 1, `PerturbThreeFactor`: 2}
+
+var _ThreeFactorRulesDescMap = map[ThreeFactorRules]string{0: `NoThreeFactor does not use three-factor learning: the default rule is used.`, 1: `HebbThreeFactor uses the Hebbian co-product of sending and receiving activity (CaD) as the synaptic eligibility, which is then modulated by the (signed) modulatory factor, as in classic reward-modulated Hebbian learning.`, 2: `PerturbThreeFactor is a node-perturbation rule, where the eligibility is the sending activity times the deviation of the receiving activity (CaD) from its long-term average (ActAvg), which reflects the random perturbations from noise (Acts.Noise should be on in the receiving layer). When the modulatory factor reflects performance relative to its expected value, this follows the gradient of performance.`}
+
+var _ThreeFactorRulesMap = map[ThreeFactorRules]string{0: `NoThreeFactor`, 1: `HebbThreeFactor`, 2: `PerturbThreeFactor`}
+
+// String returns the string representation of this ThreeFactorRules value.
+func (i ThreeFactorRules) String() string { return enums.String(i, _ThreeFactorRulesMap) }
+
+// SetString sets the ThreeFactorRules value from its string representation,
+// and returns an error if the string is invalid.
+func (i *ThreeFactorRules) SetString(s string) error {
+	return enums.SetString(i, s, _ThreeFactorRulesValueMap, "ThreeFactorRules")
+}
+
+// Int64 returns the ThreeFactorRules value as an int64.
+func (i ThreeFactorRules) Int64() int64 { return int64(i) }
+
+// SetInt64 sets the ThreeFactorRules value from an int64.
+func (i *ThreeFactorRules) SetInt64(in int64) { *i = ThreeFactorRules(in) }
+
+// Desc returns the description of the ThreeFactorRules value.
+func (i ThreeFactorRules) Desc() string { return enums.Desc(i, _ThreeFactorRulesDescMap) }
+
+// ThreeFactorRulesValues returns all possible values for the type ThreeFactorRules.
+func ThreeFactorRulesValues() []ThreeFactorRules { return _ThreeFactorRulesValues }
+
+// Values returns all possible values for the type ThreeFactorRules.
+func (i ThreeFactorRules) Values() []enums.Enum { return enums.Values(_ThreeFactorRulesValues) }
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (i ThreeFactorRules) MarshalText() ([]byte, error) { return []byte(i.String()), nil }
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+func (i *ThreeFactorRules) UnmarshalText(text []byte) error {
+	return enums.UnmarshalText(i, text, "ThreeFactorRules")
+}
+
+var _ThreeFactorModsValues = []ThreeFactorMods{0, 1}
+
+// ThreeFactorModsN is the highest valid value for type ThreeFactorMods, plus one.
+//
+//gosl:start
+const ThreeFactorModsN ThreeFactorMods = 2
+
+//gosl:end
+
+var _ThreeFactorModsValueMap = map[string]ThreeFactorMods{`ThreeFactorGlobal`: 0, `ThreeFactorLayer`: 1}
+
+var _ThreeFactorModsDescMap = map[ThreeFactorMods]string{0: `ThreeFactorGlobal uses a [GlobalScalarVars] value, specified by Global, e.g., the dopamine [GvDA] reward prediction error (default).`, 1: `ThreeFactorLayer uses a layer-level error signal broadcast from the layer specified by ModLayIndex (the receiving layer by default), as the reduction of the [LayerPhaseDiff] relative to its running average [LayerPhaseDiffAvg], so that smaller than expected errors reinforce the eligible synapses.`}
+
+var _ThreeFactorModsMap = map[ThreeFactorMods]string{0: `ThreeFactorGlobal`, 1: `ThreeFactorLayer`}
+
+// String returns the string representation of this ThreeFactorMods value.
+func (i ThreeFactorMods) String() string { return enums.String(i, _ThreeFactorModsMap) }
+
+// SetString sets the ThreeFactorMods value from its string representation,
+// and returns an error if the string is invalid.
+func (i *ThreeFactorMods) SetString(s string) error {
+	return enums.SetString(i, s, _ThreeFactorModsValueMap, "ThreeFactorMods")
+}
+
+// Int64 returns the ThreeFactorMods value as an int64.
+func (i ThreeFactorMods) Int64() int64 { return int64(i) }
+
+// SetInt64 sets the ThreeFactorMods value from an int64.
+func (i *ThreeFactorMods) SetInt64(in int64) { *i = ThreeFactorMods(in) }
+
+// Desc returns the description of the ThreeFactorMods value.
+func (i ThreeFactorMods) Desc() string { return enums.Desc(i, _ThreeFactorModsDescMap) }
+
+// ThreeFactorModsValues returns all possible values for the type ThreeFactorMods.
+func ThreeFactorModsValues() []ThreeFactorMods { return _ThreeFactorModsValues }
+
+// Values returns all possible values for the type ThreeFactorMods.
+func (i ThreeFactorMods) Values() []enums.Enum { return enums.Values(_ThreeFactorModsValues) }
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (i ThreeFactorMods) MarshalText() ([]byte, error) { return []byte(i.String()), nil }
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+func (i *ThreeFactorMods) UnmarshalText(text []byte) error {
+	return enums.UnmarshalText(i, text, "ThreeFactorMods")
+}
+
+var _WtBoundModesValues = []WtBoundModes{0, 1, 2, 3, 4}
+
+// WtBoundModesN is the highest valid value for type WtBoundModes, plus one.
+//
+//gosl:start
+const WtBoundModesN WtBoundModes = 5
+
+//gosl:end
+
+var _WtBoundModesValueMap = map[string]WtBoundModes{`SoftBound`: 0, `HardBound`: 1, `MultBound`: 2, `LogNormBound`: 3, `DaleBound`: 4}
+
+var _WtBoundModesDescMap = map[WtBoundModes]string{0: `SoftBound is the standard exponential soft weight bounding, where weight increases are multiplied by the distance to the Max bound, and decreases by the distance to the Min bound. This is offset by the sigmoidal contrast enhancement in [SWtAdaptParams].`, 1: `HardBound is additive learning independent of the weight value, with weights hard-bounded (clipped) at the Min and Max bounds. This produces a bimodal weight distribution over time.`, 2: `MultBound is multiplicative soft-bounded learning with configurable exponents, where increases are multiplied by the distance to Max raised to the MuPlus power, and decreases by the distance to Min raised to the MuMinus power (Gutig et al, 2003). 0 = additive (HardBound) and 1 = SoftBound.`, 3: `LogNormBound has additive weight increases and decreases proportional to the weight value, as in van Rossum et al (2000). This produces a stable, unimodal, approximately log-normal weight distribution centered around the Ref value, where increases and decreases balance.`, 4: `DaleBound is additive learning for pathways with a fixed sign according to Dale&#39;s law, where weights are magnitudes hard-bounded at the Min and Max bounds and cannot change sign. For inhibitory pathways, the sign of the weight change is inverted, so that learning increases inhibition where the same change would decrease excitation.`}
+
+var _WtBoundModesMap = map[WtBoundModes]string{0: `SoftBound`, 1: `HardBound`, 2: `MultBound`, 3: `LogNormBound`, 4: `DaleBound`}
+
+// String returns the string representation of this WtBoundModes value.
+func (i WtBoundModes) String() string { return enums.String(i, _WtBoundModesMap) }
+
+// SetString sets the WtBoundModes value from its string representation,
+// and returns an error if the string is invalid.
+func (i *WtBoundModes) SetString(s string) error {
+	return enums.SetString(i, s, _WtBoundModesValueMap, "WtBoundModes")
+}
+
+// Int64 returns the WtBoundModes value as an int64.
+func (i WtBoundModes) Int64() int64 { return int64(i) }
+
+// SetInt64 sets the WtBoundModes value from an int64.
+func (i *WtBoundModes) SetInt64(in int64) { *i = WtBoundModes(in) }
+
+// Desc returns the description of the WtBoundModes value.
+func (i WtBoundModes) Desc() string { return enums.Desc(i, _WtBoundModesDescMap) }
+
+// WtBoundModesValues returns all possible values for the type WtBoundModes.
+func WtBoundModesValues() []WtBoundModes { return _WtBoundModesValues }
+
+// Values returns all possible values for the type WtBoundModes.
+func (i WtBoundModes) Values() []enums.Enum { return enums.Values(_WtBoundModesValues) }
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (i WtBoundModes) MarshalText() ([]byte, error) { return []byte(i.String()), nil }
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+func (i *WtBoundModes) UnmarshalText(text []byte) error {
+	return enums.UnmarshalText(i, text, "WtBoundModes")
+}
+
+var _SpikeSendModesValues = []SpikeSendModes{0, 1, 2}
+
+// SpikeSendModesN is the highest valid value for type SpikeSendModes, plus one.
+const SpikeSendModesN SpikeSendModes = 3
+
+var _SpikeSendModesValueMap = map[string]SpikeSendModes{`SpikeSendAuto`: 0, `SpikeSendSparse`: 1, `SpikeSendDense`: 2}
+
+var _SpikeSendModesDescMap = map[SpikeSendModes]string{0: `SpikeSendAuto uses sparse event-driven sending when the fraction of neurons spiking on the last measured cycle is below Thr, and the dense [SendSpike] kernel otherwise.`, 1: `SpikeSendSparse always uses sparse event-driven sending.`, 2: `SpikeSendDense always uses the dense [SendSpike] kernel, which visits the sending pathways of every neuron.`}
+
+var _SpikeSendModesMap = map[SpikeSendModes]string{0: `SpikeSendAuto`, 1: `SpikeSendSparse`, 2: `SpikeSendDense`}
+
+// String returns the string representation of this SpikeSendModes value.
+func (i SpikeSendModes) String() string { return enums.String(i, _SpikeSendModesMap) }
+
+// SetString sets the SpikeSendModes value from its string representation,
+// and returns an error if the string is invalid.
+func (i *SpikeSendModes) SetString(s string) error {
+	return enums.SetString(i, s, _SpikeSendModesValueMap, "SpikeSendModes")
+}
+
+// Int64 returns the SpikeSendModes value as an int64.
+func (i SpikeSendModes) Int64() int64 { return int64(i) }
+
+// SetInt64 sets the SpikeSendModes value from an int64.
+func (i *SpikeSendModes) SetInt64(in int64) { *i = SpikeSendModes(in) }
+
+// Desc returns the description of the SpikeSendModes value.
+func (i SpikeSendModes) Desc() string { return enums.Desc(i, _SpikeSendModesDescMap) }
+
+// SpikeSendModesValues returns all possible values for the type SpikeSendModes.
+func SpikeSendModesValues() []SpikeSendModes { return _SpikeSendModesValues }
+
+// Values returns all possible values for the type SpikeSendModes.
+func (i SpikeSendModes) Values() []enums.Enum { return enums.Values(_SpikeSendModesValues) }
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (i SpikeSendModes) MarshalText() ([]byte, error) { return []byte(i.String()), nil }
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+func (i *SpikeSendModes) UnmarshalText(text []byte) error {
+	return enums.UnmarshalText(i, text, "SpikeSendModes")
+}
+
+var _TraceCatsValues = []TraceCats{0, 1, 2, 3}
+
+// TraceCatsN is the highest valid value for type TraceCats, plus one.
+const TraceCatsN TraceCats = 4
+
+var _TraceCatsValueMap = map[string]TraceCats{`TraceLoop`: 0, `TraceFunc`: 1, `TraceKernel`: 2, `TraceLayer`: 3}
+
+var _TraceCatsDescMap = map[TraceCats]string{0: `TraceLoop is an iteration of a looper level, e.g., Trial or Epoch, recorded by [LooperTrace].`, 1: `TraceFunc is a network function, e.g., Cycle or DWt, or a function timer from [Network.FunTimerStart] and [Network.FunTimerStop].`, 2: `TraceKernel is an invocation of a compute kernel on the CPU, in the [CPUPool].`, 3: `TraceLayer is the time that one worker spent running the items of a kernel invocation that belong to one layer.`}
+
+var _TraceCatsMap = map[TraceCats]string{0: `TraceLoop`, 1: `TraceFunc`, 2: `TraceKernel`, 3: `TraceLayer`}
+
+// String returns the string representation of this TraceCats value.
+func (i TraceCats) String() string { return enums.String(i, _TraceCatsMap) }
+
+// SetString sets the TraceCats value from its string representation,
+// and returns an error if the string is invalid.
+func (i *TraceCats) SetString(s string) error {
+	return enums.SetString(i, s, _TraceCatsValueMap, "TraceCats")
+}
+
+// Int64 returns the TraceCats value as an int64.
+func (i TraceCats) Int64() int64 { return int64(i) }
+
+// SetInt64 sets the TraceCats value from an int64.
+func (i *TraceCats) SetInt64(in int64) { *i = TraceCats(in) }
+
+// Desc returns the description of the TraceCats value.
+func (i TraceCats) Desc() string { return enums.Desc(i, _TraceCatsDescMap) }
+
+// TraceCatsValues returns all possible values for the type TraceCats.
+func TraceCatsValues() []TraceCats { return _TraceCatsValues }
+
+// Values returns all possible values for the type TraceCats.
+func (i TraceCats) Values() []enums.Enum { return enums.Values(_TraceCatsValues) }
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (i TraceCats) MarshalText() ([]byte, error) { return []byte(i.String()), nil }
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+func (i *TraceCats) UnmarshalText(text []byte) error {
+	return enums.UnmarshalText(i, text, "TraceCats")
+}
+
+var _CommBackendsValues = []CommBackends{0, 1, 2}
+
+// CommBackendsN is the highest valid value for type CommBackends, plus one.
+const CommBackendsN CommBackends = 3
+
+var _CommBackendsValueMap = map[string]CommBackends{`NoComm`: 0, `CommMPI`: 1, `CommLocal`: 2}
+
+var _CommBackendsDescMap = map[CommBackends]string{0: `NoComm runs in a single process.`, 1: `CommMPI uses MPI via [MPIComm], with processes launched by mpirun.`, 2: `CommLocal uses processes on the local machine via [LocalComm], which are started automatically by [LocalInit].`}
+
+var _CommBackendsMap = map[CommBackends]string{0: `NoComm`, 1: `CommMPI`, 2: `CommLocal`}
+
+// String returns the string representation of this CommBackends value.
+func (i CommBackends) String() string { return enums.String(i, _CommBackendsMap) }
+
+// SetString sets the CommBackends value from its string representation,
+// and returns an error if the string is invalid.
+func (i *CommBackends) SetString(s string) error {
+	return enums.SetString(i, s, _CommBackendsValueMap, "CommBackends")
+}
+
+// Int64 returns the CommBackends value as an int64.
+func (i CommBackends) Int64() int64 { return int64(i) }
+
+// SetInt64 sets the CommBackends value from an int64.
+func (i *CommBackends) SetInt64(in int64) { *i = CommBackends(in) }
+
+// Desc returns the description of the CommBackends value.
+func (i CommBackends) Desc() string { return enums.Desc(i, _CommBackendsDescMap) }
+
+// CommBackendsValues returns all possible values for the type CommBackends.
+func CommBackendsValues() []CommBackends { return _CommBackendsValues }
+
+// Values returns all possible values for the type CommBackends.
+func (i CommBackends) Values() []enums.Enum { return enums.Values(_CommBackendsValues) }
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (i CommBackends) MarshalText() ([]byte, error) { return []byte(i.String()), nil }
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+func (i *CommBackends) UnmarshalText(text []byte) error {
+	return enums.UnmarshalText(i, text, "CommBackends")
+}
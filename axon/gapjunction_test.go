@@ -0,0 +1,172 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package axon
+
+import (
+	"testing"
+
+	"cogentcore.org/lab/tensor"
+	"github.com/emer/emergent/v2/etime"
+	"github.com/emer/emergent/v2/paths"
+	"github.com/stretchr/testify/assert"
+)
+
+// newGapTestNet returns a network with an Input layer driving one
+// IO neuron at a time, with IO neurons coupled by gap junctions of
+// given conductance, using given connectivity pattern.
+func newGapTestNet(gapG float32, pat paths.Pattern) (*Network, *Path) {
+	testNet := NewNetwork("testNetGap")
+	testNet.SetRandSeed(42)
+	testNet.SetMaxData(1)
+
+	inLay := testNet.AddLayer("Input", InputLayer, 4, 1)
+	ioLay := testNet.AddLayer("IO", IOLayer, 4, 1)
+
+	testNet.ConnectLayers(inLay, ioLay, paths.NewOneToOne(), ForwardPath)
+	gap := testNet.ConnectLayers(ioLay, ioLay, pat, GapJunctionPath)
+	gap.AddDefaultParams(func(pt *PathParams) {
+		pt.Gap.G = gapG
+	})
+
+	testNet.Rubicon.SetNUSs(4, 3)
+	testNet.Rubicon.Defaults()
+
+	testNet.Build()
+	testNet.Defaults()
+	testNet.InitWeights()
+	testNet.ThetaCycleStart(etime.Train, false)
+	return testNet, gap
+}
+
+// gapVmSpread returns the average over cycles of the difference between
+// the maximum and minimum Vm across IO neurons, with input to the first one.
+func gapVmSpread(net *Network, nCyc int) float32 {
+	inLay := net.LayerByName("Input")
+	io := net.LayerByName("IO")
+	net.ThetaCycleStart(etime.Train, false)
+	net.MinusPhaseStart()
+	net.InitExt()
+	inLay.ApplyExt(0, newInPats().SubSpace(0))
+	net.ApplyExts()
+	spread := float32(0)
+	for range nCyc {
+		net.Cycle(false)
+		mn := float32(100)
+		mx := float32(-100)
+		for lni := range io.NNeurons {
+			vm := Neurons.Value(int(io.NeurStIndex+lni), 0, int(Vm))
+			mn = min(mn, vm)
+			mx = max(mx, vm)
+		}
+		spread += mx - mn
+	}
+	return spread / float32(nCyc)
+}
+
+func TestGapJunctionSync(t *testing.T) {
+	net, _ := newGapTestNet(0, paths.NewFull())
+	spread := gapVmSpread(net, 100)
+	assert.Greater(t, spread, float32(1))
+
+	// coupling pulls the Vm of the other neurons toward the driven one,
+	// more so with stronger coupling, but the driven neuron still spikes
+	// and resets, so the spread remains well above zero.
+	for _, g := range []float32{20, 100} {
+		gapNet, _ := newGapTestNet(g, paths.NewFull())
+		gapSpread := gapVmSpread(gapNet, 100)
+		assert.Less(t, gapSpread, 0.75*spread)
+		spread = gapSpread
+
+		// the coupling current is conserved across neurons
+		io := gapNet.LayerByName("IO")
+		assert.NotZero(t, Neurons.Value(int(io.NeurStIndex+1), 0, int(Igap)))
+		igap := float32(0)
+		for lni := range io.NNeurons {
+			igap += Neurons.Value(int(io.NeurStIndex+lni), 0, int(Igap))
+		}
+		assert.InDelta(t, 0, igap, 0.01)
+	}
+}
+
+// gapSpikeSpread returns the difference between the last and first
+// cycle at which the IO neurons first spike, with graded input to all of
+// them, and the number of neurons that did not spike within nCyc cycles.
+func gapSpikeSpread(net *Network, nCyc int) (spread, nMiss int) {
+	inLay := net.LayerByName("Input")
+	io := net.LayerByName("IO")
+	inPat := tensor.NewFloat32(4, 1)
+	for i := range 4 {
+		inPat.Set(1-0.1*float32(i), i, 0)
+	}
+	net.ThetaCycleStart(etime.Train, false)
+	net.MinusPhaseStart()
+	net.InitExt()
+	inLay.ApplyExt(0, inPat)
+	net.ApplyExts()
+	first := make([]int, io.NNeurons)
+	for lni := range first {
+		first[lni] = -1
+	}
+	for cyc := range nCyc {
+		net.Cycle(false)
+		for lni := range first {
+			if first[lni] < 0 && Neurons.Value(int(io.NeurStIndex)+lni, 0, int(Spike)) > 0 {
+				first[lni] = cyc
+			}
+		}
+	}
+	mn, mx := nCyc, 0
+	for _, fc := range first {
+		if fc < 0 {
+			nMiss++
+			continue
+		}
+		mn = min(mn, fc)
+		mx = max(mx, fc)
+	}
+	return mx - mn, nMiss
+}
+
+func TestGapJunctionSpikeSync(t *testing.T) {
+	net, _ := newGapTestNet(0, paths.NewFull())
+	spread, nMiss := gapSpikeSpread(net, 200)
+	assert.Equal(t, 0, nMiss)
+	assert.Greater(t, spread, 0)
+
+	// coupled neurons spike closer together in time
+	gapNet, _ := newGapTestNet(100, paths.NewFull())
+	gapSpread, nMiss := gapSpikeSpread(gapNet, 200)
+	assert.Equal(t, 0, nMiss)
+	assert.Less(t, gapSpread, spread)
+}
+
+func TestGapJunctionSymmetric(t *testing.T) {
+	rnd := paths.NewUniformRand()
+	rnd.PCon = 0.3
+	_, gap := newGapTestNet(1, rnd)
+	nn := len(gap.RecvCon)
+	cons := make(map[[2]int]bool)
+	for ri := range nn {
+		for _, si := range gap.RecvConIndex[gap.RecvCon[ri].Start : gap.RecvCon[ri].Start+gap.RecvCon[ri].N] {
+			cons[[2]int{int(si), ri}] = true
+		}
+	}
+	assert.Greater(t, len(cons), 0)
+	for sr := range cons {
+		assert.True(t, cons[[2]int{sr[1], sr[0]}], "missing reverse connection: %v", sr)
+	}
+	nsend := uint32(0)
+	for _, sc := range gap.SendCon {
+		nsend += sc.N
+	}
+	assert.Equal(t, uint32(len(cons)), nsend)
+
+	// a gap junction pathway between different layers is invalid
+	net := NewNetwork("testNetGapErr")
+	inLay := net.AddLayer("Input", InputLayer, 4, 1)
+	ioLay := net.AddLayer("IO", IOLayer, 4, 1)
+	bad := net.ConnectLayers(inLay, ioLay, paths.NewFull(), GapJunctionPath)
+	assert.Error(t, bad.Validate(false))
+}
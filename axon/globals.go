@@ -54,10 +54,14 @@ const (
 	// Driven by BLA -> PPtg that detects changes in BLA activity, via LDTLayer type.
 	GvACh
 
-	// NE is norepinepherine -- not yet in use
+	// NE is norepinephrine, computed by the LCLayer (locus coeruleus)
+	// from surprise and uncertainty. Modulates gain, learning rate and
+	// exploration noise via NeuroModParams.
 	GvNE
 
-	// Ser is serotonin -- not yet in use
+	// Ser is serotonin, computed by the DRNLayer (dorsal raphe nucleus)
+	// from aversive outcomes and patient waiting. Modulates learning rate
+	// via NeuroModParams, and costs and urgency in Rubicon.
 	GvSer
 
 	// AChRaw is raw ACh value used in updating global ACh value by LDTLayer.
@@ -58,10 +58,15 @@ const (
 	ExtsVar GPUVars = 16
 	PoolsVar GPUVars = 17
 	PoolsIntVar GPUVars = 18
-	PathGBufVar GPUVars = 19
-	PathGSynsVar GPUVars = 20
-	SynapsesVar GPUVars = 21
-	SynapseTracesVar GPUVars = 22
+	DendritesVar GPUVars = 19
+	PathGBufVar GPUVars = 20
+	PathGSynsVar GPUVars = 21
+	SynapsesVar GPUVars = 22
+	SynapseTracesVar GPUVars = 23
+	PathSTPVar GPUVars = 24
+	STDPSpikesVar GPUVars = 25
+	SynapseSTDPVar GPUVars = 26
+	SynapseConsolVar GPUVars = 27
 )
 
 // Tensor stride variables
@@ -134,6 +139,7 @@ func GPUInit() {
 			vr = sgp.Add("Exts", gpu.Float32, 1, gpu.ComputeShader)
 			vr = sgp.Add("Pools", gpu.Float32, 1, gpu.ComputeShader)
 			vr = sgp.Add("PoolsInt", gpu.Int32, 1, gpu.ComputeShader)
+			vr = sgp.Add("Dendrites", gpu.Float32, 1, gpu.ComputeShader)
 			sgp.SetNValues(1)
 		}
 		{
@@ -149,6 +155,10 @@ func GPUInit() {
 			vr = sgp.Add("SynapseTraces3", gpu.Float32, 1, gpu.ComputeShader)
 			vr = sgp.Add("SynapseTraces4", gpu.Float32, 1, gpu.ComputeShader)
 			vr = sgp.Add("SynapseTraces5", gpu.Float32, 1, gpu.ComputeShader)
+			vr = sgp.Add("PathSTP", gpu.Float32, 1, gpu.ComputeShader)
+			vr = sgp.Add("STDPSpikes", gpu.Float32, 1, gpu.ComputeShader)
+			vr = sgp.Add("SynapseSTDP", gpu.Float32, 1, gpu.ComputeShader)
+			vr = sgp.Add("SynapseConsol", gpu.Float32, 1, gpu.ComputeShader)
 			sgp.SetNValues(1)
 		}
 		var pl *gpu.ComputePipeline
@@ -192,6 +202,7 @@ func GPUInit() {
 		pl = gpu.NewComputePipelineShaderFS(shaders, "shaders/CycleNeuron.wgsl", sy)
 		pl.AddVarUsed(0, "TensorStrides")
 		pl.AddVarUsed(2, "Ctx")
+		pl.AddVarUsed(2, "Dendrites")
 		pl.AddVarUsed(2, "GlobalScalars")
 		pl.AddVarUsed(2, "GlobalVectors")
 		pl.AddVarUsed(2, "LayerStates")
@@ -225,6 +236,21 @@ func GPUInit() {
 		pl.AddVarUsed(3, "SynapseTraces4")
 		pl.AddVarUsed(3, "SynapseTraces5")
 		pl.AddVarUsed(3, "Synapses")
+		pl = gpu.NewComputePipelineShaderFS(shaders, "shaders/DWtSTDPSyn.wgsl", sy)
+		pl.AddVarUsed(0, "TensorStrides")
+		pl.AddVarUsed(2, "Ctx")
+		pl.AddVarUsed(1, "NetworkIxs")
+		pl.AddVarUsed(0, "Paths")
+		pl.AddVarUsed(3, "STDPSpikes")
+		pl.AddVarUsed(1, "SynapseIxs")
+		pl.AddVarUsed(3, "SynapseSTDP")
+		pl.AddVarUsed(3, "SynapseTraces0")
+		pl.AddVarUsed(3, "SynapseTraces1")
+		pl.AddVarUsed(3, "SynapseTraces2")
+		pl.AddVarUsed(3, "SynapseTraces3")
+		pl.AddVarUsed(3, "SynapseTraces4")
+		pl.AddVarUsed(3, "SynapseTraces5")
+		pl.AddVarUsed(3, "Synapses")
 		pl = gpu.NewComputePipelineShaderFS(shaders, "shaders/DWtSubMeanNeuron.wgsl", sy)
 		pl.AddVarUsed(0, "TensorStrides")
 		pl.AddVarUsed(2, "Ctx")
@@ -255,14 +281,44 @@ func GPUInit() {
 		pl.AddVarUsed(3, "SynapseTraces4")
 		pl.AddVarUsed(3, "SynapseTraces5")
 		pl.AddVarUsed(3, "Synapses")
+		pl = gpu.NewComputePipelineShaderFS(shaders, "shaders/DWtThreeFactorSyn.wgsl", sy)
+		pl.AddVarUsed(0, "TensorStrides")
+		pl.AddVarUsed(2, "Ctx")
+		pl.AddVarUsed(2, "GlobalScalars")
+		pl.AddVarUsed(2, "LayerStates")
+		pl.AddVarUsed(1, "NetworkIxs")
+		pl.AddVarUsed(2, "NeuronAvgs")
+		pl.AddVarUsed(2, "Neurons")
+		pl.AddVarUsed(0, "Paths")
+		pl.AddVarUsed(1, "SynapseIxs")
+		pl.AddVarUsed(3, "SynapseTraces0")
+		pl.AddVarUsed(3, "SynapseTraces1")
+		pl.AddVarUsed(3, "SynapseTraces2")
+		pl.AddVarUsed(3, "SynapseTraces3")
+		pl.AddVarUsed(3, "SynapseTraces4")
+		pl.AddVarUsed(3, "SynapseTraces5")
 		pl = gpu.NewComputePipelineShaderFS(shaders, "shaders/GPUTestWrite.wgsl", sy)
 		pl.AddVarUsed(0, "TensorStrides")
 		pl.AddVarUsed(2, "Ctx")
 		pl.AddVarUsed(1, "NetworkIxs")
 		pl.AddVarUsed(2, "Neurons")
+		pl = gpu.NewComputePipelineShaderFS(shaders, "shaders/GapJunctionsNeuron.wgsl", sy)
+		pl.AddVarUsed(0, "TensorStrides")
+		pl.AddVarUsed(2, "Ctx")
+		pl.AddVarUsed(0, "Layers")
+		pl.AddVarUsed(1, "NetworkIxs")
+		pl.AddVarUsed(1, "NeuronIxs")
+		pl.AddVarUsed(2, "Neurons")
+		pl.AddVarUsed(1, "PathRecvCon")
+		pl.AddVarUsed(0, "Paths")
+		pl.AddVarUsed(1, "RecvPathIxs")
+		pl.AddVarUsed(1, "RecvSynIxs")
+		pl.AddVarUsed(1, "SynapseIxs")
+		pl.AddVarUsed(3, "Synapses")
 		pl = gpu.NewComputePipelineShaderFS(shaders, "shaders/GatherSpikes.wgsl", sy)
 		pl.AddVarUsed(0, "TensorStrides")
 		pl.AddVarUsed(2, "Ctx")
+		pl.AddVarUsed(2, "Dendrites")
 		pl.AddVarUsed(0, "Layers")
 		pl.AddVarUsed(1, "NetworkIxs")
 		pl.AddVarUsed(2, "NeuronAvgs")
@@ -309,6 +365,7 @@ func GPUInit() {
 		pl = gpu.NewComputePipelineShaderFS(shaders, "shaders/MinusPhasePost.wgsl", sy)
 		pl.AddVarUsed(0, "TensorStrides")
 		pl.AddVarUsed(2, "Ctx")
+		pl.AddVarUsed(2, "Dendrites")
 		pl.AddVarUsed(2, "GlobalScalars")
 		pl.AddVarUsed(2, "GlobalVectors")
 		pl.AddVarUsed(0, "Layers")
@@ -331,11 +388,13 @@ func GPUInit() {
 		pl = gpu.NewComputePipelineShaderFS(shaders, "shaders/NewStateNeuron.wgsl", sy)
 		pl.AddVarUsed(0, "TensorStrides")
 		pl.AddVarUsed(2, "Ctx")
+		pl.AddVarUsed(2, "Dendrites")
 		pl.AddVarUsed(0, "Layers")
 		pl.AddVarUsed(1, "NetworkIxs")
 		pl.AddVarUsed(2, "NeuronAvgs")
 		pl.AddVarUsed(1, "NeuronIxs")
 		pl.AddVarUsed(2, "Neurons")
+		pl.AddVarUsed(3, "STDPSpikes")
 		pl = gpu.NewComputePipelineShaderFS(shaders, "shaders/PlusPhaseEndNeuron.wgsl", sy)
 		pl.AddVarUsed(0, "TensorStrides")
 		pl.AddVarUsed(2, "Ctx")
@@ -353,6 +412,7 @@ func GPUInit() {
 		pl = gpu.NewComputePipelineShaderFS(shaders, "shaders/PlusPhaseEndPost.wgsl", sy)
 		pl.AddVarUsed(0, "TensorStrides")
 		pl.AddVarUsed(2, "Ctx")
+		pl.AddVarUsed(2, "Dendrites")
 		pl.AddVarUsed(2, "GlobalScalars")
 		pl.AddVarUsed(2, "GlobalVectors")
 		pl.AddVarUsed(2, "LayerStates")
@@ -381,7 +441,7 @@ func GPUInit() {
 		pl.AddVarUsed(1, "PoolIxs")
 		pl.AddVarUsed(2, "Pools")
 		pl.AddVarUsed(2, "PoolsInt")
-		pl = gpu.NewComputePipelineShaderFS(shaders, "shaders/SendSpike.wgsl", sy)
+		pl = gpu.NewComputePipelineShaderFS(shaders, "shaders/PostSpikeNeuron.wgsl", sy)
 		pl.AddVarUsed(0, "TensorStrides")
 		pl.AddVarUsed(2, "Ctx")
 		pl.AddVarUsed(2, "GlobalScalars")
@@ -391,11 +451,20 @@ func GPUInit() {
 		pl.AddVarUsed(1, "NetworkIxs")
 		pl.AddVarUsed(1, "NeuronIxs")
 		pl.AddVarUsed(2, "Neurons")
+		pl.AddVarUsed(1, "PoolIxs")
+		pl.AddVarUsed(2, "Pools")
+		pl.AddVarUsed(3, "STDPSpikes")
+		pl = gpu.NewComputePipelineShaderFS(shaders, "shaders/SendSpike.wgsl", sy)
+		pl.AddVarUsed(0, "TensorStrides")
+		pl.AddVarUsed(2, "Ctx")
+		pl.AddVarUsed(0, "Layers")
+		pl.AddVarUsed(1, "NetworkIxs")
+		pl.AddVarUsed(1, "NeuronIxs")
+		pl.AddVarUsed(2, "Neurons")
 		pl.AddVarUsed(3, "PathGBuf")
+		pl.AddVarUsed(3, "PathSTP")
 		pl.AddVarUsed(1, "PathSendCon")
 		pl.AddVarUsed(0, "Paths")
-		pl.AddVarUsed(1, "PoolIxs")
-		pl.AddVarUsed(2, "Pools")
 		pl.AddVarUsed(1, "SynapseIxs")
 		pl.AddVarUsed(3, "Synapses")
 		pl = gpu.NewComputePipelineShaderFS(shaders, "shaders/SlowAdaptLayer.wgsl", sy)
@@ -415,6 +484,7 @@ func GPUInit() {
 		pl.AddVarUsed(1, "NetworkIxs")
 		pl.AddVarUsed(2, "NeuronAvgs")
 		pl.AddVarUsed(1, "NeuronIxs")
+		pl.AddVarUsed(2, "Neurons")
 		pl.AddVarUsed(1, "PathRecvCon")
 		pl.AddVarUsed(0, "Paths")
 		pl.AddVarUsed(1, "RecvPathIxs")
@@ -433,6 +503,7 @@ func GPUInit() {
 		pl.AddVarUsed(2, "Ctx")
 		pl.AddVarUsed(1, "NetworkIxs")
 		pl.AddVarUsed(0, "Paths")
+		pl.AddVarUsed(3, "SynapseConsol")
 		pl.AddVarUsed(1, "SynapseIxs")
 		pl.AddVarUsed(3, "Synapses")
 		sy.Config()
@@ -832,6 +903,48 @@ func RunOneDWtFromDiSyn(n int, syncVars ...GPUVars) {
 		RunDWtFromDiSynCPU(n)
 	}
 }
+// RunDWtSTDPSyn runs the DWtSTDPSyn kernel with given number of elements,
+// on either the CPU or GPU depending on the UseGPU variable.
+// Can call multiple Run* kernels in a row, which are then all launched
+// in the same command submission on the GPU, which is by far the most efficient.
+// MUST call RunDone (with optional vars to sync) after all Run calls.
+// Alternatively, a single-shot RunOneDWtSTDPSyn call does Run and Done for a
+// single run-and-sync case.
+func RunDWtSTDPSyn(n int) {
+	if UseGPU {
+		RunDWtSTDPSynGPU(n)
+	} else {
+		RunDWtSTDPSynCPU(n)
+	}
+}
+
+// RunDWtSTDPSynGPU runs the DWtSTDPSyn kernel on the GPU. See [RunDWtSTDPSyn] for more info.
+func RunDWtSTDPSynGPU(n int) {
+	sy := GPUSystem
+	pl := sy.ComputePipelines["DWtSTDPSyn"]
+	ce, _ := sy.BeginComputePass()
+	pl.Dispatch1D(ce, n, 64)
+}
+
+// RunDWtSTDPSynCPU runs the DWtSTDPSyn kernel on the CPU.
+func RunDWtSTDPSynCPU(n int) {
+	gpu.VectorizeFunc(0, n, DWtSTDPSyn)
+}
+
+// RunOneDWtSTDPSyn runs the DWtSTDPSyn kernel with given number of elements,
+// on either the CPU or GPU depending on the UseGPU variable.
+// This version then calls RunDone with the given variables to sync
+// after the Run, for a single-shot Run-and-Done call. If multiple kernels
+// can be run in sequence, it is much more efficient to do multiple Run*
+// calls followed by a RunDone call.
+func RunOneDWtSTDPSyn(n int, syncVars ...GPUVars) {
+	if UseGPU {
+		RunDWtSTDPSynGPU(n)
+		RunDone(syncVars...)
+	} else {
+		RunDWtSTDPSynCPU(n)
+	}
+}
 // RunDWtSubMeanNeuron runs the DWtSubMeanNeuron kernel with given number of elements,
 // on either the CPU or GPU depending on the UseGPU variable.
 // Can call multiple Run* kernels in a row, which are then all launched
@@ -916,6 +1029,48 @@ func RunOneDWtSyn(n int, syncVars ...GPUVars) {
 		RunDWtSynCPU(n)
 	}
 }
+// RunDWtThreeFactorSyn runs the DWtThreeFactorSyn kernel with given number of elements,
+// on either the CPU or GPU depending on the UseGPU variable.
+// Can call multiple Run* kernels in a row, which are then all launched
+// in the same command submission on the GPU, which is by far the most efficient.
+// MUST call RunDone (with optional vars to sync) after all Run calls.
+// Alternatively, a single-shot RunOneDWtThreeFactorSyn call does Run and Done for a
+// single run-and-sync case.
+func RunDWtThreeFactorSyn(n int) {
+	if UseGPU {
+		RunDWtThreeFactorSynGPU(n)
+	} else {
+		RunDWtThreeFactorSynCPU(n)
+	}
+}
+
+// RunDWtThreeFactorSynGPU runs the DWtThreeFactorSyn kernel on the GPU. See [RunDWtThreeFactorSyn] for more info.
+func RunDWtThreeFactorSynGPU(n int) {
+	sy := GPUSystem
+	pl := sy.ComputePipelines["DWtThreeFactorSyn"]
+	ce, _ := sy.BeginComputePass()
+	pl.Dispatch1D(ce, n, 64)
+}
+
+// RunDWtThreeFactorSynCPU runs the DWtThreeFactorSyn kernel on the CPU.
+func RunDWtThreeFactorSynCPU(n int) {
+	gpu.VectorizeFunc(0, n, DWtThreeFactorSyn)
+}
+
+// RunOneDWtThreeFactorSyn runs the DWtThreeFactorSyn kernel with given number of elements,
+// on either the CPU or GPU depending on the UseGPU variable.
+// This version then calls RunDone with the given variables to sync
+// after the Run, for a single-shot Run-and-Done call. If multiple kernels
+// can be run in sequence, it is much more efficient to do multiple Run*
+// calls followed by a RunDone call.
+func RunOneDWtThreeFactorSyn(n int, syncVars ...GPUVars) {
+	if UseGPU {
+		RunDWtThreeFactorSynGPU(n)
+		RunDone(syncVars...)
+	} else {
+		RunDWtThreeFactorSynCPU(n)
+	}
+}
 // RunGPUTestWrite runs the GPUTestWrite kernel with given number of elements,
 // on either the CPU or GPU depending on the UseGPU variable.
 // Can call multiple Run* kernels in a row, which are then all launched
@@ -958,6 +1113,48 @@ func RunOneGPUTestWrite(n int, syncVars ...GPUVars) {
 		RunGPUTestWriteCPU(n)
 	}
 }
+// RunGapJunctionsNeuron runs the GapJunctionsNeuron kernel with given number of elements,
+// on either the CPU or GPU depending on the UseGPU variable.
+// Can call multiple Run* kernels in a row, which are then all launched
+// in the same command submission on the GPU, which is by far the most efficient.
+// MUST call RunDone (with optional vars to sync) after all Run calls.
+// Alternatively, a single-shot RunOneGapJunctionsNeuron call does Run and Done for a
+// single run-and-sync case.
+func RunGapJunctionsNeuron(n int) {
+	if UseGPU {
+		RunGapJunctionsNeuronGPU(n)
+	} else {
+		RunGapJunctionsNeuronCPU(n)
+	}
+}
+
+// RunGapJunctionsNeuronGPU runs the GapJunctionsNeuron kernel on the GPU. See [RunGapJunctionsNeuron] for more info.
+func RunGapJunctionsNeuronGPU(n int) {
+	sy := GPUSystem
+	pl := sy.ComputePipelines["GapJunctionsNeuron"]
+	ce, _ := sy.BeginComputePass()
+	pl.Dispatch1D(ce, n, 64)
+}
+
+// RunGapJunctionsNeuronCPU runs the GapJunctionsNeuron kernel on the CPU.
+func RunGapJunctionsNeuronCPU(n int) {
+	gpu.VectorizeFunc(0, n, GapJunctionsNeuron)
+}
+
+// RunOneGapJunctionsNeuron runs the GapJunctionsNeuron kernel with given number of elements,
+// on either the CPU or GPU depending on the UseGPU variable.
+// This version then calls RunDone with the given variables to sync
+// after the Run, for a single-shot Run-and-Done call. If multiple kernels
+// can be run in sequence, it is much more efficient to do multiple Run*
+// calls followed by a RunDone call.
+func RunOneGapJunctionsNeuron(n int, syncVars ...GPUVars) {
+	if UseGPU {
+		RunGapJunctionsNeuronGPU(n)
+		RunDone(syncVars...)
+	} else {
+		RunGapJunctionsNeuronCPU(n)
+	}
+}
 // RunGatherSpikes runs the GatherSpikes kernel with given number of elements,
 // on either the CPU or GPU depending on the UseGPU variable.
 // Can call multiple Run* kernels in a row, which are then all launched
@@ -1546,6 +1743,48 @@ func RunOnePoolGi(n int, syncVars ...GPUVars) {
 		RunPoolGiCPU(n)
 	}
 }
+// RunPostSpikeNeuron runs the PostSpikeNeuron kernel with given number of elements,
+// on either the CPU or GPU depending on the UseGPU variable.
+// Can call multiple Run* kernels in a row, which are then all launched
+// in the same command submission on the GPU, which is by far the most efficient.
+// MUST call RunDone (with optional vars to sync) after all Run calls.
+// Alternatively, a single-shot RunOnePostSpikeNeuron call does Run and Done for a
+// single run-and-sync case.
+func RunPostSpikeNeuron(n int) {
+	if UseGPU {
+		RunPostSpikeNeuronGPU(n)
+	} else {
+		RunPostSpikeNeuronCPU(n)
+	}
+}
+
+// RunPostSpikeNeuronGPU runs the PostSpikeNeuron kernel on the GPU. See [RunPostSpikeNeuron] for more info.
+func RunPostSpikeNeuronGPU(n int) {
+	sy := GPUSystem
+	pl := sy.ComputePipelines["PostSpikeNeuron"]
+	ce, _ := sy.BeginComputePass()
+	pl.Dispatch1D(ce, n, 64)
+}
+
+// RunPostSpikeNeuronCPU runs the PostSpikeNeuron kernel on the CPU.
+func RunPostSpikeNeuronCPU(n int) {
+	gpu.VectorizeFunc(0, n, PostSpikeNeuron)
+}
+
+// RunOnePostSpikeNeuron runs the PostSpikeNeuron kernel with given number of elements,
+// on either the CPU or GPU depending on the UseGPU variable.
+// This version then calls RunDone with the given variables to sync
+// after the Run, for a single-shot Run-and-Done call. If multiple kernels
+// can be run in sequence, it is much more efficient to do multiple Run*
+// calls followed by a RunDone call.
+func RunOnePostSpikeNeuron(n int, syncVars ...GPUVars) {
+	if UseGPU {
+		RunPostSpikeNeuronGPU(n)
+		RunDone(syncVars...)
+	} else {
+		RunPostSpikeNeuronCPU(n)
+	}
+}
 // RunSendSpike runs the SendSpike kernel with given number of elements,
 // on either the CPU or GPU depending on the UseGPU variable.
 // Can call multiple Run* kernels in a row, which are then all launched
@@ -1838,6 +2077,9 @@ func ToGPU(vars ...GPUVars) {
 		case PoolsIntVar:
 			v, _ := syVars.ValueByIndex(2, "PoolsInt", 0)
 			gpu.SetValueFrom(v, PoolsInt.Values)
+		case DendritesVar:
+			v, _ := syVars.ValueByIndex(2, "Dendrites", 0)
+			gpu.SetValueFrom(v, Dendrites.Values)
 		case PathGBufVar:
 			v, _ := syVars.ValueByIndex(3, "PathGBuf", 0)
 			gpu.SetValueFrom(v, PathGBuf.Values)
@@ -1857,6 +2099,18 @@ func ToGPU(vars ...GPUVars) {
 				ed := min(bsz * (bi+1), n)
 				gpu.SetValueFrom(v, SynapseTraces.Values[st:ed])
 			}
+		case PathSTPVar:
+			v, _ := syVars.ValueByIndex(3, "PathSTP", 0)
+			gpu.SetValueFrom(v, PathSTP.Values)
+		case STDPSpikesVar:
+			v, _ := syVars.ValueByIndex(3, "STDPSpikes", 0)
+			gpu.SetValueFrom(v, STDPSpikes.Values)
+		case SynapseSTDPVar:
+			v, _ := syVars.ValueByIndex(3, "SynapseSTDP", 0)
+			gpu.SetValueFrom(v, SynapseSTDP.Values)
+		case SynapseConsolVar:
+			v, _ := syVars.ValueByIndex(3, "SynapseConsol", 0)
+			gpu.SetValueFrom(v, SynapseConsol.Values)
 		}
 	}
 }
@@ -1878,7 +2132,7 @@ func ToGPUTensorStrides() {
 	}
 	sy := GPUSystem
 	syVars := sy.Vars()
-	TensorStrides.SetShapeSizes(190)
+	TensorStrides.SetShapeSizes(240)
 	TensorStrides.SetInt1D(PoolIxs.Shape().Strides[0], 0)
 	TensorStrides.SetInt1D(PoolIxs.Shape().Strides[1], 1)
 	TensorStrides.SetInt1D(NeuronIxs.Shape().Strides[0], 10)
@@ -1912,16 +2166,30 @@ func ToGPUTensorStrides() {
 	TensorStrides.SetInt1D(PoolsInt.Shape().Strides[0], 140)
 	TensorStrides.SetInt1D(PoolsInt.Shape().Strides[1], 141)
 	TensorStrides.SetInt1D(PoolsInt.Shape().Strides[2], 142)
-	TensorStrides.SetInt1D(PathGBuf.Shape().Strides[0], 150)
-	TensorStrides.SetInt1D(PathGBuf.Shape().Strides[1], 151)
-	TensorStrides.SetInt1D(PathGBuf.Shape().Strides[2], 152)
-	TensorStrides.SetInt1D(PathGSyns.Shape().Strides[0], 160)
-	TensorStrides.SetInt1D(PathGSyns.Shape().Strides[1], 161)
-	TensorStrides.SetInt1D(Synapses.Shape().Strides[0], 170)
-	TensorStrides.SetInt1D(Synapses.Shape().Strides[1], 171)
-	TensorStrides.SetInt1D(SynapseTraces.Shape().Strides[0], 180)
-	TensorStrides.SetInt1D(SynapseTraces.Shape().Strides[1], 181)
-	TensorStrides.SetInt1D(SynapseTraces.Shape().Strides[2], 182)
+	TensorStrides.SetInt1D(Dendrites.Shape().Strides[0], 150)
+	TensorStrides.SetInt1D(Dendrites.Shape().Strides[1], 151)
+	TensorStrides.SetInt1D(Dendrites.Shape().Strides[2], 152)
+	TensorStrides.SetInt1D(PathGBuf.Shape().Strides[0], 160)
+	TensorStrides.SetInt1D(PathGBuf.Shape().Strides[1], 161)
+	TensorStrides.SetInt1D(PathGBuf.Shape().Strides[2], 162)
+	TensorStrides.SetInt1D(PathGSyns.Shape().Strides[0], 170)
+	TensorStrides.SetInt1D(PathGSyns.Shape().Strides[1], 171)
+	TensorStrides.SetInt1D(Synapses.Shape().Strides[0], 180)
+	TensorStrides.SetInt1D(Synapses.Shape().Strides[1], 181)
+	TensorStrides.SetInt1D(SynapseTraces.Shape().Strides[0], 190)
+	TensorStrides.SetInt1D(SynapseTraces.Shape().Strides[1], 191)
+	TensorStrides.SetInt1D(SynapseTraces.Shape().Strides[2], 192)
+	TensorStrides.SetInt1D(PathSTP.Shape().Strides[0], 200)
+	TensorStrides.SetInt1D(PathSTP.Shape().Strides[1], 201)
+	TensorStrides.SetInt1D(PathSTP.Shape().Strides[2], 202)
+	TensorStrides.SetInt1D(STDPSpikes.Shape().Strides[0], 210)
+	TensorStrides.SetInt1D(STDPSpikes.Shape().Strides[1], 211)
+	TensorStrides.SetInt1D(STDPSpikes.Shape().Strides[2], 212)
+	TensorStrides.SetInt1D(SynapseSTDP.Shape().Strides[0], 220)
+	TensorStrides.SetInt1D(SynapseSTDP.Shape().Strides[1], 221)
+	TensorStrides.SetInt1D(SynapseSTDP.Shape().Strides[2], 222)
+	TensorStrides.SetInt1D(SynapseConsol.Shape().Strides[0], 230)
+	TensorStrides.SetInt1D(SynapseConsol.Shape().Strides[1], 231)
 	v, _ := syVars.ValueByIndex(0, "TensorStrides", 0)
 	gpu.SetValueFrom(v, TensorStrides.Values)
 }
@@ -1989,6 +2257,9 @@ func ReadFromGPU(vars ...GPUVars) {
 		case PoolsIntVar:
 			v, _ := syVars.ValueByIndex(2, "PoolsInt", 0)
 			v.GPUToRead(sy.CommandEncoder)
+		case DendritesVar:
+			v, _ := syVars.ValueByIndex(2, "Dendrites", 0)
+			v.GPUToRead(sy.CommandEncoder)
 		case PathGBufVar:
 			v, _ := syVars.ValueByIndex(3, "PathGBuf", 0)
 			v.GPUToRead(sy.CommandEncoder)
@@ -2006,6 +2277,18 @@ func ReadFromGPU(vars ...GPUVars) {
 				v, _ := syVars.ValueByIndex(3, fmt.Sprintf("SynapseTraces%d", bi), 0)
 				v.GPUToRead(sy.CommandEncoder)
 			}
+		case PathSTPVar:
+			v, _ := syVars.ValueByIndex(3, "PathSTP", 0)
+			v.GPUToRead(sy.CommandEncoder)
+		case STDPSpikesVar:
+			v, _ := syVars.ValueByIndex(3, "STDPSpikes", 0)
+			v.GPUToRead(sy.CommandEncoder)
+		case SynapseSTDPVar:
+			v, _ := syVars.ValueByIndex(3, "SynapseSTDP", 0)
+			v.GPUToRead(sy.CommandEncoder)
+		case SynapseConsolVar:
+			v, _ := syVars.ValueByIndex(3, "SynapseConsol", 0)
+			v.GPUToRead(sy.CommandEncoder)
 		}
 	}
 }
@@ -2092,6 +2375,10 @@ func SyncFromGPU(vars ...GPUVars) {
 			v, _ := syVars.ValueByIndex(2, "PoolsInt", 0)
 			v.ReadSync()
 			gpu.ReadToBytes(v, PoolsInt.Values)
+		case DendritesVar:
+			v, _ := syVars.ValueByIndex(2, "Dendrites", 0)
+			v.ReadSync()
+			gpu.ReadToBytes(v, Dendrites.Values)
 		case PathGBufVar:
 			v, _ := syVars.ValueByIndex(3, "PathGBuf", 0)
 			v.ReadSync()
@@ -2115,6 +2402,22 @@ func SyncFromGPU(vars ...GPUVars) {
 				ed := min(bsz * (bi+1), n)
 				gpu.ReadToBytes(v, SynapseTraces.Values[st:ed])
 			}
+		case PathSTPVar:
+			v, _ := syVars.ValueByIndex(3, "PathSTP", 0)
+			v.ReadSync()
+			gpu.ReadToBytes(v, PathSTP.Values)
+		case STDPSpikesVar:
+			v, _ := syVars.ValueByIndex(3, "STDPSpikes", 0)
+			v.ReadSync()
+			gpu.ReadToBytes(v, STDPSpikes.Values)
+		case SynapseSTDPVar:
+			v, _ := syVars.ValueByIndex(3, "SynapseSTDP", 0)
+			v.ReadSync()
+			gpu.ReadToBytes(v, SynapseSTDP.Values)
+		case SynapseConsolVar:
+			v, _ := syVars.ValueByIndex(3, "SynapseConsol", 0)
+			v.ReadSync()
+			gpu.ReadToBytes(v, SynapseConsol.Values)
 		}
 	}
 }
@@ -7,8 +7,8 @@ package axon
 import (
 	"fmt"
 
+	"cogentcore.org/core/base/errors"
 	"cogentcore.org/core/core"
-	"cogentcore.org/lab/base/mpi"
 )
 
 //////// Misc
@@ -31,28 +31,34 @@ func ToggleLayersOff(net *Network, layerNames []string, off bool) {
 // WeightsFilename returns default current weights file name,
 // using train run and epoch counters from looper
 // and the RunName string identifying tag, parameters and starting run,
+// with the [Network.WeightsFileExt] extension (default ".wts.gz").
 func WeightsFilename(net *Network, ctrString, runName string) string {
-	return net.Name + "_" + runName + "_" + ctrString + ".wts.gz"
+	ext := net.WeightsFileExt
+	if ext == "" {
+		ext = ".wts.gz"
+	}
+	return net.Name + "_" + runName + "_" + ctrString + ext
 }
 
 // SaveWeights saves network weights to filename with WeightsFilename information
-// to identify the weights.
-// only for 0 rank MPI if running mpi
+// to identify the weights, in the format determined by the file extension
+// (see [Network.SaveWeights]).
+// only for rank 0 if running data-parallel across processes (see [CommRank])
 // Returns the name of the file saved to, or empty if not saved.
 func SaveWeights(net *Network, ctrString, runName string) string {
-	if mpi.WorldRank() > 0 {
+	if CommRank() > 0 {
 		return ""
 	}
 	fnm := WeightsFilename(net, ctrString, runName)
 	fmt.Printf("Saving Weights to: %s\n", fnm)
-	net.SaveWeightsJSON(core.Filename(fnm))
+	net.SaveWeights(core.Filename(fnm))
 	return fnm
 }
 
 // SaveWeightsIfConfigSet saves network weights if the given config
 // bool value has been set to true.
 // uses WeightsFilename information to identify the weights.
-// only for 0 rank MPI if running mpi
+// only for rank 0 if running data-parallel across processes (see [CommRank])
 // Returns the name of the file saved to, or empty if not saved.
 func SaveWeightsIfConfigSet(net *Network, cfgWts bool, ctrString, runName string) string {
 	if cfgWts {
@@ -60,3 +66,28 @@ func SaveWeightsIfConfigSet(net *Network, cfgWts bool, ctrString, runName string
 	}
 	return ""
 }
+
+//////// Trace files
+
+// SaveTrace saves the [Network.Trace] timeline as a Chrome trace-event
+// JSON file (.trace.json) and a gzipped pprof profile (.pprof.gz),
+// with file names based on the network name and runName.
+// only for rank 0 if running data-parallel across processes (see [CommRank])
+func SaveTrace(net *Network, runName string) {
+	if CommRank() > 0 {
+		return
+	}
+	fnm := net.Name + "_" + runName
+	fmt.Printf("Saving Trace to: %s.trace.json, %s.pprof.gz\n", fnm, fnm)
+	errors.Log(net.Trace.SaveChromeTrace(fnm + ".trace.json"))
+	errors.Log(net.Trace.SavePprof(fnm + ".pprof.gz"))
+}
+
+//////// Checkpoint files
+
+// CheckpointFilename returns default current checkpoint file name,
+// using train run and epoch counters from looper
+// and the RunName string identifying tag, parameters and starting run.
+func CheckpointFilename(net *Network, ctrString, runName string) string {
+	return net.Name + "_" + runName + "_" + ctrString + ".ckpt.gz"
+}
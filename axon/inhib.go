@@ -145,6 +145,16 @@ type InhibParams struct {
 	// Pool determines inhibition within sub-pools of units, for layers with 4D shape.
 	// This is almost always necessary if the layer has sub-pools.
 	Pool fsfffb.GiParams `display:"inline"`
+
+	// Interneurons indicates that inhibition for this layer is provided by
+	// explicit PV, SST and VIP interneuron layers via inhibitory pathways
+	// (see [Network.AddInterneurons]), so the FS-FFFB inhibition from the
+	// Layer and Pool parameters is not applied to the neurons. It is still
+	// computed in the pools, as the equivalent TotalGi for comparison
+	// (see [Layer.InterneuronGi]).
+	Interneurons slbool.Bool
+
+	pad, pad1, pad2 int32
 }
 
 func (ip *InhibParams) Update() {
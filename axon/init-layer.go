@@ -102,6 +102,8 @@ func (ly *Layer) InitActAvgLayer(ctx *Context) {
 		NeuronAvgs.Set(0, int(ni), int(DTrgAvg))
 		NeuronAvgs.Set(ly.Params.Acts.Init.GetGeBase(ly.Network.Rand), int(ni), int(GeBase))
 		NeuronAvgs.Set(ly.Params.Acts.Init.GetGiBase(ly.Network.Rand), int(ni), int(GiBase))
+		NeuronAvgs.Set(0, int(ni), int(IPThr))
+		NeuronAvgs.Set(0, int(ni), int(IPGl))
 		if gibinit > 0 {
 			gib := gibinit * (tmax - trg)
 			NeuronAvgs.Set(gib, int(ni), int(GiBase))
@@ -150,6 +152,8 @@ func (ly *Layer) InitActAvgPools(ctx *Context) {
 			NeuronAvgs.Set(0, int(ni), int(DTrgAvg))
 			NeuronAvgs.Set(ly.Params.Acts.Init.GetGeBase(ly.Network.Rand), int(ni), int(GeBase))
 			NeuronAvgs.Set(ly.Params.Acts.Init.GetGiBase(ly.Network.Rand), int(ni), int(GiBase))
+			NeuronAvgs.Set(0, int(ni), int(IPThr))
+			NeuronAvgs.Set(0, int(ni), int(IPGl))
 			if gibinit > 0 {
 				gib := gibinit * (tmax - trg)
 				NeuronAvgs.Set(gib, int(ni), int(GiBase))
@@ -168,6 +172,7 @@ func (ly *Layer) InitActs(ctx *Context) { //types:add
 		}
 		for di := uint32(0); di < ly.MaxData; di++ {
 			ly.Params.Acts.InitActs(ctx, ni, di)
+			ly.Params.DendInitActs(ctx, ni, di)
 		}
 	}
 	np := ly.NPools
@@ -181,6 +186,9 @@ func (ly *Layer) InitActs(ctx *Context) { //types:add
 			// Target layers are dynamically updated
 		}
 	}
+	for _, pt := range ly.SendPaths {
+		pt.Params.InitSTP(ctx)
+	}
 	// ly.InitPathGBuffs(ctx)
 }
 
@@ -216,7 +224,7 @@ func (ly *Layer) InitGScale(ctx *Context) {
 	totGmRel := float32(0)
 	totGmnRel := float32(0)
 	for _, pt := range ly.RecvPaths {
-		if pt.Off {
+		if pt.Off || pt.Type == GapJunctionPath { // gap junctions use Gap.G directly
 			continue
 		}
 		slay := pt.Send
@@ -232,7 +240,7 @@ func (ly *Layer) InitGScale(ctx *Context) {
 		//		continue
 		//	}
 		switch pt.Params.Com.GType {
-		case InhibitoryG:
+		case InhibitoryG, DendInhibitoryG:
 			totGiRel += pt.Params.PathScale.Rel
 		case ModulatoryG:
 			totGmRel += pt.Params.PathScale.Rel
@@ -244,8 +252,11 @@ func (ly *Layer) InitGScale(ctx *Context) {
 	}
 
 	for _, pt := range ly.RecvPaths {
+		if pt.Type == GapJunctionPath {
+			continue
+		}
 		switch pt.Params.Com.GType {
-		case InhibitoryG:
+		case InhibitoryG, DendInhibitoryG:
 			if totGiRel > 0 {
 				pt.Params.GScale.Rel = pt.Params.PathScale.Rel / totGiRel
 				pt.Params.GScale.Scale /= totGiRel
@@ -295,6 +306,7 @@ func (ly *LayerParams) DecayState(ctx *Context, di uint32, decay, glong, ahp flo
 			continue
 		}
 		ly.Acts.DecayState(ctx, ni, di, decay, glong, ahp)
+		ly.DendDecayState(ctx, ni, di, decay, glong)
 		// Note: synapse-level Ca decay happens in DWt
 		if ahp == 1 {
 			lt := ly.Type
@@ -330,6 +342,7 @@ func (ly *LayerParams) DecayStatePool(ctx *Context, pool int, decay, glong, ahp
 				continue
 			}
 			ly.Acts.DecayState(ctx, ni, di, decay, glong, ahp)
+			ly.DendDecayState(ctx, ni, di, decay, glong)
 		}
 		PoolInhibDecay(pi, di, decay)
 	}
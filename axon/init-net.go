@@ -34,6 +34,7 @@ func (nt *Network) InitWeights() { //types:add
 		nt.Rubicon.Reset(di)
 	}
 	nt.BuildPathGBuf()
+	nt.BuildSTDP()
 	ctx.SlowCounter = 0
 	for _, ly := range nt.Layers {
 		if ly.Off {
@@ -51,6 +52,7 @@ func (nt *Network) InitWeights() { //types:add
 	}
 	// dur := time.Now().Sub(st)
 	// fmt.Printf("sym: %v\n", dur)
+	nt.BuildConsol()
 	ToGPUAll()
 }
 
@@ -165,7 +167,7 @@ func (nt *Network) InitActs() { //types:add
 		ly.InitActs(ctx)
 	}
 	ToGPULayersNeurons()
-	ToGPU(PathGBufVar, PathGSynsVar)
+	ToGPU(PathGBufVar, PathGSynsVar, PathSTPVar)
 }
 
 // UpdateExtFlags updates the neuron flags for external input based on current
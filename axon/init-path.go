@@ -54,6 +54,7 @@ func (pt *Path) SetSWtsRPool(ctx *Context, swts tensor.Tensor) {
 						wt := pt.Params.SWts.ClipWt(swt + (Synapses.Value(int(syni), int(Wt)) - pt.Params.SWts.Init.Mean))
 						Synapses.Set(wt, int(syni), int(Wt))
 						Synapses.Set(pt.Params.SWts.LWtFromWts(wt, swt), int(syni), int(LWt))
+						pt.ClipLWtSyn(syni)
 					}
 				}
 			}
@@ -99,6 +100,7 @@ func (pt *Path) SetSWtsFunc(ctx *Context, swtFun func(si, ri int, send, recv *te
 			wt := pt.Params.SWts.ClipWt(swt + (Synapses.Value(int(syni), int(Wt)) - pt.Params.SWts.Init.Mean))
 			Synapses.Set(wt, int(syni), int(Wt))
 			Synapses.Set(pt.Params.SWts.LWtFromWts(wt, swt), int(syni), int(LWt))
+			pt.ClipLWtSyn(syni)
 		}
 	}
 }
@@ -108,6 +110,20 @@ func (pt *Path) SetSWtsFunc(ctx *Context, swtFun func(si, ri int, send, recv *te
 // It also updates the linear weight value based on the sigmoidal weight value.
 func (pt *Path) InitWeightsSyn(ctx *Context, syni uint32, rnd randx.Rand, mean, spct float32) {
 	pt.Params.SWts.InitWeightsSyn(ctx, syni, rnd, mean, spct)
+	pt.ClipLWtSyn(syni)
+}
+
+// ClipLWtSyn clips the initial linear weight LWt of given synapse to the
+// SWts.Bound Min, Max range, updating Wt to match if it was clipped.
+func (pt *Path) ClipLWtSyn(syni uint32) {
+	sp := &pt.Params.SWts
+	lwt := Synapses.Value(int(syni), int(LWt))
+	clwt := sp.Bound.ClipLWt(lwt)
+	if clwt == lwt {
+		return
+	}
+	Synapses.Set(clwt, int(syni), int(LWt))
+	Synapses.Set(sp.WtValue(Synapses.Value(int(syni), int(SWt)), clwt), int(syni), int(Wt))
 }
 
 // InitWeightsSynTrace initializes SynapseTraces values
@@ -121,6 +137,9 @@ func (pt *Path) InitWeightsSynTrace(ctx *Context, syni, di uint32) {
 func (pt *Path) InitWeights(ctx *Context, nt *Network) {
 	pt.Params.Learn.LRate.Init()
 	pt.Params.InitGBuffs(ctx)
+	pt.Params.InitSTP(ctx)
+	pt.StructPruned = 0
+	pt.StructWeak = 0
 	rlay := pt.Recv
 	spct := pt.Params.SWts.Init.SPct
 	if rlay.Params.IsTarget() {
@@ -0,0 +1,71 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package axon
+
+import (
+	"github.com/emer/axon/v2/fsfffb"
+)
+
+// InterneuronDefaults sets the parameters common to all explicit
+// interneuron layers: InhibPVLayer, InhibSSTLayer and InhibVIPLayer.
+// Inhibition among interneurons is via explicit pathways,
+// so the FS-FFFB inhibition is off, and there is no learning.
+func (ly *LayerParams) InterneuronDefaults() {
+	ly.Inhib.Layer.On.SetBool(false)
+	ly.Inhib.Pool.On.SetBool(false)
+	ly.Inhib.ActAvg.Nominal = 0.2
+	ly.Learn.TrgAvgAct.RescaleOn.SetBool(false)
+	ly.Acts.NMDA.Ge = 0
+	ly.Acts.MaintNMDA.Ge = 0
+	ly.Acts.GabaB.Gk = 0
+}
+
+// InhibPVDefaults sets the parameters for [InhibPVLayer] fast-spiking
+// interneurons, which have no spike-rate adaptation and a short
+// refractory period.
+func (ly *LayerParams) InhibPVDefaults() {
+	ly.InterneuronDefaults()
+	ly.Acts.Mahp.Gk = 0
+	ly.Acts.Sahp.Gk = 0
+	ly.Acts.KNa.On.SetBool(false)
+	ly.Acts.Spikes.Tr = 1
+}
+
+// InhibSSTDefaults sets the parameters for [InhibSSTLayer] interneurons,
+// which retain the default spike-rate adaptation, consistent with
+// their slower, accommodating firing.
+func (ly *LayerParams) InhibSSTDefaults() {
+	ly.InterneuronDefaults()
+	ly.Inhib.ActAvg.Nominal = 0.1
+}
+
+// InterneuronGi returns the average inhibitory synaptic conductance across
+// neurons in the layer, including both the soma (GiSyn) and dendrite
+// (GiDendSyn), which for a layer with [InhibParams.Interneurons] reflects
+// the inhibition from explicit interneurons, along with the average of the
+// equivalent FS-FFFB inhibition (GiMult * TotalGi) computed in the pools
+// for the same layer activity, for given data index.
+// This can be used to calibrate the interneuron circuit relative to the
+// standard FS-FFFB inhibition. Values are for the current cycle,
+// so the Neurons and Pools state must be current on the CPU.
+func (ly *Layer) InterneuronGi(di int) (gi, fffbGi float32) {
+	giMult := LayerStates.Value(int(ly.Index), di, int(LayerGiMult))
+	n := 0
+	for lni := range ly.NNeurons {
+		ni := ly.NeurStIndex + lni
+		if NeuronIsOff(ni) {
+			continue
+		}
+		pi := ly.Params.PoolIndex(NeuronIxs.Value(int(ni), int(NrnSubPool)))
+		gi += Neurons.Value(int(ni), di, int(GiSyn)) + Neurons.Value(int(ni), di, int(GiDendSyn))
+		fffbGi += giMult * Pools.Value(int(pi), di, int(fsfffb.TotalGi))
+		n++
+	}
+	if n > 0 {
+		gi /= float32(n)
+		fffbGi /= float32(n)
+	}
+	return
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package axon
+
+import (
+	"github.com/emer/emergent/v2/paths"
+)
+
+// InterneuronConfig has the configuration for [Network.AddInterneurons].
+type InterneuronConfig struct {
+
+	// NPV is the number of PV+ fast-spiking interneurons.
+	NPV int `default:"8"`
+
+	// NSST is the number of SST+ dendrite-targeting interneurons.
+	NSST int `default:"4"`
+
+	// NVIP is the number of VIP+ disinhibitory interneurons.
+	NVIP int `default:"4"`
+
+	// PVGi is the absolute strength (PathScale.Abs) of the
+	// PV to layer soma inhibition.
+	PVGi float32 `default:"1"`
+
+	// SSTGi is the absolute strength (PathScale.Abs) of the
+	// SST to layer dendrite inhibition.
+	SSTGi float32 `default:"1"`
+
+	// SSTTauF is the facilitation time constant for the short-term
+	// plasticity of the layer to SST excitation, which causes SST
+	// interneurons to be recruited progressively by sustained activity.
+	SSTTauF float32 `default:"200"`
+
+	// Space is the spacing between layers for placement.
+	Space float32 `default:"2"`
+}
+
+func (ic *InterneuronConfig) Defaults() {
+	ic.NPV = 8
+	ic.NSST = 4
+	ic.NVIP = 4
+	ic.PVGi = 1
+	ic.SSTGi = 1
+	ic.SSTTauF = 200
+	ic.Space = 2
+}
+
+// AddInterneurons adds explicit PV, SST and VIP interneuron layers that
+// provide the inhibition for given layer, in place of the FS-FFFB
+// inhibition function, which is still computed for comparison purposes
+// (see [InhibParams.Interneurons] and [Layer.InterneuronGi]).
+// The layer excites the PV neurons, which inhibit the layer soma and each
+// other, and it excites the SST neurons through facilitating synapses,
+// which inhibit the layer dendrites via [DendInhibitoryG] and the PV
+// neurons. The VIP neurons inhibit the SST neurons, and should receive
+// top-down or other modulatory inputs to disinhibit the layer dendrites.
+// Layers are named with the layer name + PV, SST, VIP, and pathways have
+// classes named by the sender and receiver types (e.g., PVToLayer),
+// for parameter styling. If cfg is nil, defaults are used.
+func (net *Network) AddInterneurons(lay *Layer, cfg *InterneuronConfig) (pv, sst, vip *Layer) {
+	if cfg == nil {
+		cfg = &InterneuronConfig{}
+		cfg.Defaults()
+	}
+	pv = net.AddLayer2D(lay.Name+"PV", InhibPVLayer, 1, cfg.NPV)
+	sst = net.AddLayer2D(lay.Name+"SST", InhibSSTLayer, 1, cfg.NSST)
+	vip = net.AddLayer2D(lay.Name+"VIP", InhibVIPLayer, 1, cfg.NVIP)
+
+	lay.AddDefaultParams(func(ly *LayerParams) {
+		ly.Inhib.Interneurons.SetBool(true)
+	})
+
+	full := paths.NewFull()
+
+	pt := net.ConnectLayers(lay, pv, full, ForwardPath).AddClass("ToPV")
+	pt.AddDefaultParams(func(pt *PathParams) {
+		pt.SetFixedWts()
+	})
+	pt = net.ConnectLayers(pv, lay, full, InhibPath).AddClass("PVToLayer")
+	pt.AddDefaultParams(func(pt *PathParams) {
+		pt.SetFixedWts()
+		pt.PathScale.Abs = cfg.PVGi
+	})
+	pt = net.ConnectLayers(pv, pv, full, InhibPath).AddClass("PVToPV")
+	pt.AddDefaultParams(func(pt *PathParams) {
+		pt.SetFixedWts()
+	})
+
+	pt = net.ConnectLayers(lay, sst, full, ForwardPath).AddClass("ToSST")
+	pt.AddDefaultParams(func(pt *PathParams) {
+		pt.SetFixedWts()
+		pt.STP.On.SetBool(true)
+		pt.STP.U = 0.1
+		pt.STP.TauF = cfg.SSTTauF
+	})
+	pt = net.ConnectLayers(sst, lay, full, InhibPath).AddClass("SSTToLayer")
+	pt.AddDefaultParams(func(pt *PathParams) {
+		pt.SetFixedWts()
+		pt.Com.GType = DendInhibitoryG
+		pt.PathScale.Abs = cfg.SSTGi
+	})
+	pt = net.ConnectLayers(sst, pv, full, InhibPath).AddClass("SSTToPV")
+	pt.AddDefaultParams(func(pt *PathParams) {
+		pt.SetFixedWts()
+	})
+
+	pt = net.ConnectLayers(vip, sst, full, InhibPath).AddClass("VIPToSST")
+	pt.AddDefaultParams(func(pt *PathParams) {
+		pt.SetFixedWts()
+	})
+
+	pv.PlaceRightOf(lay, cfg.Space)
+	sst.PlaceBehind(pv, cfg.Space)
+	vip.PlaceBehind(sst, cfg.Space)
+	return
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package axon
+
+import (
+	"testing"
+
+	"github.com/emer/emergent/v2/etime"
+	"github.com/emer/emergent/v2/paths"
+	"github.com/stretchr/testify/assert"
+)
+
+// newInterneuronTestNet returns the standard test network with explicit
+// interneurons for the Hidden layer. vipGeBase is the tonic excitation
+// of the VIP interneurons.
+func newInterneuronTestNet(vipGeBase float32) *Network {
+	testNet := NewNetwork("testNetInterneurons")
+	testNet.SetRandSeed(42)
+	testNet.SetMaxData(1)
+
+	inLay := testNet.AddLayer("Input", InputLayer, 4, 1)
+	hidLay := testNet.AddLayer("Hidden", SuperLayer, 4, 1)
+	outLay := testNet.AddLayer("Output", TargetLayer, 4, 1)
+
+	one2one := paths.NewOneToOne()
+	testNet.ConnectLayers(inLay, hidLay, one2one, ForwardPath)
+	testNet.ConnectLayers(hidLay, outLay, one2one, ForwardPath)
+	testNet.ConnectLayers(outLay, hidLay, one2one, BackPath)
+
+	cfg := &InterneuronConfig{}
+	cfg.Defaults()
+	cfg.NPV = 4
+	cfg.NSST = 2
+	cfg.NVIP = 2
+	_, _, vip := testNet.AddInterneurons(hidLay, cfg)
+	vip.AddDefaultParams(func(ly *LayerParams) {
+		ly.Acts.Init.GeBase = vipGeBase
+	})
+
+	testNet.Rubicon.SetNUSs(4, 3)
+	testNet.Rubicon.Defaults()
+
+	testNet.Build()
+	testNet.Defaults()
+	ApplyParamSheets(testNet, layerParams["Base"], pathParams["Base"])
+	testNet.InitWeights()
+	testNet.ThetaCycleStart(etime.Train, false)
+	return testNet
+}
+
+// interneuronMinus runs the minus phase for the first input pattern,
+// returning the average Hidden InterneuronGi values over cycles,
+// and the average GiDendSyn of the Hidden neurons.
+func interneuronMinus(net *Network) (gi, fffbGi, dendGi float32) {
+	inLay := net.LayerByName("Input")
+	hid := net.LayerByName("Hidden")
+	net.ThetaCycleStart(etime.Train, false)
+	net.MinusPhaseStart()
+	net.InitExt()
+	inLay.ApplyExt(0, newInPats().SubSpace(0))
+	net.ApplyExts()
+	ncyc := 150
+	for range ncyc {
+		net.Cycle(false)
+		cgi, cfffb := hid.InterneuronGi(0)
+		gi += cgi
+		fffbGi += cfffb
+		for lni := range hid.NNeurons {
+			dendGi += Neurons.Value(int(hid.NeurStIndex+lni), 0, int(GiDendSyn))
+		}
+	}
+	gi /= float32(ncyc)
+	fffbGi /= float32(ncyc)
+	dendGi /= float32(ncyc * int(hid.NNeurons))
+	return
+}
+
+func TestInterneuronsGi(t *testing.T) {
+	net := newInterneuronTestNet(0)
+	hid := net.LayerByName("Hidden")
+	assert.Equal(t, InhibPVLayer, net.LayerByName("HiddenPV").Type)
+	assert.Equal(t, InhibSSTLayer, net.LayerByName("HiddenSST").Type)
+	assert.Equal(t, InhibVIPLayer, net.LayerByName("HiddenVIP").Type)
+	assert.True(t, hid.Params.Inhib.Interneurons.IsTrue())
+	assert.True(t, hid.Params.Inhib.Layer.On.IsTrue())
+	sstPath, err := hid.RecvPathBySendName("HiddenSST")
+	assert.NoError(t, err)
+	assert.Equal(t, DendInhibitoryG, sstPath.(*Path).Params.Com.GType)
+	pvPath, err := hid.RecvPathBySendName("HiddenPV")
+	assert.NoError(t, err)
+	assert.Equal(t, InhibitoryG, pvPath.(*Path).Params.Com.GType)
+
+	// interneurons provide inhibition, and the FS-FFFB equivalent is
+	// computed but not applied.
+	gi, fffbGi, _ := interneuronMinus(net)
+	assert.Greater(t, gi, float32(0))
+	assert.Greater(t, fffbGi, float32(0))
+	ni := hid.NeurStIndex
+	nGi := Neurons.Value(int(ni), 0, int(Gi))
+	nGiSyn := Neurons.Value(int(ni), 0, int(GiSyn))
+	nGiNoise := Neurons.Value(int(ni), 0, int(GiNoise))
+	assert.InDelta(t, nGiSyn+nGiNoise, nGi, 1.0e-6)
+}
+
+func TestInterneuronsVIP(t *testing.T) {
+	net := newInterneuronTestNet(0)
+	_, _, dendGi := interneuronMinus(net)
+
+	// tonic VIP activity inhibits SST, disinhibiting the dendrites.
+	vipNet := newInterneuronTestNet(0.5)
+	_, _, vipDendGi := interneuronMinus(vipNet)
+	if assert.Greater(t, dendGi, float32(0)) {
+		assert.Less(t, vipDendGi, dendGi)
+	}
+}
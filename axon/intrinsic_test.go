@@ -0,0 +1,131 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package axon
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/emer/emergent/v2/etime"
+	"github.com/emer/emergent/v2/paths"
+	"github.com/stretchr/testify/assert"
+)
+
+// newIntrinsicTestNet returns the standard test network with
+// intrinsic plasticity of both threshold and leak in the Hidden layer.
+func newIntrinsicTestNet() *Network {
+	testNet := NewNetwork("testNetIntrinsic")
+	testNet.SetRandSeed(42)
+	testNet.SetMaxData(1)
+
+	inLay := testNet.AddLayer("Input", InputLayer, 4, 1)
+	hidLay := testNet.AddLayer("Hidden", SuperLayer, 4, 1)
+	outLay := testNet.AddLayer("Output", TargetLayer, 4, 1)
+	hidLay.AddDefaultParams(func(ly *LayerParams) {
+		ly.Acts.Intrinsic.On.SetBool(true)
+		ly.Acts.Intrinsic.Gl.SetBool(true)
+	})
+
+	one2one := paths.NewOneToOne()
+	testNet.ConnectLayers(inLay, hidLay, one2one, ForwardPath)
+	testNet.ConnectLayers(hidLay, outLay, one2one, ForwardPath)
+	testNet.ConnectLayers(outLay, hidLay, one2one, BackPath)
+
+	testNet.Rubicon.SetNUSs(4, 3)
+	testNet.Rubicon.Defaults()
+
+	testNet.Build()
+	testNet.Defaults()
+	ApplyParamSheets(testNet, layerParams["Base"], pathParams["Base"])
+	testNet.InitWeights()
+	testNet.ThetaCycleStart(etime.Train, false)
+	return testNet
+}
+
+// setLayerNeuronAvgs sets given NeuronAvgs variable to val for all
+// neurons in layer.
+func setLayerNeuronAvgs(ly *Layer, vr NeuronAvgVars, val float32) {
+	for lni := range ly.NNeurons {
+		NeuronAvgs.Set(val, int(ly.NeurStIndex+lni), int(vr))
+	}
+}
+
+func TestIntrinsicAdapt(t *testing.T) {
+	net := newIntrinsicTestNet()
+	hid := net.LayerByName("Hidden")
+	ip := &hid.Params.Acts.Intrinsic
+	trg := ip.TrgHz / hid.Params.Acts.Spikes.MaxHz
+
+	hi := int(hid.NeurStIndex)
+	lo := hi + 1
+	NeuronAvgs.Set(trg+0.1, hi, int(ActAvg))
+	NeuronAvgs.Set(trg-0.05, lo, int(ActAvg))
+	net.SlowAdapt()
+
+	assert.InDelta(t, ip.ThrRate*0.1, NeuronAvgs.Value(hi, int(IPThr)), 1.0e-5)
+	assert.InDelta(t, ip.GlRate*0.1, NeuronAvgs.Value(hi, int(IPGl)), 1.0e-5)
+	assert.InDelta(t, -ip.ThrRate*0.05, NeuronAvgs.Value(lo, int(IPThr)), 1.0e-5)
+	assert.InDelta(t, -ip.GlRate*0.05, NeuronAvgs.Value(lo, int(IPGl)), 1.0e-5)
+
+	for range 20 {
+		net.SlowAdapt()
+	}
+	assert.Equal(t, ip.ThrMax, NeuronAvgs.Value(hi, int(IPThr)))
+	assert.Equal(t, ip.GlMax, NeuronAvgs.Value(hi, int(IPGl)))
+
+	// layers without intrinsic plasticity are not affected
+	in := net.LayerByName("Input")
+	assert.Equal(t, float32(0), NeuronAvgs.Value(int(in.NeurStIndex), int(IPThr)))
+
+	net.InitWeights()
+	assert.Equal(t, float32(0), NeuronAvgs.Value(hi, int(IPThr)))
+	assert.Equal(t, float32(0), NeuronAvgs.Value(hi, int(IPGl)))
+}
+
+func TestIntrinsicExcitability(t *testing.T) {
+	// hidActM returns the summed Hidden ActM after a trial with given offsets.
+	hidActM := func(thr, gl float32) float32 {
+		net := newIntrinsicTestNet()
+		hid := net.LayerByName("Hidden")
+		setLayerNeuronAvgs(hid, IPThr, thr)
+		setLayerNeuronAvgs(hid, IPGl, gl)
+		runTestTrials(net, 0, 1)
+		act := float32(0)
+		for lni := range hid.NNeurons {
+			act += Neurons.Value(int(hid.NeurStIndex+lni), 0, int(ActM))
+		}
+		return act
+	}
+
+	base := hidActM(0, 0)
+	assert.Greater(t, base, float32(0))
+	assert.Less(t, hidActM(5, 0), base)
+	assert.Greater(t, hidActM(-5, 0), base)
+	assert.Less(t, hidActM(0, 0.5), base)
+}
+
+func TestIntrinsicWeights(t *testing.T) {
+	net := newIntrinsicTestNet()
+	hid := net.LayerByName("Hidden")
+	for lni := range hid.NNeurons {
+		ni := int(hid.NeurStIndex + lni)
+		NeuronAvgs.Set(float32(lni)-1.5, ni, int(IPThr))
+		NeuronAvgs.Set(0.1*float32(lni), ni, int(IPGl))
+	}
+	var b bytes.Buffer
+	assert.NoError(t, net.WriteWeightsJSON(&b))
+	assert.Contains(t, b.String(), `"IPThr"`)
+
+	loadNet := newIntrinsicTestNet()
+	assert.NoError(t, loadNet.ReadWeightsJSON(&b))
+	lhid := loadNet.LayerByName("Hidden")
+	for lni := range lhid.NNeurons {
+		ni := int(lhid.NeurStIndex + lni)
+		assert.Equal(t, float32(lni)-1.5, NeuronAvgs.Value(ni, int(IPThr)))
+		assert.InDelta(t, 0.1*float32(lni), NeuronAvgs.Value(ni, int(IPGl)), 1.0e-6)
+	}
+	lw := lhid.WeightsLayer()
+	assert.Len(t, lw.Units["IPThr"], int(lhid.NNeurons))
+}
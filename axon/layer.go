@@ -59,6 +59,15 @@ type Layer struct {
 	// Neuron, Pool, Values storage is allocated to hold this amount.
 	MaxData uint32 `display:"-"`
 
+	// DendComps is the number of dendritic compartments per neuron, which must
+	// be set prior to [Network.Build]. The first compartment is always the
+	// standard VmDend, so values <= 1 produce the usual single dendrite.
+	// Additional compartments are chained in sequence from proximal to distal
+	// (e.g., basal, apical trunk, apical tuft), coupled by [DendParams.GAxial]
+	// conductances, and each computes its own NMDA and VGCC channels.
+	// Pathways target a specific compartment via [PathParams.DendComp].
+	DendComps int
+
 	// RecvPaths is the list of receiving pathways into this layer from other layers.
 	RecvPaths []*Path
 
@@ -160,6 +169,8 @@ func (ly *Layer) Defaults() { //types:add
 
 	case LDTLayer:
 		ly.LDTDefaults()
+	case LCLayer, DRNLayer:
+		ly.NeuroModNucleusDefaults()
 	case BLALayer:
 		ly.BLADefaults()
 	case CeMLayer:
@@ -189,6 +200,13 @@ func (ly *Layer) Defaults() { //types:add
 		ly.BGThalDefaults()
 	case VSGatedLayer:
 		ly.Params.VSGatedDefaults()
+
+	case InhibPVLayer:
+		ly.Params.InhibPVDefaults()
+	case InhibSSTLayer:
+		ly.Params.InhibSSTDefaults()
+	case InhibVIPLayer:
+		ly.Params.InterneuronDefaults()
 	}
 	ly.Params.CT.DecayForNCycles(int(ctx.ThetaCycles))
 	ly.applyDefaultParams()
@@ -674,17 +692,27 @@ func (ly *Layer) VarRange(varNm string) (min, max float32, err error) {
 // in a JSON text format.  We build in the indentation logic to make it much faster and
 // more efficient.
 func (ly *Layer) WriteWeightsJSON(w io.Writer, depth int) {
+	ly.LayerBase.WriteWeightsJSONBase(w, depth, ly.weightsMetaData()...)
+}
+
+// weightsMetaData sets the layer MetaData to the values saved in
+// weights files, and returns the names of the unit variables saved,
+// for WriteWeightsJSON and WeightsLayer.
+func (ly *Layer) weightsMetaData() []string {
 	li := ly.Index
 	ly.MetaData = make(map[string]string)
 	ly.MetaData["ActMAvg"] = fmt.Sprintf("%g", LayerStates.Value(int(li), int(0), int(LayerActMAvg)))
 	ly.MetaData["ActPAvg"] = fmt.Sprintf("%g", LayerStates.Value(int(li), int(0), int(LayerActPAvg)))
 	ly.MetaData["GiMult"] = fmt.Sprintf("%g", LayerStates.Value(int(li), int(0), int(LayerGiMult)))
 
+	var uvars []string
 	if ly.Params.IsLearnTrgAvg() {
-		ly.LayerBase.WriteWeightsJSONBase(w, depth, "ActAvg", "TrgAvg")
-	} else {
-		ly.LayerBase.WriteWeightsJSONBase(w, depth)
+		uvars = append(uvars, "ActAvg", "TrgAvg")
+	}
+	if ly.Params.Acts.Intrinsic.On.IsTrue() {
+		uvars = append(uvars, "IPThr", "IPGl")
 	}
+	return uvars
 }
 
 // SetWeights sets the weights for this layer from weights.Layer decoded values
@@ -711,22 +739,17 @@ func (ly *Layer) SetWeights(lw *weights.Layer) error {
 		}
 	}
 	if lw.Units != nil {
-		if ta, ok := lw.Units["ActAvg"]; ok {
-			for lni := range ta {
-				if lni > int(ly.NNeurons) {
-					break
-				}
-				ni := ly.NeurStIndex + uint32(lni)
-				NeuronAvgs.Set(ta[lni], int(ni), int(ActAvg))
+		for _, vr := range []NeuronAvgVars{ActAvg, TrgAvg, IPThr, IPGl} {
+			ta, ok := lw.Units[vr.String()]
+			if !ok {
+				continue
 			}
-		}
-		if ta, ok := lw.Units["TrgAvg"]; ok {
 			for lni := range ta {
 				if lni > int(ly.NNeurons) {
 					break
 				}
 				ni := ly.NeurStIndex + uint32(lni)
-				NeuronAvgs.Set(ta[lni], int(ni), int(TrgAvg))
+				NeuronAvgs.Set(ta[lni], int(ni), int(vr))
 			}
 		}
 	}
@@ -51,6 +51,16 @@ type LayerIndexes struct {
 
 	// layer shape Units X dimension
 	ShpUnX int32 `edit:"-"`
+
+	// DendSt is the starting index in the global Dendrites list of
+	// additional dendritic compartments for this layer.
+	DendSt uint32 `edit:"-"`
+
+	// DendN is the number of additional dendritic compartments per neuron,
+	// beyond the first VmDend compartment: [Layer.DendComps] - 1.
+	DendN uint32 `edit:"-"`
+
+	pad, pad1 uint32
 }
 
 // LayerInhibIndexes contains indexes of layers for between-layer inhibition.
@@ -145,6 +155,14 @@ type LayerParams struct {
 	// value (LV) activations, which update every cycle.
 	VTA VTAParams `display:"inline"`
 
+	// LC has parameters for locus coeruleus norepinephrine (NE) based on
+	// surprise and uncertainty.
+	LC LCParams `display:"inline"`
+
+	// DRN has parameters for dorsal raphe nucleus serotonin (Ser) based on
+	// aversive outcomes and patient waiting for expected rewards.
+	DRN DRNParams `display:"inline"`
+
 	// RWPred has parameters for reward prediction using a simple Rescorla-Wagner
 	// learning rule (i.e., PV learning in the Rubicon framework).
 	RWPred RWPredParams `display:"inline"`
@@ -212,6 +230,8 @@ func (ly *LayerParams) Update() {
 
 	ly.LDT.Update()
 	ly.VTA.Update()
+	ly.LC.Update()
+	ly.DRN.Update()
 
 	ly.RWPred.Update()
 	ly.RWDa.Update()
@@ -242,6 +262,8 @@ func (ly *LayerParams) Defaults() {
 
 	ly.LDT.Defaults()
 	ly.VTA.Defaults()
+	ly.LC.Defaults()
+	ly.DRN.Defaults()
 
 	ly.RWPred.Defaults()
 	ly.RWDa.Defaults()
@@ -271,6 +293,10 @@ func (ly *LayerParams) ShouldDisplay(field string) bool {
 		return ly.Type == LDTLayer
 	case "VTA":
 		return ly.Type == VTALayer
+	case "LC":
+		return ly.Type == LCLayer
+	case "DRN":
+		return ly.Type == DRNLayer
 	case "RWPred":
 		return ly.Type == RWPredLayer
 	case "RWDa":
@@ -274,6 +274,48 @@ const (
 	// between the TDIntegLayer activations in the minus and plus phase.
 	// These are retrieved from Special LayerValues.
 	TDDaLayer
+
+	//////// Interneurons
+
+	// InhibPVLayer represents parvalbumin positive (PV+) fast-spiking
+	// basket cell interneurons, which receive feedforward and feedback
+	// excitation from the principal cells of a layer, and provide fast
+	// GABA-A inhibition onto their soma (perisomatic), along with mutual
+	// inhibition among themselves. This corresponds to the FS fast-spiking
+	// component of the FS-FFFB inhibition function.
+	// See [Network.AddInterneurons].
+	InhibPVLayer
+
+	// InhibSSTLayer represents somatostatin positive (SST+) interneurons,
+	// such as Martinotti cells, which receive facilitating excitation from
+	// the principal cells of a layer, and provide slower GABA-A inhibition
+	// onto their dendrites (VmDend) via [DendInhibitoryG] pathways.
+	// This corresponds to the SS slow-spiking component of FS-FFFB.
+	InhibSSTLayer
+
+	// InhibVIPLayer represents vasoactive intestinal peptide positive (VIP+)
+	// interneurons, which are driven by top-down and neuromodulatory inputs,
+	// and inhibit the SST interneurons, thereby disinhibiting the dendrites
+	// of the principal cells.
+	InhibVIPLayer
+
+	//////// Neuromodulatory nuclei
+
+	// LCLayer represents the locus coeruleus, which releases
+	// norepinephrine (NE). It computes the NE global value from surprise
+	// (the magnitude of phasic dopamine) and uncertainty in the expected
+	// outcome (PVposVar), which modulates gain, learning rate and
+	// exploration noise via NeuroModParams, and giving up in Rubicon.
+	// Its activity reflects the NE level.
+	LCLayer
+
+	// DRNLayer represents the dorsal raphe nucleus, which releases
+	// serotonin (Ser, 5-HT). It computes the Ser global value from
+	// aversive outcomes and patient waiting for expected positive outcomes,
+	// suppressed by urgency, which modulates learning rate via
+	// NeuroModParams, and reduces cost and urgency effects in Rubicon.
+	// Its activity reflects the Ser level.
+	DRNLayer
 )
 
 // IsExtLayerType returns true if the layer type deals with external input:
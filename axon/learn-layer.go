@@ -205,8 +205,12 @@ func (ly *LayerParams) AvgDifFromTrgAvg(ctx *Context) {
 }
 
 // SlowAdaptNeuron does path & synapse level slow adaptation on SWt and
-// overall synaptic scaling, per each receiving neuron ri.
+// overall synaptic scaling, per each receiving neuron ri,
+// and intrinsic plasticity of neuron excitability if enabled.
 func (ly *LayerParams) SlowAdaptNeuron(ctx *Context, ri uint32) {
+	if ly.Acts.Intrinsic.On.IsTrue() && !NeuronIsOff(ri) {
+		ly.Acts.Intrinsic.Adapt(ri, ly.Acts.Spikes.MaxHz)
+	}
 	lni := ri - ly.Indexes.NeurSt
 	rn := ly.Indexes.RecvN
 	for pi := uint32(0); pi < rn; pi++ {
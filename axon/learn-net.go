@@ -10,11 +10,16 @@ package axon
 // running-average activation values. Copies synapses back from GPU,
 // for case where viewing the synapses.
 func (nt *Network) DWt() {
+	if nt.Trace.On {
+		nt.Trace.Begin(TraceFunc, "DWt", "", 0)
+		defer nt.Trace.EndName("DWt")
+	}
 	nix := nt.NetIxs()
 	ctx := nt.Context()
 	sd := int(nix.NSyns * ctx.NData)
-	RunDWtSyn(sd)
-	RunDWtFromDiSyn(int(nix.NSyns))
+	RunKernel("DWtSyn", sd, RunDWtSynGPU, DWtSyn)
+	nt.dwtRules(sd)
+	RunKernel("DWtFromDiSyn", int(nix.NSyns), RunDWtFromDiSynGPU, DWtFromDiSyn)
 	RunDoneSynapsesTrace()
 }
 
@@ -22,10 +27,14 @@ func (nt *Network) DWt() {
 // after having done DWt previously.
 // Also does SlowUpdate.
 func (nt *Network) WtFromDWt() {
+	if nt.Trace.On {
+		nt.Trace.Begin(TraceFunc, "WtFromDWt", "", 0)
+		defer nt.Trace.EndName("WtFromDWt")
+	}
 	nix := nt.NetIxs()
-	RunWtFromDWtLayer(int(nix.NLayers))
-	RunDWtSubMeanNeuron(int(nix.NNeurons))
-	RunWtFromDWtSyn(int(nix.NSyns))
+	RunKernel("WtFromDWtLayer", int(nix.NLayers), RunWtFromDWtLayerGPU, WtFromDWtLayer)
+	RunKernel("DWtSubMeanNeuron", int(nix.NNeurons), RunDWtSubMeanNeuronGPU, DWtSubMeanNeuron)
+	RunKernel("WtFromDWtSyn", int(nix.NSyns), RunWtFromDWtSynGPU, WtFromDWtSyn)
 	nt.SlowUpdate()
 	RunDoneSynapses()
 }
@@ -36,18 +45,38 @@ func (nt *Network) WtFromDWt() {
 // This should be used when not viewing the weights.
 // Also does SlowUpdate.
 func (nt *Network) DWtToWt() {
+	if nt.Trace.On {
+		nt.Trace.Begin(TraceFunc, "DWtToWt", "", 0)
+		defer nt.Trace.EndName("DWtToWt")
+	}
 	nix := nt.NetIxs()
 	ctx := nt.Context()
 	sd := int(nix.NSyns * ctx.NData)
-	RunDWtSyn(sd)
-	RunDWtFromDiSyn(int(nix.NSyns))
-	RunWtFromDWtLayer(int(nix.NLayers))
-	RunDWtSubMeanNeuron(int(nix.NNeurons))
-	RunWtFromDWtSyn(int(nix.NSyns))
+	RunKernel("DWtSyn", sd, RunDWtSynGPU, DWtSyn)
+	nt.dwtRules(sd)
+	RunKernel("DWtFromDiSyn", int(nix.NSyns), RunDWtFromDiSynGPU, DWtFromDiSyn)
+	RunKernel("WtFromDWtLayer", int(nix.NLayers), RunWtFromDWtLayerGPU, WtFromDWtLayer)
+	RunKernel("DWtSubMeanNeuron", int(nix.NNeurons), RunDWtSubMeanNeuronGPU, DWtSubMeanNeuron)
+	RunKernel("WtFromDWtSyn", int(nix.NSyns), RunWtFromDWtSynGPU, WtFromDWtSyn)
 	nt.SlowUpdate()
 	RunDone()
 }
 
+// dwtRules runs the DWtThreeFactorSyn and DWtSTDPSyn kernels
+// after DWtSyn, if any pathways use those learning rules,
+// for sd = synapses * data.
+func (nt *Network) dwtRules(sd int) {
+	for _, pt := range nt.Paths {
+		if !pt.Off && pt.Params.Learn.ThreeFactor.On() {
+			RunKernel("DWtThreeFactorSyn", sd, RunDWtThreeFactorSynGPU, DWtThreeFactorSyn)
+			break
+		}
+	}
+	if nt.NetIxs().NSTDPSyns > 0 {
+		RunKernel("DWtSTDPSyn", sd, RunDWtSTDPSynGPU, DWtSTDPSyn)
+	}
+}
+
 // SlowUpdate does ctx.SlowInc() and calls SlowAdapt at SlowInterval
 // and AdaptGi at AdaptGiInterval.
 func (nt *Network) SlowUpdate() {
@@ -62,17 +91,30 @@ func (nt *Network) SlowUpdate() {
 }
 
 // SlowAdapt runs slow adaptation functions associated with sleep,
-// including synaptic scaling associated with overall neural activity.
+// including synaptic scaling associated with overall neural activity,
+// and structural plasticity for pathways with StructPlast.On,
+// which runs on the CPU (see [Network.StructPlast]).
 func (nt *Network) SlowAdapt() {
+	if nt.Trace.On {
+		nt.Trace.Begin(TraceFunc, "SlowAdapt", "", 0)
+		defer nt.Trace.EndName("SlowAdapt")
+	}
 	nix := nt.NetIxs()
-	RunSlowAdaptLayer(int(nix.NLayers))
-	RunSlowAdaptNeuron(int(nix.NNeurons))
+	RunKernel("SlowAdaptLayer", int(nix.NLayers), RunSlowAdaptLayerGPU, SlowAdaptLayer)
+	RunKernel("SlowAdaptNeuron", int(nix.NNeurons), RunSlowAdaptNeuronGPU, SlowAdaptNeuron)
+	if nt.structPlastOn() {
+		RunDone(CtxVar, SynapsesVar, SynapseTracesVar, NeuronsVar, SynapseSTDPVar, SynapseConsolVar)
+		nt.StructPlast()
+		ToGPUSynapsesIndexes()
+		ToGPU(CtxVar)
+		RunGPUSync()
+	}
 }
 
 // AdaptGi does adapting inhibition at a slower interval.
 func (nt *Network) AdaptGi() {
 	nix := nt.NetIxs()
-	RunAdaptGiLayer(int(nix.NLayers))
+	RunKernel("AdaptGiLayer", int(nix.NLayers), RunAdaptGiLayerGPU, AdaptGiLayer)
 }
 
 // LRateMod sets the LRate modulation parameter for Paths, which is
@@ -246,6 +288,36 @@ func DWtSyn(i uint32) { //gosl:kernel
 	Paths[pti].DWtSyn(ctx, &Layers[Paths[pti].Indexes.RecvLayer], syni, si, ri, di)
 }
 
+// DWtThreeFactorSyn is the kernel over Synapses * Data to
+// compute weight changes for [ThreeFactorParams] pathways.
+func DWtThreeFactorSyn(i uint32) { //gosl:kernel
+	ctx := GetCtx(0)
+	syni := ctx.ItemIndex(i)
+	if syni >= NetworkIxs[0].NSyns {
+		return
+	}
+	di := ctx.DataIndex(i)
+	pti := SynapseIxs.Value(int(syni), int(SynPathIndex))
+	si := SynapseIxs.Value(int(syni), int(SynSendIndex))
+	ri := SynapseIxs.Value(int(syni), int(SynRecvIndex))
+	Paths[pti].DWtThreeFactor(ctx, syni, si, ri, di)
+}
+
+// DWtSTDPSyn is the kernel over Synapses * Data to
+// compute weight changes for [STDPParams] pathways.
+func DWtSTDPSyn(i uint32) { //gosl:kernel
+	ctx := GetCtx(0)
+	syni := ctx.ItemIndex(i)
+	if syni >= NetworkIxs[0].NSyns {
+		return
+	}
+	di := ctx.DataIndex(i)
+	pti := SynapseIxs.Value(int(syni), int(SynPathIndex))
+	si := SynapseIxs.Value(int(syni), int(SynSendIndex))
+	ri := SynapseIxs.Value(int(syni), int(SynRecvIndex))
+	Paths[pti].DWtSTDP(ctx, syni, si, ri, di)
+}
+
 // DWtFromDiSyn is the kernel over Synapses (not * Data) to
 // integrate DWt over Di data parallel values.
 func DWtFromDiSyn(syni uint32) { //gosl:kernel
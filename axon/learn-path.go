@@ -38,11 +38,16 @@ func (pt *PathParams) DWtSyn(ctx *Context, rlay *LayerParams, syni, si, ri, di u
 		pt.DWtSynRWPred(ctx, syni, si, ri, di)
 	case TDPredPath:
 		pt.DWtSynTDPred(ctx, syni, si, ri, di)
+	case ActorPath:
+		pt.DWtSynActor(ctx, rlay, syni, si, ri, di)
 	case BLAPath:
 		pt.DWtSynBLA(ctx, syni, si, ri, di)
 	case HipPath:
 		pt.DWtSynHip(ctx, syni, si, ri, di, isTarget) // by default this is the same as DWtSynCortex (w/ unused Hebb component in the algorithm) except that it uses WtFromDWtSynNoLimits
 	default:
+		if pt.Learn.ThreeFactor.On() || pt.Learn.STDP.On() {
+			return // separate DWtThreeFactorSyn, DWtSTDPSyn kernels
+		}
 		if pt.Learn.Hebb.On.IsTrue() {
 			pt.DWtSynHebb(ctx, syni, si, ri, di)
 		} else if isTarget {
@@ -59,6 +64,38 @@ func (pt *PathParams) DWtSyn(ctx *Context, rlay *LayerParams, syni, si, ri, di u
 	}
 }
 
+// DWtSynDefault returns true if this pathway type uses the default
+// learning rules in DWtSyn, which include the [ThreeFactorParams] and
+// [STDPParams] rules that are computed in separate kernels.
+func (pt *PathParams) DWtSynDefault() bool {
+	switch pt.Type {
+	case CTCtxtPath, VSMatrixPath, DSMatrixPath, VSPatchPath, DSPatchPath, CNIOPath, RWPath, TDPredPath, ActorPath, BLAPath, HipPath:
+		return false
+	default:
+		return true
+	}
+}
+
+// DWtThreeFactor computes the weight change for pathways using the
+// [ThreeFactorParams] rule, called by the DWtThreeFactorSyn kernel,
+// which is separate from DWtSyn to stay within GPU buffer limits.
+func (pt *PathParams) DWtThreeFactor(ctx *Context, syni, si, ri, di uint32) {
+	if pt.Learn.Learn == 0 || !pt.DWtSynDefault() || !pt.Learn.ThreeFactor.On() {
+		return
+	}
+	pt.DWtSynThreeFactor(ctx, syni, si, ri, di)
+}
+
+// DWtSTDP computes the weight change for pathways using the
+// [STDPParams] rule, called by the DWtSTDPSyn kernel,
+// which is separate from DWtSyn to stay within GPU buffer limits.
+func (pt *PathParams) DWtSTDP(ctx *Context, syni, si, ri, di uint32) {
+	if pt.Learn.Learn == 0 || !pt.DWtSynDefault() || pt.Learn.ThreeFactor.On() || !pt.Learn.STDP.On() {
+		return
+	}
+	pt.DWtSynSTDP(ctx, syni, si, ri, di)
+}
+
 // SynCa gets the synaptic calcium P (potentiation) and D (depression)
 // values, using an optimized integration of neuron-level [NeuronTraces] values,
 // and weight factors to capture the different CaP vs. CaD time constants.
@@ -119,8 +156,9 @@ func (pt *PathParams) SynCaTotal(ctx *Context, si, ri, di uint32, edcyc, ncyc in
 // IMPORTANT: all DWt routines MUST set DiDWt to _something_, otherwise the
 // previous value will persist! i.e., set it to 0 if no learning.
 
-// DWtSynSoftBound does the standard soft weight bounding for given
-// dwt weight change value. SoftBound must be done in the DWt step
+// DWtSynSoftBound does the weight bounding for given dwt weight change
+// value, according to the SWts.Bound weight-dependence mode (standard
+// soft bounding by default). This must be done in the DWt step
 // and not later, because it is learning-rule specific and enters
 // into the zero-sum computation.
 func (pt *PathParams) DWtSynSoftBound(ctx *Context, syni, di uint32, dwt float32) {
@@ -128,12 +166,8 @@ func (pt *PathParams) DWtSynSoftBound(ctx *Context, syni, di uint32, dwt float32
 		SynapseTraces.Set(0.0, int(syni), int(di), int(DiDWt))
 	} else {
 		lwt := Synapses.Value(int(syni), int(LWt)) // linear weight
-		edw := dwt
-		if edw > 0 {
-			edw *= (1 - lwt)
-		} else {
-			edw *= lwt
-		}
+		inhib := pt.IsInhib() || pt.Com.GType == DendInhibitoryG
+		edw := pt.SWts.Bound.DWt(dwt, lwt, inhib)
 		SynapseTraces.Set(pt.Learn.LRate.Eff*edw, int(syni), int(di), int(DiDWt))
 	}
 }
@@ -381,10 +415,29 @@ func (pt *PathParams) DWtSynTDPred(ctx *Context, syni, si, ri, di uint32) {
 		}
 	}
 
-	dwt := da * Neurons.Value(int(si), int(di), int(CaDPrev)) // no recv unit activation, prior trial act
+	sact := Neurons.Value(int(si), int(di), int(CaDPrev)) // no recv unit activation, prior trial act
+	if pt.RLPred.Lambda > 0 {
+		sact = pt.RLPred.Trace(SynapseTraces.Value(int(syni), int(di), int(Tr)), sact)
+		SynapseTraces.Set(sact, int(syni), int(di), int(Tr))
+	}
+	dwt := da * sact
 	SynapseTraces.Set(eff_lr*dwt, int(syni), int(di), int(DiDWt))
 }
 
+// DWtSynActor computes the weight change (learning) at given synapse,
+// for the ActorPath type: DA-modulated policy gradient, where the
+// eligibility trace accumulates the sending activity times the receiving
+// activity relative to the expected (nominal) level, on the prior trial.
+func (pt *PathParams) DWtSynActor(ctx *Context, rlay *LayerParams, syni, si, ri, di uint32) {
+	da := pt.RLPred.DaFromTol(GlobalScalars.Value(int(GvDA), int(di)))
+	ract := Neurons.Value(int(ri), int(di), int(CaDPrev)) - rlay.Inhib.ActAvg.Nominal
+	dtr := Neurons.Value(int(si), int(di), int(CaDPrev)) * ract
+	tr := pt.RLPred.Trace(SynapseTraces.Value(int(syni), int(di), int(Tr)), dtr)
+	SynapseTraces.Set(dtr, int(syni), int(di), int(DTr))
+	SynapseTraces.Set(tr, int(syni), int(di), int(Tr))
+	SynapseTraces.Set(pt.Learn.LRate.Eff*da*tr, int(syni), int(di), int(DiDWt))
+}
+
 // DWtSynVSMatrix computes the weight change (learning) at given synapse,
 // for the VSMatrixPath type.
 func (pt *PathParams) DWtSynVSMatrix(ctx *Context, syni, si, ri, di uint32) {
@@ -612,6 +665,9 @@ func (pt *PathParams) WtFromDWtSyn(ctx *Context, syni uint32) {
 func (pt *PathParams) WtFromDWtSynCortex(ctx *Context, syni uint32) {
 	dwt := Synapses.Value(int(syni), int(DWt))
 	Synapses.SetAdd(dwt, int(syni), int(DSWt))
+	if pt.Learn.Consol.On() {
+		dwt = pt.DWtSynConsol(syni, dwt)
+	}
 	wt := Synapses.Value(int(syni), int(Wt))
 	lwt := Synapses.Value(int(syni), int(LWt))
 
@@ -625,6 +681,9 @@ func (pt *PathParams) WtFromDWtSynCortex(ctx *Context, syni uint32) {
 // WtFromDWtSynNoLimits -- weight update without limits
 func (pt *PathParams) WtFromDWtSynNoLimits(ctx *Context, syni uint32) {
 	dwt := Synapses.Value(int(syni), int(DWt))
+	if pt.Learn.Consol.On() {
+		dwt = pt.DWtSynConsol(syni, dwt)
+	}
 	if dwt == 0 {
 		return
 	}
@@ -685,7 +744,7 @@ func (pt *PathParams) SWtFromWt(ctx *Context, rlay *LayerParams, pti, ri, lni ui
 		Synapses.Set(0.0, int(syni), int(DSWt))
 		wt := Synapses.Value(int(syni), int(Wt))
 		lwt := pt.SWts.LWtFromWts(wt, swt)
-		lwt -= hiDk * lwt
+		lwt = pt.SWts.Bound.ClipLWt(lwt - hiDk*lwt)
 		Synapses.Set(lwt, int(syni), int(LWt))
 		Synapses.Set(pt.SWts.WtValue(swt, lwt), int(syni), int(Wt))
 	}
@@ -711,10 +770,11 @@ func (pt *PathParams) SynScale(ctx *Context, rlay *LayerParams, pti, ri, lni uin
 		lwt := Synapses.Value(int(syni), int(LWt))
 		swt := Synapses.Value(int(syni), int(SWt))
 		if adif >= 0 { // key to have soft bounding on lwt here!
-			Synapses.SetAdd((1-lwt)*adif*swt, int(syni), int(LWt))
+			lwt += (1 - lwt) * adif * swt
 		} else {
-			Synapses.SetAdd(lwt*adif*swt, int(syni), int(LWt))
+			lwt += lwt * adif * swt
 		}
+		Synapses.Set(pt.SWts.Bound.ClipLWt(lwt), int(syni), int(LWt))
 		Synapses.Set(pt.SWts.WtValue(swt, Synapses.Value(int(syni), int(LWt))), int(syni), int(Wt))
 	}
 }
@@ -7,7 +7,9 @@
 package axon
 
 import (
-	// "fmt"
+	"fmt"
+
+	"cogentcore.org/core/base/errors"
 	"cogentcore.org/core/math32"
 	"cogentcore.org/core/math32/minmax"
 	"cogentcore.org/lab/base/randx"
@@ -24,6 +26,7 @@ import (
 // LearnCaParams parameterizes the neuron-level calcium signals driving learning:
 // LearnCa = NMDA + VGCC Ca sources, where VGCC can be simulated from spiking or
 // use the more complex and dynamaic VGCC channel directly.
+// Ca from the CaT T-type channel, if active, is integrated along with VGCC.
 // LearnCa is then integrated in a cascading manner at multiple time scales:
 // CaM (as in calmodulin), CaP (ltP, CaMKII, plus phase), CaD (ltD, DAPK1, minus phase).
 type LearnCaParams struct {
@@ -110,12 +113,12 @@ func (lc *LearnCaParams) ShouldDisplay(field string) bool {
 }
 
 // VgccCa updates the simulated VGCC calcium from spiking, if that option is selected,
-// and performs time-integration of VgccCa
+// and performs time-integration of VgccCa, along with CaT channel CatCa.
 func (lc *LearnCaParams) VgccCaFromSpike(ctx *Context, ni, di uint32) {
 	if lc.SpikeVGCC.IsTrue() {
 		Neurons.Set(lc.SpikeVgccCa*Neurons.Value(int(ni), int(di), int(Spike)), int(ni), int(di), int(VgccCa))
 	}
-	Neurons.SetAdd(Neurons.Value(int(ni), int(di), int(VgccCa))-lc.VgccDt*Neurons.Value(int(ni), int(di), int(VgccCaInt)), int(ni), int(di), int(VgccCaInt))
+	Neurons.SetAdd(Neurons.Value(int(ni), int(di), int(VgccCa))+Neurons.Value(int(ni), int(di), int(CatCa))-lc.VgccDt*Neurons.Value(int(ni), int(di), int(VgccCaInt)), int(ni), int(di), int(VgccCaInt))
 	// Dt only affects decay, not rise time
 }
 
@@ -705,6 +708,7 @@ func (ln *LearnNeuronParams) InitNeuronCa(ctx *Context, ni, di uint32) {
 
 	Neurons.Set(0, int(ni), int(di), int(VgccCa))
 	Neurons.Set(0, int(ni), int(di), int(VgccCaInt))
+	Neurons.Set(0, int(ni), int(di), int(CatCa))
 
 	Neurons.Set(0, int(ni), int(di), int(LearnCa))
 
@@ -929,6 +933,139 @@ func (sp *SWtAdaptParams) ShouldDisplay(field string) bool {
 	}
 }
 
+// WtBoundModes are the weight-dependence modes for learning in the
+// linear [LWt] weights, used in [WtBoundParams].
+type WtBoundModes int32 //enums:enum
+
+const (
+	// SoftBound is the standard exponential soft weight bounding, where
+	// weight increases are multiplied by the distance to the Max bound,
+	// and decreases by the distance to the Min bound. This is
+	// offset by the sigmoidal contrast enhancement in [SWtAdaptParams].
+	SoftBound WtBoundModes = iota
+
+	// HardBound is additive learning independent of the weight value,
+	// with weights hard-bounded (clipped) at the Min and Max bounds.
+	// This produces a bimodal weight distribution over time.
+	HardBound
+
+	// MultBound is multiplicative soft-bounded learning with configurable
+	// exponents, where increases are multiplied by the distance to Max raised
+	// to the MuPlus power, and decreases by the distance to Min raised to the
+	// MuMinus power (Gutig et al, 2003). 0 = additive (HardBound)
+	// and 1 = SoftBound.
+	MultBound
+
+	// LogNormBound has additive weight increases and decreases proportional
+	// to the weight value, as in van Rossum et al (2000). This produces a
+	// stable, unimodal, approximately log-normal weight distribution centered
+	// around the Ref value, where increases and decreases balance.
+	LogNormBound
+
+	// DaleBound is additive learning for pathways with a fixed sign according
+	// to Dale's law, where weights are magnitudes hard-bounded at the Min and Max
+	// bounds and cannot change sign. For inhibitory pathways, the sign of the
+	// weight change is inverted, so that learning increases inhibition
+	// where the same change would decrease excitation.
+	DaleBound
+)
+
+// WtBoundParams are the weight bounds and weight-dependence mode for
+// learning in the linear [LWt] weights.
+type WtBoundParams struct {
+
+	// Mode is the weight-dependence mode for learning.
+	Mode WtBoundModes
+
+	// MuPlus is the exponent on the distance to the Max bound for
+	// weight increases in MultBound mode.
+	MuPlus float32 `default:"1" min:"0"`
+
+	// MuMinus is the exponent on the distance to the Min bound for
+	// weight decreases in MultBound mode.
+	MuMinus float32 `default:"1" min:"0"`
+
+	// Ref is the normalized weight value (0-1 between Min and Max)
+	// where weight increases and decreases balance in LogNormBound mode,
+	// which is the center of the resulting weight distribution.
+	Ref float32 `default:"0.5" min:"0" max:"1"`
+
+	// Min is the lower bound on [LWt] values. Must be >= 0.
+	Min float32 `default:"0" min:"0" max:"1"`
+
+	// Max is the upper bound on [LWt] values. Must be <= 1.
+	Max float32 `default:"1" min:"0" max:"1"`
+
+	pad, pad1 float32
+}
+
+func (wb *WtBoundParams) Defaults() {
+	wb.Mode = SoftBound
+	wb.MuPlus = 1
+	wb.MuMinus = 1
+	wb.Ref = 0.5
+	wb.Min = 0
+	wb.Max = 1
+}
+
+// Update rejects a Max that is not greater than Min, which would make
+// the normalized weight in DWt undefined, logging an error and
+// restoring the default 0-1 bounds.
+func (wb *WtBoundParams) Update() {
+	if wb.Max <= wb.Min {
+		errors.Log(fmt.Errorf("axon.WtBoundParams: Max = %g must be greater than Min = %g: using default 0-1 bounds", wb.Max, wb.Min))
+		wb.Min = 0
+		wb.Max = 1
+	}
+}
+
+func (wb *WtBoundParams) ShouldDisplay(field string) bool {
+	switch field {
+	case "MuPlus", "MuMinus":
+		return wb.Mode == MultBound
+	case "Ref":
+		return wb.Mode == LogNormBound
+	default:
+		return true
+	}
+}
+
+// ClipLWt returns the linear weight value clipped to the Min, Max bounds.
+func (wb *WtBoundParams) ClipLWt(lwt float32) float32 {
+	return math32.Clamp(lwt, wb.Min, wb.Max)
+}
+
+// DWt returns the weight change for given raw weight change dwt and
+// linear weight value lwt, according to the Mode. inhib is true for
+// inhibitory pathways, which invert the sign in DaleBound mode.
+func (wb *WtBoundParams) DWt(dwt, lwt float32, inhib bool) float32 {
+	x := math32.Clamp((lwt-wb.Min)/(wb.Max-wb.Min), 0.0, 1.0)
+	switch wb.Mode {
+	case HardBound:
+		return dwt
+	case MultBound:
+		if dwt > 0 {
+			return dwt * math32.Pow(1-x, wb.MuPlus)
+		}
+		return dwt * math32.Pow(x, wb.MuMinus)
+	case LogNormBound:
+		if dwt > 0 {
+			return dwt * wb.Ref
+		}
+		return dwt * x
+	case DaleBound:
+		if inhib {
+			return -dwt
+		}
+		return dwt
+	default:
+		if dwt > 0 {
+			return dwt * (1 - x)
+		}
+		return dwt * x
+	}
+}
+
 // SWtParams manages structural, slowly adapting weight values [SWt],
 // in terms of initialization and updating over course of learning.
 // SWts impose initial and slowly adapting constraints on neuron connectivity
@@ -947,17 +1084,23 @@ type SWtParams struct {
 	// Limit limits the range of [SWt] values, so that they do not fully
 	// determine the effective overall weight value.
 	Limit minmax.F32 `default:"{'Min':0.2,'Max':0.8}" display:"inline"`
+
+	// Bound has the bounds and weight-dependence mode for learning
+	// in the linear [LWt] values.
+	Bound WtBoundParams `display:"inline"`
 }
 
 func (sp *SWtParams) Defaults() {
 	sp.Init.Defaults()
 	sp.Adapt.Defaults()
 	sp.Limit.Set(0.2, 0.8)
+	sp.Bound.Defaults()
 }
 
 func (sp *SWtParams) Update() {
 	sp.Init.Update()
 	sp.Adapt.Update()
+	sp.Bound.Update()
 }
 
 // WtVal returns the effective Wt value given the SWt and LWt values
@@ -1031,13 +1174,8 @@ func (sp *SWtParams) WtFromDWt(wt, lwt *float32, dwt, swt float32) {
 		}
 		return
 	}
-	// note: softbound happened at dwt stage
-	*lwt += dwt
-	if *lwt < 0 {
-		*lwt = 0
-	} else if *lwt > 1 {
-		*lwt = 1
-	}
+	// note: weight dependence (Bound.Mode) happened at dwt stage
+	*lwt = sp.Bound.ClipLWt(*lwt + dwt)
 	*wt = sp.WtValue(swt, *lwt)
 }
 
@@ -1255,12 +1393,26 @@ type LearnSynParams struct {
 
 	// hebbian learning option, which overrides the default learning rules
 	Hebb HebbParams `display:"inline"`
+
+	// spike-timing-dependent plasticity option, which overrides the default learning rules
+	STDP STDPParams `display:"inline"`
+
+	// weight consolidation option, which protects weights that were
+	// important for previously learned tasks
+	Consol ConsolParams `display:"inline"`
+
+	// three-factor learning option, which overrides the default learning rules
+	// with an eligibility trace modulated by a global or layer-level factor
+	ThreeFactor ThreeFactorParams `display:"inline"`
 }
 
 func (ls *LearnSynParams) Update() {
 	ls.LRate.Update()
 	ls.DWt.Update()
 	ls.Hebb.Update()
+	ls.STDP.Update()
+	ls.Consol.Update()
+	ls.ThreeFactor.Update()
 }
 
 func (ls *LearnSynParams) Defaults() {
@@ -1268,6 +1420,9 @@ func (ls *LearnSynParams) Defaults() {
 	ls.LRate.Defaults()
 	ls.DWt.Defaults()
 	ls.Hebb.Defaults()
+	ls.STDP.Defaults()
+	ls.Consol.Defaults()
+	ls.ThreeFactor.Defaults()
 }
 
 func (ls *LearnSynParams) ShouldDisplay(field string) bool {
@@ -5,6 +5,14 @@
 package axon
 
 import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"cogentcore.org/core/base/errors"
+	"cogentcore.org/core/core"
 	"cogentcore.org/core/enums"
 	"github.com/emer/emergent/v2/looper"
 	"github.com/emer/emergent/v2/netview"
@@ -311,3 +319,251 @@ func (vu *NetViewUpdate) RecordSyns() {
 	}
 	vu.View.RecordSyns()
 }
+
+//////// Checkpoints
+
+// CheckpointState is implemented by sim-level state, such as environments,
+// that must be saved along with the network in a checkpoint so that
+// a resumed run continues exactly where it left off.
+// See [LooperSaveCheckpoint] and [LooperLoadCheckpoint].
+type CheckpointState interface {
+
+	// SaveCheckpoint writes the state to given writer.
+	SaveCheckpoint(w io.Writer) error
+
+	// LoadCheckpoint reads the state saved by SaveCheckpoint from given reader.
+	// If it returns an error, the state is restored by loading the
+	// state that was saved just before.
+	LoadCheckpoint(r io.Reader) error
+}
+
+// LooperSaveCheckpoint saves the full network state using [Network.SaveCheckpoint],
+// followed by the looper counters for the current mode, and then any
+// additional sim-level states, to given writer.
+// It is designed to be called from an OnEnd function at given level
+// (e.g., Trial or Epoch), at which point the counter at that level has not
+// yet been incremented. Therefore, the saved counters reflect the state at
+// the start of the next iteration of that level: the level counter is
+// incremented and all levels below it are reset to 0.
+// Resuming with [LooperLoadCheckpoint] continues with that next iteration,
+// producing the same results as an uninterrupted run, as long as all
+// sim-level state (e.g., environments) is also saved in states.
+func LooperSaveCheckpoint(w io.Writer, ls *looper.Stacks, net *Network, level enums.Enum, states ...CheckpointState) error {
+	if err := net.SaveCheckpoint(w); err != nil {
+		return err
+	}
+	st := ls.ModeStack()
+	ctrs := []int64{ls.Mode.Int64(), level.Int64(), int64(len(st.Order))}
+	below := false
+	for _, lev := range st.Order {
+		cur := st.Loops[lev].Counter.Cur
+		switch {
+		case below:
+			cur = 0
+		case lev.Int64() == level.Int64():
+			cur++
+			below = true
+		}
+		ctrs = append(ctrs, lev.Int64(), int64(cur))
+	}
+	if err := binary.Write(w, binary.LittleEndian, ctrs); err != nil {
+		return err
+	}
+	for _, sst := range states {
+		if err := writeCheckpointState(w, sst); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeCheckpointState writes the state saved by given CheckpointState
+// to given writer, preceded by its length, so that it can be read in full
+// by [readCheckpointState] before it is applied.
+func writeCheckpointState(w io.Writer, sst CheckpointState) error {
+	var b bytes.Buffer
+	if err := sst.SaveCheckpoint(&b); err != nil {
+		return err
+	}
+	if err := binary.Write(w, binary.LittleEndian, int64(b.Len())); err != nil {
+		return err
+	}
+	_, err := w.Write(b.Bytes())
+	return err
+}
+
+// readCheckpointState reads a state written by [writeCheckpointState]
+// from given reader, without applying it.
+func readCheckpointState(r io.Reader) ([]byte, error) {
+	var n int64
+	if err := binary.Read(r, binary.LittleEndian, &n); err != nil {
+		return nil, err
+	}
+	if n < 0 || n > maxCheckpointStateSize {
+		return nil, fmt.Errorf("LooperLoadCheckpoint: invalid state size: %d", n)
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// maxCheckpointStateSize is the maximum size of each sim-level state
+// in a checkpoint, which protects against allocating from a corrupt size.
+const maxCheckpointStateSize = 1 << 30
+
+// loadCheckpointStates applies the given saved states, which must all
+// read their full data. If any of them fails, the states that were already
+// changed are restored to their prior values, so that either all of
+// the states are loaded or none of them are.
+func loadCheckpointStates(states []CheckpointState, data [][]byte) error {
+	prior := make([][]byte, len(states))
+	for i, sst := range states {
+		var b bytes.Buffer
+		if err := sst.SaveCheckpoint(&b); err != nil {
+			return err
+		}
+		prior[i] = b.Bytes()
+	}
+	for i, sst := range states {
+		br := bytes.NewReader(data[i])
+		err := sst.LoadCheckpoint(br)
+		if err == nil && br.Len() > 0 {
+			err = fmt.Errorf("LooperLoadCheckpoint: state %d did not read all of its %d bytes", i, len(data[i]))
+		}
+		if err != nil {
+			for j := range i + 1 {
+				states[j].LoadCheckpoint(bytes.NewReader(prior[j]))
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// LooperLoadCheckpoint loads the full network state using [Network.LoadCheckpoint],
+// followed by the looper counters and any additional sim-level states,
+// as saved by [LooperSaveCheckpoint] with the same states. This must be called
+// after the looper has been configured and its counters initialized
+// (e.g., the Run counter range), just before calling [looper.Stacks.Cont],
+// which then resumes the run from where it was saved. The looper Mode is set
+// to the saved mode. The levels above the saved level were already started
+// in the saved run, so their OnStart functions (e.g., NewRun) are skipped
+// on their next iteration, instead of resetting the loaded state.
+// The whole checkpoint is read and validated before anything is applied,
+// so the network, looper and states are unchanged if an error is returned.
+func LooperLoadCheckpoint(r io.Reader, ls *looper.Stacks, net *Network, states ...CheckpointState) error {
+	br := bufio.NewReader(r)
+	setNet, err := net.readCheckpoint(br)
+	if err != nil {
+		return err
+	}
+	var hdr [3]int64
+	if err := binary.Read(br, binary.LittleEndian, hdr[:]); err != nil {
+		return err
+	}
+	var mode enums.Enum
+	var st *looper.Stack
+	for md, mst := range ls.Stacks {
+		if md.Int64() == hdr[0] {
+			mode = md
+			st = mst
+			break
+		}
+	}
+	if st == nil {
+		return fmt.Errorf("LooperLoadCheckpoint: mode %d not found in looper stacks", hdr[0])
+	}
+	if hdr[2] != int64(len(st.Order)) {
+		return fmt.Errorf("LooperLoadCheckpoint: number of levels in checkpoint: %d != looper: %d", hdr[2], len(st.Order))
+	}
+	ctrs := make([]int64, 2*hdr[2])
+	if err := binary.Read(br, binary.LittleEndian, ctrs); err != nil {
+		return err
+	}
+	hasLevel := false
+	for i, lev := range st.Order {
+		if ctrs[2*i] != lev.Int64() {
+			return fmt.Errorf("LooperLoadCheckpoint: level %d in checkpoint does not match looper level: %s", ctrs[2*i], lev)
+		}
+		if ctrs[2*i+1] < 0 {
+			return fmt.Errorf("LooperLoadCheckpoint: negative counter %d for level: %s", ctrs[2*i+1], lev)
+		}
+		if lev.Int64() == hdr[1] {
+			hasLevel = true
+		}
+	}
+	if !hasLevel {
+		return fmt.Errorf("LooperLoadCheckpoint: saved level %d not found in looper", hdr[1])
+	}
+	data := make([][]byte, len(states))
+	for i := range states {
+		if data[i], err = readCheckpointState(br); err != nil {
+			return err
+		}
+	}
+	if err := loadCheckpointStates(states, data); err != nil {
+		return err
+	}
+	setNet()
+	ls.Mode = mode
+	above := true
+	for i, lev := range st.Order {
+		lp := st.Loops[lev]
+		lp.Counter.Cur = int(ctrs[2*i+1])
+		if lev.Int64() == hdr[1] {
+			above = false
+		}
+		if above {
+			looperSkipNextOnStart(lp)
+		}
+	}
+	return nil
+}
+
+// looperSkipNextOnStart arranges for the OnStart functions of given loop
+// to be skipped the next time they would be run, after which they are restored.
+func looperSkipNextOnStart(lp *looper.Loop) {
+	onStart := lp.OnStart
+	lp.OnStart = nil
+	lp.OnStart.Add("SkipResumed", func() { lp.OnStart = onStart })
+}
+
+// LooperSaveCheckpointFile saves a checkpoint using [LooperSaveCheckpoint]
+// to given file name. If filename has .gz extension, then the file is
+// gzip compressed.
+func LooperSaveCheckpointFile(filename core.Filename, ls *looper.Stacks, net *Network, level enums.Enum, states ...CheckpointState) error {
+	return writeCheckpointFile(filename, func(w io.Writer) error {
+		return LooperSaveCheckpoint(w, ls, net, level, states...)
+	})
+}
+
+// LooperOpenCheckpointFile loads a checkpoint using [LooperLoadCheckpoint]
+// from given file name. If filename has .gz extension, then the file is
+// gzip uncompressed.
+func LooperOpenCheckpointFile(filename core.Filename, ls *looper.Stacks, net *Network, states ...CheckpointState) error {
+	return readCheckpointFile(filename, func(r io.Reader) error {
+		return LooperLoadCheckpoint(r, ls, net, states...)
+	})
+}
+
+// LooperAddCheckpoint adds an OnEnd function to the given mode and level
+// of the looper, which saves a checkpoint every interval iterations of that
+// level to the file name returned by the filename function, using
+// [LooperSaveCheckpointFile], including any given sim-level states.
+// This should be called after [LooperStandard] so that the weights have
+// already been updated at the end of the trial.
+func LooperAddCheckpoint(ls *looper.Stacks, net *Network, mode, level enums.Enum, interval int, filename func() string, states ...CheckpointState) {
+	lp := ls.Loop(mode, level)
+	lp.OnEnd.Add("Checkpoint", func() {
+		if interval <= 0 || (lp.Counter.Cur+1)%interval != 0 {
+			return
+		}
+		fnm := filename()
+		if CommRank() > 0 || fnm == "" {
+			return
+		}
+		errors.Log(LooperSaveCheckpointFile(core.Filename(fnm), ls, net, level, states...))
+	})
+}
@@ -0,0 +1,460 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package axon
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+
+	"cogentcore.org/core/base/errors"
+	"cogentcore.org/core/base/metadata"
+	"cogentcore.org/core/core"
+	"cogentcore.org/core/enums"
+	"cogentcore.org/core/math32"
+	"cogentcore.org/lab/plot"
+	"cogentcore.org/lab/tensorfs"
+	"github.com/emer/emergent/v2/looper"
+	"github.com/emer/emergent/v2/params"
+)
+
+// LRateSchedTypes are the types of learning rate schedule
+// computed by [LRateSchedule].
+type LRateSchedTypes int32 //enums:enum
+
+const (
+	// LRateStep multiplies the learning rate by Factor every
+	// Interval steps: Factor^floor(step / Interval).
+	LRateStep LRateSchedTypes = iota
+
+	// LRateExp decays the learning rate exponentially, by Factor
+	// per Interval steps: Factor^(step / Interval).
+	LRateExp
+
+	// LRateCosine decreases the learning rate from 1 to Min over
+	// Interval steps, following a half cosine wave, and stays at Min
+	// thereafter.
+	LRateCosine
+
+	// LRateWarmup only has the initial Warmup period, and stays at 1
+	// thereafter.
+	LRateWarmup
+
+	// LRatePlateau multiplies the learning rate by Factor each time
+	// the epoch-level Stat has not improved by at least Threshold
+	// for Patience epochs.
+	LRatePlateau
+)
+
+// LRateSchedule is a declarative learning rate schedule, which sets the
+// [LRateParams] Sched multiplier of the pathways selected by Sel and LayerSel,
+// using the same selector syntax as params sheets (.Class, #Name, or a Type
+// name that matches all). Schedules are added to [Network.LRateScheds],
+// and are driven by the training epoch (and optionally trial) counters
+// via [LooperLRateSched]. If multiple schedules select the same pathway,
+// their values are multiplied. All schedules have an optional initial
+// Warmup period, during which the learning rate ramps up linearly from
+// WarmupStart, after which the decay of the schedule starts.
+type LRateSchedule struct {
+
+	// On enables this schedule.
+	On bool
+
+	// Name of this schedule, which is used for its stat (LRate_Name).
+	Name string `default:"Sched"`
+
+	// Sel is the params selector for the pathways that this schedule
+	// applies to: .Class, #Name, or Path for all pathways.
+	Sel string `default:"Path"`
+
+	// LayerSel is an optional params selector for the receiving layers
+	// of the pathways that this schedule applies to: .Class, #Name, or
+	// empty for all layers.
+	LayerSel string
+
+	// Type is the type of schedule.
+	Type LRateSchedTypes
+
+	// PerTrial updates the schedule every training trial, with steps
+	// counted in trials, instead of every epoch. Does not apply to LRatePlateau.
+	PerTrial bool
+
+	// Warmup is the number of initial steps over which the learning
+	// rate increases linearly from WarmupStart to 1.
+	Warmup int `default:"0" min:"0"`
+
+	// WarmupStart is the initial learning rate multiplier for Warmup.
+	WarmupStart float32 `default:"0.1"`
+
+	// Interval is the number of steps per Factor decrease for LRateStep
+	// and LRateExp, and the total number of decay steps for LRateCosine.
+	Interval int `default:"100" min:"1"`
+
+	// Factor is the multiplier per Interval for LRateStep and LRateExp,
+	// and per plateau for LRatePlateau.
+	Factor float32 `default:"0.5"`
+
+	// Min is the minimum learning rate multiplier, which is the final
+	// value for LRateCosine.
+	Min float32 `default:"0.01"`
+
+	// Stat is the name of the training epoch-level stat that
+	// is monitored for LRatePlateau, where lower values are better
+	// (e.g., Err or UnitErr).
+	Stat string `default:"UnitErr"`
+
+	// Patience is the number of epochs without improvement of Stat
+	// before the learning rate is decreased, for LRatePlateau.
+	Patience int `default:"10" min:"1"`
+
+	// Threshold is the minimum decrease in Stat that counts as an
+	// improvement, for LRatePlateau.
+	Threshold float32 `default:"0.01"`
+
+	// Value is the current learning rate multiplier.
+	Value float32 `edit:"-"`
+
+	// plateau is the multiplier from plateaus so far, for LRatePlateau.
+	plateau float32
+
+	// best is the best Stat value so far, for LRatePlateau.
+	best float64
+
+	// wait is the number of epochs since the last improvement, for LRatePlateau.
+	wait int
+}
+
+func (sc *LRateSchedule) Defaults() {
+	sc.Name = "Sched"
+	sc.Sel = "Path"
+	sc.WarmupStart = 0.1
+	sc.Interval = 100
+	sc.Factor = 0.5
+	sc.Min = 0.01
+	sc.Stat = "UnitErr"
+	sc.Patience = 10
+	sc.Threshold = 0.01
+	sc.Init()
+}
+
+func (sc *LRateSchedule) ShouldDisplay(field string) bool {
+	switch field {
+	case "Interval":
+		return sc.Type == LRateStep || sc.Type == LRateExp || sc.Type == LRateCosine
+	case "Factor":
+		return sc.Type != LRateCosine && sc.Type != LRateWarmup
+	case "Stat", "Patience", "Threshold":
+		return sc.Type == LRatePlateau
+	case "PerTrial":
+		return sc.Type != LRatePlateau
+	case "WarmupStart":
+		return sc.Warmup > 0
+	default:
+		return true
+	}
+}
+
+// Init resets the schedule state, at the start of a run.
+func (sc *LRateSchedule) Init() {
+	sc.Value = 1
+	sc.plateau = 1
+	sc.best = math.Inf(1)
+	sc.wait = 0
+}
+
+// String returns a one-line description of the schedule.
+func (sc *LRateSchedule) String() string {
+	s := fmt.Sprintf("%s: Sel: %q LayerSel: %q Type: %s", sc.Name, sc.Sel, sc.LayerSel, sc.Type)
+	if sc.PerTrial {
+		s += " PerTrial"
+	}
+	if sc.Warmup > 0 {
+		s += fmt.Sprintf(" Warmup: %d WarmupStart: %g", sc.Warmup, sc.WarmupStart)
+	}
+	switch sc.Type {
+	case LRateStep, LRateExp:
+		s += fmt.Sprintf(" Interval: %d Factor: %g Min: %g", sc.Interval, sc.Factor, sc.Min)
+	case LRateCosine:
+		s += fmt.Sprintf(" Interval: %d Min: %g", sc.Interval, sc.Min)
+	case LRatePlateau:
+		s += fmt.Sprintf(" Stat: %s Patience: %d Threshold: %g Factor: %g Min: %g", sc.Stat, sc.Patience, sc.Threshold, sc.Factor, sc.Min)
+	}
+	if !sc.On {
+		s += " (Off)"
+	}
+	return s
+}
+
+// Compute returns the learning rate multiplier at given step,
+// counted in epochs or trials (if PerTrial) from the start of the run.
+func (sc *LRateSchedule) Compute(step int) float32 {
+	if sc.Warmup > 0 && step < sc.Warmup {
+		return sc.WarmupStart + (1-sc.WarmupStart)*float32(step)/float32(sc.Warmup)
+	}
+	ds := float32(step - sc.Warmup)
+	iv := float32(max(sc.Interval, 1))
+	var v float32
+	switch sc.Type {
+	case LRateStep:
+		v = math32.Pow(sc.Factor, math32.Floor(ds/iv))
+	case LRateExp:
+		v = math32.Pow(sc.Factor, ds/iv)
+	case LRateCosine:
+		ph := min(ds/iv, 1)
+		return sc.Min + (1-sc.Min)*0.5*(1+math32.Cos(math32.Pi*ph))
+	case LRateWarmup:
+		return 1
+	case LRatePlateau:
+		v = sc.plateau
+	}
+	return max(v, sc.Min)
+}
+
+// PlateauUpdate updates the LRatePlateau state with given value
+// of the Stat at the end of an epoch, returning true if the
+// learning rate was decreased.
+func (sc *LRateSchedule) PlateauUpdate(stat float64) bool {
+	if sc.Type != LRatePlateau || math.IsNaN(stat) {
+		return false
+	}
+	if stat < sc.best-float64(sc.Threshold) {
+		sc.best = stat
+		sc.wait = 0
+		return false
+	}
+	sc.wait++
+	if sc.wait < sc.Patience {
+		return false
+	}
+	sc.wait = 0
+	sc.plateau *= sc.Factor
+	return true
+}
+
+// Applies returns true if this schedule applies to given pathway.
+// This must only be called after the network has been built and is
+// current, as for params selectors.
+func (sc *LRateSchedule) Applies(pt *Path) bool {
+	if !sc.On {
+		return false
+	}
+	return params.SelMatch(sc.Sel, pt.Params) && params.SelMatch(sc.LayerSel, pt.Recv.Params)
+}
+
+////////  Network
+
+// AddLRateSchedule adds a new learning rate schedule of given type
+// to [Network.LRateScheds], applying to the pathways selected by
+// given params selector, using default parameters that can then be
+// modified on the returned schedule.
+func (nt *Network) AddLRateSchedule(name string, typ LRateSchedTypes, sel string) *LRateSchedule {
+	sc := &LRateSchedule{}
+	sc.Defaults()
+	sc.On = true
+	sc.Name = name
+	sc.Type = typ
+	sc.Sel = sel
+	nt.LRateScheds = append(nt.LRateScheds, sc)
+	return sc
+}
+
+// LRateSchedByName returns the learning rate schedule of given name,
+// or nil if not found.
+func (nt *Network) LRateSchedByName(name string) *LRateSchedule {
+	for _, sc := range nt.LRateScheds {
+		if sc.Name == name {
+			return sc
+		}
+	}
+	return nil
+}
+
+// LRateSchedsInit initializes the state of all learning rate schedules,
+// at the start of a run.
+func (nt *Network) LRateSchedsInit() {
+	for _, sc := range nt.LRateScheds {
+		sc.Init()
+	}
+}
+
+// LRateSchedsUpdate computes the values of the learning rate schedules
+// for given epoch, and trial count from the start of the run
+// (for PerTrial schedules), and applies them to the selected pathways.
+// Returns true if any pathway learning rates changed, in which case
+// ToGPUParams() must be called.
+func (nt *Network) LRateSchedsUpdate(epoch, trial int) bool {
+	if len(nt.LRateScheds) == 0 {
+		return false
+	}
+	for _, sc := range nt.LRateScheds {
+		step := epoch
+		if sc.PerTrial && sc.Type != LRatePlateau {
+			step = trial
+		}
+		sc.Value = sc.Compute(step)
+	}
+	changed := false
+	for _, pt := range nt.Paths {
+		sched := float32(1)
+		sel := false
+		for _, sc := range nt.LRateScheds {
+			if sc.Applies(pt) {
+				sched *= sc.Value
+				sel = true
+			}
+		}
+		if !sel || pt.Params.Learn.LRate.Sched == sched {
+			continue
+		}
+		pt.LRateSched(sched)
+		changed = true
+	}
+	return changed
+}
+
+// AllLRateScheds returns a listing of all the learning rate schedules,
+// and the pathways that each one applies to.
+func (nt *Network) AllLRateScheds() string {
+	var b strings.Builder
+	for _, sc := range nt.LRateScheds {
+		b.WriteString(sc.String() + "\n")
+		for _, pt := range nt.Paths {
+			if sc.Applies(pt) {
+				b.WriteString("\t" + pt.Name + "\n")
+			}
+		}
+	}
+	return b.String()
+}
+
+// SaveAllLRateScheds saves a listing of all the learning rate schedules
+// to given file.
+func (nt *Network) SaveAllLRateScheds(filename core.Filename) error {
+	str := nt.AllLRateScheds()
+	err := os.WriteFile(string(filename), []byte(str), 0666)
+	return errors.Log(err)
+}
+
+// lrateSchedState is the number of state values per schedule
+// saved in a checkpoint: Value, plateau, best, and wait.
+const lrateSchedState = 4
+
+// writeLRateScheds writes the state of the learning rate schedules,
+// for [Network.SaveCheckpoint].
+func (nt *Network) writeLRateScheds(w io.Writer) error {
+	sizes := []int{len(nt.LRateScheds), lrateSchedState}
+	if err := writeCheckpointHeader(w, "LRateScheds", sizes); err != nil {
+		return err
+	}
+	st := make([]float64, 0, sizes[0]*sizes[1])
+	for _, sc := range nt.LRateScheds {
+		st = append(st, float64(sc.Value), float64(sc.plateau), sc.best, float64(sc.wait))
+	}
+	return binary.Write(w, binary.LittleEndian, st)
+}
+
+// readLRateScheds reads the state of the learning rate schedules,
+// for [Network.LoadCheckpoint], returning a function that sets it.
+// The same schedules must be configured as when the state was saved.
+func (nt *Network) readLRateScheds(r io.Reader) (func(), error) {
+	sizes := []int{len(nt.LRateScheds), lrateSchedState}
+	if err := readCheckpointHeader(r, "LRateScheds", sizes); err != nil {
+		return nil, err
+	}
+	st := make([]float64, sizes[0]*sizes[1])
+	if err := binary.Read(r, binary.LittleEndian, st); err != nil {
+		return nil, err
+	}
+	set := func() {
+		for i, sc := range nt.LRateScheds {
+			s := st[i*lrateSchedState:]
+			sc.Value = float32(s[0])
+			sc.plateau = float32(s[1])
+			sc.best = s[2]
+			sc.wait = int(s[3])
+		}
+	}
+	return set, nil
+}
+
+// LooperLRateSched adds functions to the given training mode loops to update the
+// learning rate schedules in [Network.LRateScheds] at the start of each epoch,
+// and of each trial for PerTrial schedules. The schedules are initialized at
+// the start of the first epoch of each run, and the LRatePlateau schedules
+// are updated at the end of each epoch with the final value of their Stat
+// at the epoch level of the training mode in statsDir.
+// The schedules must be configured prior to calling this function, and it
+// must be called after the function that records the epoch stats is added,
+// so that the current epoch's Stat is used. The schedule state is saved by
+// [Network.SaveCheckpoint], so [LooperAddCheckpoint] must be called after
+// this function for the checkpoint to include the last plateau update.
+func LooperLRateSched(ls *looper.Stacks, net *Network, statsDir *tensorfs.Node, trainMode, epochLevel, trialLevel enums.Enum) {
+	if len(net.LRateScheds) == 0 {
+		return
+	}
+	epochLoop := ls.Loop(trainMode, epochLevel)
+	trialLoop := ls.Loop(trainMode, trialLevel)
+	trialStep := func() int {
+		return epochLoop.Counter.Cur*trialLoop.Counter.Max + trialLoop.Counter.Cur
+	}
+	update := func() {
+		if net.LRateSchedsUpdate(epochLoop.Counter.Cur, trialStep()) {
+			ToGPUParams()
+		}
+	}
+	epochLoop.OnStart.Add("LRateSched", func() {
+		if epochLoop.Counter.Cur == 0 {
+			net.LRateSchedsInit()
+		}
+		update()
+	})
+	epochLoop.OnEnd.Add("LRateSched", func() {
+		levelDir := StatsNode(statsDir, trainMode, epochLevel)
+		for _, sc := range net.LRateScheds {
+			if sc.Type != LRatePlateau {
+				continue
+			}
+			if st := levelDir.Node(sc.Stat); st != nil && st.Tensor.DimSize(0) > 0 {
+				sc.PlateauUpdate(st.Tensor.Float1D(-1))
+			}
+		}
+	})
+	perTrial := false
+	for _, sc := range net.LRateScheds {
+		if sc.PerTrial && sc.Type != LRatePlateau {
+			perTrial = true
+		}
+	}
+	if perTrial {
+		trialLoop.OnStart.Add("LRateSched", update)
+	}
+}
+
+// StatLRateSched returns a Stats function that records the current value of
+// each learning rate schedule in [Network.LRateScheds], as LRate_<Name>,
+// at the given epoch level of the training mode.
+func StatLRateSched(statsDir *tensorfs.Node, net *Network, trainMode, epochLevel enums.Enum) func(mode, level enums.Enum, start bool) {
+	return func(mode, level enums.Enum, start bool) {
+		if mode.Int64() != trainMode.Int64() || level.Int64() != epochLevel.Int64() {
+			return
+		}
+		levelDir := StatsNode(statsDir, mode, level)
+		for _, sc := range net.LRateScheds {
+			tsr := levelDir.Float64("LRate_" + sc.Name)
+			if start {
+				tsr.SetNumRows(0)
+				plot.SetFirstStyler(tsr, func(s *plot.Style) {
+					s.Range.SetMin(0)
+				})
+				metadata.SetDoc(tsr, "Learning rate schedule multiplier: "+sc.String())
+				continue
+			}
+			tsr.AppendRowFloat(float64(sc.Value))
+		}
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package axon
+
+import (
+	"bytes"
+	"testing"
+
+	"cogentcore.org/core/math32"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLRateScheduleCompute(t *testing.T) {
+	sc := &LRateSchedule{}
+	sc.Defaults()
+	sc.Interval = 10
+
+	sc.Type = LRateStep
+	assert.Equal(t, float32(1), sc.Compute(0))
+	assert.Equal(t, float32(1), sc.Compute(9))
+	assert.Equal(t, float32(0.5), sc.Compute(10))
+	assert.Equal(t, float32(0.25), sc.Compute(25))
+	assert.Equal(t, sc.Min, sc.Compute(1000))
+
+	sc.Type = LRateExp
+	assert.InDelta(t, math32.Sqrt(0.5), sc.Compute(5), 1.0e-6)
+	assert.InDelta(t, 0.5, sc.Compute(10), 1.0e-6)
+
+	sc.Type = LRateCosine
+	assert.InDelta(t, 1, sc.Compute(0), 1.0e-6)
+	assert.InDelta(t, 0.5*(1+sc.Min), sc.Compute(5), 1.0e-6)
+	assert.InDelta(t, sc.Min, sc.Compute(10), 1.0e-6)
+	assert.InDelta(t, sc.Min, sc.Compute(20), 1.0e-6)
+
+	sc.Type = LRateWarmup
+	sc.Warmup = 4
+	assert.InDelta(t, sc.WarmupStart, sc.Compute(0), 1.0e-6)
+	assert.InDelta(t, 0.5*(1+sc.WarmupStart), sc.Compute(2), 1.0e-6)
+	assert.Equal(t, float32(1), sc.Compute(4))
+	assert.Equal(t, float32(1), sc.Compute(100))
+
+	// decay starts after warmup
+	sc.Type = LRateStep
+	assert.Equal(t, float32(1), sc.Compute(13))
+	assert.Equal(t, float32(0.5), sc.Compute(14))
+}
+
+func TestLRateSchedulePlateau(t *testing.T) {
+	sc := &LRateSchedule{}
+	sc.Defaults()
+	sc.Type = LRatePlateau
+	sc.Patience = 3
+	assert.False(t, sc.PlateauUpdate(1))
+	assert.False(t, sc.PlateauUpdate(0.5))
+	assert.False(t, sc.PlateauUpdate(0.495)) // below threshold
+	assert.False(t, sc.PlateauUpdate(0.5))
+	assert.True(t, sc.PlateauUpdate(0.5))
+	assert.Equal(t, float32(0.5), sc.Compute(0))
+	assert.False(t, sc.PlateauUpdate(0.3))
+	assert.Equal(t, float32(0.5), sc.Compute(0))
+	sc.Init()
+	assert.Equal(t, float32(1), sc.Compute(0))
+}
+
+func TestLRateSchedsUpdate(t *testing.T) {
+	net := newTestNet(1)
+	all := net.AddLRateSchedule("All", LRateStep, "Path")
+	all.Interval = 2
+	back := net.AddLRateSchedule("Back", LRateStep, ".BackPath")
+	back.Interval = 1
+	out := net.AddLRateSchedule("Out", LRateWarmup, "Path")
+	out.LayerSel = "#Output"
+	out.Warmup = 10
+	out.WarmupStart = 0.5
+
+	hidIn := net.LayerByName("Hidden").RecvPaths[0]
+	hidBack := net.LayerByName("Hidden").RecvPaths[1]
+	outHid := net.LayerByName("Output").RecvPaths[0]
+	assert.Equal(t, "BackPath", hidBack.Params.Type.String())
+
+	assert.True(t, net.LRateSchedsUpdate(2, 0))
+	assert.Equal(t, float32(0.5), hidIn.Params.Learn.LRate.Sched)
+	assert.Equal(t, float32(0.125), hidBack.Params.Learn.LRate.Sched)
+	assert.InDelta(t, 0.5*0.6, outHid.Params.Learn.LRate.Sched, 1.0e-6)
+	assert.InDelta(t, 0.5*hidIn.Params.Learn.LRate.Base, hidIn.Params.Learn.LRate.Eff, 1.0e-6)
+	assert.False(t, net.LRateSchedsUpdate(2, 0))
+
+	back.On = false
+	assert.True(t, net.LRateSchedsUpdate(2, 0))
+	assert.Equal(t, float32(0.5), hidBack.Params.Learn.LRate.Sched)
+	assert.Contains(t, net.AllLRateScheds(), "HiddenToOutput")
+	assert.Equal(t, out, net.LRateSchedByName("Out"))
+}
+
+func TestLRateSchedCheckpoint(t *testing.T) {
+	newNet := func() (*Network, *LRateSchedule) {
+		net := newTestNet(1)
+		sc := net.AddLRateSchedule("Plateau", LRatePlateau, "Path")
+		sc.Patience = 2
+		return net, sc
+	}
+	net, sc := newNet()
+	stats := []float64{1, 0.5, 0.5, 0.5, 0.5, 0.2, 0.3, 0.3}
+	for _, st := range stats[:5] {
+		sc.PlateauUpdate(st)
+	}
+	net.LRateSchedsUpdate(5, 0)
+	var b bytes.Buffer
+	assert.NoError(t, net.SaveCheckpoint(&b))
+
+	resNet, resSc := newNet()
+	assert.NoError(t, resNet.LoadCheckpoint(bytes.NewReader(b.Bytes())))
+	assert.Equal(t, sc.Value, resSc.Value)
+	for _, st := range stats[5:] {
+		assert.Equal(t, sc.PlateauUpdate(st), resSc.PlateauUpdate(st))
+		assert.Equal(t, sc.Compute(0), resSc.Compute(0))
+	}
+	assert.Equal(t, float32(0.25), resSc.Compute(0))
+
+	otherNet := newTestNet(1)
+	assert.Error(t, otherNet.LoadCheckpoint(bytes.NewReader(b.Bytes())))
+}
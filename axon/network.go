@@ -84,6 +84,13 @@ type NetworkIndexes struct {
 
 	// RubiconNNegUSs is the total number of .Rubicon Negative USs.
 	RubiconNNegUSs uint32 `edit:"-"`
+
+	// NSTDPSyns is the total number of synapses in pathways using
+	// STDP learning, set in [Network.BuildSTDP]. If 0, spike times
+	// are not recorded.
+	NSTDPSyns uint32 `edit:"-"`
+
+	pad, pad1, pad2 uint32
 }
 
 //gosl:end
@@ -120,6 +127,37 @@ type Network struct {
 	// NThreads is number of threads to use for parallel processing.
 	NThreads int
 
+	// WeightsFileExt is the file extension used by [WeightsFilename],
+	// which determines the format used by [SaveWeights]: the default
+	// (empty) is ".wts.gz" for gzipped JSON, and [WeightsBinaryExt] + ".gz"
+	// is the compact binary format.
+	WeightsFileExt string
+
+	// SpikeSend has the parameters and state for event-driven sparse
+	// sending of spikes on the CPU.
+	SpikeSend SpikeSend
+
+	// Trace records a timeline of the looper levels, function timers
+	// and CPU kernels, for export as a Chrome trace or pprof profile.
+	Trace Tracer `display:"-"`
+
+	// ConsolTasks is the number of task boundaries marked by
+	// [Network.ConsolSnapshot] since InitWeights, for weight consolidation.
+	ConsolTasks int `edit:"-"`
+
+	// LRateScheds are learning rate schedules that set the LRate.Sched
+	// multiplier of selected pathways over the course of training.
+	// See [LRateSchedule] and [LooperLRateSched].
+	LRateScheds []*LRateSchedule
+
+	// specBuilders are the builder methods called to make the network,
+	// for ExportSpec.
+	specBuilders []*specBuilder
+
+	// specBuilding is set while a builder method is being recorded
+	// in specBuilders.
+	specBuilding bool
+
 	// todo: following is basically obsolete:
 
 	// record function timer information.
@@ -220,6 +258,12 @@ type Network struct {
 	// [NExts][Data]; NExts = [In / Out Layers][Neurons]
 	Exts tensor.Float32 `display:"-"`
 
+	// Dendrites are the [DendVars] state values for the additional dendritic
+	// compartments beyond the first VmDend compartment, for layers with
+	// [Layer.DendComps] > 1. Use [LayerParams.DendIndex] to access.
+	// [NDendComps][Data][DendVarsN]; NDendComps = [Layer][Neurons][DendComps-1]
+	Dendrites tensor.Float32 `display:"-"`
+
 	//////// Synapse State
 
 	// PathGBuf is the conductance buffer for accumulating spikes.
@@ -234,6 +278,26 @@ type Network struct {
 	// [NPathNeur][Data]
 	PathGSyns tensor.Float32 `display:"-"`
 
+	// PathSTP has the short-term plasticity state for each sending neuron
+	// in each pathway, with variables defined in [STPVars].
+	// [NSendCon][Data][STPVarsN]; NSendCon = [Layer][SendPaths][SendNeurons]
+	PathSTP tensor.Float32 `display:"-"`
+
+	// STDPSpikes records the spike times of each neuron within the
+	// theta cycle, for STDP learning. Only allocated if used.
+	// [NNeurons][Data][STDPMaxSpikes+1]
+	STDPSpikes tensor.Float32 `display:"-"`
+
+	// SynapseSTDP has the per-synapse STDP trace values for pathways
+	// using STDP learning. Only allocated if used.
+	// [NSTDPSyns][Data][STDPVarsN]
+	SynapseSTDP tensor.Float32 `display:"-"`
+
+	// SynapseConsol has the per-synapse weight consolidation state
+	// for pathways using it. Only allocated if used.
+	// [NConsolSyns][ConsolVarsN]
+	SynapseConsol tensor.Float32 `display:"-"`
+
 	//	Synapses are the synapse level variables (weights etc).
 	//
 	// These do not depend on the data parallel index, unlike [SynapseTraces].
@@ -280,6 +344,8 @@ func (nt *Network) Init() {
 	nt.Ctx = make([]Context, 1)
 	nt.Context().Defaults()
 	nt.NetIxs().MaxData = 1
+	nt.SpikeSend.Defaults()
+	nt.Trace.Defaults()
 	NetworkIxs = nt.NetworkIxs // may reference things before build
 }
 
@@ -476,7 +542,7 @@ func (nt *Network) AllPathScales() string {
 // to either `params_good` if good = true (for current good reference params)
 // or `params_2006_01_02` (year, month, day) datestamp,
 // providing a snapshot of the simulation params for easy diffs and later reference.
-// Also saves current Config state.
+// Also saves current Config state, and the learning rate schedules, if any.
 func (nt *Network) SaveParamsSnapshot(cfg any, good bool) error {
 	date := time.Now().Format("2006_01_02")
 	if good {
@@ -493,6 +559,9 @@ func (nt *Network) SaveParamsSnapshot(cfg any, good bool) error {
 	nt.SaveParams(emer.NonDefault, core.Filename(filepath.Join(dir, "params_nondef.txt")))
 	nt.SaveAllLayerInhibs(core.Filename(filepath.Join(dir, "params_layers.txt")))
 	nt.SaveAllPathScales(core.Filename(filepath.Join(dir, "params_paths.txt")))
+	if len(nt.LRateScheds) > 0 {
+		nt.SaveAllLRateScheds(core.Filename(filepath.Join(dir, "params_lrate_scheds.txt")))
+	}
 	return nil
 }
 
@@ -727,6 +796,7 @@ func (nt *Network) Build() error { //types:add
 	}
 	nt.Rubicon.Update()
 	nt.FunTimes = make(map[string]*timer.Time)
+	CPUPool.ResetStats()
 	maxData := int(nix.MaxData)
 	var errs []error
 	totNeurons := 0
@@ -779,6 +849,7 @@ func (nt *Network) Build() error { //types:add
 	rpathIndex := 0
 	poolIndex := 0
 	extIndex := 0
+	dendIndex := 0
 	for li, ly := range nt.Layers {
 		ly.Params = &nt.LayerParams[li]
 		ly.Params.Type = ly.Type
@@ -798,6 +869,9 @@ func (nt *Network) Build() error { //types:add
 		ly.Params.Indexes.NPools = uint32(np)
 		ly.Params.Indexes.NeurSt = uint32(neurIndex)
 		ly.Params.Indexes.NNeurons = uint32(nn)
+		ly.Params.Indexes.DendSt = uint32(dendIndex)
+		ly.Params.Indexes.DendN = uint32(max(ly.DendComps-1, 0))
+		dendIndex += nn * int(ly.Params.Indexes.DendN)
 		if shp.NumDims() == 2 {
 			ly.Params.Indexes.ShpUnY = int32(shp.DimSize(0))
 			ly.Params.Indexes.ShpUnX = int32(shp.DimSize(1))
@@ -855,9 +929,14 @@ func (nt *Network) Build() error { //types:add
 	nt.SynapseTraces.SetShapeSizes(totSynapses, maxData, int(SynapseTraceVarsN))
 	nt.SynapseIxs.SetShapeSizes(totSynapses, int(SynapseIndexVarsN))
 	nt.PathSendCon.SetShapeSizes(totSendCon, 2)
+	nt.PathSTP.SetShapeSizes(totSendCon, maxData, int(STPVarsN))
 	nt.PathRecvCon.SetShapeSizes(totRecvCon, 2)
 	nt.RecvPathIxs.SetShapeSizes(rpathIndex)
 	nt.RecvSynIxs.SetShapeSizes(totSynapses)
+	nt.Dendrites.SetShapeSizes(max(dendIndex, 1), maxData, int(DendVarsN)) // avoid empty GPU buffer
+	nt.STDPSpikes.SetShapeSizes(1, 1, 1)                                   // allocated in BuildSTDP
+	nt.SynapseSTDP.SetShapeSizes(1, 1, int(STDPVarsN))
+	nt.SynapseConsol.SetShapeSizes(1, int(ConsolVarsN)) // allocated in BuildConsol
 
 	// distribute synapses, send
 	syIndex := 0
@@ -879,20 +958,9 @@ func (nt *Network) Build() error { //types:add
 			pt.SynStIndex = uint32(syIndex)
 			pt.Params.Index = uint32(ptidx)
 			pt.NSyns = uint32(nsyn)
-			for sni := range ly.NNeurons {
-				si := ly.NeurStIndex + sni
-				scon := pt.SendCon[sni]
-				nt.PathSendCon.Set(scon.Start, int(sendConIndex), int(StartOff))
-				nt.PathSendCon.Set(scon.N, int(sendConIndex), int(Nitems))
-				sendConIndex++
-				for syi := scon.Start; syi < scon.Start+scon.N; syi++ {
-					syni := pt.SynStIndex + syi
-					nt.SynapseIxs.Set(uint32(si), int(syni), int(SynSendIndex)) // network-global idx
-					nt.SynapseIxs.Set(pt.SendConIndex[syi]+uint32(rlay.NeurStIndex), int(syni), int(SynRecvIndex))
-					nt.SynapseIxs.Set(uint32(ptidx), int(syni), int(SynPathIndex))
-					syIndex++
-				}
-			}
+			pt.SetSynapseIxs()
+			sendConIndex += int(ly.NNeurons)
+			syIndex += nsyn
 			ptidx++
 		}
 	}
@@ -910,21 +978,9 @@ func (nt *Network) Build() error { //types:add
 			nt.RecvPathIxs.Set(pt.Params.Index, rpathIndex)
 			pt.Params.Indexes.RecvConSt = uint32(recvConIndex)
 			pt.Params.Indexes.RecvSynSt = uint32(syIndex)
-			synSt := pt.Params.Indexes.SynapseSt
-			for rni := range ly.NNeurons {
-				if len(pt.RecvCon) <= int(rni) {
-					continue
-				}
-				rcon := pt.RecvCon[rni]
-				nt.PathRecvCon.Set(rcon.Start, int(recvConIndex), int(StartOff))
-				nt.PathRecvCon.Set(rcon.N, int(recvConIndex), int(Nitems))
-				recvConIndex++
-				syIndexes := pt.RecvSynIxs(rni)
-				for _, ssi := range syIndexes {
-					nt.RecvSynIxs.Set(ssi+synSt, syIndex)
-					syIndex++
-				}
-			}
+			pt.SetRecvSynIxs()
+			recvConIndex += min(len(pt.RecvCon), int(ly.NNeurons))
+			syIndex += len(pt.RecvSynIndex)
 			rpathIndex++
 		}
 	}
@@ -984,7 +1040,7 @@ func ToGPUNeurons() {
 
 // ToGPULayersNeurons copies all the layer-level and neuron state to the GPU.
 func ToGPULayersNeurons() {
-	ToGPU(CtxVar, GlobalScalarsVar, GlobalVectorsVar, LayerStatesVar, PoolsVar, PoolsIntVar, NeuronsVar, NeuronAvgsVar)
+	ToGPU(CtxVar, GlobalScalarsVar, GlobalVectorsVar, LayerStatesVar, PoolsVar, PoolsIntVar, DendritesVar, NeuronsVar, NeuronAvgsVar)
 }
 
 // ToGPUSynapses copies the Synapse state to the GPU.
@@ -992,6 +1048,14 @@ func ToGPUSynapses() {
 	ToGPU(SynapsesVar)
 }
 
+// ToGPUSynapsesIndexes copies the Synapse and SynapseTraces state and all
+// the indexes to the GPU, which is needed after changes in connectivity
+// from structural plasticity (see [Network.StructPlast]).
+func ToGPUSynapsesIndexes() {
+	ToGPUIndexes()
+	ToGPU(SynapsesVar, SynapseTracesVar, SynapseSTDPVar, SynapseConsolVar)
+}
+
 // ToGPULayersSynapses copies the Layers and Synapse state to the GPU.
 func ToGPULayersSynapses() {
 	ToGPULayers()
@@ -1005,8 +1069,9 @@ func ToGPUAll() {
 	ToGPUParams()
 	ToGPULayersNeurons()
 	ToGPUSynapses()
-	ToGPU(SynapseTracesVar)          // only time we call this
-	ToGPU(PathGBufVar, PathGSynsVar) // and this
+	ToGPU(SynapseTracesVar)                      // only time we call this
+	ToGPU(PathGBufVar, PathGSynsVar, PathSTPVar) // and this
+	ToGPU(STDPSpikesVar, SynapseSTDPVar, SynapseConsolVar)
 }
 
 // note: RunDone can only be run once, so all vars need to be present in the one call.
@@ -1024,7 +1089,7 @@ func RunDoneLayers() {
 // RunDoneLayersNeurons finishes running and copies all the layer-level
 // and neuron state from the GPU, including context and globals.
 func RunDoneLayersNeurons() {
-	RunDone(CtxVar, GlobalScalarsVar, GlobalVectorsVar, LayerStatesVar, PoolsVar, PoolsIntVar, NeuronsVar, NeuronAvgsVar)
+	RunDone(CtxVar, GlobalScalarsVar, GlobalVectorsVar, LayerStatesVar, PoolsVar, PoolsIntVar, DendritesVar, NeuronsVar, NeuronAvgsVar)
 }
 
 // RunDoneSynapses finishes running and copies the Synapse state back.
@@ -1044,6 +1109,13 @@ func RunDoneSynapsesTrace() {
 	RunDone(SynapsesVar, SynapseTracesVar)
 }
 
+// RunDoneAll finishes running and copies all of the dynamic state back
+// from the GPU, including Exts, SynapseTraces, the PathGBuf, PathGSyns
+// spike buffers, PathSTP, STDP and consolidation state. This is needed for saving a full checkpoint.
+func RunDoneAll() {
+	RunDone(CtxVar, GlobalScalarsVar, GlobalVectorsVar, LayerStatesVar, PoolsVar, PoolsIntVar, DendritesVar, NeuronsVar, NeuronAvgsVar, ExtsVar, SynapsesVar, SynapseTracesVar, PathGBufVar, PathGSynsVar, PathSTPVar, STDPSpikesVar, SynapseSTDPVar, SynapseConsolVar)
+}
+
 // BuildPathGBuf builds the PathGBuf, PathGSyns,
 // based on the MaxDelay values in the PathParams,
 // which should have been configured by this point.
@@ -1077,6 +1149,32 @@ func (nt *Network) BuildPathGBuf() {
 	}
 }
 
+// BuildSTDP allocates the [STDPSpikes] and [SynapseSTDP] state for
+// pathways using an [STDPParams] learning rule, which must be set
+// prior to calling. It is called in InitWeights, and only allocates
+// minimal placeholders if no pathways use STDP.
+func (nt *Network) BuildSTDP() {
+	nix := nt.NetIxs()
+	maxData := int(nix.MaxData)
+	nstdp := uint32(0)
+	for _, pt := range nt.Paths {
+		pt.Params.Indexes.STDPSt = nstdp
+		if pt.Params.Learn.STDP.On() {
+			nstdp += pt.NSyns
+		}
+	}
+	nix.NSTDPSyns = nstdp
+	if nstdp == 0 {
+		nt.STDPSpikes.SetShapeSizes(1, 1, 1)
+		nt.SynapseSTDP.SetShapeSizes(1, 1, int(STDPVarsN))
+	} else {
+		nt.STDPSpikes.SetShapeSizes(int(nix.NNeurons), maxData, STDPMaxSpikes+1)
+		nt.SynapseSTDP.SetShapeSizes(int(nstdp), maxData, int(STDPVarsN))
+	}
+	nt.STDPSpikes.SetZeros()
+	nt.SynapseSTDP.SetZeros()
+}
+
 // SetAsCurrent sets this network's values as the current global variables,
 // that are then processed in the code.
 func (nt *Network) SetAsCurrent() {
@@ -1100,11 +1198,17 @@ func (nt *Network) SetAsCurrent() {
 	Exts = &nt.Exts
 	Pools = &nt.Pools
 	PoolsInt = &nt.PoolsInt
+	Dendrites = &nt.Dendrites
 	PathGBuf = &nt.PathGBuf
 	PathGSyns = &nt.PathGSyns
+	PathSTP = &nt.PathSTP
+	STDPSpikes = &nt.STDPSpikes
+	SynapseSTDP = &nt.SynapseSTDP
+	SynapseConsol = &nt.SynapseConsol
 	Synapses = &nt.Synapses
 	SynapseTraces = &nt.SynapseTraces
 	gpu.NumThreads = nt.NThreads
+	CPUPool.SetNWorkers(nt.NThreads)
 }
 
 // DeleteAll deletes all layers, prepares network for re-configuring and building
@@ -1125,7 +1229,8 @@ func (nt *Network) WriteWeightsJSON(w io.Writer) error {
 
 func (nt *Network) ReadWeightsJSON(r io.Reader) error {
 	err := nt.NetworkBase.ReadWeightsJSON(r)
-	ToGPULayersSynapses()
+	ToGPULayers()
+	ToGPUSynapsesIndexes() // connectivity can change, per Path.SetWeights
 	RunGPUSync()
 	RunDone()
 	return err
@@ -6,8 +6,11 @@ package axon
 
 import (
 	"bytes"
+	"path/filepath"
 	"testing"
 
+	"cogentcore.org/core/core"
+	"cogentcore.org/core/math32"
 	"github.com/emer/emergent/v2/paths"
 	"github.com/stretchr/testify/assert"
 )
@@ -241,3 +244,78 @@ func TestSaveWeights(t *testing.T) {
 		t.Error(err.Error())
 	}
 }
+
+func TestSaveWeightsBinary(t *testing.T) {
+	var b bytes.Buffer
+	testNet := newTestNetFull(1)
+	runTestTrials(testNet, 0, 2)
+	assert.NoError(t, testNet.WriteWeightsBinary(&b, false))
+
+	loadNet := newTestNetFull(1)
+	assert.NoError(t, loadNet.ReadWeightsBinary(bytes.NewReader(b.Bytes())))
+	assert.Equal(t, testNet.WeightsHash(), loadNet.WeightsHash())
+
+	// corrupted or truncated data must fail the checksum,
+	// without changing the network
+	bad := bytes.Clone(b.Bytes())
+	bad[len(bad)/2] ^= 0xff
+	badNet := newTestNetFull(1)
+	badHash := badNet.WeightsHash()
+	assert.Error(t, badNet.ReadWeightsBinary(bytes.NewReader(bad)))
+	assert.Equal(t, badHash, badNet.WeightsHash())
+	assert.Error(t, badNet.ReadWeightsBinary(bytes.NewReader(b.Bytes()[:b.Len()-2])))
+	assert.Equal(t, badHash, badNet.WeightsHash())
+
+	// lengths in the file are limited by the network size
+	var big bytes.Buffer
+	ww := newWeightsWriter(&big)
+	ww.string(weightsBinaryMagic)
+	ww.uint32s(WeightsBinaryVersion)
+	ww.string(testNet.Name)
+	ww.uint32s(0, 1) // no MetaData, 1 layer
+	ww.string("Input")
+	ww.uint32s(1 << 30) // shape length
+	assert.NoError(t, ww.finish())
+	assert.ErrorContains(t, loadNet.ReadWeightsBinary(&big), "exceeds maximum")
+
+	var h bytes.Buffer
+	assert.NoError(t, testNet.WriteWeightsBinary(&h, true))
+	assert.Less(t, h.Len(), b.Len())
+	halfNet := newTestNetFull(1)
+	assert.NoError(t, halfNet.ReadWeightsBinary(&h))
+	var wts, hwts []float32
+	testNet.SynsSlice(&wts, Wt)
+	halfNet.SynsSlice(&hwts, Wt)
+	assert.InDeltaSlice(t, wts, hwts, 1.0e-3)
+}
+
+func TestConvertWeights(t *testing.T) {
+	dir := t.TempDir()
+	testNet := newTestNet(1)
+	jfn := core.Filename(filepath.Join(dir, "test.wts.gz"))
+	bfn := core.Filename(filepath.Join(dir, "test"+WeightsBinaryExt))
+	cfn := core.Filename(filepath.Join(dir, "conv.wts"))
+	assert.NoError(t, testNet.SaveWeights(jfn))
+	assert.NoError(t, ConvertWeightsFile(jfn, bfn, false))
+
+	// JSON has limited precision, so compare against weights loaded from it
+	jsonNet := newTestNet(1)
+	assert.NoError(t, jsonNet.OpenWeights(jfn))
+	loadNet := newTestNet(1)
+	assert.NoError(t, loadNet.OpenWeights(bfn))
+	assert.Equal(t, jsonNet.WeightsHash(), loadNet.WeightsHash())
+
+	assert.NoError(t, ConvertWeightsFile(bfn, cfn, false))
+	convNet := newTestNet(1)
+	assert.NoError(t, convNet.OpenWeights(cfn))
+	assert.Equal(t, jsonNet.WeightsHash(), convNet.WeightsHash())
+}
+
+func TestFloat16(t *testing.T) {
+	for _, v := range []float32{0, 1, -1, 0.5, 0.1, 0.333, 65504, 6.1035156e-05, 5.9604645e-08} {
+		h := float16ToFloat32(float32ToFloat16(v))
+		assert.InDelta(t, v, h, float64(1.0e-3*math32.Abs(v)))
+	}
+	assert.Equal(t, uint16(0x3c00), float32ToFloat16(1))
+	assert.Equal(t, uint16(0x7c00), float32ToFloat16(1.0e6))
+}
@@ -100,6 +100,26 @@ type NeuroModParams struct {
 	// should be small for acq, but roughly equal to burst for ext.
 	DipGain float32 `min:"0" default:"1"`
 
+	// NEGain is the norepinephrine (NE) modulation of excitatory conductance
+	// gain, reflecting the adaptive gain effects of locus coeruleus (LC)
+	// activity: Ge *= 1 + (NEGain * NE). NE is computed by the LCLayer.
+	NEGain float32 `min:"0"`
+
+	// NELRateMod is the proportion of maximum learning rate that NE can modulate.
+	// e.g., if 0.2, then NE = 0 = 80% of std learning rate, 1 = 100%.
+	NELRateMod float32 `min:"0" max:"1"`
+
+	// SerLRateMod is the proportion of maximum learning rate that serotonin
+	// (Ser, 5-HT) can modulate. e.g., if 0.2, then Ser = 0 = 80% of std
+	// learning rate, 1 = 100%. Ser is computed by the DRNLayer.
+	SerLRateMod float32 `min:"0" max:"1"`
+
+	// NENoise is the extent to which NE increases the background noise
+	// in excitatory conductance (GeNoise), driving more exploratory behavior
+	// with increasing NE: Ge += (NENoise * NE) * GeNoise.
+	// Requires Acts.Noise to be On.
+	NENoise float32 `min:"0"`
+
 	pad, pad1, pad2 float32
 }
 
@@ -110,11 +130,17 @@ func (nm *NeuroModParams) Defaults() {
 	nm.AChLRateMod = 0
 	nm.BurstGain = 1
 	nm.DipGain = 1
+	nm.NEGain = 0
+	nm.NELRateMod = 0
+	nm.SerLRateMod = 0
+	nm.NENoise = 0
 }
 
 func (nm *NeuroModParams) Update() {
 	nm.DALRateMod = math32.Clamp(nm.DALRateMod, 0, 1)
 	nm.AChLRateMod = math32.Clamp(nm.AChLRateMod, 0, 1)
+	nm.NELRateMod = math32.Clamp(nm.NELRateMod, 0, 1)
+	nm.SerLRateMod = math32.Clamp(nm.SerLRateMod, 0, 1)
 }
 
 func (nm *NeuroModParams) ShouldDisplay(field string) bool {
@@ -173,6 +199,12 @@ func (nm *NeuroModParams) LRMod(da, ach float32) float32 {
 	return lmod
 }
 
+// NESerLRMod returns the learning rate modulation factor due to
+// norepinephrine (NE) and serotonin (Ser), which multiplies LRMod.
+func (nm *NeuroModParams) NESerLRMod(ne, ser float32) float32 {
+	return nm.LRModFact(nm.NELRateMod, ne) * nm.LRModFact(nm.SerLRateMod, ser)
+}
+
 // GGain returns effective Ge and Gi gain factor given
 // total dopamine (DA) value: tonic + phasic.
 // factor is 1 for no modulation, otherwise higher or lower.
@@ -200,6 +232,18 @@ func (nm *NeuroModParams) GGain(da float32) float32 {
 	return gain
 }
 
+// NEGeGain returns the Ge gain factor given norepinephrine (NE) value.
+// factor is 1 for no modulation, otherwise higher.
+func (nm *NeuroModParams) NEGeGain(ne float32) float32 {
+	return 1 + nm.NEGain*ne
+}
+
+// NENoiseGain returns the additional multiplier on GeNoise given
+// norepinephrine (NE) value, which is 0 for no modulation.
+func (nm *NeuroModParams) NENoiseGain(ne float32) float32 {
+	return nm.NENoise * ne
+}
+
 // GIFromACh returns amount of extra inhibition to add based on disinhibitory
 // effects of ACh -- no inhibition when ACh = 1, extra when < 1.
 func (nm *NeuroModParams) GiFromACh(ach float32) float32 {
@@ -0,0 +1,175 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package axon
+
+import (
+	"testing"
+
+	"github.com/emer/emergent/v2/etime"
+	"github.com/emer/emergent/v2/paths"
+	"github.com/emer/emergent/v2/relpos"
+	"github.com/stretchr/testify/assert"
+)
+
+// newLCDRNTestNet returns the standard test network with added
+// LC and DRN layers.
+func newLCDRNTestNet() *Network {
+	testNet := NewNetwork("testNetLCDRN")
+	testNet.SetRandSeed(42)
+	testNet.SetMaxData(1)
+
+	inLay := testNet.AddLayer("Input", InputLayer, 4, 1)
+	hidLay := testNet.AddLayer("Hidden", SuperLayer, 4, 1)
+	outLay := testNet.AddLayer("Output", TargetLayer, 4, 1)
+	testNet.AddLCDRNLayers(relpos.Behind, 2)
+
+	testNet.ConnectLayers(inLay, hidLay, paths.NewFull(), ForwardPath)
+	testNet.ConnectLayers(hidLay, outLay, paths.NewFull(), ForwardPath)
+	testNet.ConnectLayers(outLay, hidLay, paths.NewFull(), BackPath)
+
+	testNet.Rubicon.SetNUSs(4, 3)
+	testNet.Rubicon.Defaults()
+
+	testNet.Build()
+	testNet.Defaults()
+	testNet.InitWeights()
+	return testNet
+}
+
+// runLCDRNCycles runs given number of cycles and returns the NE and Ser values.
+func runLCDRNCycles(net *Network, nCyc int) (ne, ser float32) {
+	for range nCyc {
+		net.Cycle(false)
+	}
+	return GlobalScalars.Value(int(GvNE), 0), GlobalScalars.Value(int(GvSer), 0)
+}
+
+func TestLCDRNLayers(t *testing.T) {
+	net := newLCDRNTestNet()
+	lc := net.LayerByName("LC")
+	drn := net.LayerByName("DRN")
+	net.ThetaCycleStart(etime.Train, false)
+	net.MinusPhaseStart()
+
+	ne, ser := runLCDRNCycles(net, 10)
+	assert.InDelta(t, lc.Params.LC.Tonic, ne, 1.0e-5)
+	assert.InDelta(t, drn.Params.DRN.Tonic, ser, 1.0e-5)
+	assert.InDelta(t, ne, Neurons.Value(int(lc.NeurStIndex), 0, int(Act)), 1.0e-5)
+
+	// surprise and uncertainty drive NE up instantly
+	GlobalScalars.Set(0.4, int(GvDA), 0)
+	GlobalScalars.Set(0.2, int(GvPVposVar), 0)
+	ne, _ = runLCDRNCycles(net, 1)
+	assert.InDelta(t, 0.1+0.4+0.5*0.2, ne, 1.0e-5)
+
+	// and NE decays back down toward tonic after
+	GlobalScalars.Set(-0.1, int(GvDA), 0)
+	GlobalScalars.Set(0, int(GvPVposVar), 0)
+	ne, _ = runLCDRNCycles(net, 10)
+	assert.Less(t, ne, float32(0.6))
+	assert.Greater(t, ne, float32(0.2))
+
+	// aversive outcomes drive Ser, which urgency reduces
+	GlobalScalars.Set(1, int(GvNegUSOutcome), 0)
+	GlobalScalars.Set(0.5, int(GvPVneg), 0)
+	_, ser = runLCDRNCycles(net, 1)
+	assert.InDelta(t, 0.6, ser, 1.0e-5)
+	GlobalScalars.Set(0, int(GvNegUSOutcome), 0)
+	GlobalScalars.Set(1, int(GvVSMatrixHasGated), 0)
+	GlobalScalars.Set(0.8, int(GvPVposEst), 0)
+	GlobalScalars.Set(1, int(GvUrgency), 0)
+	assert.InDelta(t, 0.5*(0.1+0.5*0.8), drn.Params.DRN.Ser(0), 1.0e-5)
+}
+
+func TestNeuroModNESer(t *testing.T) {
+	nm := &NeuroModParams{}
+	nm.Defaults()
+	assert.Equal(t, float32(1), nm.NEGeGain(1))
+	assert.Equal(t, float32(0), nm.NENoiseGain(1))
+	assert.Equal(t, float32(1), nm.NESerLRMod(0, 0))
+
+	nm.NEGain = 0.5
+	nm.NENoise = 2
+	nm.NELRateMod = 0.2
+	nm.SerLRateMod = 0.5
+	nm.Update()
+	assert.InDelta(t, 1.25, nm.NEGeGain(0.5), 1.0e-6)
+	assert.InDelta(t, 1, nm.NENoiseGain(0.5), 1.0e-6)
+	assert.InDelta(t, 0.8*0.5, nm.NESerLRMod(0, 0), 1.0e-6)
+	assert.InDelta(t, 1, nm.NESerLRMod(1, 1), 1.0e-6)
+}
+
+func TestRubiconNESer(t *testing.T) {
+	net := newLCDRNTestNet()
+	rp := &net.Rubicon
+	rp.Reset(0)
+
+	GlobalScalars.Set(1, int(GvPVnegSum), 0)
+	cn0, gu0 := rp.GiveUp.Sums(0)
+
+	// the factors default to 0, so NE and Ser have no effect
+	GlobalScalars.Set(1, int(GvNE), 0)
+	GlobalScalars.Set(1, int(GvSer), 0)
+	cn, gu := rp.GiveUp.Sums(0)
+	assert.Equal(t, cn0, cn)
+	assert.Equal(t, gu0, gu)
+	GlobalScalars.Set(0, int(GvSer), 0)
+
+	rp.GiveUp.NE = 0.5
+	rp.GiveUp.SerPatience = 0.5
+	rp.Urgency.SerInhib = 0.5
+
+	// NE increases give up
+	cn, gu = rp.GiveUp.Sums(0)
+	assert.Equal(t, cn0, cn)
+	assert.InDelta(t, gu0+rp.GiveUp.NE, gu, 1.0e-6)
+
+	// Ser reduces the cost utility factor
+	GlobalScalars.Set(0, int(GvNE), 0)
+	GlobalScalars.Set(1, int(GvSer), 0)
+	_, gu = rp.GiveUp.Sums(0)
+	assert.Less(t, gu, gu0)
+
+	// Ser reduces urgency-driven tonic DA
+	GlobalScalars.Set(2*rp.Urgency.U50, int(GvUrgencyRaw), 0)
+	GlobalScalars.Set(0, int(GvSer), 0)
+	rp.Urgency.Urge(0)
+	da0 := GlobalScalars.Value(int(GvDAtonic), 0)
+	assert.Greater(t, da0, float32(0))
+	GlobalScalars.Set(1, int(GvSer), 0)
+	rp.Urgency.Urge(0)
+	assert.InDelta(t, da0*(1-rp.Urgency.SerInhib), GlobalScalars.Value(int(GvDAtonic), 0), 1.0e-4)
+}
+
+// newRubiconTestNet returns a network with the Rubicon layers
+// made by AddRubicon, which include the LC and DRN layers.
+func newRubiconTestNet() *Network {
+	testNet := NewNetwork("testNetRubicon")
+	testNet.SetRandSeed(42)
+	testNet.SetMaxData(1)
+
+	testNet.Rubicon.SetNUSs(4, 3)
+	testNet.AddRubicon(1, 2, 2, 2, 2, 2, 2, 2)
+	testNet.Rubicon.Defaults()
+
+	testNet.Build()
+	testNet.Defaults()
+	testNet.InitWeights()
+	testNet.ThetaCycleStart(etime.Train, false)
+	return testNet
+}
+
+func TestRubiconLCDRNLayers(t *testing.T) {
+	net := newRubiconTestNet()
+	lc := net.LayerByName("LC")
+	drn := net.LayerByName("DRN")
+	assert.Equal(t, LCLayer, lc.Type)
+	assert.Equal(t, DRNLayer, drn.Type)
+	net.MinusPhaseStart()
+	ne, ser := runLCDRNCycles(net, 10)
+	assert.Greater(t, ne, float32(0))
+	assert.Greater(t, ser, float32(0))
+	assert.InDelta(t, ne, Neurons.Value(int(lc.NeurStIndex), 0, int(Act)), 1.0e-5)
+}
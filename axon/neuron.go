@@ -314,9 +314,45 @@ const (
 	VgccCa
 
 	// VgccCaInt is the time-integrated VGCC calcium flux. This is actually
-	// what drives learning.
+	// what drives learning. It also integrates [CatCa] from CaT channels.
 	VgccCaInt
 
+	////////  CaT T-type calcium and NaP persistent sodium channels
+
+	// Gcat is the conductance of the low-threshold T-type calcium channel,
+	// which drives rebound bursting after hyperpolarization.
+	Gcat
+
+	// CatM is the activation gate of the CaT channel.
+	CatM
+
+	// CatH is the inactivation gate of the CaT channel, which is
+	// de-inactivated by hyperpolarization.
+	CatH
+
+	// CatCa is the instantaneous CaT calcium flux, which is integrated
+	// into [VgccCaInt] to contribute to [LearnCa].
+	CatCa
+
+	// Gnap is the conductance of the persistent sodium channel.
+	Gnap
+
+	// NapM is the activation gate of the NaP channel.
+	NapM
+
+	// NapH is the slow inactivation gate of the NaP channel.
+	NapH
+
+	////////  Gap junctions
+
+	// Igap is the total gap junction current from [GapJunctionPath]
+	// pathways, proportional to the Vm difference with coupled neurons.
+	Igap
+
+	// GapVm is the Vm at the end of the previous cycle, which is used
+	// for computing gap junction currents between coupled neurons.
+	GapVm
+
 	// Burst is the layer 5 IB intrinsic bursting neural activation value,
 	// computed by thresholding the [CaP] value in Super superficial layers.
 	Burst
@@ -358,8 +394,15 @@ const (
 
 	// SSGiDend is the amount of SST+ somatostatin positive slow spiking
 	// inhibition applied to dendritic Vm (VmDend).
+	// This includes GiDendSyn from explicit SST interneurons.
 	SSGiDend
 
+	// GiDendSyn is the time-integrated inhibitory synaptic conductance from
+	// pathways with [DendInhibitoryG] conductance type, e.g., from explicit
+	// SST+ interneurons, which is added to SSGiDend to inhibit the dendrite
+	// instead of the soma. Does *not* include Gbar.I.
+	GiDendSyn
+
 	// GknaMed is the conductance of sodium-gated potassium channel (KNa)
 	// medium dynamics (Slick), which produces accommodation / adaptation.
 	GknaMed
@@ -375,6 +418,17 @@ const (
 	// KirM is the Kir potassium (K) inwardly rectifying gating value.
 	KirM
 
+	////////  HCN hyperpolarization-activated cation channel
+
+	// Gh is the conductance of the HCN hyperpolarization-activated
+	// cation channel (Ih current), including the HCN.Gbar factor.
+	// This drives a depolarizing current after hyperpolarization.
+	Gh
+
+	// HcnM is the HCN channel gating value, which opens slowly
+	// with hyperpolarization.
+	HcnM
+
 	////////  SKCa small conductance calcium-gated potassium channels
 
 	// Gsk is Calcium-gated potassium channel conductance as a function
@@ -606,6 +660,16 @@ const (
 
 	// GiBase is baseline level of Gi, added to GiRaw, for intrinsic excitability.
 	GiBase
+
+	// IPThr is the intrinsic plasticity offset on the spike threshold (mV),
+	// adapted at SlowInterval intervals to drive ActAvg toward the
+	// Acts.Intrinsic.TrgHz target firing rate.
+	IPThr
+
+	// IPGl is the intrinsic plasticity offset on the leak conductance,
+	// as a proportion of Gbar.L, adapted at SlowInterval intervals to drive
+	// ActAvg toward the Acts.Intrinsic.TrgHz target firing rate.
+	IPGl
 )
 
 // NeuronIndexVars are neuron-level indexes used to access layers and pools
@@ -712,6 +776,21 @@ var NeuronVarProps = map[string]string{
 	"VgccCa":    `cat:"Excite" auto-scale:"+"`,
 	"VgccCaInt": `cat:"Excite" auto-scale:"+"`,
 
+	////////  CaT T-type calcium and NaP persistent sodium channels
+
+	"Gcat":  `cat:"Excite" auto-scale:"+"`,
+	"CatM":  `cat:"Excite"`,
+	"CatH":  `cat:"Excite"`,
+	"CatCa": `cat:"Excite" auto-scale:"+"`,
+	"Gnap":  `cat:"Excite" auto-scale:"+"`,
+	"NapM":  `cat:"Excite"`,
+	"NapH":  `cat:"Excite"`,
+
+	////////  Gap junctions
+
+	"Igap":  `cat:"Excite" auto-scale:"+"`,
+	"GapVm": `cat:"Excite" min:"-100" max:"0"`,
+
 	////////  Misc Excitatory Vars
 
 	"Burst":      `cat:"Excite"`,
@@ -728,14 +807,20 @@ var NeuronVarProps = map[string]string{
 
 	//////// SST somatostatin inhibition factors
 
-	"Gak":      `cat:"Inhib" auto-scale:"+"`,
-	"SSGiDend": `cat:"Inhib" auto-scale:"+"`,
+	"Gak":       `cat:"Inhib" auto-scale:"+"`,
+	"SSGiDend":  `cat:"Inhib" auto-scale:"+"`,
+	"GiDendSyn": `cat:"Inhib" auto-scale:"+"`,
 
 	"GknaMed":  `cat:"Inhib" auto-scale:"+"`,
 	"GknaSlow": `cat:"Inhib" auto-scale:"+"`,
 	"Gkir":     `cat:"Inhib"`,
 	"KirM":     `cat:"Inhib"`,
 
+	////////  HCN hyperpolarization-activated cation channel
+
+	"Gh":   `cat:"Excite"`,
+	"HcnM": `cat:"Excite"`,
+
 	////////  SKCa small conductance calcium-gated potassium channels
 
 	"Gsk":    `cat:"Inhib"`,
@@ -797,6 +882,8 @@ var NeuronVarProps = map[string]string{
 	"AvgDif":  `cat:"Avg"`,
 	"GeBase":  `cat:"Avg"`,
 	"GiBase":  `cat:"Avg"`,
+	"IPThr":   `cat:"Avg" auto-scale:"+"`,
+	"IPGl":    `cat:"Avg" auto-scale:"+"`,
 
 	//////// Layer-level variables
 
@@ -15,6 +15,7 @@ import (
 
 	"cogentcore.org/core/base/errors"
 	"cogentcore.org/core/base/indent"
+	"cogentcore.org/core/base/slicesx"
 	"cogentcore.org/core/math32"
 	"cogentcore.org/core/math32/minmax"
 	"cogentcore.org/lab/tensor"
@@ -63,6 +64,15 @@ type Path struct {
 	// number of synapses in this pathway
 	NSyns uint32 `display:"-"`
 
+	// StructPruned is the total number of synapses pruned and replaced by new
+	// synapses through structural plasticity (see [StructPlastParams]),
+	// since the last InitWeights.
+	StructPruned int `edit:"-"`
+
+	// StructWeak is the number of synapses below the structural plasticity
+	// pruning thresholds at the most recent update.
+	StructWeak int `edit:"-"`
+
 	// starting offset and N cons for each recv neuron, for indexing into the RecvSynIndex array of indexes into the Syns synapses, which are organized sender-based.  This is locally managed during build process, but also copied to network global PathRecvCons slice for GPU usage.
 	RecvCon []StartN `display:"-"`
 
@@ -107,8 +117,12 @@ func (pt *Path) Defaults() {
 		pt.Params.PathScale.Rel = 0.1
 	case RWPath, TDPredPath:
 		pt.Params.RLPredDefaults()
+	case ActorPath:
+		pt.Params.ActorDefaults()
 	case BLAPath:
 		pt.Params.BLADefaults()
+	case GapJunctionPath:
+		pt.Params.SetFixedWts()
 	case HipPath:
 		pt.Params.HipDefaults()
 	case VSPatchPath:
@@ -127,7 +141,7 @@ func (pt *Path) Update() {
 	if pt.Params == nil {
 		return
 	}
-	if pt.Params.Type == InhibPath { // || pt.Params.Type == CNiIOToOutPath {
+	if pt.Params.Type == InhibPath && pt.Params.Com.GType != DendInhibitoryG { // || pt.Params.Type == CNiIOToOutPath {
 		pt.Params.Com.GType = InhibitoryG
 	}
 	pt.Params.Update()
@@ -163,6 +177,9 @@ func (pt *Path) Validate(logmsg bool) error {
 	if pt.Send == nil {
 		emsg += "Send is nil; "
 	}
+	if pt.Type == GapJunctionPath && pt.Recv != pt.Send {
+		emsg += "GapJunctionPath must have the same Send and Recv layer; "
+	}
 	if emsg != "" {
 		err := errors.New(emsg)
 		if logmsg {
@@ -183,6 +200,93 @@ func (pt *Path) RecvSynIxs(ri uint32) []uint32 {
 	return pt.RecvSynIndex[rcon.Start : rcon.Start+rcon.N]
 }
 
+// SetSynapseIxs sets the network global [PathSendCon] and [SynapseIxs]
+// index values for this pathway, from the SendCon and SendConIndex
+// connectivity, using the start indexes in Params.Indexes.
+func (pt *Path) SetSynapseIxs() {
+	slay := pt.Send
+	rlay := pt.Recv
+	sendConIndex := pt.Params.Indexes.SendConSt
+	for sni := range slay.NNeurons {
+		si := slay.NeurStIndex + sni
+		scon := pt.SendCon[sni]
+		PathSendCon.Set(scon.Start, int(sendConIndex), int(StartOff))
+		PathSendCon.Set(scon.N, int(sendConIndex), int(Nitems))
+		sendConIndex++
+		for syi := scon.Start; syi < scon.Start+scon.N; syi++ {
+			syni := pt.SynStIndex + syi
+			SynapseIxs.Set(uint32(si), int(syni), int(SynSendIndex)) // network-global idx
+			SynapseIxs.Set(pt.SendConIndex[syi]+uint32(rlay.NeurStIndex), int(syni), int(SynRecvIndex))
+			SynapseIxs.Set(pt.Params.Index, int(syni), int(SynPathIndex))
+		}
+	}
+}
+
+// SetRecvSynIxs sets the network global [PathRecvCon] and [RecvSynIxs]
+// index values for this pathway, from the RecvCon and RecvSynIndex
+// connectivity, using the start indexes in Params.Indexes.
+func (pt *Path) SetRecvSynIxs() {
+	rlay := pt.Recv
+	recvConIndex := pt.Params.Indexes.RecvConSt
+	syIndex := pt.Params.Indexes.RecvSynSt
+	for rni := range rlay.NNeurons {
+		if len(pt.RecvCon) <= int(rni) {
+			continue
+		}
+		rcon := pt.RecvCon[rni]
+		PathRecvCon.Set(rcon.Start, int(recvConIndex), int(StartOff))
+		PathRecvCon.Set(rcon.N, int(recvConIndex), int(Nitems))
+		recvConIndex++
+		syIndexes := pt.RecvSynIxs(rni)
+		for _, ssi := range syIndexes {
+			RecvSynIxs.Set(ssi+pt.SynStIndex, int(syIndex))
+			syIndex++
+		}
+	}
+}
+
+// ConsFromIxs sets the CPU-side connectivity of this pathway
+// (SendCon, SendConIndex, RecvCon, RecvConIndex, RecvSynIndex)
+// from the network global index values, which is the inverse of
+// [Path.SetSynapseIxs] and [Path.SetRecvSynIxs]. This is used
+// after loading the global indexes, e.g., in [Network.LoadCheckpoint].
+func (pt *Path) ConsFromIxs() {
+	slay := pt.Send
+	rlay := pt.Recv
+	nsyn := pt.NSyns
+	pt.SendCon = make([]StartN, slay.NNeurons)
+	pt.SendConNAvgMax.Init()
+	for sni := range slay.NNeurons {
+		sci := pt.Params.Indexes.SendConSt + sni
+		scon := &pt.SendCon[sni]
+		scon.Start = PathSendCon.Value(int(sci), int(StartOff))
+		scon.N = PathSendCon.Value(int(sci), int(Nitems))
+		pt.SendConNAvgMax.UpdateValue(float32(scon.N), int32(sni))
+	}
+	pt.SendConNAvgMax.CalcAvg()
+	pt.SendConIndex = make([]uint32, nsyn)
+	for syi := range nsyn {
+		pt.SendConIndex[syi] = SynapseIxs.Value(int(pt.SynStIndex+syi), int(SynRecvIndex)) - rlay.NeurStIndex
+	}
+	pt.RecvCon = make([]StartN, rlay.NNeurons)
+	pt.RecvConNAvgMax.Init()
+	for rni := range rlay.NNeurons {
+		rci := pt.Params.Indexes.RecvConSt + rni
+		rcon := &pt.RecvCon[rni]
+		rcon.Start = PathRecvCon.Value(int(rci), int(StartOff))
+		rcon.N = PathRecvCon.Value(int(rci), int(Nitems))
+		pt.RecvConNAvgMax.UpdateValue(float32(rcon.N), int32(rni))
+	}
+	pt.RecvConNAvgMax.CalcAvg()
+	pt.RecvSynIndex = make([]uint32, nsyn)
+	pt.RecvConIndex = make([]uint32, nsyn)
+	for ci := range nsyn {
+		syi := RecvSynIxs.Value(int(pt.Params.Indexes.RecvSynSt+ci)) - pt.SynStIndex
+		pt.RecvSynIndex[ci] = syi
+		pt.RecvConIndex[ci] = SynapseIxs.Value(int(pt.SynStIndex+syi), int(SynSendIndex)) - slay.NeurStIndex
+	}
+}
+
 // Build constructs the full connectivity among the layers.
 // Calls Validate and returns error if invalid.
 // Pat.Connect is called to get the pattern of the connection.
@@ -201,6 +305,9 @@ func (pt *Path) Build() error {
 	sendn, recvn, cons := pt.Pattern.Connect(ssh, rsh, pt.Recv == pt.Send)
 	slen := ssh.Len()
 	rlen := rsh.Len()
+	if pt.Type == GapJunctionPath {
+		symmetricCons(slen, sendn, recvn, cons)
+	}
 	tcons := pt.SetConStartN(&pt.SendCon, &pt.SendConNAvgMax, sendn)
 	tconr := pt.SetConStartN(&pt.RecvCon, &pt.RecvConNAvgMax, recvn)
 	if tconr != tcons {
@@ -244,6 +351,31 @@ func (pt *Path) Build() error {
 	return nil
 }
 
+// symmetricCons makes the connectivity from a pattern symmetric, for
+// pathways within the same layer of n neurons, by adding the reverse of
+// any connection that does not already exist, and updating the counts.
+func symmetricCons(n int, sendn, recvn *tensor.Int32, cons *tensor.Bool) {
+	cbits := cons.Values
+	for ri := 0; ri < n; ri++ {
+		for si := ri + 1; si < n; si++ {
+			fwd := cbits.Index(ri*n + si)
+			rev := cbits.Index(si*n + ri)
+			if fwd == rev {
+				continue
+			}
+			if fwd { // add si <- ri
+				cbits.Set(true, si*n+ri)
+				sendn.Values[ri]++
+				recvn.Values[si]++
+			} else { // add ri <- si
+				cbits.Set(true, ri*n+si)
+				sendn.Values[si]++
+				recvn.Values[ri]++
+			}
+		}
+	}
+}
+
 // SetConStartN sets the *Con StartN values given n tensor from Pat.
 // Returns total number of connections for this direction.
 func (pt *Path) SetConStartN(con *[]StartN, avgmax *minmax.AvgMax32, tn *tensor.Int32) uint32 {
@@ -467,23 +599,21 @@ func (pt *Path) WriteWeightsJSON(w io.Writer, depth int) {
 	w.Write(indent.TabBytes(depth))
 	w.Write([]byte(fmt.Sprintf("\"Rs\": [\n")))
 	depth++
+	pr := &weights.Recv{}
 	for ri := 0; ri < nr; ri++ {
-		rc := pt.RecvCon[ri]
-		syIndexes := pt.RecvSynIxs(uint32(ri))
+		pt.weightsRecv(ri, pr)
 		w.Write(indent.TabBytes(depth))
 		w.Write([]byte("{\n"))
 		depth++
 		w.Write(indent.TabBytes(depth))
-		w.Write([]byte(fmt.Sprintf("\"Ri\": %v,\n", ri)))
+		w.Write([]byte(fmt.Sprintf("\"Ri\": %v,\n", pr.Ri)))
 		w.Write(indent.TabBytes(depth))
-		w.Write([]byte(fmt.Sprintf("\"N\": %v,\n", rc.N)))
+		w.Write([]byte(fmt.Sprintf("\"N\": %v,\n", pr.N)))
 		w.Write(indent.TabBytes(depth))
 		w.Write([]byte("\"Si\": [ "))
-		for ci, syi := range syIndexes {
-			syni := pt.SynStIndex + syi
-			si := pt.Params.SynSendLayerIndex(syni)
+		for ci, si := range pr.Si {
 			w.Write([]byte(fmt.Sprintf("%v", si)))
-			if ci == int(rc.N-1) {
+			if ci == pr.N-1 {
 				w.Write([]byte(" "))
 			} else {
 				w.Write([]byte(", "))
@@ -492,10 +622,9 @@ func (pt *Path) WriteWeightsJSON(w io.Writer, depth int) {
 		w.Write([]byte("],\n"))
 		w.Write(indent.TabBytes(depth))
 		w.Write([]byte("\"Wt\": [ "))
-		for ci, syi := range syIndexes {
-			syni := pt.SynStIndex + syi
-			w.Write([]byte(strconv.FormatFloat(float64(Synapses.Value(int(syni), int(Wt))), 'g', weights.Prec, 32)))
-			if ci == int(rc.N-1) {
+		for ci, wt := range pr.Wt {
+			w.Write([]byte(strconv.FormatFloat(float64(wt), 'g', weights.Prec, 32)))
+			if ci == pr.N-1 {
 				w.Write([]byte(" "))
 			} else {
 				w.Write([]byte(", "))
@@ -504,10 +633,9 @@ func (pt *Path) WriteWeightsJSON(w io.Writer, depth int) {
 		w.Write([]byte("],\n"))
 		w.Write(indent.TabBytes(depth))
 		w.Write([]byte("\"Wt1\": [ ")) // Wt1 is SWt
-		for ci, syi := range syIndexes {
-			syni := pt.SynStIndex + syi
-			w.Write([]byte(strconv.FormatFloat(float64(Synapses.Value(int(syni), int(SWt))), 'g', weights.Prec, 32)))
-			if ci == int(rc.N-1) {
+		for ci, wt := range pr.Wt1 {
+			w.Write([]byte(strconv.FormatFloat(float64(wt), 'g', weights.Prec, 32)))
+			if ci == pr.N-1 {
 				w.Write([]byte(" "))
 			} else {
 				w.Write([]byte(", "))
@@ -530,9 +658,37 @@ func (pt *Path) WriteWeightsJSON(w io.Writer, depth int) {
 	w.Write([]byte("}")) // note: leave unterminated as outer loop needs to add , or just \n depending
 }
 
-// SetWeights sets the weights for this pathway from weights.Path decoded values
+// weightsRecv sets the sending indexes and weights (Wt, Wt1 = SWt) for
+// given receiving neuron into pr, as saved in weights files,
+// for WriteWeightsJSON and WeightsPath.
+func (pt *Path) weightsRecv(ri int, pr *weights.Recv) {
+	syIndexes := pt.RecvSynIxs(uint32(ri))
+	n := len(syIndexes)
+	pr.Ri = ri
+	pr.N = n
+	pr.Si = slicesx.SetLength(pr.Si, n)
+	pr.Wt = slicesx.SetLength(pr.Wt, n)
+	pr.Wt1 = slicesx.SetLength(pr.Wt1, n)
+	for ci, syi := range syIndexes {
+		syni := pt.SynStIndex + syi
+		pr.Si[ci] = int(pt.Params.SynSendLayerIndex(syni))
+		pr.Wt[ci] = Synapses.Value(int(syni), int(Wt))
+		pr.Wt1[ci] = Synapses.Value(int(syni), int(SWt)) // Wt1 is SWt
+	}
+}
+
+// SetWeights sets the weights for this pathway from weights.Path decoded values.
+// If the connectivity differs from the current one, as a result of structural
+// plasticity (see [StructPlastParams]), the pathway is rewired to match,
+// which requires the same total number of synapses.
 func (pt *Path) SetWeights(pw *weights.Path) error {
 	var err error
+	if !pt.sameCons(pw) {
+		err = pt.setConsFromWeights(pw)
+		if err != nil {
+			return err
+		}
+	}
 	for i := range pw.Rs {
 		pr := &pw.Rs[i]
 		hasWt1 := len(pr.Wt1) >= len(pr.Si)
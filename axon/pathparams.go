@@ -80,7 +80,15 @@ type PathIndexes struct {
 	// [Layer][RecvPaths][RecvNeurons]
 	NPathNeurSt uint32
 
-	pad uint32
+	// STDPSt is the start index into global SynapseSTDP array,
+	// for pathways using STDP learning. Set in [Network.BuildSTDP].
+	STDPSt uint32
+
+	// ConsolSt is the start index into global SynapseConsol array,
+	// for pathways using weight consolidation. Set in [Network.BuildConsol].
+	ConsolSt uint32
+
+	pad, pad1, pad2 uint32
 }
 
 // RecvNIndexToLayIndex converts a neuron's index in network level global list of all neurons
@@ -122,7 +130,14 @@ type PathParams struct {
 	// Index is the index of the pathway in global path list: [Layer][SendPaths]
 	Index uint32 `edit:"-"`
 
-	pad, pad1 int32
+	// DendComp is the dendritic compartment that the excitatory input from this
+	// pathway targets, for receiving layers with [Layer.DendComps] > 1.
+	// 0 is the standard VmDend compartment (and soma), and higher values target
+	// the additional, more distal compartments. Values beyond the number of
+	// compartments in the receiving layer revert to 0.
+	DendComp int32 `min:"0"`
+
+	pad int32
 
 	// recv and send neuron-level pathway index array access info
 	Indexes PathIndexes `display:"-"`
@@ -130,6 +145,17 @@ type PathParams struct {
 	// synaptic communication parameters: delay, probability of failure
 	Com SynComParams `display:"inline"`
 
+	// short-term plasticity parameters, for Tsodyks-Markram style
+	// synaptic depression and facilitation based on sending spikes.
+	STP STPParams `display:"inline"`
+
+	// Gap has the gap junction parameters for [GapJunctionPath] electrical coupling.
+	Gap GapJunctionParams `display:"inline"`
+
+	// StructPlast has the structural plasticity parameters, for pruning
+	// persistently weak synapses and regrowing new ones.
+	StructPlast StructPlastParams `display:"inline"`
+
 	// pathway scaling parameters for computing GScale:
 	// modulates overall strength of pathway, using both
 	// absolute and relative factors, with adaptation option to maintain target max conductances
@@ -145,8 +171,8 @@ type PathParams struct {
 	// conductance scaling values
 	GScale GScaleValues `display:"inline"`
 
-	// Params for RWPath and TDPredPath for doing dopamine-modulated learning
-	// for reward prediction: Da * Send activity.
+	// Params for RWPath, TDPredPath and ActorPath for doing dopamine-modulated
+	// learning for reward prediction: Da * Send activity.
 	// Use in RWPredLayer or TDPredLayer typically to generate reward predictions.
 	// If the Da sign is positive, the first recv unit learns fully; for negative,
 	// second one learns fully.
@@ -216,6 +242,9 @@ func (pt *PathParams) StyleName() string {
 
 func (pt *PathParams) Defaults() {
 	pt.Com.Defaults()
+	pt.STP.Defaults()
+	pt.Gap.Defaults()
+	pt.StructPlast.Defaults()
 	pt.SWts.Defaults()
 	pt.PathScale.Defaults()
 	pt.Learn.Defaults()
@@ -228,6 +257,9 @@ func (pt *PathParams) Defaults() {
 
 func (pt *PathParams) Update() {
 	pt.Com.Update()
+	pt.STP.Update()
+	pt.Gap.Update()
+	pt.StructPlast.Update()
 	pt.PathScale.Update()
 	pt.SWts.Update()
 	pt.Learn.Update()
@@ -245,7 +277,7 @@ func (pt *PathParams) Update() {
 func (pt *PathParams) ShouldDisplay(field string) bool {
 	switch field {
 	case "RLPred":
-		return pt.Type == RWPath || pt.Type == TDPredPath
+		return pt.Type == RWPath || pt.Type == TDPredPath || pt.Type == ActorPath
 	case "VSMatrix":
 		return pt.Type == VSMatrixPath
 	case "DSMatrix":
@@ -254,6 +286,8 @@ func (pt *PathParams) ShouldDisplay(field string) bool {
 		return pt.Type == BLAPath
 	case "Hip":
 		return pt.Type == HipPath
+	case "Gap":
+		return pt.Type == GapJunctionPath
 	default:
 		return true
 	}
@@ -82,6 +82,8 @@ const (
 
 	// TDPredPath does dopamine-modulated learning for reward prediction:
 	// DWt = Da * Send.CaDPrev (activity on *previous* timestep)
+	// If RLPred.Lambda > 0, an eligibility trace of Send.CaDPrev is used
+	// instead, for TD(lambda) learning.
 	// Uses RLPredPath parameters.
 	// Use in TDPredLayer typically to generate reward predictions.
 	// If the Da sign is positive, the first recv unit learns fully;
@@ -99,6 +101,25 @@ const (
 
 	// HipPath is a special pathway for the hippocampus. TODO: fixme.
 	HipPath
+
+	// GapJunctionPath is an electrical coupling pathway via gap junctions,
+	// which produces a current in each receiving neuron proportional to the
+	// Vm difference with each of its coupled sending neurons, computed every
+	// cycle, instead of sending spikes. Connectivity is made symmetric at Build,
+	// and the sending and receiving layers must be the same.
+	// Gap junctions are important for synchrony in the inferior olive (IO),
+	// thalamic reticular nucleus (TRN), and interneuron networks.
+	GapJunctionPath
+
+	// ActorPath does dopamine-modulated policy-gradient learning for the
+	// actor layer in an actor-critic pairing (see [Network.AddActorCritic]):
+	// DWt = Da * Tr, where the eligibility trace Tr accumulates
+	// Send.CaDPrev * (Recv.CaDPrev - ActAvg.Nominal) over time steps,
+	// decaying by RLPred.Lambda. The Da signal reflects the TD error
+	// for the state on the *previous* time step, so it reinforces
+	// the actions taken then.
+	// Uses RLPredPath parameters.
+	ActorPath
 )
 
 //gosl:end
@@ -26,6 +26,7 @@ var (
 // Appropriate connections are made between layers, using standard styles.
 // space is the spacing between layers (2 typical).
 func (net *Network) AddVentralBG(prefix string, nPoolsY, nPoolsX, nNeurY, nNeurX, gpNeurY, gpNeurX int, space float32) (matrixGo, matrixNo, gpePr, gpeAk, stn, gpi *Layer) {
+	defer net.endSpecBuilder(net.startSpecBuilder(&LayerSpec{Name: prefix, Shape: []int{nPoolsY, nPoolsX, nNeurY, nNeurX}, Builder: &BuilderSpec{Type: BuildVentralBG, Space: space, GPShape: []int{gpNeurY, gpNeurX}}}))
 	bglay := "VBG"
 	gpi = net.AddGPiLayer2D(prefix+"VGPi", bglay, gpNeurY, gpNeurX)
 	gpePr = net.AddGPeLayer2D(prefix+"VGPePr", bglay, gpNeurY, gpNeurX)
@@ -101,6 +102,7 @@ func (net *Network) AddVentralBG(prefix string, nPoolsY, nPoolsX, nNeurY, nNeurX
 // Appropriate PoolOneToOne connections are made between layers, using standard styles.
 // space is the spacing between layers (2 typical)
 func (net *Network) AddDorsalBG(prefix string, poolSTN bool, nPoolsY, nPoolsX, nNeurY, nNeurX, gpNeurY, gpNeurX int, space float32) (matrixGo, matrixNo, patchD1, patchD2, gpePr, gpeAk, stn, gpi, pf *Layer) {
+	defer net.endSpecBuilder(net.startSpecBuilder(&LayerSpec{Name: prefix, Shape: []int{nPoolsY, nPoolsX, nNeurY, nNeurX}, Builder: &BuilderSpec{Type: BuildDorsalBG, Space: space, GPShape: []int{gpNeurY, gpNeurX}, PoolSTN: poolSTN}}))
 	bglay := "DBG"
 	gpi = net.AddGPiLayer4D(prefix+"DGPi", bglay, nPoolsY, nPoolsX, gpNeurY, gpNeurX)
 	gpePr = net.AddGPeLayer4D(prefix+"DGPePr", bglay, nPoolsY, nPoolsX, gpNeurY, gpNeurX)
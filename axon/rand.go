@@ -1,6 +1,7 @@
 package axon
 
 import (
+	"cogentcore.org/lab/base/randx"
 	"cogentcore.org/lab/gosl/slrand"
 )
 
@@ -28,3 +29,59 @@ func GetRandomNumber(index uint32, counter uint64, funIndex RandFunIndex) float3
 }
 
 //gosl:end
+
+// These are the RandFunIndex values for random numbers generated on the CPU
+// between cycles, using [Context.RandCPU]. Each such use claims a new block
+// of RandFunCPUN counter values after those used by the prior cycles, so these
+// numbers do not overlap with those used on the GPU.
+const (
+	RandFunStructPlast RandFunIndex = iota
+	RandFunSleepReplay
+	RandFunCPUN
+)
+
+// RandCPU returns a [randx.Rand] source of counter-based random numbers
+// from [GetRandomNumber] with given function index, for use on the CPU
+// between cycles, and advances the RandCounter past the block of numbers
+// it uses. Because the numbers are determined by the RandCounter, which is
+// saved in checkpoints, runs resume exactly, unlike with [Network.Rand].
+// The Context must be copied to the GPU after this, if in use.
+func (ctx *Context) RandCPU(funIndex RandFunIndex) *CounterRand {
+	cr := &CounterRand{Counter: ctx.RandCounter.Counter, FunIndex: funIndex}
+	ctx.RandCounter.Add(uint32(RandFunCPUN))
+	return cr
+}
+
+// CounterRand is a [randx.Rand] that returns successive counter-based
+// random numbers from [GetRandomNumber], using the Key as the index,
+// which is incremented for each number. Only the Float32, Float64 and Intn
+// methods are supported: the others panic.
+type CounterRand struct {
+	randx.Rand
+
+	// Counter is the random counter.
+	Counter uint64
+
+	// FunIndex is the random function index.
+	FunIndex RandFunIndex
+
+	// Key is the index for the next random number.
+	Key uint32
+}
+
+// Float32 returns the next random number in the range [0,1).
+func (cr *CounterRand) Float32() float32 {
+	v := GetRandomNumber(cr.Key, cr.Counter, cr.FunIndex)
+	cr.Key++
+	return v
+}
+
+// Float64 returns the next random number in the range [0,1).
+func (cr *CounterRand) Float64() float64 {
+	return float64(cr.Float32())
+}
+
+// Intn returns the next random number in the range [0,n).
+func (cr *CounterRand) Intn(n int) int {
+	return min(int(cr.Float32()*float32(n)), n-1)
+}
@@ -147,3 +147,22 @@ func (ly *Layer) TDIntegPostBuild() {
 func (ly *Layer) TDDaPostBuild() {
 	ly.Params.TDDa.TDIntegLayIndex = ly.BuildConfigFindLayer("TDIntegLayName", true)
 }
+
+// ActorAction returns the index of the action taken on the previous trial
+// by an actor layer (see [Network.AddActorCritic]), as the most active
+// unit in terms of CaDPrev, for given data parallel index, or -1 if no
+// unit was active. This is the action that the current DA signal evaluates,
+// and should be called at the start of the trial, e.g., to update the environment.
+func (ly *Layer) ActorAction(di uint32) int {
+	mx := float32(0)
+	act := -1
+	for lni := range ly.NNeurons {
+		ni := ly.NeurStIndex + lni
+		ca := Neurons.Value(int(ni), int(di), int(CaDPrev))
+		if ca > mx {
+			mx = ca
+			act = int(lni)
+		}
+	}
+	return act
+}
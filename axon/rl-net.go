@@ -25,6 +25,7 @@ func (nt *Network) AddClampDaLayer(name string) *Layer {
 // Pathway from Rew to RewInteg is given class TDToInteg -- should
 // have no learning and 1 weight.
 func (nt *Network) AddTDLayers(prefix string, rel relpos.Relations, space float32) (rew, rp, ri, td *Layer) {
+	defer nt.endSpecBuilder(nt.startSpecBuilder(&LayerSpec{Name: prefix, Builder: &BuilderSpec{Type: BuildTDLayers, Space: space, Rel: rel}}))
 	rew = nt.AddRewLayer(prefix + "Rew")
 	rp = nt.AddLayer2D(prefix+"RewPred", TDPredLayer, 1, 2)
 	ri = nt.AddLayer2D(prefix+"RewInteg", TDIntegLayer, 1, 2)
@@ -47,6 +48,7 @@ func (nt *Network) AddTDLayers(prefix string, rel relpos.Relations, space float3
 // Reward layer, a RWPred prediction layer, and a dopamine layer that computes diff.
 // Only generates DA when Rew layer has external input -- otherwise zero.
 func (nt *Network) AddRWLayers(prefix string, rel relpos.Relations, space float32) (rew, rp, da *Layer) {
+	defer nt.endSpecBuilder(nt.startSpecBuilder(&LayerSpec{Name: prefix, Builder: &BuilderSpec{Type: BuildRWLayers, Space: space, Rel: rel}}))
 	rew = nt.AddRewLayer(prefix + "Rew")
 	rp = nt.AddLayer2D(prefix+"RWPred", RWPredLayer, 1, 2)
 	da = nt.AddLayer2D(prefix+"DA", RWDaLayer, 1, 1)
@@ -65,3 +67,59 @@ func (nt *Network) AddRWLayers(prefix string, rel relpos.Relations, space float3
 func (nt *Network) ConnectToRWPath(send, recv *Layer, pat paths.Pattern) *Path {
 	return nt.ConnectLayers(send, recv, pat, RWPath)
 }
+
+// ResetTDTraces resets the TD(lambda) eligibility traces (Tr) of all
+// the TDPredPath and ActorPath synapses for given data parallel index.
+// This must be called at the start of each new episode (e.g., each
+// sequence of time steps leading up to a reward), so that credit is not
+// assigned to the inputs from the prior episode.
+func (nt *Network) ResetTDTraces(di uint32) {
+	RunGPUSync()
+	RunDone(SynapseTracesVar)
+	for _, pt := range nt.Paths {
+		if pt.Type != TDPredPath && pt.Type != ActorPath {
+			continue
+		}
+		for syi := range pt.NSyns {
+			syni := int(pt.SynStIndex + syi)
+			nt.SynapseTraces.Set(0, syni, int(di), int(Tr))
+			nt.SynapseTraces.Set(0, syni, int(di), int(DTr))
+		}
+	}
+	ToGPU(SynapseTracesVar)
+}
+
+// AddActorCritic adds an actor-critic pairing, where the critic is the
+// standard set of TD layers (see [Network.AddTDLayers]), which can use
+// TD(lambda) eligibility traces via RLPred.Lambda on the TDPredPath inputs
+// to RewPred, and the actor is a layer with nActions units, one per action,
+// whose inputs should be connected using [Network.ConnectToActor].
+// The actor learns to select actions using the DA signal from the critic,
+// with noise providing exploration, and the action taken on a given
+// trial is available via [Layer.ActorAction] at the start of the next trial.
+func (nt *Network) AddActorCritic(prefix string, nActions int, rel relpos.Relations, space float32) (rew, rp, ri, td, actor *Layer) {
+	rew, rp, ri, td = nt.AddTDLayers(prefix, rel, space)
+	actor = nt.AddLayer2D(prefix+"Actor", SuperLayer, 1, nActions)
+	actor.AddClass("RLActor")
+	actor.AddDefaultParams(func(ly *LayerParams) {
+		ly.Acts.Decay.Act = 1
+		ly.Acts.Decay.Glong = 1
+		ly.Acts.Noise.On.SetBool(true)
+		ly.Acts.Noise.Ge = 0.01
+		ly.Acts.Noise.Gi = 0.01
+		ly.Inhib.ActAvg.Nominal = 1 / float32(nActions)
+		ly.Inhib.Layer.Gi = 1.5
+	})
+	if rel == relpos.Behind {
+		actor.PlaceBehind(td, space)
+	} else {
+		actor.PlaceRightOf(td, space)
+	}
+	return
+}
+
+// ConnectToActor adds an ActorPath from given sending layer to an actor layer,
+// as created by [Network.AddActorCritic].
+func (nt *Network) ConnectToActor(send, recv *Layer, pat paths.Pattern) *Path {
+	return nt.ConnectLayers(send, recv, pat, ActorPath)
+}
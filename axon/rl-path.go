@@ -4,12 +4,15 @@
 
 package axon
 
+import "cogentcore.org/core/math32"
+
 //gosl:start
 
 // RLPredPathParams does dopamine-modulated learning for reward prediction: Da * Send.Act
 // Used by RWPath and TDPredPath within corresponding RWPredLayer or TDPredLayer
 // to generate reward predictions based on its incoming weights, using linear activation
 // function. Has no weight bounds or limits on sign etc.
+// Also used by ActorPath for the actor in an actor-critic pairing.
 type RLPredPathParams struct {
 
 	// how much to learn on opposite DA sign coding neuron (0..1)
@@ -18,7 +21,14 @@ type RLPredPathParams struct {
 	// tolerance on DA -- if below this abs value, then DA goes to zero and there is no learning -- prevents prediction from exactly learning to cancel out reward value, retaining a residual valence of signal
 	DaTol float32
 
-	pad, pad1 float32
+	// Lambda is the decay factor per time step (trial) for the eligibility trace
+	// of sending activity used in TDPredPath and ActorPath learning, which
+	// corresponds to the product of the discount gamma and lambda in TD(lambda).
+	// 0 = standard TD(0) learning from the previous time step only.
+	// Larger values propagate the TD error back to earlier states much faster.
+	Lambda float32 `min:"0" max:"1"`
+
+	pad float32
 }
 
 func (pj *RLPredPathParams) Defaults() {
@@ -28,6 +38,20 @@ func (pj *RLPredPathParams) Defaults() {
 func (pj *RLPredPathParams) Update() {
 }
 
+// DaFromTol returns the given da value subject to the DaTol tolerance.
+func (pj *RLPredPathParams) DaFromTol(da float32) float32 {
+	if pj.DaTol > 0 && math32.Abs(da) < pj.DaTol {
+		return 0
+	}
+	return da
+}
+
+// Trace returns the updated eligibility trace given the prior trace
+// and the new sending activity.
+func (pj *RLPredPathParams) Trace(tr, act float32) float32 {
+	return pj.Lambda*tr + act
+}
+
 //gosl:end
 
 func (pj *PathParams) RLPredDefaults() {
@@ -36,3 +60,12 @@ func (pj *PathParams) RLPredDefaults() {
 	pj.SWts.Init.Var = 0
 	pj.SWts.Init.Sym.SetBool(false)
 }
+
+// ActorDefaults sets default params for the ActorPath.
+func (pj *PathParams) ActorDefaults() {
+	pj.SWts.Init.Mean = 0.5
+	pj.SWts.Init.Var = 0.1
+	pj.SWts.Init.Sym.SetBool(false)
+	pj.Learn.LRate.Base = 0.1
+	pj.RLPred.Lambda = 0.8
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package axon
+
+import (
+	"testing"
+
+	"github.com/emer/emergent/v2/etime"
+	"github.com/emer/emergent/v2/paths"
+	"github.com/emer/emergent/v2/relpos"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRLPredTrace(t *testing.T) {
+	rp := &RLPredPathParams{}
+	rp.Defaults()
+	assert.Equal(t, float32(1), rp.Trace(0.5, 1))
+	rp.Lambda = 0.5
+	assert.Equal(t, float32(1.25), rp.Trace(0.5, 1))
+	assert.Equal(t, float32(0.01), rp.DaFromTol(0.01))
+	rp.DaTol = 0.05
+	assert.Equal(t, float32(0), rp.DaFromTol(0.01))
+	assert.Equal(t, float32(-0.1), rp.DaFromTol(-0.1))
+}
+
+// newTestACNet returns an actor-critic network with a 4 time step
+// CSC Input layer, and the TDPredPath from Input to RewPred.
+func newTestACNet(lambda float32) (*Network, *Path) {
+	net := NewNetwork("testAC")
+	net.SetRandSeed(42)
+	net.SetMaxData(1)
+	inp := net.AddLayer2D("Input", InputLayer, 1, 4)
+	_, rp, _, _, actor := net.AddActorCritic("", 2, relpos.RightOf, 2)
+	full := paths.NewFull()
+	pt := net.ConnectLayers(inp, rp, full, TDPredPath)
+	net.ConnectToActor(inp, actor, full)
+	net.Build()
+	net.Defaults()
+	pt.Params.RLPred.Lambda = lambda
+	pt.Params.Learn.LRate.Base = 0.5
+	net.UpdateParams()
+	net.InitWeights()
+	return net, pt
+}
+
+// runTestCSC runs one sequence of time steps through the CSC input,
+// with reward on the last step.
+func runTestCSC(net *Network) {
+	inLay := net.LayerByName("Input")
+	clearMask, setMask, toTarg := inLay.ApplyExtFlags()
+	for tm := range 4 {
+		net.ThetaCycleStart(etime.Train, false)
+		net.MinusPhaseStart()
+		net.InitExt()
+		inLay.ApplyExtValue(uint32(tm), 0, 1, clearMask, setMask, toTarg)
+		GlobalSetRew(0, 1, tm == 3)
+		net.ApplyExts()
+		for cyc := range 200 {
+			net.Cycle(false)
+			if cyc == 149 {
+				net.MinusPhaseEnd()
+				net.PlusPhaseStart()
+			}
+		}
+		net.PlusPhaseEnd()
+		net.DWtToWt()
+	}
+}
+
+func TestTDLambda(t *testing.T) {
+	// with TD(0), only the step just before the reward learns in the
+	// first sequence, while TD(lambda) learns back to the onset.
+	onset := func(lambda float32) (float32, float32) {
+		net, pt := newTestACNet(lambda)
+		runTestCSC(net)
+		wt := func(si int) float32 {
+			return Synapses.Value(int(pt.SynStIndex)+pt.SynIndex(si, 0), int(Wt))
+		}
+		return wt(0), wt(2)
+	}
+	w0, w2 := onset(0)
+	assert.Greater(t, w2, float32(0))
+	assert.Less(t, w0, 0.1*w2)
+	l0, l2 := onset(0.9)
+	assert.Greater(t, l0, 5*w0)
+	assert.Greater(t, l2, l0)
+
+	// the traces are reset at the start of a new episode
+	net, pt := newTestACNet(0.9)
+	runTestCSC(net)
+	apt := net.LayerByName("Actor").RecvPaths[0]
+	trSum := func(pt *Path) float32 {
+		sum := float32(0)
+		for syi := range pt.NSyns {
+			sum += SynapseTraces.Value(int(pt.SynStIndex+syi), 0, int(Tr))
+		}
+		return sum
+	}
+	assert.Greater(t, trSum(pt), float32(0))
+	assert.NotZero(t, trSum(apt))
+	net.ResetTDTraces(0)
+	assert.Zero(t, trSum(pt))
+	assert.Zero(t, trSum(apt))
+
+	net, _ = newTestACNet(0)
+	actor := net.LayerByName("Actor")
+	assert.Equal(t, 2, int(actor.NNeurons))
+	assert.Equal(t, float32(0.5), actor.Params.Inhib.ActAvg.Nominal)
+	apt = actor.RecvPaths[0]
+	assert.Equal(t, ActorPath, apt.Type)
+	assert.Equal(t, float32(0.8), apt.Params.RLPred.Lambda)
+	assert.Equal(t, -1, actor.ActorAction(0))
+	Neurons.Set(0.2, int(actor.NeurStIndex), 0, int(CaDPrev))
+	Neurons.Set(0.5, int(actor.NeurStIndex+1), 0, int(CaDPrev))
+	assert.Equal(t, 1, actor.ActorAction(0))
+}
@@ -163,6 +163,90 @@ func (vt *VTAParams) VTADA(ctx *Context, di uint32, ach float32, hasRew bool) {
 	GlobalScalars.Set(netDA, int(GvDA), int(di))    // general neuromod DA
 }
 
+// LCParams are for computing the norepinephrine (NE) global neuromodulatory
+// signal from the locus coeruleus (LC), as a function of surprise,
+// i.e., the magnitude of phasic dopamine (positive or negative reward
+// prediction errors), and the uncertainty in the expected positive
+// outcome (PVposVar), on top of a tonic baseline level.
+// NE modulates neural gain, learning rate, and exploration (noise)
+// via NeuroModParams, and drives giving up on the current goal in Rubicon.
+type LCParams struct {
+
+	// Tonic is the baseline tonic level of NE.
+	Tonic float32 `default:"0.1"`
+
+	// SurpriseGain is the gain on the absolute value of phasic dopamine,
+	// reflecting reward prediction error surprise.
+	SurpriseGain float32 `default:"1"`
+
+	// UncertGain is the gain on the uncertainty (variance) in the expected
+	// positive outcome, GvPVposVar.
+	UncertGain float32 `default:"0.5"`
+
+	pad float32
+}
+
+func (lc *LCParams) Defaults() {
+	lc.Tonic = 0.1
+	lc.SurpriseGain = 1
+	lc.UncertGain = 0.5
+}
+
+func (lc *LCParams) Update() {
+}
+
+// NE returns the NE value based on current global state.
+func (lc *LCParams) NE(di uint32) float32 {
+	surp := math32.Abs(GlobalScalars.Value(int(GvDA), int(di)))
+	unc := GlobalScalars.Value(int(GvPVposVar), int(di))
+	return math32.Clamp(lc.Tonic+lc.SurpriseGain*surp+lc.UncertGain*unc, 0, 1)
+}
+
+// DRNParams are for computing the serotonin (Ser, 5-HT) global
+// neuromodulatory signal from the dorsal raphe nucleus (DRN),
+// as a function of aversive outcomes (negative USs), and patient
+// waiting for an expected positive outcome while goal engaged,
+// which is suppressed by urgency (i.e., a temporal discounting signal).
+// Ser modulates learning rate via NeuroModParams, and reduces the
+// impact of costs and urgency in Rubicon.
+type DRNParams struct {
+
+	// Tonic is the baseline tonic level of Ser.
+	Tonic float32 `default:"0.1"`
+
+	// NegGain is the gain on the negative primary value (PVneg)
+	// when a negative US outcome is experienced.
+	NegGain float32 `default:"1"`
+
+	// WaitGain is the gain on the estimated positive outcome (PVposEst)
+	// while goal engaged (VSMatrixHasGated), supporting patient waiting.
+	WaitGain float32 `default:"0.5"`
+
+	// UrgencyInhib is the proportion by which urgency (GvUrgency)
+	// reduces Ser, producing steeper temporal discounting.
+	UrgencyInhib float32 `default:"0.5" min:"0" max:"1"`
+}
+
+func (dr *DRNParams) Defaults() {
+	dr.Tonic = 0.1
+	dr.NegGain = 1
+	dr.WaitGain = 0.5
+	dr.UrgencyInhib = 0.5
+}
+
+func (dr *DRNParams) Update() {
+	dr.UrgencyInhib = math32.Clamp(dr.UrgencyInhib, 0, 1)
+}
+
+// Ser returns the Ser value based on current global state.
+func (dr *DRNParams) Ser(di uint32) float32 {
+	neg := GlobalScalars.Value(int(GvNegUSOutcome), int(di)) * GlobalScalars.Value(int(GvPVneg), int(di))
+	wait := GlobalScalars.Value(int(GvVSMatrixHasGated), int(di)) * GlobalScalars.Value(int(GvPVposEst), int(di))
+	ser := dr.Tonic + dr.NegGain*neg + dr.WaitGain*wait
+	ser *= 1 - dr.UrgencyInhib*GlobalScalars.Value(int(GvUrgency), int(di))
+	return math32.Clamp(ser, 0, 1)
+}
+
 //gosl:end
 
 func (ly *Layer) BLADefaults() {
@@ -253,6 +337,24 @@ func (ly *Layer) LDTDefaults() {
 	}
 }
 
+// NeuroModNucleusDefaults sets defaults for the LC and DRN layers,
+// which compute their global neuromodulator values directly, and thus
+// do not need to learn.
+func (ly *Layer) NeuroModNucleusDefaults() {
+	lp := ly.Params
+	lp.Inhib.ActAvg.Nominal = 0.5
+	lp.Inhib.Layer.On.SetBool(false)
+	lp.Inhib.Pool.On.SetBool(false)
+	lp.Acts.Decay.Act = 0
+	lp.Acts.Decay.Glong = 0
+	lp.Learn.TrgAvgAct.RescaleOn.SetBool(false)
+
+	for _, pj := range ly.RecvPaths {
+		pj.Params.SetFixedWts()
+		pj.Params.PathScale.Abs = 1
+	}
+}
+
 func (ly *LayerParams) VSPatchDefaults() {
 	ly.Acts.Decay.Act = 1
 	ly.Acts.Decay.Glong = 1
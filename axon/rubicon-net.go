@@ -407,6 +407,19 @@ func (nt *Network) AddVTALHbLDTLayers(rel relpos.Relations, space float32) (vta,
 	return
 }
 
+// AddLCDRNLayers adds LC norepinephrine (NE) and DRN serotonin (Ser)
+// layers, which compute the corresponding values in Global.
+func (nt *Network) AddLCDRNLayers(rel relpos.Relations, space float32) (lc, drn *Layer) {
+	lc = nt.AddLayer2D("LC", LCLayer, 1, 1)
+	drn = nt.AddLayer2D("DRN", DRNLayer, 1, 1)
+	if rel == relpos.Behind {
+		drn.PlaceBehind(lc, space)
+	} else {
+		drn.PlaceRightOf(lc, space)
+	}
+	return
+}
+
 // AddSCLayer2D adds superior colliculcus 2D layer
 // which computes stimulus onset via trial-delayed inhibition
 // (Inhib.FFPrv) -- connect with fixed random input from sensory
@@ -613,6 +626,7 @@ func (nt *Network) AddACCost(nCosts, accY, accX int, space float32) (acc, accCT,
 // Uses the network Rubicon.NPosUSs, NNegUSs, NCosts for number of pools --
 // must be configured prior to calling this.  Calls:
 // * AddVTALHbLDTLayers
+// * AddLCDRNLayers
 // * AddRubiconPulvLayers
 // * AddVS
 // * AddAmygdala
@@ -630,6 +644,10 @@ func (nt *Network) AddRubiconOFCus(nYneur, popY, popX, bgY, bgX, ofcY, ofcX int,
 	_ = lhb
 	_ = ldt
 
+	lc, drn := nt.AddLCDRNLayers(relpos.Behind, space)
+	lc.PlaceBehind(ldt, space)
+	_ = drn
+
 	drives, drivesP, urgency, usPos, usNeg, cost, costFinal, usPosP, usNegP, costP, pvPos, pvNeg, pvPosP, pvNegP := nt.AddRubiconPulvLayers(nYneur, popY, popX, space)
 	_ = urgency
 
@@ -1002,6 +1020,7 @@ func (nt *Network) AddRubiconOFCus(nYneur, popY, popX, bgY, bgX, ofcY, ofcX int,
 // Needs CS -> BLA, OFC connections to be made.
 // Returns layers most likely to be used for remaining connections and positions.
 func (nt *Network) AddRubicon(nYneur, popY, popX, bgY, bgX, pfcY, pfcX int, space float32) (vSgpi, vSmtxGo, vSmtxNo, urgency, pvPos, blaPosAcq, blaPosExt, blaNegAcq, blaNegExt, blaNov, ofcPos, ofcPosCT, ofcPosPT, ofcPosPTp, ilPos, ilPosCT, ilPosPT, ilPosPTp, ofcNeg, ofcNegCT, ofcNegPT, ofcNegPTp, ilNeg, ilNegCT, ilNegPT, ilNegPTp, accCost, plUtil, sc *Layer) {
+	defer nt.endSpecBuilder(nt.startSpecBuilder(&LayerSpec{Name: "Rubicon", Builder: &BuilderSpec{Type: BuildRubicon, Space: space, NYneur: nYneur, PopShape: []int{popY, popX}, BGShape: []int{bgY, bgX}, PFCShape: []int{pfcY, pfcX}}}))
 
 	full := paths.NewFull()
 	var pt *Path
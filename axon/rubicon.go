@@ -173,6 +173,11 @@ type UrgencyParams struct {
 
 	// gain factor for driving tonic DA levels as a function of urgency
 	DAtonic float32 `default:"50"`
+
+	// SerInhib is the proportion by which serotonin (GvSer, from DRNLayer)
+	// reduces the tonic DA driven by urgency, producing more patient behavior.
+	// Defaults to 0 so that existing models are unaffected.
+	SerInhib float32 `default:"0" min:"0" max:"1"`
 }
 
 func (ur *UrgencyParams) Defaults() {
@@ -180,6 +185,7 @@ func (ur *UrgencyParams) Defaults() {
 	ur.Power = 4
 	ur.Thr = 0.2
 	ur.DAtonic = 50
+	ur.SerInhib = 0
 }
 
 func (ur *UrgencyParams) Update() {
@@ -214,7 +220,8 @@ func (ur *UrgencyParams) Urge(di uint32) float32 {
 		urge = 0
 	}
 	GlobalScalars.Set(urge, int(GvUrgency), int(di))
-	GlobalScalars.Set(ur.DAtonic*urge, int(GvDAtonic), int(di)) // simple equation for now
+	serInhib := 1 - ur.SerInhib*GlobalScalars.Value(int(GvSer), int(di))
+	GlobalScalars.Set(ur.DAtonic*urge*serInhib, int(GvDAtonic), int(di)) // simple equation for now
 	return urge
 }
 
@@ -514,6 +521,17 @@ type GiveUpParams struct {
 	// values over time
 	ProgressRateTau float32 `default:"2"`
 
+	// NE is the factor multiplying norepinephrine (GvNE, from LCLayer)
+	// as a give up factor: high NE levels drive disengagement from the
+	// current goal and exploration of alternatives.
+	// Defaults to 0 so that existing models are unaffected.
+	NE float32 `default:"0"`
+
+	// SerPatience is the proportion by which serotonin (GvSer, from DRNLayer)
+	// reduces the give up utility cost factor, supporting patience.
+	// Defaults to 0 so that existing models are unaffected.
+	SerPatience float32 `default:"0" min:"0" max:"1"`
+
 	// 1/tau
 	ProgressRateDt float32 `display:"-"`
 }
@@ -528,6 +546,8 @@ func (gp *GiveUpParams) Defaults() {
 	gp.VSPatchSumMax = 1
 	gp.VSPatchVarMax = 0.5
 	gp.ProgressRateTau = 2
+	gp.NE = 0
+	gp.SerPatience = 0
 }
 
 func (gp *GiveUpParams) Update() {
@@ -554,7 +574,7 @@ func (gp *GiveUpParams) Prob(cnSum, guSum float32, rnd randx.Rand) (float32, boo
 // contributions to the probability function.
 func (gp *GiveUpParams) Sums(di uint32) (cnSum, guSum float32) {
 	negSum := GlobalScalars.Value(int(GvPVnegSum), int(di))
-	guU := gp.Utility * max(gp.MinUtility, negSum)
+	guU := gp.Utility * max(gp.MinUtility, negSum) * (1 - gp.SerPatience*GlobalScalars.Value(int(GvSer), int(di)))
 	cnU := gp.Utility * max(gp.MinUtility, GlobalScalars.Value(int(GvPVposEst), int(di))) // todo: var?
 	GlobalScalars.Set(guU, int(GvGiveUpUtility), int(di))
 	GlobalScalars.Set(cnU, int(GvContUtility), int(di))
@@ -572,7 +592,7 @@ func (gp *GiveUpParams) Sums(di uint32) (cnSum, guSum float32) {
 	GlobalScalars.Set(guP, int(GvGiveUpProgress), int(di))
 	GlobalScalars.Set(cnP, int(GvContProgress), int(di))
 
-	guSum = guU + guT + guP
+	guSum = guU + guT + guP + gp.NE*GlobalScalars.Value(int(GvNE), int(di))
 	cnSum = cnU + cnT + cnP
 	GlobalScalars.Set(guSum, int(GvGiveUpSum), int(di))
 	GlobalScalars.Set(cnSum, int(GvContSum), int(di))
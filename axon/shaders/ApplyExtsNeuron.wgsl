@@ -39,6 +39,20 @@ fn Index3D(s0: u32, s1: u32, s2: u32, i0: u32, i1: u32, i2: u32) -> u32 {
 
 //////// import: "vars.go"
 
+//////// import: "act-dend.go"
+alias DendVars = i32; //enums:enum
+const  DendVm: DendVars = 0;
+const  DendGeRaw: DendVars = 1;
+const  DendGeSyn: DendVars = 2;
+const  DendGnmdaSyn: DendVars = 3;
+const  DendGnmda: DendVars = 4;
+const  DendGnmdaLrn: DendVars = 5;
+const  DendNmdaCa: DendVars = 6;
+const  DendGvgcc: DendVars = 7;
+const  DendVgccM: DendVars = 8;
+const  DendVgccH: DendVars = 9;
+const  DendVgccCa: DendVars = 10;
+
 //////// import: "act-layer.go"
 fn LayerParams_ApplyExtFlags(ly: LayerParams, clearMask: ptr<function,NeuronFlags>,setMask: ptr<function,NeuronFlags>, toTarg: ptr<function,bool>) {
 	*clearMask = NeuronHasExt | NeuronHasTarg | NeuronHasCmpr;
@@ -107,12 +121,32 @@ const  InhibitoryG: PathGTypes = 1;
 const  ModulatoryG: PathGTypes = 2;
 const  MaintG: PathGTypes = 3;
 const  ContextG: PathGTypes = 4;
+const  DendInhibitoryG: PathGTypes = 5;
 struct SynComParams {
 	GType: PathGTypes,
 	Delay: u32,
 	MaxDelay: u32,
 	DelLen: u32,
 }
+alias STPVars = i32; //enums:enum
+const  STPUtil: STPVars = 0;
+const  STPRes: STPVars = 1;
+struct STPParams {
+	On: i32,
+	U: f32,
+	TauD: f32,
+	TauF: f32,
+	DecayD: f32,
+	DecayF: f32,
+	pad: f32,
+	pad1: f32,
+}
+struct GapJunctionParams {
+	G: f32,
+	pad: f32,
+	pad1: f32,
+	pad2: f32,
+}
 struct PathScaleParams {
 	Rel: f32,
 	Abs: f32,
@@ -149,7 +183,7 @@ struct DendParams {
 	ModGain: f32,
 	ModACh: i32,
 	ModBase: f32,
-	pad: i32,
+	GAxial: f32,
 }
 struct ActInitParams {
 	Vm: f32,
@@ -216,6 +250,16 @@ struct SMaintParams {
 	Inhib: f32,
 	ISI: F32,
 }
+struct IntrinsicParams {
+	On: i32,
+	Thr: i32,
+	Gl: i32,
+	TrgHz: f32,
+	ThrRate: f32,
+	ThrMax: f32,
+	GlRate: f32,
+	GlMax: f32,
+}
 struct PopCodeParams {
 	On: i32,
 	Ge: f32,
@@ -241,13 +285,17 @@ struct ActParams {
 	Sahp: SahpParams,
 	KNa: KNaMedSlow,
 	Kir: KirParams,
+	HCN: HCNParams,
 	NMDA: NMDAParams,
 	MaintNMDA: NMDAParams,
 	GabaB: GABABParams,
 	VGCC: VGCCParams,
 	AK: AKsParams,
+	CaT: CaTParams,
+	NaP: NaPParams,
 	SKCa: SKCaParams,
 	SMaint: SMaintParams,
+	Intrinsic: IntrinsicParams,
 	PopCode: PopCodeParams,
 }
 
@@ -263,6 +311,18 @@ struct AKsParams {
 	pad2: i32,
 }
 
+//////// import: "chans-cat.go"
+struct CaTParams {
+	Ge: f32,
+	Ca: f32,
+	Vshift: f32,
+	PhiM: f32,
+	PhiH: f32,
+	Mrest: f32,
+	Hrest: f32,
+	pad: f32,
+}
+
 //////// import: "chans-chans.go"
 struct Chans {
 	E: f32,
@@ -287,6 +347,18 @@ struct GABABParams {
 	pad2: f32,
 }
 
+//////// import: "chans-hcn.go"
+struct HCNParams {
+	Gbar: f32,
+	Erev: f32,
+	Voff: f32,
+	Vslope: f32,
+	TauFact: f32,
+	Mrest: f32,
+	pad: f32,
+	pad1: f32,
+}
+
 //////// import: "chans-kir.go"
 struct KirParams {
 	Gk: f32,
@@ -331,6 +403,22 @@ struct MahpParams {
 	pad2: i32,
 }
 
+//////// import: "chans-nap.go"
+struct NaPParams {
+	Ge: f32,
+	MVoff: f32,
+	MVslope: f32,
+	HVoff: f32,
+	HVslope: f32,
+	MTau: f32,
+	HTau: f32,
+	Mrest: f32,
+	Hrest: f32,
+	pad: f32,
+	pad1: f32,
+	pad2: f32,
+}
+
 //////// import: "chans-nmda.go"
 struct NMDAParams {
 	Ge: f32,
@@ -379,6 +467,26 @@ struct VGCCParams {
 	pad1: i32,
 }
 
+//////// import: "consol.go"
+alias ConsolRules = i32; //enums:enum
+const  NoConsol: ConsolRules = 0;
+const  ConsolEWC: ConsolRules = 1;
+const  ConsolSI: ConsolRules = 2;
+alias ConsolVars = i32; //enums:enum
+const  ConsolAnchor: ConsolVars = 0;
+const  ConsolImp: ConsolVars = 1;
+const  ConsolAcc: ConsolVars = 2;
+struct ConsolParams {
+	Rule: ConsolRules,
+	Strength: f32,
+	Tau: f32,
+	Xi: f32,
+	Keep: f32,
+	Dt: f32,
+	pad: f32,
+	pad1: f32,
+}
+
 //////// import: "context.go"
 const  NeuronTraceCycles = 10;
 const  NeuronTraceThetas = 3;
@@ -433,21 +541,21 @@ struct PulvinarParams {
 }
 
 //////// import: "enumgen.go"
-const PathGTypesN: PathGTypes = 5;
+const PathGTypesN: PathGTypes = 6;
 const GlobalScalarVarsN: GlobalScalarVars = 58;
 const GlobalVectorVarsN: GlobalVectorVars = 10;
-const GPUVarsN: GPUVars = 23;
-const LayerTypesN: LayerTypes = 37;
+const GPUVarsN: GPUVars = 28;
+const LayerTypesN: LayerTypes = 42;
 const LayerVarsN: LayerVars = 12;
 const ViewTimesN: ViewTimes = 7;
 const DAModTypesN: DAModTypes = 4;
 const ValenceTypesN: ValenceTypes = 3;
 const NeuronFlagsN: NeuronFlags = 9;
-const NeuronVarsN: NeuronVars = 100;
+const NeuronVarsN: NeuronVars = 112;
 const NeuronTracesVarsN: NeuronTracesVars = 2;
-const NeuronAvgVarsN: NeuronAvgVars = 7;
+const NeuronAvgVarsN: NeuronAvgVars = 9;
 const NeuronIndexVarsN: NeuronIndexVars = 3;
-const PathTypesN: PathTypes = 14;
+const PathTypesN: PathTypes = 16;
 const GPLayerTypesN: GPLayerTypes = 3;
 const PoolIndexVarsN: PoolIndexVars = 4;
 const PoolIntVarsN: PoolIntVars = 6;
@@ -457,6 +565,16 @@ const AvgMaxVarsN: AvgMaxVars = 7;
 const SynapseVarsN: SynapseVars = 5;
 const SynapseTraceVarsN: SynapseTraceVars = 3;
 const SynapseIndexVarsN: SynapseIndexVars = 3;
+const STPVarsN: STPVars = 2;
+const DendVarsN: DendVars = 11;
+const RegrowModesN: RegrowModes = 2;
+const STDPRulesN: STDPRules = 3;
+const STDPVarsN: STDPVars = 4;
+const ConsolRulesN: ConsolRules = 3;
+const ConsolVarsN: ConsolVars = 3;
+const ThreeFactorRulesN: ThreeFactorRules = 3;
+const ThreeFactorModsN: ThreeFactorMods = 2;
+const WtBoundModesN: WtBoundModes = 5;
 
 //////// import: "fsfffb-enumgen.go"
 const InhibVarsN: InhibVars = 19;
@@ -603,10 +721,34 @@ struct InhibParams {
 	ActAvg: ActAvgParams,
 	Layer: GiParams,
 	Pool: GiParams,
+	Interneurons: i32,
+	pad: i32,
+	pad1: i32,
+	pad2: i32,
 }
 
 //////// import: "init-layer.go"
 
+//////// import: "kinase-cathr.go"
+struct CaThrParams { //types:add
+	Tau: f32,
+	CaTau: f32,
+	CaPre: f32,
+	CaPost: f32,
+	PreDelay: i32,
+	ThrD: f32,
+	ThrP: f32,
+	GainD: f32,
+	GainP: f32,
+	RhoStar: f32,
+	Noise: f32,
+	CaDt: f32,
+	Dt: f32,
+	NoiseDt: f32,
+	pad: f32,
+	pad1: f32,
+}
+
 //////// import: "kinase-params.go"
 struct CaDtParams { //types:add
 	MTau: f32,
@@ -640,6 +782,10 @@ struct LayerIndexes {
 	ShpPlX: i32,
 	ShpUnY: i32,
 	ShpUnX: i32,
+	DendSt: u32,
+	DendN: u32,
+	pad: u32,
+	pad1: u32,
 }
 struct LayerInhibIndexes {
 	Index1: i32,
@@ -666,6 +812,8 @@ struct LayerParams {
 	Nuclear: NuclearParams,
 	LDT: LDTParams,
 	VTA: VTAParams,
+	LC: LCParams,
+	DRN: DRNParams,
 	RWPred: RWPredParams,
 	RWDa: RWDaParams,
 	TDInteg: TDIntegParams,
@@ -712,6 +860,11 @@ const  RWDaLayer: LayerTypes = 33;
 const  TDPredLayer: LayerTypes = 34;
 const  TDIntegLayer: LayerTypes = 35;
 const  TDDaLayer: LayerTypes = 36;
+const  InhibPVLayer: LayerTypes = 37;
+const  InhibSSTLayer: LayerTypes = 38;
+const  InhibVIPLayer: LayerTypes = 39;
+const  LCLayer: LayerTypes = 40;
+const  DRNLayer: LayerTypes = 41;
 fn IsExtLayerType(lt: LayerTypes) -> bool {
 	if (lt == InputLayer || lt == TargetLayer || lt == CompareLayer || lt == RewLayer) {
 		return true;
@@ -815,10 +968,27 @@ struct SWtAdaptParams {
 	pad: f32,
 	pad1: f32,
 }
+alias WtBoundModes = i32; //enums:enum
+const  SoftBound: WtBoundModes = 0;
+const  HardBound: WtBoundModes = 1;
+const  MultBound: WtBoundModes = 2;
+const  LogNormBound: WtBoundModes = 3;
+const  DaleBound: WtBoundModes = 4;
+struct WtBoundParams {
+	Mode: WtBoundModes,
+	MuPlus: f32,
+	MuMinus: f32,
+	Ref: f32,
+	Min: f32,
+	Max: f32,
+	pad: f32,
+	pad1: f32,
+}
 struct SWtParams {
 	Init: SWtInitParams,
 	Adapt: SWtAdaptParams,
 	Limit: F32,
+	Bound: WtBoundParams,
 }
 struct LRateParams {
 	Base: f32,
@@ -850,6 +1020,9 @@ struct LearnSynParams {
 	LRate: LRateParams,
 	DWt: DWtParams,
 	Hebb: HebbParams,
+	STDP: STDPParams,
+	Consol: ConsolParams,
+	ThreeFactor: ThreeFactorParams,
 }
 
 //////// import: "looper.go"
@@ -900,6 +1073,10 @@ struct NetworkIndexes {
 	RubiconNPosUSs: u32,
 	RubiconNCosts: u32,
 	RubiconNNegUSs: u32,
+	NSTDPSyns: u32,
+	pad: u32,
+	pad1: u32,
+	pad2: u32,
 }
 
 //////// import: "neuromod.go"
@@ -922,6 +1099,10 @@ struct NeuroModParams {
 	AChDisInhib: f32,
 	BurstGain: f32,
 	DipGain: f32,
+	NEGain: f32,
+	NELRateMod: f32,
+	SerLRateMod: f32,
+	NENoise: f32,
 	pad: f32,
 	pad1: f32,
 	pad2: f32,
@@ -986,54 +1167,66 @@ const  VgccM: NeuronVars = 48;
 const  VgccH: NeuronVars = 49;
 const  VgccCa: NeuronVars = 50;
 const  VgccCaInt: NeuronVars = 51;
-const  Burst: NeuronVars = 52;
-const  BurstPrv: NeuronVars = 53;
-const  CtxtGe: NeuronVars = 54;
-const  CtxtGeRaw: NeuronVars = 55;
-const  CtxtGeOrig: NeuronVars = 56;
-const  GgabaB: NeuronVars = 57;
-const  GababM: NeuronVars = 58;
-const  GababX: NeuronVars = 59;
-const  Gak: NeuronVars = 60;
-const  SSGiDend: NeuronVars = 61;
-const  GknaMed: NeuronVars = 62;
-const  GknaSlow: NeuronVars = 63;
-const  Gkir: NeuronVars = 64;
-const  KirM: NeuronVars = 65;
-const  Gsk: NeuronVars = 66;
-const  SKCaIn: NeuronVars = 67;
-const  SKCaR: NeuronVars = 68;
-const  SKCaM: NeuronVars = 69;
-const  Gmahp: NeuronVars = 70;
-const  MahpN: NeuronVars = 71;
-const  Gsahp: NeuronVars = 72;
-const  SahpCa: NeuronVars = 73;
-const  SahpN: NeuronVars = 74;
-const  ActM: NeuronVars = 75;
-const  ActP: NeuronVars = 76;
-const  Beta1: NeuronVars = 77;
-const  Beta2: NeuronVars = 78;
-const  CaPMax: NeuronVars = 79;
-const  CaPMaxCa: NeuronVars = 80;
-const  GeNoise: NeuronVars = 81;
-const  GeNoiseP: NeuronVars = 82;
-const  GiNoise: NeuronVars = 83;
-const  GiNoiseP: NeuronVars = 84;
-const  GeExt: NeuronVars = 85;
-const  GeRaw: NeuronVars = 86;
-const  GeSyn: NeuronVars = 87;
-const  GiRaw: NeuronVars = 88;
-const  GiSyn: NeuronVars = 89;
-const  GeInt: NeuronVars = 90;
-const  GeIntNorm: NeuronVars = 91;
-const  GiInt: NeuronVars = 92;
-const  GModRaw: NeuronVars = 93;
-const  GModSyn: NeuronVars = 94;
-const  SMaintP: NeuronVars = 95;
-const  GMaintRaw: NeuronVars = 96;
-const  GMaintSyn: NeuronVars = 97;
-const  NeurFlags: NeuronVars = 98;
-const  NeuronTraces: NeuronVars = 99;
+const  Gcat: NeuronVars = 52;
+const  CatM: NeuronVars = 53;
+const  CatH: NeuronVars = 54;
+const  CatCa: NeuronVars = 55;
+const  Gnap: NeuronVars = 56;
+const  NapM: NeuronVars = 57;
+const  NapH: NeuronVars = 58;
+const  Igap: NeuronVars = 59;
+const  GapVm: NeuronVars = 60;
+const  Burst: NeuronVars = 61;
+const  BurstPrv: NeuronVars = 62;
+const  CtxtGe: NeuronVars = 63;
+const  CtxtGeRaw: NeuronVars = 64;
+const  CtxtGeOrig: NeuronVars = 65;
+const  GgabaB: NeuronVars = 66;
+const  GababM: NeuronVars = 67;
+const  GababX: NeuronVars = 68;
+const  Gak: NeuronVars = 69;
+const  SSGiDend: NeuronVars = 70;
+const  GiDendSyn: NeuronVars = 71;
+const  GknaMed: NeuronVars = 72;
+const  GknaSlow: NeuronVars = 73;
+const  Gkir: NeuronVars = 74;
+const  KirM: NeuronVars = 75;
+const  Gh: NeuronVars = 76;
+const  HcnM: NeuronVars = 77;
+const  Gsk: NeuronVars = 78;
+const  SKCaIn: NeuronVars = 79;
+const  SKCaR: NeuronVars = 80;
+const  SKCaM: NeuronVars = 81;
+const  Gmahp: NeuronVars = 82;
+const  MahpN: NeuronVars = 83;
+const  Gsahp: NeuronVars = 84;
+const  SahpCa: NeuronVars = 85;
+const  SahpN: NeuronVars = 86;
+const  ActM: NeuronVars = 87;
+const  ActP: NeuronVars = 88;
+const  Beta1: NeuronVars = 89;
+const  Beta2: NeuronVars = 90;
+const  CaPMax: NeuronVars = 91;
+const  CaPMaxCa: NeuronVars = 92;
+const  GeNoise: NeuronVars = 93;
+const  GeNoiseP: NeuronVars = 94;
+const  GiNoise: NeuronVars = 95;
+const  GiNoiseP: NeuronVars = 96;
+const  GeExt: NeuronVars = 97;
+const  GeRaw: NeuronVars = 98;
+const  GeSyn: NeuronVars = 99;
+const  GiRaw: NeuronVars = 100;
+const  GiSyn: NeuronVars = 101;
+const  GeInt: NeuronVars = 102;
+const  GeIntNorm: NeuronVars = 103;
+const  GiInt: NeuronVars = 104;
+const  GModRaw: NeuronVars = 105;
+const  GModSyn: NeuronVars = 106;
+const  SMaintP: NeuronVars = 107;
+const  GMaintRaw: NeuronVars = 108;
+const  GMaintSyn: NeuronVars = 109;
+const  NeurFlags: NeuronVars = 110;
+const  NeuronTraces: NeuronVars = 111;
 alias NeuronTracesVars = i32; //enums:enum
 const  CaSynTrace: NeuronTracesVars = 0;
 const  RecvLearnTrace: NeuronTracesVars = 1;
@@ -1045,6 +1238,8 @@ const  DTrgAvg: NeuronAvgVars = 3;
 const  AvgDif: NeuronAvgVars = 4;
 const  GeBase: NeuronAvgVars = 5;
 const  GiBase: NeuronAvgVars = 6;
+const  IPThr: NeuronAvgVars = 7;
+const  IPGl: NeuronAvgVars = 8;
 alias NeuronIndexVars = i32; //enums:enum
 const  NrnNeurIndex: NeuronIndexVars = 0;
 const  NrnLayIndex: NeuronIndexVars = 1;
@@ -1098,7 +1293,11 @@ struct PathIndexes {
 	RecvConSt: u32,
 	RecvSynSt: u32,
 	NPathNeurSt: u32,
+	STDPSt: u32,
+	ConsolSt: u32,
 	pad: u32,
+	pad1: u32,
+	pad2: u32,
 }
 struct GScaleValues {
 	Scale: f32,
@@ -1109,10 +1308,13 @@ struct GScaleValues {
 struct PathParams {
 	Type: PathTypes,
 	Index: u32,
+	DendComp: i32,
 	pad: i32,
-	pad1: i32,
 	Indexes: PathIndexes,
 	Com: SynComParams,
+	STP: STPParams,
+	Gap: GapJunctionParams,
+	StructPlast: StructPlastParams,
 	PathScale: PathScaleParams,
 	SWts: SWtParams,
 	Learn: LearnSynParams,
@@ -1140,6 +1342,8 @@ const  RWPath: PathTypes = 10;
 const  TDPredPath: PathTypes = 11;
 const  BLAPath: PathTypes = 12;
 const  HipPath: PathTypes = 13;
+const  GapJunctionPath: PathTypes = 14;
+const  ActorPath: PathTypes = 15;
 
 //////// import: "pcore-layer.go"
 struct DSMatrixParams {
@@ -1261,8 +1465,8 @@ struct TDDaParams {
 struct RLPredPathParams {
 	OppSignLRate: f32,
 	DaTol: f32,
+	Lambda: f32,
 	pad: f32,
-	pad1: f32,
 }
 
 //////// import: "rubicon-layer.go"
@@ -1282,6 +1486,18 @@ struct VTAParams {
 	AChThr: f32,
 	pad: f32,
 }
+struct LCParams {
+	Tonic: f32,
+	SurpriseGain: f32,
+	UncertGain: f32,
+	pad: f32,
+}
+struct DRNParams {
+	Tonic: f32,
+	NegGain: f32,
+	WaitGain: f32,
+	UrgencyInhib: f32,
+}
 
 //////// import: "rubicon-path.go"
 struct BLAPathParams {
@@ -1295,6 +1511,51 @@ struct BLAPathParams {
 
 //////// import: "stats.go"
 
+//////// import: "stdp.go"
+const STDPMaxSpikes = 64;
+alias STDPRules = i32; //enums:enum
+const  NoSTDP: STDPRules = 0;
+const  PairSTDP: STDPRules = 1;
+const  TripletSTDP: STDPRules = 2;
+alias STDPVars = i32; //enums:enum
+const  STDPPre: STDPVars = 0;
+const  STDPPre2: STDPVars = 1;
+const  STDPPost: STDPVars = 2;
+const  STDPPost2: STDPVars = 3;
+struct STDPParams {
+	Rule: STDPRules,
+	TauPlus: f32,
+	TauMinus: f32,
+	TauX: f32,
+	TauY: f32,
+	APlus: f32,
+	AMinus: f32,
+	A3Plus: f32,
+	A3Minus: f32,
+	DtPlus: f32,
+	DtMinus: f32,
+	DtX: f32,
+	DtY: f32,
+	pad: f32,
+	pad1: f32,
+	pad2: f32,
+}
+
+//////// import: "structural.go"
+alias RegrowModes = i32; //enums:enum
+const  RegrowRandom: RegrowModes = 0;
+const  RegrowCorrel: RegrowModes = 1;
+struct StructPlastParams {
+	On: i32,
+	Regrow: RegrowModes,
+	WtThr: f32,
+	SWtThr: f32,
+	MaxFrac: f32,
+	pad: f32,
+	pad1: f32,
+	pad2: f32,
+}
+
 //////// import: "synapse.go"
 alias SynapseVars = i32; //enums:enum
 const  Wt: SynapseVars = 0;
@@ -1311,6 +1572,25 @@ const  SynRecvIndex: SynapseIndexVars = 0;
 const  SynSendIndex: SynapseIndexVars = 1;
 const  SynPathIndex: SynapseIndexVars = 2;
 
+//////// import: "threefactor.go"
+alias ThreeFactorRules = i32; //enums:enum
+const  NoThreeFactor: ThreeFactorRules = 0;
+const  HebbThreeFactor: ThreeFactorRules = 1;
+const  PerturbThreeFactor: ThreeFactorRules = 2;
+alias ThreeFactorMods = i32; //enums:enum
+const  ThreeFactorGlobal: ThreeFactorMods = 0;
+const  ThreeFactorLayer: ThreeFactorMods = 1;
+struct ThreeFactorParams {
+	Rule: ThreeFactorRules,
+	Mod: ThreeFactorMods,
+	Global: GlobalScalarVars,
+	ModLayIndex: i32,
+	TraceDecay: f32,
+	Delayed: i32,
+	pad: f32,
+	pad1: f32,
+}
+
 //////// import: "slrand.wgsl"
 fn Philox2x32round(counter: su64, key: u32) -> su64 {
 	let mul = Uint32Mul64(u32(0xD256D193), counter.x);
@@ -28,6 +28,8 @@ var<storage, read_write> GlobalScalars: array<f32>;
 var<storage, read_write> GlobalVectors: array<f32>;
 @group(2) @binding(7)
 var<storage, read_write> Pools: array<f32>;
+@group(2) @binding(9)
+var<storage, read_write> Dendrites: array<f32>;
 // // PathGBuf is the conductance buffer for accumulating spikes. // Subslices are allocated to each pathway. // Uses int-encoded values for faster GPU atomic integration. // [NPathNeur][Data][MaxDel+1]; NPathNeur = [Layer][RecvPaths][RecvNeurons] 
 
 alias GPUVars = i32;
@@ -49,6 +51,81 @@ fn Index3D(s0: u32, s1: u32, s2: u32, i0: u32, i1: u32, i2: u32) -> u32 {
 
 //////// import: "vars.go"
 
+//////// import: "act-dend.go"
+alias DendVars = i32; //enums:enum
+const  DendVm: DendVars = 0;
+const  DendGeRaw: DendVars = 1;
+const  DendGeSyn: DendVars = 2;
+const  DendGnmdaSyn: DendVars = 3;
+const  DendGnmda: DendVars = 4;
+const  DendGnmdaLrn: DendVars = 5;
+const  DendNmdaCa: DendVars = 6;
+const  DendGvgcc: DendVars = 7;
+const  DendVgccM: DendVars = 8;
+const  DendVgccH: DendVars = 9;
+const  DendVgccCa: DendVars = 10;
+fn LayerParams_DendIndex(ly: LayerParams, ni: u32,dc: u32) -> u32 {
+	return ly.Indexes.DendSt + (ni-ly.Indexes.NeurSt)*ly.Indexes.DendN + dc - 1;
+}
+fn LayerParams_DendGFromRaw(ly: LayerParams, ctx: Context, ni: u32,di: u32) {
+	for (var dc = u32(1);
+	 dc <= ly.Indexes.DendN; dc++) {
+		var dci = LayerParams_DendIndex(ly, ni, dc);
+		var v = Dendrites[Index3D(TensorStrides[150], TensorStrides[151], TensorStrides[152], u32(dci), u32(di), u32(DendVm))];
+		var geRaw = max(Dendrites[Index3D(TensorStrides[150], TensorStrides[151], TensorStrides[152], u32(dci), u32(di), u32(DendGeRaw))], 0.0);
+		if (ly.Acts.NMDA.Ge > 0) {
+			Dendrites[Index3D(TensorStrides[150], TensorStrides[151], TensorStrides[152], u32(dci), u32(di), u32(DendGnmdaSyn))] = NMDAParams_NMDASyn(ly.Acts.NMDA, Dendrites[Index3D(TensorStrides[150], TensorStrides[151], TensorStrides[152], u32(dci), u32(di), u32(DendGnmdaSyn))], geRaw);
+			Dendrites[Index3D(TensorStrides[150], TensorStrides[151], TensorStrides[152], u32(dci), u32(di), u32(DendGnmda))] = NMDAParams_Gnmda(ly.Acts.NMDA, Dendrites[Index3D(TensorStrides[150], TensorStrides[151], TensorStrides[152], u32(dci), u32(di), u32(DendGnmdaSyn))], v);
+		}
+		Dendrites[Index3D(TensorStrides[150], TensorStrides[151], TensorStrides[152], u32(dci), u32(di), u32(DendGnmdaLrn))] = NMDAParams_NMDASyn(ly.Learn.LearnNMDA, Dendrites[Index3D(TensorStrides[150], TensorStrides[151], TensorStrides[152], u32(dci), u32(di), u32(DendGnmdaLrn))], geRaw);
+		var gnmda = NMDAParams_Gnmda(ly.Learn.LearnNMDA, Dendrites[Index3D(TensorStrides[150], TensorStrides[151], TensorStrides[152], u32(dci), u32(di), u32(DendGnmdaLrn))], v);
+		Dendrites[Index3D(TensorStrides[150], TensorStrides[151], TensorStrides[152], u32(dci), u32(di), u32(DendNmdaCa))] = gnmda * NMDAParams_CaFromV(ly.Learn.LearnNMDA, v);
+		if (ly.Acts.VGCC.Ge > 0) {
+			var m = Dendrites[Index3D(TensorStrides[150], TensorStrides[151], TensorStrides[152], u32(dci), u32(di), u32(DendVgccM))];
+			var h = Dendrites[Index3D(TensorStrides[150], TensorStrides[151], TensorStrides[152], u32(dci), u32(di), u32(DendVgccH))];
+			var gvgcc = VGCCParams_Gvgcc(ly.Acts.VGCC, v, m, h);
+			Dendrites[Index3D(TensorStrides[150], TensorStrides[151], TensorStrides[152], u32(dci), u32(di), u32(DendGvgcc))] = gvgcc;
+			Dendrites[Index3D(TensorStrides[150], TensorStrides[151], TensorStrides[152], u32(dci), u32(di), u32(DendVgccM))] = m + VGCCParams_DeltaMFromV(ly.Acts.VGCC, v, m);
+			Dendrites[Index3D(TensorStrides[150], TensorStrides[151], TensorStrides[152], u32(dci), u32(di), u32(DendVgccH))] = h + VGCCParams_DeltaHFromV(ly.Acts.VGCC, v, h);
+			Dendrites[Index3D(TensorStrides[150], TensorStrides[151], TensorStrides[152], u32(dci), u32(di), u32(DendVgccCa))] = VGCCParams_CaFromG(ly.Acts.VGCC, v, gvgcc, Dendrites[Index3D(TensorStrides[150], TensorStrides[151], TensorStrides[152], u32(dci), u32(di), u32(DendVgccCa))]);
+		}
+		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(NmdaCa))] += Dendrites[Index3D(TensorStrides[150], TensorStrides[151], TensorStrides[152], u32(dci), u32(di), u32(DendNmdaCa))];
+		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(VgccCaInt))] += Dendrites[Index3D(TensorStrides[150], TensorStrides[151], TensorStrides[152], u32(dci), u32(di), u32(DendVgccCa))];
+	}
+}
+fn LayerParams_DendVmFromG(ly: LayerParams, ctx: Context, ni: u32,di: u32) {
+	var dn = ly.Indexes.DendN;
+	if (dn == 0) {
+		return;
+	}
+	var gax = ly.Acts.Dend.GAxial;
+	var gi = (Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Gi))] + Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(SSGiDend))]) * ly.Acts.Gbar.I;
+	var gk = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(GgabaB))] * ly.Acts.Gbar.K;
+	var dtEff = ly.Acts.Dt.VmDendDt * ly.Acts.Dt.DtStep;
+	var v1 = Dendrites[Index3D(TensorStrides[150], TensorStrides[151], TensorStrides[152], u32(LayerParams_DendIndex(ly, ni, u32(u32(1)))), u32(di), u32(DendVm))];
+	var vprev = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(VmDend))];
+	for (var dc = u32(1);
+	 dc <= dn; dc++) {
+		var dci = LayerParams_DendIndex(ly, ni, dc);
+		var v = Dendrites[Index3D(TensorStrides[150], TensorStrides[151], TensorStrides[152], u32(dci), u32(di), u32(DendVm))];
+		var vnext = v;
+		if (dc < dn) {
+			vnext = Dendrites[Index3D(TensorStrides[150], TensorStrides[151], TensorStrides[152], u32(dci + 1), u32(di), u32(DendVm))];
+		}
+		var ge = (Dendrites[Index3D(TensorStrides[150], TensorStrides[151], TensorStrides[152], u32(dci), u32(di), u32(DendGeSyn))] + Dendrites[Index3D(TensorStrides[150], TensorStrides[151], TensorStrides[152], u32(dci), u32(di), u32(DendGnmda))] + Dendrites[Index3D(TensorStrides[150], TensorStrides[151], TensorStrides[152], u32(dci), u32(di), u32(DendGvgcc))]) * ly.Acts.Gbar.E;
+		var nvm = v;
+		for (var i = i32(0);
+		 i < ly.Acts.Dt.VmSteps; i++) {
+			var inet = ActParams_InetFromG(ly.Acts, nvm, ge, f32(f32(1)), gi, gk, f32(f32(0))) + gax*(vprev+vnext-2*nvm);
+			nvm = ActParams_VmFromInet(ly.Acts, nvm, dtEff, inet);
+		}
+		Dendrites[Index3D(TensorStrides[150], TensorStrides[151], TensorStrides[152], u32(dci), u32(di), u32(DendVm))] = nvm;
+		vprev = nvm;
+	}
+	var vmd = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(VmDend))];
+	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(VmDend))] = ActParams_VmFromInet(ly.Acts, vmd, ly.Acts.Dt.VmDendDt, gax*(v1-vmd));
+}
+
 //////// import: "act-layer.go"
 fn SetNeuronExtPosNeg(ctx: Context, ni: u32,di: u32, val: f32) {
 	if (ni == 0) {
@@ -211,6 +288,16 @@ fn LayerParams_SpecialPreGs(ly: LayerParams, ctx: Context, pi: u32,ni: u32,di: u
 		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(GeRaw))] = geRaw;
 		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(GeSyn))] = DtParams_GeSynFromRawSteady(ly.Acts.Dt, geRaw);
 	}
+	case LCLayer: {
+		var geRaw = 0.4 * GlobalScalars[Index2D(TensorStrides[100], TensorStrides[101], u32(GvNE), u32(di))];
+		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(GeRaw))] = geRaw;
+		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(GeSyn))] = DtParams_GeSynFromRawSteady(ly.Acts.Dt, geRaw);
+	}
+	case DRNLayer: {
+		var geRaw = 0.4 * GlobalScalars[Index2D(TensorStrides[100], TensorStrides[101], u32(GvSer), u32(di))];
+		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(GeRaw))] = geRaw;
+		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(GeSyn))] = DtParams_GeSynFromRawSteady(ly.Acts.Dt, geRaw);
+	}
 	case RewLayer: {
 		NeuronSetFlag(NeuronHasExt, ni, di);
 		SetNeuronExtPosNeg(ctx, ni, di, GlobalScalars[Index2D(TensorStrides[100], TensorStrides[101], // Rew must be set in Context!
@@ -323,16 +410,25 @@ fn LayerParams_GFromRawSyn(ly: LayerParams, ctx: Context, ni: u32,di: u32) {
 	ActParams_MaintNMDAFromRaw(ly.Acts, ctx, ni, di); // uses GMaintRaw directly
 	LearnNeuronParams_LearnNMDAFromRaw(ly.Learn, ctx, ni, di, geRaw);
 	ActParams_GvgccFromVm(ly.Acts, ctx, ni, di);
-	var ege = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Gnmda))] + Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(GnmdaMaint))] + Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Gvgcc))] + extraSyn;
+	ActParams_GcatFromVm(ly.Acts, ctx, ni, di);
+	ActParams_GnapFromVm(ly.Acts, ctx, ni, di);
+	LayerParams_DendGFromRaw(ly, ctx, ni, di);
+	var ege = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Gnmda))] + Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(GnmdaMaint))] + Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Gvgcc))] + Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Gcat))] + Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Gnap))] + extraSyn;
 	ActParams_GeFromSyn(ly.Acts, ctx, ni, di, geSyn, ege); // sets nrn.GeExt too
 	ActParams_GkFromVm(ly.Acts, ctx, ni, di);
+	ActParams_GhFromVm(ly.Acts, ctx, ni, di);
 	ActParams_GSkCaFromCa(ly.Acts, ctx, ni, di);
 	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(GiSyn))] = ActParams_GiFromSyn(ly.Acts, ctx, ni, di, Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(GiSyn))]);
 }
 fn LayerParams_GiInteg(ly: LayerParams, ctx: Context, pi: u32,ni: u32,di: u32) {
 	var giMult = LayerStates[Index3D(TensorStrides[90], TensorStrides[91], TensorStrides[92], u32(ly.Index), u32(di), u32(LayerGiMult))];
-	var gi = giMult*Pools[Index3D(TensorStrides[130], TensorStrides[131], TensorStrides[132], u32(pi), u32(di), u32(TotalGi))] + Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(GiSyn))] + Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(GiNoise))] + NeuroModParams_GiFromACh(ly.Learn.NeuroMod, GlobalScalars[Index2D(TensorStrides[100], TensorStrides[101], u32(GvACh), u32(di))]);
+	var poolGi = giMult * Pools[Index3D(TensorStrides[130], TensorStrides[131], TensorStrides[132], u32(pi), u32(di), u32(TotalGi))];
 	var ssgi = Pools[Index3D(TensorStrides[130], TensorStrides[131], TensorStrides[132], u32(pi), u32(di), u32(SSGi))];
+	if (ly.Inhib.Interneurons == 1) { // FS-FFFB is only computed for comparison
+		poolGi = f32(0);
+		ssgi = f32(0);
+	}
+	var gi = poolGi + Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(GiSyn))] + Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(GiNoise))] + NeuroModParams_GiFromACh(ly.Learn.NeuroMod, GlobalScalars[Index2D(TensorStrides[100], TensorStrides[101], u32(GvACh), u32(di))]);
 	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Gi))] = gi;
 	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(SSGiDend))] = 0.0;
 	if (ctx.PlusPhase == 1 && (ly.Type == PulvinarLayer)) {
@@ -344,6 +440,7 @@ fn LayerParams_GiInteg(ly: LayerParams, ctx: Context, pi: u32,ni: u32,di: u32) {
 			Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(SSGiDend))] = ly.Acts.Dend.SSGi * ssgi;
 		}
 	}
+	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(SSGiDend))] += Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(GiDendSyn))];
 	var vm = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(VmDend))];
 	var nrnGababM = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(GababM))];
 	var nrnGababX = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(GababX))];
@@ -358,12 +455,15 @@ fn LayerParams_GiInteg(ly: LayerParams, ctx: Context, pi: u32,ni: u32,di: u32) {
 }
 fn LayerParams_GNeuroMod(ly: LayerParams, ctx: Context, ni: u32,di: u32) {
 	var ggain = NeuroModParams_GGain(ly.Learn.NeuroMod, GlobalScalars[Index2D(TensorStrides[100], TensorStrides[101], u32(GvDA), u32(di))] + GlobalScalars[Index2D(TensorStrides[100], TensorStrides[101], u32(GvDAtonic), u32(di))]);
-	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Ge))] *= ggain;
-	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72],
-	u32(ni), u32(di), u32(Gi))] *= ggain;
+	var ne = GlobalScalars[Index2D(TensorStrides[100], TensorStrides[101], u32(GvNE), u32(di))];
+	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Ge))] *= ggain * NeuroModParams_NEGeGain(ly.Learn.NeuroMod, ne);
+	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Gi))] *= ggain;
+	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Ge))] += NeuroModParams_NENoiseGain(ly.Learn.NeuroMod, ne) * Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(GeNoise))];
 }
 fn LayerParams_SpikeFromG(ly: LayerParams, ctx: Context, lpi: u32,ni: u32,di: u32) {
 	ActParams_VmFromG(ly.Acts, ctx, ni, di);
+	LayerParams_DendVmFromG(ly, ctx, ni, di);
+	LayerParams_GapJunctions(ly, ctx, ni, di);
 	ActParams_SpikeFromVm(ly.Acts, ctx, ni, di);
 	LearnNeuronParams_CaFromSpike(ly.Learn, ctx, ni, di);
 	if (!LayerParams_IsNuclear(ly)) {
@@ -391,6 +491,22 @@ fn LayerParams_SpikeFromG(ly: LayerParams, ctx: Context, lpi: u32,ni: u32,di: u3
 		}
 	}
 }
+fn LayerParams_GapJunctions(ly: LayerParams, ctx: Context, ni: u32,di: u32) {
+	var igap = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Igap))];
+	if (igap == 0) {
+		return;
+	}
+	var isi = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(ISI))];
+	if (ly.Acts.Spikes.Tr > 0 && isi >= 0 && isi < f32(ly.Acts.Spikes.Tr)) {
+		return; // refractory
+	}
+	if (igap > ly.Acts.Dt.MaxI) {
+		igap = ly.Acts.Dt.MaxI;
+	} else if (igap < -ly.Acts.Dt.MaxI) {
+		igap = -ly.Acts.Dt.MaxI;
+	}
+	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Vm))] = ActParams_VmFromInet(ly.Acts, Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Vm))], ly.Acts.Dt.VmDt, igap);
+}
 
 //////// import: "act-net.go"
 fn CycleNeuron(i: u32) { //gosl:kernel
@@ -411,12 +527,32 @@ const  InhibitoryG: PathGTypes = 1;
 const  ModulatoryG: PathGTypes = 2;
 const  MaintG: PathGTypes = 3;
 const  ContextG: PathGTypes = 4;
+const  DendInhibitoryG: PathGTypes = 5;
 struct SynComParams {
 	GType: PathGTypes,
 	Delay: u32,
 	MaxDelay: u32,
 	DelLen: u32,
 }
+alias STPVars = i32; //enums:enum
+const  STPUtil: STPVars = 0;
+const  STPRes: STPVars = 1;
+struct STPParams {
+	On: i32,
+	U: f32,
+	TauD: f32,
+	TauF: f32,
+	DecayD: f32,
+	DecayF: f32,
+	pad: f32,
+	pad1: f32,
+}
+struct GapJunctionParams {
+	G: f32,
+	pad: f32,
+	pad1: f32,
+	pad2: f32,
+}
 struct PathScaleParams {
 	Rel: f32,
 	Abs: f32,
@@ -471,7 +607,7 @@ struct DendParams {
 	ModGain: f32,
 	ModACh: i32,
 	ModBase: f32,
-	pad: i32,
+	GAxial: f32,
 }
 struct ActInitParams {
 	Vm: f32,
@@ -572,6 +708,16 @@ fn SMaintParams_ExpInt(sm: SMaintParams, isi: f32) -> f32 {
 		return f32(0);
 	}return FastExp(-max(isi, sm.ISI.Min) / sm.NNeurons);
 }
+struct IntrinsicParams {
+	On: i32,
+	Thr: i32,
+	Gl: i32,
+	TrgHz: f32,
+	ThrRate: f32,
+	ThrMax: f32,
+	GlRate: f32,
+	GlMax: f32,
+}
 struct PopCodeParams {
 	On: i32,
 	Ge: f32,
@@ -634,13 +780,17 @@ struct ActParams {
 	Sahp: SahpParams,
 	KNa: KNaMedSlow,
 	Kir: KirParams,
+	HCN: HCNParams,
 	NMDA: NMDAParams,
 	MaintNMDA: NMDAParams,
 	GabaB: GABABParams,
 	VGCC: VGCCParams,
 	AK: AKsParams,
+	CaT: CaTParams,
+	NaP: NaPParams,
 	SKCa: SKCaParams,
 	SMaint: SMaintParams,
+	Intrinsic: IntrinsicParams,
 	PopCode: PopCodeParams,
 }
 fn ActParams_NMDAFromRaw(ac: ActParams, ctx: Context, ni: u32,di: u32, geTot: f32) {
@@ -691,6 +841,42 @@ fn ActParams_GvgccFromVm(ac: ActParams, ctx: Context, ni: u32,di: u32) {
 	TensorStrides[72], u32(ni), u32(di), u32(VgccH))] += dh;
 	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(VgccCa))] = VGCCParams_CaFromG(ac.VGCC, v, Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Gvgcc))], Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(VgccCa))]);
 }
+fn ActParams_GcatFromVm(ac: ActParams, ctx: Context, ni: u32,di: u32) {
+	if (ac.CaT.Ge == 0) {
+		return;
+	}
+	var v = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(VmDend))];
+	var m = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(CatM))];
+	var h = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(CatH))];
+	var g = CaTParams_Gcat(ac.CaT, m, h);
+	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Gcat))] = g;
+	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(CatM))] = m + CaTParams_DM(ac.CaT, v, m);
+	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(CatH))] = h + CaTParams_DH(ac.CaT, v, h);
+	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72],
+	u32(ni), u32(di), u32(CatCa))] = CaTParams_CaFromG(ac.CaT, v, g);
+}
+fn ActParams_GnapFromVm(ac: ActParams, ctx: Context, ni: u32,di: u32) {
+	if (ac.NaP.Ge == 0) {
+		return;
+	}
+	var v = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Vm))];
+	var m = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(NapM))];
+	var h = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(NapH))];
+	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Gnap))] = NaPParams_Gnap(ac.NaP, m, h);
+	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(NapM))] = m + NaPParams_DM(ac.NaP, v, m);
+	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72],
+	u32(ni), u32(di), u32(NapH))] = h + NaPParams_DH(ac.NaP, v, h);
+}
+fn ActParams_GhFromVm(ac: ActParams, ctx: Context, ni: u32,di: u32) {
+	if (ac.HCN.Gbar == 0) {
+		return;
+	}
+	var v = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(VmDend))];
+	var m = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(HcnM))];
+	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Gh))] = HCNParams_Gh(ac.HCN, m);
+	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72],
+	u32(ni), u32(di), u32(HcnM))] = m + HCNParams_DM(ac.HCN, v, m);
+}
 fn ActParams_GkFromVm(ac: ActParams, ctx: Context, ni: u32,di: u32) {
 	var vm = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Vm))];
 	var vmd = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(VmDend))];
@@ -776,8 +962,8 @@ fn ActParams_GiFromSyn(ac: ActParams, ctx: Context, ni: u32,di: u32, giSyn: f32)
 		return f32(0);
 	}return giSyn;
 }
-fn ActParams_InetFromG(ac: ActParams, vm: f32,ge: f32,gl: f32,gi: f32,gk: f32) -> f32 {
-	var inet = ge*(ac.Erev.E-vm) + gl*ac.Gbar.L*(ac.Erev.L-vm) + gi*(ac.Erev.I-vm) + gk*(ac.Erev.K-vm);
+fn ActParams_InetFromG(ac: ActParams, vm: f32,ge: f32,gl: f32,gi: f32,gk: f32,gh: f32) -> f32 {
+	var inet = ge*(ac.Erev.E-vm) + gl*ac.Gbar.L*(ac.Erev.L-vm) + gi*(ac.Erev.I-vm) + gk*(ac.Erev.K-vm) + gh*(ac.HCN.Erev-vm);
 	if (inet > ac.Dt.MaxI) {
 		inet = ac.Dt.MaxI;
 	} else if (inet < -ac.Dt.MaxI) {
@@ -787,12 +973,12 @@ fn ActParams_InetFromG(ac: ActParams, vm: f32,ge: f32,gl: f32,gi: f32,gk: f32) -
 fn ActParams_VmFromInet(ac: ActParams, vm: f32,dt: f32,inet: f32) -> f32 {
 	return F32_ClampValue(ac.VmRange, vm + dt*inet);
 }
-fn ActParams_VmInteg(ac: ActParams, vm: f32,dt: f32,ge: f32,gl: f32,gi: f32,gk: f32, nvm: ptr<function,f32>,inet: ptr<function,f32>) {
+fn ActParams_VmInteg(ac: ActParams, vm: f32,dt: f32,ge: f32,gl: f32,gi: f32,gk: f32,gh: f32, nvm: ptr<function,f32>,inet: ptr<function,f32>) {
 	var dtEff = dt * ac.Dt.DtStep;
 	*nvm = vm;
 	for (var i = i32(0);
 	 i < ac.Dt.VmSteps; i++) {
-		*inet = ActParams_InetFromG(ac, *nvm, ge, gl, gi, gk);
+		*inet = ActParams_InetFromG(ac, *nvm, ge, gl, gi, gk, gh);
 		*nvm = ActParams_VmFromInet(ac, *nvm, dtEff, *inet);
 	}
 }
@@ -805,17 +991,24 @@ fn ActParams_VmFromG(ac: ActParams, ctx: Context, ni: u32,di: u32) {
 	var ge = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Ge))] * ac.Gbar.E;
 	var gi = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Gi))] * ac.Gbar.I;
 	var gk = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Gk))] * ac.Gbar.K;
+	var gh = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Gh))];
+	var gl = f32(1);
+	var thr = ac.Spikes.Thr;
+	if (ac.Intrinsic.On == 1) {
+		gl += NeuronAvgs[Index2D(TensorStrides[80], TensorStrides[81], u32(ni), u32(IPGl))];
+		thr += NeuronAvgs[Index2D(TensorStrides[80], TensorStrides[81], u32(ni), u32(IPThr))];
+	}
 	var nvm: f32;
 	var inet: f32;
 	var expi: f32;
 	if (updtVm) {
-		ActParams_VmInteg(ac, Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Vm))], ac.Dt.VmDt, ge, f32(f32(1)), gi, gk, &nvm, &inet);
+		ActParams_VmInteg(ac, Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Vm))], ac.Dt.VmDt, ge, gl, gi, gk, gh, &nvm, &inet);
 		if (updtVm && ac.Spikes.Exp == 1) { // add spike current if relevant
 			var exVm: f32;
 			exVm = 0.5 * (nvm + Neurons[Index3D(TensorStrides[70], TensorStrides[71], // midpoint for this
 			TensorStrides[72], u32(ni), u32(di), u32(Vm))]);
 			expi = ac.Gbar.L * ac.Spikes.ExpSlope *
-				FastExp((exVm-ac.Spikes.Thr)/ac.Spikes.ExpSlope);
+				FastExp((exVm-thr)/ac.Spikes.ExpSlope);
 			if (expi > ac.Dt.MaxI) {
 				expi = ac.Dt.MaxI;
 			}
@@ -835,26 +1028,27 @@ fn ActParams_VmFromG(ac: ActParams, ctx: Context, ni: u32,di: u32) {
 		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Vm))] += dvm;
 		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Inet))] = dvm * ac.Dt.VmC;
 	}
-	var glEff = f32(1);
+	var glEff = gl;
 	if (!updtVm) {
 		glEff += ac.Dend.GR;
 	}
 	var giEff: f32;
 	giEff = gi + ac.Gbar.I*Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(SSGiDend))];
-	ActParams_VmInteg(ac, Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(VmDend))], ac.Dt.VmDendDt, ge, glEff, giEff, gk, &nvm, &inet);
+	ActParams_VmInteg(ac, Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(VmDend))], ac.Dt.VmDendDt, ge, glEff, giEff, gk, gh, &nvm, &inet);
 	if (updtVm) {
 		nvm = ActParams_VmFromInet(ac, nvm, ac.Dt.VmDendDt, ac.Dend.GExp*expi);
 	}
 	Neurons[Index3D(TensorStrides[70], TensorStrides[71],
 	TensorStrides[72], u32(ni), u32(di), u32(VmDend))] = nvm;
 }
-fn ActParams_SpikeFromVmVars(ac: ActParams, nrnISI: ptr<function,f32>,nrnISIAvg: ptr<function,f32>,nrnSpike: ptr<function,f32>,nrnSpiked: ptr<function,f32>,nrnAct: ptr<function,f32>, nrnVm: f32) {
+fn ActParams_SpikeFromVmVars(ac: ActParams, nrnISI: ptr<function,f32>,nrnISIAvg: ptr<function,f32>,nrnSpike: ptr<function,f32>,nrnSpiked: ptr<function,f32>,nrnAct: ptr<function,f32>, nrnVm: f32,thrOff: f32) {
 	var thr: f32;
 	if (ac.Spikes.Exp == 1) {
 		thr = ac.Spikes.ExpThr;
 	} else {
 		thr = ac.Spikes.Thr;
 	}
+	thr += thrOff;
 	if (nrnVm >= thr) {
 		*nrnSpike = f32(1);
 		if (*nrnISIAvg == -1) {
@@ -896,7 +1090,11 @@ fn ActParams_SpikeFromVm(ac: ActParams, ctx: Context, ni: u32,di: u32) {
 	var nrnSpiked = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Spiked))];
 	var nrnAct = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Act))];
 	var nrnVm = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Vm))];
-	ActParams_SpikeFromVmVars(ac, &nrnISI, &nrnISIAvg, &nrnSpike, &nrnSpiked, &nrnAct, nrnVm);
+	var thrOff = f32(0);
+	if (ac.Intrinsic.On == 1) {
+		thrOff = NeuronAvgs[Index2D(TensorStrides[80], TensorStrides[81], u32(ni), u32(IPThr))];
+	}
+	ActParams_SpikeFromVmVars(ac, &nrnISI, &nrnISIAvg, &nrnSpike, &nrnSpiked, &nrnAct, nrnVm, thrOff);
 	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(ISI))] = nrnISI;
 	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(ISIAvg))] = nrnISIAvg;
 	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Spike))] = nrnSpike;
@@ -925,6 +1123,46 @@ fn AKsParams_Gak(ap: AKsParams, v: f32) -> f32 {
 	return ap.Gk * AKsParams_MFromV(ap, v);
 }
 
+//////// import: "chans-cat.go"
+struct CaTParams {
+	Ge: f32,
+	Ca: f32,
+	Vshift: f32,
+	PhiM: f32,
+	PhiH: f32,
+	Mrest: f32,
+	Hrest: f32,
+	pad: f32,
+}
+fn CaTParams_Minf(cp: CaTParams, v: f32) -> f32 {
+	return 1.0 / (1.0 + FastExp(-(v+cp.Vshift+57)/6.2));
+}
+fn CaTParams_Hinf(cp: CaTParams, v: f32) -> f32 {
+	return 1.0 / (1.0 + FastExp((v+cp.Vshift+81)/4));
+}
+fn CaTParams_MTau(cp: CaTParams, v: f32) -> f32 {
+	var vs = v + cp.Vshift;
+return (0.612 + 1.0/(FastExp(-(vs+132)/16.7)+FastExp((vs+16.8)/18.2))) / cp.PhiM;
+}
+fn CaTParams_HTau(cp: CaTParams, v: f32) -> f32 {
+	var vs = v + cp.Vshift;
+	if (vs < -80) {
+		return FastExp((vs+467)/66.6) / cp.PhiH;
+	}return (28 + FastExp(-(vs+22)/10.5)) / cp.PhiH;
+}
+fn CaTParams_DM(cp: CaTParams, v: f32,m: f32) -> f32 {
+	return (CaTParams_Minf(cp, v) - m) * min(1.0/CaTParams_MTau(cp, v), 1.0);
+}
+fn CaTParams_DH(cp: CaTParams, v: f32,h: f32) -> f32 {
+	return (CaTParams_Hinf(cp, v) - h) * min(1.0/CaTParams_HTau(cp, v), 1.0);
+}
+fn CaTParams_Gcat(cp: CaTParams, m: f32,h: f32) -> f32 {
+	return cp.Ge * m * m * h;
+}
+fn CaTParams_CaFromG(cp: CaTParams, v: f32,g: f32) -> f32 {
+	return -v * cp.Ca * g;
+}
+
 //////// import: "chans-chans.go"
 struct Chans {
 	E: f32,
@@ -971,6 +1209,30 @@ fn GABABParams_GgabaB(gp: GABABParams, m: f32,v: f32) -> f32 {
 	return gp.Gk * GABABParams_GFromV(gp, v) * (m + gp.Gbase);
 }
 
+//////// import: "chans-hcn.go"
+struct HCNParams {
+	Gbar: f32,
+	Erev: f32,
+	Voff: f32,
+	Vslope: f32,
+	TauFact: f32,
+	Mrest: f32,
+	pad: f32,
+	pad1: f32,
+}
+fn HCNParams_Minf(hp: HCNParams, v: f32) -> f32 {
+	return 1.0 / (1.0 + FastExp((v-hp.Voff)/hp.Vslope));
+}
+fn HCNParams_MTau(hp: HCNParams, v: f32) -> f32 {
+	return hp.TauFact / (FastExp(-14.59-0.086*v) + FastExp(-1.87+0.0701*v));
+}
+fn HCNParams_DM(hp: HCNParams, v: f32,m: f32) -> f32 {
+	return (HCNParams_Minf(hp, v) - m) / HCNParams_MTau(hp, v);
+}
+fn HCNParams_Gh(hp: HCNParams, m: f32) -> f32 {
+	return hp.Gbar * m;
+}
+
 //////// import: "chans-kir.go"
 struct KirParams {
 	Gk: f32,
@@ -1076,6 +1338,41 @@ fn MahpParams_GmAHP(mp: MahpParams, v: f32, n: ptr<function,f32>) -> f32 {
 return g;
 }
 
+//////// import: "chans-nap.go"
+struct NaPParams {
+	Ge: f32,
+	MVoff: f32,
+	MVslope: f32,
+	HVoff: f32,
+	HVslope: f32,
+	MTau: f32,
+	HTau: f32,
+	Mrest: f32,
+	Hrest: f32,
+	pad: f32,
+	pad1: f32,
+	pad2: f32,
+}
+fn NaPParams_Minf(np: NaPParams, v: f32) -> f32 {
+	return 1.0 / (1.0 + FastExp(-(v-np.MVoff)/np.MVslope));
+}
+fn NaPParams_Hinf(np: NaPParams, v: f32) -> f32 {
+	return 1.0 / (1.0 + FastExp((v-np.HVoff)/np.HVslope));
+}
+fn NaPParams_HTauFromV(np: NaPParams, v: f32) -> f32 {
+	var x = (v - np.HVoff) / (2 * np.HVslope);
+return 2 * np.HTau / (FastExp(x) + FastExp(-x));
+}
+fn NaPParams_DM(np: NaPParams, v: f32,m: f32) -> f32 {
+	return (NaPParams_Minf(np, v) - m) * min(1.0/np.MTau, 1.0);
+}
+fn NaPParams_DH(np: NaPParams, v: f32,h: f32) -> f32 {
+	return (NaPParams_Hinf(np, v) - h) * min(1.0/NaPParams_HTauFromV(np, v), 1.0);
+}
+fn NaPParams_Gnap(np: NaPParams, m: f32,h: f32) -> f32 {
+	return np.Ge * m * h;
+}
+
 //////// import: "chans-nmda.go"
 struct NMDAParams {
 	Ge: f32,
@@ -1199,6 +1496,26 @@ fn VGCCParams_CaFromG(np: VGCCParams, v: f32,g: f32,ca: f32) -> f32 {
 	return -v * np.Ca * g;
 }
 
+//////// import: "consol.go"
+alias ConsolRules = i32; //enums:enum
+const  NoConsol: ConsolRules = 0;
+const  ConsolEWC: ConsolRules = 1;
+const  ConsolSI: ConsolRules = 2;
+alias ConsolVars = i32; //enums:enum
+const  ConsolAnchor: ConsolVars = 0;
+const  ConsolImp: ConsolVars = 1;
+const  ConsolAcc: ConsolVars = 2;
+struct ConsolParams {
+	Rule: ConsolRules,
+	Strength: f32,
+	Tau: f32,
+	Xi: f32,
+	Keep: f32,
+	Dt: f32,
+	pad: f32,
+	pad1: f32,
+}
+
 //////// import: "context.go"
 const  NeuronTraceCycles = 10;
 const  NeuronTraceThetas = 3;
@@ -1268,21 +1585,21 @@ fn LayerParams_PulvinarDriver(ly: LayerParams, ctx: Context, lni: u32,di: u32, d
 }
 
 //////// import: "enumgen.go"
-const PathGTypesN: PathGTypes = 5;
+const PathGTypesN: PathGTypes = 6;
 const GlobalScalarVarsN: GlobalScalarVars = 58;
 const GlobalVectorVarsN: GlobalVectorVars = 10;
-const GPUVarsN: GPUVars = 23;
-const LayerTypesN: LayerTypes = 37;
+const GPUVarsN: GPUVars = 28;
+const LayerTypesN: LayerTypes = 42;
 const LayerVarsN: LayerVars = 12;
 const ViewTimesN: ViewTimes = 7;
 const DAModTypesN: DAModTypes = 4;
 const ValenceTypesN: ValenceTypes = 3;
 const NeuronFlagsN: NeuronFlags = 9;
-const NeuronVarsN: NeuronVars = 100;
+const NeuronVarsN: NeuronVars = 112;
 const NeuronTracesVarsN: NeuronTracesVars = 2;
-const NeuronAvgVarsN: NeuronAvgVars = 7;
+const NeuronAvgVarsN: NeuronAvgVars = 9;
 const NeuronIndexVarsN: NeuronIndexVars = 3;
-const PathTypesN: PathTypes = 14;
+const PathTypesN: PathTypes = 16;
 const GPLayerTypesN: GPLayerTypes = 3;
 const PoolIndexVarsN: PoolIndexVars = 4;
 const PoolIntVarsN: PoolIntVars = 6;
@@ -1292,6 +1609,16 @@ const AvgMaxVarsN: AvgMaxVars = 7;
 const SynapseVarsN: SynapseVars = 5;
 const SynapseTraceVarsN: SynapseTraceVars = 3;
 const SynapseIndexVarsN: SynapseIndexVars = 3;
+const STPVarsN: STPVars = 2;
+const DendVarsN: DendVars = 11;
+const RegrowModesN: RegrowModes = 2;
+const STDPRulesN: STDPRules = 3;
+const STDPVarsN: STDPVars = 4;
+const ConsolRulesN: ConsolRules = 3;
+const ConsolVarsN: ConsolVars = 3;
+const ThreeFactorRulesN: ThreeFactorRules = 3;
+const ThreeFactorModsN: ThreeFactorMods = 2;
+const WtBoundModesN: WtBoundModes = 5;
 
 //////// import: "fsfffb-enumgen.go"
 const InhibVarsN: InhibVars = 19;
@@ -1438,10 +1765,34 @@ struct InhibParams {
 	ActAvg: ActAvgParams,
 	Layer: GiParams,
 	Pool: GiParams,
+	Interneurons: i32,
+	pad: i32,
+	pad1: i32,
+	pad2: i32,
 }
 
 //////// import: "init-layer.go"
 
+//////// import: "kinase-cathr.go"
+struct CaThrParams { //types:add
+	Tau: f32,
+	CaTau: f32,
+	CaPre: f32,
+	CaPost: f32,
+	PreDelay: i32,
+	ThrD: f32,
+	ThrP: f32,
+	GainD: f32,
+	GainP: f32,
+	RhoStar: f32,
+	Noise: f32,
+	CaDt: f32,
+	Dt: f32,
+	NoiseDt: f32,
+	pad: f32,
+	pad1: f32,
+}
+
 //////// import: "kinase-params.go"
 struct CaDtParams { //types:add
 	MTau: f32,
@@ -1488,6 +1839,10 @@ struct LayerIndexes {
 	ShpPlX: i32,
 	ShpUnY: i32,
 	ShpUnX: i32,
+	DendSt: u32,
+	DendN: u32,
+	pad: u32,
+	pad1: u32,
 }
 struct LayerInhibIndexes {
 	Index1: i32,
@@ -1514,6 +1869,8 @@ struct LayerParams {
 	Nuclear: NuclearParams,
 	LDT: LDTParams,
 	VTA: VTAParams,
+	LC: LCParams,
+	DRN: DRNParams,
 	RWPred: RWPredParams,
 	RWDa: RWDaParams,
 	TDInteg: TDIntegParams,
@@ -1563,6 +1920,11 @@ const  RWDaLayer: LayerTypes = 33;
 const  TDPredLayer: LayerTypes = 34;
 const  TDIntegLayer: LayerTypes = 35;
 const  TDDaLayer: LayerTypes = 36;
+const  InhibPVLayer: LayerTypes = 37;
+const  InhibSSTLayer: LayerTypes = 38;
+const  InhibVIPLayer: LayerTypes = 39;
+const  LCLayer: LayerTypes = 40;
+const  DRNLayer: LayerTypes = 41;
 
 //////// import: "layervars.go"
 alias LayerVars = i32; //enums:enum
@@ -1605,7 +1967,7 @@ fn LearnCaParams_VgccCaFromSpike(lc: LearnCaParams, ctx: Context, ni: u32,di: u3
 	if (lc.SpikeVGCC == 1) {
 		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(VgccCa))] = lc.SpikeVgccCa * Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Spike))];
 	}
-	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(VgccCaInt))] += Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(VgccCa))] - lc.VgccDt*Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(VgccCaInt))];
+	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(VgccCaInt))] += Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(VgccCa))] + Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(CatCa))] - lc.VgccDt*Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(VgccCaInt))];
 }
 fn LearnCaParams_LearnCas(lc: LearnCaParams, ctx: Context, ni: u32,di: u32) {
 	LearnCaParams_VgccCaFromSpike(lc, ctx, ni, di);
@@ -1854,10 +2216,27 @@ struct SWtAdaptParams {
 	pad: f32,
 	pad1: f32,
 }
+alias WtBoundModes = i32; //enums:enum
+const  SoftBound: WtBoundModes = 0;
+const  HardBound: WtBoundModes = 1;
+const  MultBound: WtBoundModes = 2;
+const  LogNormBound: WtBoundModes = 3;
+const  DaleBound: WtBoundModes = 4;
+struct WtBoundParams {
+	Mode: WtBoundModes,
+	MuPlus: f32,
+	MuMinus: f32,
+	Ref: f32,
+	Min: f32,
+	Max: f32,
+	pad: f32,
+	pad1: f32,
+}
 struct SWtParams {
 	Init: SWtInitParams,
 	Adapt: SWtAdaptParams,
 	Limit: F32,
+	Bound: WtBoundParams,
 }
 struct LRateParams {
 	Base: f32,
@@ -1889,6 +2268,9 @@ struct LearnSynParams {
 	LRate: LRateParams,
 	DWt: DWtParams,
 	Hebb: HebbParams,
+	STDP: STDPParams,
+	Consol: ConsolParams,
+	ThreeFactor: ThreeFactorParams,
 }
 
 //////// import: "looper.go"
@@ -1956,6 +2338,10 @@ struct NetworkIndexes {
 	RubiconNPosUSs: u32,
 	RubiconNCosts: u32,
 	RubiconNNegUSs: u32,
+	NSTDPSyns: u32,
+	pad: u32,
+	pad1: u32,
+	pad2: u32,
 }
 
 //////// import: "neuromod.go"
@@ -1978,6 +2364,10 @@ struct NeuroModParams {
 	AChDisInhib: f32,
 	BurstGain: f32,
 	DipGain: f32,
+	NEGain: f32,
+	NELRateMod: f32,
+	SerLRateMod: f32,
+	NENoise: f32,
 	pad: f32,
 	pad1: f32,
 	pad2: f32,
@@ -2018,6 +2408,12 @@ fn NeuroModParams_GGain(nm: NeuroModParams, da: f32) -> f32 {
 		gain = f32(0);
 	}return gain;
 }
+fn NeuroModParams_NEGeGain(nm: NeuroModParams, ne: f32) -> f32 {
+	return 1 + nm.NEGain*ne;
+}
+fn NeuroModParams_NENoiseGain(nm: NeuroModParams, ne: f32) -> f32 {
+	return nm.NENoise * ne;
+}
 fn NeuroModParams_GiFromACh(nm: NeuroModParams, ach: f32) -> f32 {
 	var ai = 1 - ach;
 	if (ai < 0) {
@@ -2084,54 +2480,66 @@ const  VgccM: NeuronVars = 48;
 const  VgccH: NeuronVars = 49;
 const  VgccCa: NeuronVars = 50;
 const  VgccCaInt: NeuronVars = 51;
-const  Burst: NeuronVars = 52;
-const  BurstPrv: NeuronVars = 53;
-const  CtxtGe: NeuronVars = 54;
-const  CtxtGeRaw: NeuronVars = 55;
-const  CtxtGeOrig: NeuronVars = 56;
-const  GgabaB: NeuronVars = 57;
-const  GababM: NeuronVars = 58;
-const  GababX: NeuronVars = 59;
-const  Gak: NeuronVars = 60;
-const  SSGiDend: NeuronVars = 61;
-const  GknaMed: NeuronVars = 62;
-const  GknaSlow: NeuronVars = 63;
-const  Gkir: NeuronVars = 64;
-const  KirM: NeuronVars = 65;
-const  Gsk: NeuronVars = 66;
-const  SKCaIn: NeuronVars = 67;
-const  SKCaR: NeuronVars = 68;
-const  SKCaM: NeuronVars = 69;
-const  Gmahp: NeuronVars = 70;
-const  MahpN: NeuronVars = 71;
-const  Gsahp: NeuronVars = 72;
-const  SahpCa: NeuronVars = 73;
-const  SahpN: NeuronVars = 74;
-const  ActM: NeuronVars = 75;
-const  ActP: NeuronVars = 76;
-const  Beta1: NeuronVars = 77;
-const  Beta2: NeuronVars = 78;
-const  CaPMax: NeuronVars = 79;
-const  CaPMaxCa: NeuronVars = 80;
-const  GeNoise: NeuronVars = 81;
-const  GeNoiseP: NeuronVars = 82;
-const  GiNoise: NeuronVars = 83;
-const  GiNoiseP: NeuronVars = 84;
-const  GeExt: NeuronVars = 85;
-const  GeRaw: NeuronVars = 86;
-const  GeSyn: NeuronVars = 87;
-const  GiRaw: NeuronVars = 88;
-const  GiSyn: NeuronVars = 89;
-const  GeInt: NeuronVars = 90;
-const  GeIntNorm: NeuronVars = 91;
-const  GiInt: NeuronVars = 92;
-const  GModRaw: NeuronVars = 93;
-const  GModSyn: NeuronVars = 94;
-const  SMaintP: NeuronVars = 95;
-const  GMaintRaw: NeuronVars = 96;
-const  GMaintSyn: NeuronVars = 97;
-const  NeurFlags: NeuronVars = 98;
-const  NeuronTraces: NeuronVars = 99;
+const  Gcat: NeuronVars = 52;
+const  CatM: NeuronVars = 53;
+const  CatH: NeuronVars = 54;
+const  CatCa: NeuronVars = 55;
+const  Gnap: NeuronVars = 56;
+const  NapM: NeuronVars = 57;
+const  NapH: NeuronVars = 58;
+const  Igap: NeuronVars = 59;
+const  GapVm: NeuronVars = 60;
+const  Burst: NeuronVars = 61;
+const  BurstPrv: NeuronVars = 62;
+const  CtxtGe: NeuronVars = 63;
+const  CtxtGeRaw: NeuronVars = 64;
+const  CtxtGeOrig: NeuronVars = 65;
+const  GgabaB: NeuronVars = 66;
+const  GababM: NeuronVars = 67;
+const  GababX: NeuronVars = 68;
+const  Gak: NeuronVars = 69;
+const  SSGiDend: NeuronVars = 70;
+const  GiDendSyn: NeuronVars = 71;
+const  GknaMed: NeuronVars = 72;
+const  GknaSlow: NeuronVars = 73;
+const  Gkir: NeuronVars = 74;
+const  KirM: NeuronVars = 75;
+const  Gh: NeuronVars = 76;
+const  HcnM: NeuronVars = 77;
+const  Gsk: NeuronVars = 78;
+const  SKCaIn: NeuronVars = 79;
+const  SKCaR: NeuronVars = 80;
+const  SKCaM: NeuronVars = 81;
+const  Gmahp: NeuronVars = 82;
+const  MahpN: NeuronVars = 83;
+const  Gsahp: NeuronVars = 84;
+const  SahpCa: NeuronVars = 85;
+const  SahpN: NeuronVars = 86;
+const  ActM: NeuronVars = 87;
+const  ActP: NeuronVars = 88;
+const  Beta1: NeuronVars = 89;
+const  Beta2: NeuronVars = 90;
+const  CaPMax: NeuronVars = 91;
+const  CaPMaxCa: NeuronVars = 92;
+const  GeNoise: NeuronVars = 93;
+const  GeNoiseP: NeuronVars = 94;
+const  GiNoise: NeuronVars = 95;
+const  GiNoiseP: NeuronVars = 96;
+const  GeExt: NeuronVars = 97;
+const  GeRaw: NeuronVars = 98;
+const  GeSyn: NeuronVars = 99;
+const  GiRaw: NeuronVars = 100;
+const  GiSyn: NeuronVars = 101;
+const  GeInt: NeuronVars = 102;
+const  GeIntNorm: NeuronVars = 103;
+const  GiInt: NeuronVars = 104;
+const  GModRaw: NeuronVars = 105;
+const  GModSyn: NeuronVars = 106;
+const  SMaintP: NeuronVars = 107;
+const  GMaintRaw: NeuronVars = 108;
+const  GMaintSyn: NeuronVars = 109;
+const  NeurFlags: NeuronVars = 110;
+const  NeuronTraces: NeuronVars = 111;
 alias NeuronTracesVars = i32; //enums:enum
 const  CaSynTrace: NeuronTracesVars = 0;
 const  RecvLearnTrace: NeuronTracesVars = 1;
@@ -2143,6 +2551,8 @@ const  DTrgAvg: NeuronAvgVars = 3;
 const  AvgDif: NeuronAvgVars = 4;
 const  GeBase: NeuronAvgVars = 5;
 const  GiBase: NeuronAvgVars = 6;
+const  IPThr: NeuronAvgVars = 7;
+const  IPGl: NeuronAvgVars = 8;
 alias NeuronIndexVars = i32; //enums:enum
 const  NrnNeurIndex: NeuronIndexVars = 0;
 const  NrnLayIndex: NeuronIndexVars = 1;
@@ -2199,7 +2609,11 @@ struct PathIndexes {
 	RecvConSt: u32,
 	RecvSynSt: u32,
 	NPathNeurSt: u32,
+	STDPSt: u32,
+	ConsolSt: u32,
 	pad: u32,
+	pad1: u32,
+	pad2: u32,
 }
 struct GScaleValues {
 	Scale: f32,
@@ -2210,10 +2624,13 @@ struct GScaleValues {
 struct PathParams {
 	Type: PathTypes,
 	Index: u32,
+	DendComp: i32,
 	pad: i32,
-	pad1: i32,
 	Indexes: PathIndexes,
 	Com: SynComParams,
+	STP: STPParams,
+	Gap: GapJunctionParams,
+	StructPlast: StructPlastParams,
 	PathScale: PathScaleParams,
 	SWts: SWtParams,
 	Learn: LearnSynParams,
@@ -2241,6 +2658,8 @@ const  RWPath: PathTypes = 10;
 const  TDPredPath: PathTypes = 11;
 const  BLAPath: PathTypes = 12;
 const  HipPath: PathTypes = 13;
+const  GapJunctionPath: PathTypes = 14;
+const  ActorPath: PathTypes = 15;
 
 //////// import: "pcore-layer.go"
 struct DSMatrixParams {
@@ -2385,8 +2804,8 @@ fn TDDaParams_GeFromDA(tp: TDDaParams, da: f32) -> f32 {
 struct RLPredPathParams {
 	OppSignLRate: f32,
 	DaTol: f32,
+	Lambda: f32,
 	pad: f32,
-	pad1: f32,
 }
 
 //////// import: "rubicon-layer.go"
@@ -2406,6 +2825,18 @@ struct VTAParams {
 	AChThr: f32,
 	pad: f32,
 }
+struct LCParams {
+	Tonic: f32,
+	SurpriseGain: f32,
+	UncertGain: f32,
+	pad: f32,
+}
+struct DRNParams {
+	Tonic: f32,
+	NegGain: f32,
+	WaitGain: f32,
+	UrgencyInhib: f32,
+}
 
 //////// import: "rubicon-path.go"
 struct BLAPathParams {
@@ -2442,6 +2873,51 @@ fn RubiconUSStimValue(di: u32, usIndex: u32, valence: ValenceTypes) -> f32 {
 
 //////// import: "stats.go"
 
+//////// import: "stdp.go"
+const STDPMaxSpikes = 64;
+alias STDPRules = i32; //enums:enum
+const  NoSTDP: STDPRules = 0;
+const  PairSTDP: STDPRules = 1;
+const  TripletSTDP: STDPRules = 2;
+alias STDPVars = i32; //enums:enum
+const  STDPPre: STDPVars = 0;
+const  STDPPre2: STDPVars = 1;
+const  STDPPost: STDPVars = 2;
+const  STDPPost2: STDPVars = 3;
+struct STDPParams {
+	Rule: STDPRules,
+	TauPlus: f32,
+	TauMinus: f32,
+	TauX: f32,
+	TauY: f32,
+	APlus: f32,
+	AMinus: f32,
+	A3Plus: f32,
+	A3Minus: f32,
+	DtPlus: f32,
+	DtMinus: f32,
+	DtX: f32,
+	DtY: f32,
+	pad: f32,
+	pad1: f32,
+	pad2: f32,
+}
+
+//////// import: "structural.go"
+alias RegrowModes = i32; //enums:enum
+const  RegrowRandom: RegrowModes = 0;
+const  RegrowCorrel: RegrowModes = 1;
+struct StructPlastParams {
+	On: i32,
+	Regrow: RegrowModes,
+	WtThr: f32,
+	SWtThr: f32,
+	MaxFrac: f32,
+	pad: f32,
+	pad1: f32,
+	pad2: f32,
+}
+
 //////// import: "synapse.go"
 alias SynapseVars = i32; //enums:enum
 const  Wt: SynapseVars = 0;
@@ -2458,6 +2934,25 @@ const  SynRecvIndex: SynapseIndexVars = 0;
 const  SynSendIndex: SynapseIndexVars = 1;
 const  SynPathIndex: SynapseIndexVars = 2;
 
+//////// import: "threefactor.go"
+alias ThreeFactorRules = i32; //enums:enum
+const  NoThreeFactor: ThreeFactorRules = 0;
+const  HebbThreeFactor: ThreeFactorRules = 1;
+const  PerturbThreeFactor: ThreeFactorRules = 2;
+alias ThreeFactorMods = i32; //enums:enum
+const  ThreeFactorGlobal: ThreeFactorMods = 0;
+const  ThreeFactorLayer: ThreeFactorMods = 1;
+struct ThreeFactorParams {
+	Rule: ThreeFactorRules,
+	Mod: ThreeFactorMods,
+	Global: GlobalScalarVars,
+	ModLayIndex: i32,
+	TraceDecay: f32,
+	Delayed: i32,
+	pad: f32,
+	pad1: f32,
+}
+
 //////// import: "slrand.wgsl"
 fn Philox2x32round(counter: su64, key: u32) -> su64 {
 	let mul = Uint32Mul64(u32(0xD256D193), counter.x);
@@ -201,6 +201,20 @@ fn SynapseTracesSetDiv(vl: f32, ix: u32) {
 
 //////// import: "vars.go"
 
+//////// import: "act-dend.go"
+alias DendVars = i32; //enums:enum
+const  DendVm: DendVars = 0;
+const  DendGeRaw: DendVars = 1;
+const  DendGeSyn: DendVars = 2;
+const  DendGnmdaSyn: DendVars = 3;
+const  DendGnmda: DendVars = 4;
+const  DendGnmdaLrn: DendVars = 5;
+const  DendNmdaCa: DendVars = 6;
+const  DendGvgcc: DendVars = 7;
+const  DendVgccM: DendVars = 8;
+const  DendVgccH: DendVars = 9;
+const  DendVgccCa: DendVars = 10;
+
 //////// import: "act-layer.go"
 fn LayerParams_IsTarget(ly: LayerParams) -> bool {
 	return ly.Type == TargetLayer || ly.Type == PulvinarLayer;
@@ -215,12 +229,32 @@ const  InhibitoryG: PathGTypes = 1;
 const  ModulatoryG: PathGTypes = 2;
 const  MaintG: PathGTypes = 3;
 const  ContextG: PathGTypes = 4;
+const  DendInhibitoryG: PathGTypes = 5;
 struct SynComParams {
 	GType: PathGTypes,
 	Delay: u32,
 	MaxDelay: u32,
 	DelLen: u32,
 }
+alias STPVars = i32; //enums:enum
+const  STPUtil: STPVars = 0;
+const  STPRes: STPVars = 1;
+struct STPParams {
+	On: i32,
+	U: f32,
+	TauD: f32,
+	TauF: f32,
+	DecayD: f32,
+	DecayF: f32,
+	pad: f32,
+	pad1: f32,
+}
+struct GapJunctionParams {
+	G: f32,
+	pad: f32,
+	pad1: f32,
+	pad2: f32,
+}
 struct PathScaleParams {
 	Rel: f32,
 	Abs: f32,
@@ -251,7 +285,7 @@ struct DendParams {
 	ModGain: f32,
 	ModACh: i32,
 	ModBase: f32,
-	pad: i32,
+	GAxial: f32,
 }
 struct ActInitParams {
 	Vm: f32,
@@ -318,6 +352,16 @@ struct SMaintParams {
 	Inhib: f32,
 	ISI: F32,
 }
+struct IntrinsicParams {
+	On: i32,
+	Thr: i32,
+	Gl: i32,
+	TrgHz: f32,
+	ThrRate: f32,
+	ThrMax: f32,
+	GlRate: f32,
+	GlMax: f32,
+}
 struct PopCodeParams {
 	On: i32,
 	Ge: f32,
@@ -343,13 +387,17 @@ struct ActParams {
 	Sahp: SahpParams,
 	KNa: KNaMedSlow,
 	Kir: KirParams,
+	HCN: HCNParams,
 	NMDA: NMDAParams,
 	MaintNMDA: NMDAParams,
 	GabaB: GABABParams,
 	VGCC: VGCCParams,
 	AK: AKsParams,
+	CaT: CaTParams,
+	NaP: NaPParams,
 	SKCa: SKCaParams,
 	SMaint: SMaintParams,
+	Intrinsic: IntrinsicParams,
 	PopCode: PopCodeParams,
 }
 
@@ -365,6 +413,18 @@ struct AKsParams {
 	pad2: i32,
 }
 
+//////// import: "chans-cat.go"
+struct CaTParams {
+	Ge: f32,
+	Ca: f32,
+	Vshift: f32,
+	PhiM: f32,
+	PhiH: f32,
+	Mrest: f32,
+	Hrest: f32,
+	pad: f32,
+}
+
 //////// import: "chans-chans.go"
 struct Chans {
 	E: f32,
@@ -389,6 +449,18 @@ struct GABABParams {
 	pad2: f32,
 }
 
+//////// import: "chans-hcn.go"
+struct HCNParams {
+	Gbar: f32,
+	Erev: f32,
+	Voff: f32,
+	Vslope: f32,
+	TauFact: f32,
+	Mrest: f32,
+	pad: f32,
+	pad1: f32,
+}
+
 //////// import: "chans-kir.go"
 struct KirParams {
 	Gk: f32,
@@ -433,6 +505,22 @@ struct MahpParams {
 	pad2: i32,
 }
 
+//////// import: "chans-nap.go"
+struct NaPParams {
+	Ge: f32,
+	MVoff: f32,
+	MVslope: f32,
+	HVoff: f32,
+	HVslope: f32,
+	MTau: f32,
+	HTau: f32,
+	Mrest: f32,
+	Hrest: f32,
+	pad: f32,
+	pad1: f32,
+	pad2: f32,
+}
+
 //////// import: "chans-nmda.go"
 struct NMDAParams {
 	Ge: f32,
@@ -481,6 +569,26 @@ struct VGCCParams {
 	pad1: i32,
 }
 
+//////// import: "consol.go"
+alias ConsolRules = i32; //enums:enum
+const  NoConsol: ConsolRules = 0;
+const  ConsolEWC: ConsolRules = 1;
+const  ConsolSI: ConsolRules = 2;
+alias ConsolVars = i32; //enums:enum
+const  ConsolAnchor: ConsolVars = 0;
+const  ConsolImp: ConsolVars = 1;
+const  ConsolAcc: ConsolVars = 2;
+struct ConsolParams {
+	Rule: ConsolRules,
+	Strength: f32,
+	Tau: f32,
+	Xi: f32,
+	Keep: f32,
+	Dt: f32,
+	pad: f32,
+	pad1: f32,
+}
+
 //////// import: "context.go"
 const  NeuronTraceCycles = 10;
 const  NeuronTraceThetas = 3;
@@ -544,21 +652,21 @@ struct PulvinarParams {
 }
 
 //////// import: "enumgen.go"
-const PathGTypesN: PathGTypes = 5;
+const PathGTypesN: PathGTypes = 6;
 const GlobalScalarVarsN: GlobalScalarVars = 58;
 const GlobalVectorVarsN: GlobalVectorVars = 10;
-const GPUVarsN: GPUVars = 23;
-const LayerTypesN: LayerTypes = 37;
+const GPUVarsN: GPUVars = 28;
+const LayerTypesN: LayerTypes = 42;
 const LayerVarsN: LayerVars = 12;
 const ViewTimesN: ViewTimes = 7;
 const DAModTypesN: DAModTypes = 4;
 const ValenceTypesN: ValenceTypes = 3;
 const NeuronFlagsN: NeuronFlags = 9;
-const NeuronVarsN: NeuronVars = 100;
+const NeuronVarsN: NeuronVars = 112;
 const NeuronTracesVarsN: NeuronTracesVars = 2;
-const NeuronAvgVarsN: NeuronAvgVars = 7;
+const NeuronAvgVarsN: NeuronAvgVars = 9;
 const NeuronIndexVarsN: NeuronIndexVars = 3;
-const PathTypesN: PathTypes = 14;
+const PathTypesN: PathTypes = 16;
 const GPLayerTypesN: GPLayerTypes = 3;
 const PoolIndexVarsN: PoolIndexVars = 4;
 const PoolIntVarsN: PoolIntVars = 6;
@@ -568,6 +676,16 @@ const AvgMaxVarsN: AvgMaxVars = 7;
 const SynapseVarsN: SynapseVars = 5;
 const SynapseTraceVarsN: SynapseTraceVars = 3;
 const SynapseIndexVarsN: SynapseIndexVars = 3;
+const STPVarsN: STPVars = 2;
+const DendVarsN: DendVars = 11;
+const RegrowModesN: RegrowModes = 2;
+const STDPRulesN: STDPRules = 3;
+const STDPVarsN: STDPVars = 4;
+const ConsolRulesN: ConsolRules = 3;
+const ConsolVarsN: ConsolVars = 3;
+const ThreeFactorRulesN: ThreeFactorRules = 3;
+const ThreeFactorModsN: ThreeFactorMods = 2;
+const WtBoundModesN: WtBoundModes = 5;
 
 //////// import: "fsfffb-enumgen.go"
 const InhibVarsN: InhibVars = 19;
@@ -714,10 +832,34 @@ struct InhibParams {
 	ActAvg: ActAvgParams,
 	Layer: GiParams,
 	Pool: GiParams,
+	Interneurons: i32,
+	pad: i32,
+	pad1: i32,
+	pad2: i32,
 }
 
 //////// import: "init-layer.go"
 
+//////// import: "kinase-cathr.go"
+struct CaThrParams { //types:add
+	Tau: f32,
+	CaTau: f32,
+	CaPre: f32,
+	CaPost: f32,
+	PreDelay: i32,
+	ThrD: f32,
+	ThrP: f32,
+	GainD: f32,
+	GainP: f32,
+	RhoStar: f32,
+	Noise: f32,
+	CaDt: f32,
+	Dt: f32,
+	NoiseDt: f32,
+	pad: f32,
+	pad1: f32,
+}
+
 //////// import: "kinase-params.go"
 struct CaDtParams { //types:add
 	MTau: f32,
@@ -751,6 +893,10 @@ struct LayerIndexes {
 	ShpPlX: i32,
 	ShpUnY: i32,
 	ShpUnX: i32,
+	DendSt: u32,
+	DendN: u32,
+	pad: u32,
+	pad1: u32,
 }
 struct LayerInhibIndexes {
 	Index1: i32,
@@ -777,6 +923,8 @@ struct LayerParams {
 	Nuclear: NuclearParams,
 	LDT: LDTParams,
 	VTA: VTAParams,
+	LC: LCParams,
+	DRN: DRNParams,
 	RWPred: RWPredParams,
 	RWDa: RWDaParams,
 	TDInteg: TDIntegParams,
@@ -823,6 +971,11 @@ const  RWDaLayer: LayerTypes = 33;
 const  TDPredLayer: LayerTypes = 34;
 const  TDIntegLayer: LayerTypes = 35;
 const  TDDaLayer: LayerTypes = 36;
+const  InhibPVLayer: LayerTypes = 37;
+const  InhibSSTLayer: LayerTypes = 38;
+const  InhibVIPLayer: LayerTypes = 39;
+const  LCLayer: LayerTypes = 40;
+const  DRNLayer: LayerTypes = 41;
 
 //////// import: "layervars.go"
 alias LayerVars = i32; //enums:enum
@@ -886,6 +1039,9 @@ fn PathParams_DWtSyn(pt: PathParams, ctx: Context, rlay: LayerParams, syni: u32,
 	case TDPredPath: {
 		PathParams_DWtSynTDPred(pt, ctx, syni, si, ri, di);
 	}
+	case ActorPath: {
+		PathParams_DWtSynActor(pt, ctx, rlay, syni, si, ri, di);
+	}
 	case BLAPath: {
 		PathParams_DWtSynBLA(pt, ctx, syni, si, ri, di);
 	}
@@ -893,6 +1049,9 @@ fn PathParams_DWtSyn(pt: PathParams, ctx: Context, rlay: LayerParams, syni: u32,
 		PathParams_DWtSynHip(pt, ctx, syni, si, ri, di, isTarget);
 	} // by default this is the same as DWtSynCortex (w/ unused Hebb component in the algorithm) except that it uses WtFromDWtSynNoLimits
 	default: {
+		if (ThreeFactorParams_On(pt.Learn.ThreeFactor) || STDPParams_On(pt.Learn.STDP)) {
+			return; // separate DWtThreeFactorSyn, DWtSTDPSyn kernels
+		}
 		if (pt.Learn.Hebb.On == 1) {
 			PathParams_DWtSynHebb(pt, ctx, syni, si, ri, di);
 		} else if (isTarget) {
@@ -954,18 +1113,14 @@ fn PathParams_SynCaTotal(pt: PathParams, ctx: Context, si: u32,ri: u32,di: u32,
 }
 fn PathParams_DWtSynSoftBound(pt: PathParams, ctx: Context, syni: u32,di: u32, dwt: f32) {
 	if (dwt == 0) {
-		SynapseTracesSet(0.0, Index3D(TensorStrides[180], TensorStrides[181], TensorStrides[182], u32(syni), u32(di), u32(DiDWt)));
+		SynapseTracesSet(0.0, Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(DiDWt)));
 	} else {
-		var lwt = Synapses[Index2D(TensorStrides[170], TensorStrides[171], // linear weight
+		var lwt = Synapses[Index2D(TensorStrides[180], TensorStrides[181], // linear weight
 		u32(syni), u32(LWt))];
-		var edw = dwt;
-		if (edw > 0) {
-			edw *= (1 - lwt);
-		} else {
-			edw *= lwt;
-		}
-		SynapseTracesSet(pt.Learn.LRate.Eff * edw, Index3D(TensorStrides[180], TensorStrides[181],
-		TensorStrides[182], u32(syni), u32(di), u32(DiDWt)));
+		var inhib = PathParams_IsInhib(pt) || pt.Com.GType == DendInhibitoryG;
+		var edw = WtBoundParams_DWt(pt.SWts.Bound, dwt, lwt, inhib);
+		SynapseTracesSet(pt.Learn.LRate.Eff * edw, Index3D(TensorStrides[190], TensorStrides[191],
+		TensorStrides[192], u32(syni), u32(di), u32(DiDWt)));
 	}
 }
 fn PathParams_DWtSynCortex(pt: PathParams, ctx: Context, rlay: LayerParams, syni: u32,si: u32,ri: u32,di: u32) {
@@ -973,13 +1128,13 @@ fn PathParams_DWtSynCortex(pt: PathParams, ctx: Context, rlay: LayerParams, syni
 	var winSt = ctx.CyclesTotal - ctx.ThetaCycles;
 	var winEd = ctx.CyclesTotal;
 	if (learnNow == 0 || learnNow < winSt || learnNow > winEd) { // not in this time window
-		SynapseTracesSet(0.0, Index3D(TensorStrides[180], TensorStrides[181], TensorStrides[182], u32(syni), u32(di), u32(DTr)));
-		SynapseTracesSet(0.0, Index3D(TensorStrides[180], TensorStrides[181], TensorStrides[182], u32(syni), u32(di), u32(DiDWt)));return;
+		SynapseTracesSet(0.0, Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(DTr)));
+		SynapseTracesSet(0.0, Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(DiDWt)));return;
 	}
 	var syCa = PathParams_SynCaTotal(pt, ctx, si, ri, di, learnNow, rlay.Learn.Timing.SynCaCycles);
-	SynapseTracesSet(syCa, Index3D(TensorStrides[180], TensorStrides[181], TensorStrides[182], u32(syni), u32(di), u32(DTr)));
-	var tr = DWtParams_SynTrace(pt.Learn.DWt, SynapseTracesGet(Index3D(TensorStrides[180], TensorStrides[181], TensorStrides[182], u32(syni), u32(di), u32(Tr))), syCa);
-	SynapseTracesSet(tr, Index3D(TensorStrides[180], TensorStrides[181], TensorStrides[182], u32(syni), u32(di), u32(Tr)));
+	SynapseTracesSet(syCa, Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(DTr)));
+	var tr = DWtParams_SynTrace(pt.Learn.DWt, SynapseTracesGet(Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(Tr))), syCa);
+	SynapseTracesSet(tr, Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(Tr)));
 	var dwt = f32(0);
 	if (syCa > pt.Learn.DWt.LearnThr) { // todo: elminate?
 		var bi = NeuronTraceIndex(RecvLearnTrace, learnNow);
@@ -995,13 +1150,13 @@ fn PathParams_DWtSynCortexEnabled(pt: PathParams, ctx: Context, rlay: LayerParam
 	var winSt = ctx.CyclesTotal - 2*ctx.ThetaCycles;
 	var winEd = ctx.CyclesTotal - ctx.ThetaCycles;
 	if (learnNow == 0 || enabled > learnNow || learnNow < winSt || learnNow > winEd) { // not in this time window
-		SynapseTracesSet(0.0, Index3D(TensorStrides[180], TensorStrides[181], TensorStrides[182], u32(syni), u32(di), u32(DTr)));
-		SynapseTracesSet(0.0, Index3D(TensorStrides[180], TensorStrides[181], TensorStrides[182], u32(syni), u32(di), u32(DiDWt)));return;
+		SynapseTracesSet(0.0, Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(DTr)));
+		SynapseTracesSet(0.0, Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(DiDWt)));return;
 	}
 	var syCa = PathParams_SynCaTotal(pt, ctx, si, ri, di, learnNow, rlay.Learn.Timing.SynCaCycles);
-	SynapseTracesSet(syCa, Index3D(TensorStrides[180], TensorStrides[181], TensorStrides[182], u32(syni), u32(di), u32(DTr)));
-	var tr = DWtParams_SynTrace(pt.Learn.DWt, SynapseTracesGet(Index3D(TensorStrides[180], TensorStrides[181], TensorStrides[182], u32(syni), u32(di), u32(Tr))), syCa);
-	SynapseTracesSet(tr, Index3D(TensorStrides[180], TensorStrides[181], TensorStrides[182], u32(syni), u32(di), u32(Tr)));
+	SynapseTracesSet(syCa, Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(DTr)));
+	var tr = DWtParams_SynTrace(pt.Learn.DWt, SynapseTracesGet(Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(Tr))), syCa);
+	SynapseTracesSet(tr, Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(Tr)));
 	var dwt = f32(0);
 	if (syCa > pt.Learn.DWt.LearnThr) { // todo: elminate?
 		var ppOff = ctx.ThetaStart - ctx.ThetaCycles + ctx.ISICycles + ctx.MinusCycles + 10; // have to constrain due to RecvTrace recording
@@ -1017,30 +1172,30 @@ fn PathParams_DWtSynTarget(pt: PathParams, ctx: Context, syni: u32,si: u32,ri: u
 	var caP: f32;
 	var caD: f32;
 	PathParams_SynCa(pt, ctx, si, ri, di, &caP, &caD);
-	SynapseTracesSet(caD, Index3D(TensorStrides[180], TensorStrides[181], TensorStrides[182], u32(syni), u32(di), u32(DTr)));
-	var tr = DWtParams_SynTrace(pt.Learn.DWt, SynapseTracesGet(Index3D(TensorStrides[180], TensorStrides[181], TensorStrides[182], u32(syni), u32(di), u32(Tr))), caD);
-	SynapseTracesSet(tr, Index3D(TensorStrides[180], TensorStrides[181], TensorStrides[182], u32(syni), u32(di), u32(Tr)));
+	SynapseTracesSet(caD, Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(DTr)));
+	var tr = DWtParams_SynTrace(pt.Learn.DWt, SynapseTracesGet(Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(Tr))), caD);
+	SynapseTracesSet(tr, Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(Tr)));
 	var dwt = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ri), u32(di), u32(RLRate))] * (caP - caD);
 	PathParams_DWtSynSoftBound(pt, ctx, syni, di, dwt);
 }
 fn PathParams_DWtSynCTCtxt(pt: PathParams, ctx: Context, syni: u32,si: u32,ri: u32,di: u32) {
 	var syn = Neurons[Index3D(TensorStrides[70], TensorStrides[71], // previous burst, not synCa
 	TensorStrides[72], u32(si), u32(di), u32(BurstPrv))];
-	SynapseTracesSet(syn, Index3D(TensorStrides[180], TensorStrides[181], TensorStrides[182], u32(syni), u32(di), u32(DTr)));
-	var tr = DWtParams_SynTrace(pt.Learn.DWt, SynapseTracesGet(Index3D(TensorStrides[180], TensorStrides[181], TensorStrides[182], u32(syni), u32(di), u32(Tr))), syn);
-	SynapseTracesSet(tr, Index3D(TensorStrides[180], TensorStrides[181],
-	TensorStrides[182], u32(syni), u32(di), u32(Tr)));
+	SynapseTracesSet(syn, Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(DTr)));
+	var tr = DWtParams_SynTrace(pt.Learn.DWt, SynapseTracesGet(Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(Tr))), syn);
+	SynapseTracesSet(tr, Index3D(TensorStrides[190], TensorStrides[191],
+	TensorStrides[192], u32(syni), u32(di), u32(Tr)));
 	var dwt = tr * (Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ri), u32(di), u32(LearnCaP))] - Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ri), u32(di), u32(LearnCaD))]) * Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ri), u32(di), u32(ETrLearn))];
 	PathParams_DWtSynSoftBound(pt, ctx, syni, di, dwt);
 }
 fn PathParams_DWtSynHebb(pt: PathParams, ctx: Context, syni: u32,si: u32,ri: u32,di: u32) {
 	var rLearnCaP = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ri), u32(di), u32(LearnCaP))];
 	var sNrnCap = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(si), u32(di), u32(LearnCaP))];
-	var lwt = Synapses[Index2D(TensorStrides[170], TensorStrides[171], // linear weight
+	var lwt = Synapses[Index2D(TensorStrides[180], TensorStrides[181], // linear weight
 	u32(syni), u32(LWt))];
 	var hebb = rLearnCaP * (pt.Learn.Hebb.Up*sNrnCap*(1-lwt) - pt.Learn.Hebb.Down*(1-sNrnCap)*lwt);
-	SynapseTracesSet(pt.Learn.LRate.Eff * hebb, Index3D(TensorStrides[180], TensorStrides[181],
-	TensorStrides[182], u32(syni), u32(di), u32(DiDWt)));
+	SynapseTracesSet(pt.Learn.LRate.Eff * hebb, Index3D(TensorStrides[190], TensorStrides[191],
+	TensorStrides[192], u32(syni), u32(di), u32(DiDWt)));
 }
 fn PathParams_DWtSynHip(pt: PathParams, ctx: Context, syni: u32,si: u32,ri: u32,di: u32, isTarget: bool) {
 }
@@ -1053,7 +1208,7 @@ fn PathParams_DWtSynBLA(pt: PathParams, ctx: Context, syni: u32,si: u32,ri: u32,
 		if (ract < pt.Learn.DWt.LearnThr) {
 			ract = f32(0);
 		}
-		var tr = SynapseTracesGet(Index3D(TensorStrides[180], TensorStrides[181], TensorStrides[182], u32(syni), u32(di), u32(Tr)));
+		var tr = SynapseTracesGet(Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(Tr)));
 		var ustr = pt.BLA.USTrace;
 		tr = ustr*Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(si), u32(di), u32(Burst))] + (1.0-ustr)*tr;
 		var delta = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ri), u32(di), u32(CaP))] - Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72],
@@ -1062,14 +1217,14 @@ fn PathParams_DWtSynBLA(pt: PathParams, ctx: Context, syni: u32,si: u32,ri: u32,
 			delta *= pt.BLA.NegDeltaLRate;
 		}
 		dwt = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ri), u32(di), u32(RLRate))] * tr * delta * ract;
-		SynapseTracesSet(0.0, Index3D(TensorStrides[180], TensorStrides[181], TensorStrides[182], u32(syni), u32(di), u32(Tr)));
+		SynapseTracesSet(0.0, Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(Tr)));
 	} else if (ach > pt.BLA.AChThr) {
 		var dtr = ach * Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(si), u32(di), u32(Burst))];
-		SynapseTracesSet(dtr, Index3D(TensorStrides[180], TensorStrides[181], TensorStrides[182], u32(syni), u32(di), u32(DTr)));
-		var tr = DWtParams_SynTrace(pt.Learn.DWt, SynapseTracesGet(Index3D(TensorStrides[180], TensorStrides[181], TensorStrides[182], u32(syni), u32(di), u32(Tr))), dtr);
-		SynapseTracesSet(tr, Index3D(TensorStrides[180], TensorStrides[181], TensorStrides[182], u32(syni), u32(di), u32(Tr)));
+		SynapseTracesSet(dtr, Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(DTr)));
+		var tr = DWtParams_SynTrace(pt.Learn.DWt, SynapseTracesGet(Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(Tr))), dtr);
+		SynapseTracesSet(tr, Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(Tr)));
 	} else {
-		SynapseTracesSet(0.0, Index3D(TensorStrides[180], TensorStrides[181], TensorStrides[182], u32(syni), u32(di), u32(DTr)));
+		SynapseTracesSet(0.0, Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(DTr)));
 	}
 	PathParams_DWtSynSoftBound(pt, ctx, syni, di, dwt);
 }
@@ -1106,8 +1261,8 @@ fn PathParams_DWtSynRWPred(pt: PathParams, ctx: Context, syni: u32,si: u32,ri: u
 	}
 	var dwt = da * Neurons[Index3D(TensorStrides[70], TensorStrides[71], // no recv unit activation
 	TensorStrides[72], u32(si), u32(di), u32(CaP))];
-	SynapseTracesSet(eff_lr * dwt, Index3D(TensorStrides[180], TensorStrides[181],
-	TensorStrides[182], u32(syni), u32(di), u32(DiDWt)));
+	SynapseTracesSet(eff_lr * dwt, Index3D(TensorStrides[190], TensorStrides[191],
+	TensorStrides[192], u32(syni), u32(di), u32(DiDWt)));
 }
 fn PathParams_DWtSynTDPred(pt: PathParams, ctx: Context, syni: u32,si: u32,ri: u32,di: u32) {
 	var lda = GlobalScalars[Index2D(TensorStrides[100], TensorStrides[101], u32(GvDA), u32(di))];
@@ -1125,16 +1280,31 @@ fn PathParams_DWtSynTDPred(pt: PathParams, ctx: Context, syni: u32,si: u32,ri: u
 			eff_lr *= pt.RLPred.OppSignLRate;
 		}
 	}
-	var dwt = da * Neurons[Index3D(TensorStrides[70], TensorStrides[71], // no recv unit activation, prior trial act
+	var sact = Neurons[Index3D(TensorStrides[70], TensorStrides[71], // no recv unit activation, prior trial act
 	TensorStrides[72], u32(si), u32(di), u32(CaDPrev))];
-	SynapseTracesSet(eff_lr * dwt, Index3D(TensorStrides[180], TensorStrides[181],
-	TensorStrides[182], u32(syni), u32(di), u32(DiDWt)));
+	if (pt.RLPred.Lambda > 0) {
+		sact = RLPredPathParams_Trace(pt.RLPred, SynapseTracesGet(Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(Tr))), sact);
+		SynapseTracesSet(sact, Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(Tr)));
+	}
+	var dwt = da * sact;
+	SynapseTracesSet(eff_lr * dwt, Index3D(TensorStrides[190], TensorStrides[191],
+	TensorStrides[192], u32(syni), u32(di), u32(DiDWt)));
+}
+fn PathParams_DWtSynActor(pt: PathParams, ctx: Context, rlay: LayerParams, syni: u32,si: u32,ri: u32,di: u32) {
+	var da = RLPredPathParams_DaFromTol(pt.RLPred, GlobalScalars[Index2D(TensorStrides[100], TensorStrides[101], u32(GvDA), u32(di))]);
+	var ract = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ri), u32(di), u32(CaDPrev))] - rlay.Inhib.ActAvg.Nominal;
+	var dtr = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(si), u32(di), u32(CaDPrev))] * ract;
+	var tr = RLPredPathParams_Trace(pt.RLPred, SynapseTracesGet(Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(Tr))), dtr);
+	SynapseTracesSet(dtr, Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(DTr)));
+	SynapseTracesSet(tr, Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(Tr)));
+	SynapseTracesSet(pt.Learn.LRate.Eff * da * tr, Index3D(TensorStrides[190], TensorStrides[191],
+	TensorStrides[192], u32(syni), u32(di), u32(DiDWt)));
 }
 fn PathParams_DWtSynVSMatrix(pt: PathParams, ctx: Context, syni: u32,si: u32,ri: u32,di: u32) {
 	var hasRew = GlobalScalars[Index2D(TensorStrides[100], TensorStrides[101], u32(GvHasRew), u32(di))] > 0;
 	var ach = GlobalScalars[Index2D(TensorStrides[100], TensorStrides[101], u32(GvACh), u32(di))];
 	if (!hasRew && ach < 0.1) {
-		SynapseTracesSet(0.0, Index3D(TensorStrides[180], TensorStrides[181], TensorStrides[182], u32(syni), u32(di), u32(DTr)));return;
+		SynapseTracesSet(0.0, Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(DTr)));return;
 	}
 	var rlr = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ri), u32(di), u32(RLRate))];
 	var rplus = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ri), u32(di), u32(CaP))];
@@ -1146,20 +1316,20 @@ fn PathParams_DWtSynVSMatrix(pt: PathParams, ctx: Context, syni: u32,si: u32,ri:
 	}
 	var dwt = f32(0);
 	if (hasRew) {
-		var tr = SynapseTracesGet(Index3D(TensorStrides[180], TensorStrides[181], TensorStrides[182], u32(syni), u32(di), u32(Tr)));
+		var tr = SynapseTracesGet(Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(Tr)));
 		if (pt.VSMatrix.RewActLearn == 1) {
 			tr += (1 - GlobalScalars[Index2D(TensorStrides[100], TensorStrides[101], u32(GvGoalMaint), u32(di))]) * dtr;
 		}
 		dtr = f32(0);
 		dwt = rlr * pt.Learn.LRate.Eff * tr;
-		SynapseTracesSet(0.0, Index3D(TensorStrides[180], TensorStrides[181], TensorStrides[182], u32(syni), u32(di), u32(Tr)));
+		SynapseTracesSet(0.0, Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(Tr)));
 	} else {
 		dtr *= rlr;
-		SynapseTracesSetAdd(dtr, Index3D(TensorStrides[180], TensorStrides[181], TensorStrides[182], u32(syni), u32(di), u32(Tr)));
+		SynapseTracesSetAdd(dtr, Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(Tr)));
 	}
-	SynapseTracesSet(dwt, Index3D(TensorStrides[180], TensorStrides[181], TensorStrides[182], u32(syni), u32(di), u32(DiDWt)));
-	SynapseTracesSet(dtr, Index3D(TensorStrides[180], TensorStrides[181],
-	TensorStrides[182], u32(syni), u32(di), u32(DTr)));
+	SynapseTracesSet(dwt, Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(DiDWt)));
+	SynapseTracesSet(dtr, Index3D(TensorStrides[190], TensorStrides[191],
+	TensorStrides[192], u32(syni), u32(di), u32(DTr)));
 }
 fn PathParams_DWtSynDSMatrix(pt: PathParams, ctx: Context, syni: u32,si: u32,ri: u32,di: u32) {
 	var rlr = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ri), u32(di), u32(RLRate))];
@@ -1167,9 +1337,9 @@ fn PathParams_DWtSynDSMatrix(pt: PathParams, ctx: Context, syni: u32,si: u32,ri:
 	var dtr = f32(0);
 	if (GlobalScalars[Index2D(TensorStrides[100], TensorStrides[101], // US time -- use DA and current recv activity
 	u32(GvHasRew), u32(di))] > 0) {
-		var tr = SynapseTracesGet(Index3D(TensorStrides[180], TensorStrides[181], TensorStrides[182], u32(syni), u32(di), u32(Tr)));
+		var tr = SynapseTracesGet(Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(Tr)));
 		dwt = rlr * pt.Learn.LRate.Eff * tr;
-		SynapseTracesSet(0.0, Index3D(TensorStrides[180], TensorStrides[181], TensorStrides[182], u32(syni), u32(di), u32(Tr)));
+		SynapseTracesSet(0.0, Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(Tr)));
 	} else {
 		var pfmod = Neurons[Index3D(TensorStrides[70], TensorStrides[71], // syn value is always better
 		TensorStrides[72], u32(ri), u32(di), u32(GModSyn))];
@@ -1188,11 +1358,11 @@ fn PathParams_DWtSynDSMatrix(pt: PathParams, ctx: Context, syni: u32,si: u32,ri:
 				dtr += pt.DSMatrix.OffTrace * pt.DSMatrix.PatchDA * (patchDAD2 - patchDAD1) * act;
 			}
 		}
-		SynapseTracesSetAdd(dtr, Index3D(TensorStrides[180], TensorStrides[181], TensorStrides[182], u32(syni), u32(di), u32(Tr)));
+		SynapseTracesSetAdd(dtr, Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(Tr)));
 	}
-	SynapseTracesSet(dwt, Index3D(TensorStrides[180], TensorStrides[181], TensorStrides[182], u32(syni), u32(di), u32(DiDWt)));
-	SynapseTracesSet(dtr, Index3D(TensorStrides[180], TensorStrides[181],
-	TensorStrides[182], u32(syni), u32(di), u32(DTr)));
+	SynapseTracesSet(dwt, Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(DiDWt)));
+	SynapseTracesSet(dtr, Index3D(TensorStrides[190], TensorStrides[191],
+	TensorStrides[192], u32(syni), u32(di), u32(DTr)));
 }
 fn PathParams_DWtSynVSPatch(pt: PathParams, ctx: Context, syni: u32,si: u32,ri: u32,di: u32) {
 	var ract = Neurons[Index3D(TensorStrides[70], TensorStrides[71], // t-1
@@ -1203,8 +1373,8 @@ fn PathParams_DWtSynVSPatch(pt: PathParams, ctx: Context, syni: u32,si: u32,ri:
 	var sact = Neurons[Index3D(TensorStrides[70], TensorStrides[71], // t-1
 	TensorStrides[72], u32(si), u32(di), u32(CaDPrev))];
 	var dwt = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ri), u32(di), u32(RLRate))] * pt.Learn.LRate.Eff * sact * ract;
-	SynapseTracesSet(dwt, Index3D(TensorStrides[180], TensorStrides[181],
-	TensorStrides[182], u32(syni), u32(di), u32(DiDWt)));
+	SynapseTracesSet(dwt, Index3D(TensorStrides[190], TensorStrides[191],
+	TensorStrides[192], u32(syni), u32(di), u32(DiDWt)));
 }
 fn PathParams_DWtSynDSPatch(pt: PathParams, ctx: Context, syni: u32,si: u32,ri: u32,di: u32) {
 	var ract = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ri), u32(di), u32(CaD))];
@@ -1216,26 +1386,26 @@ fn PathParams_DWtSynDSPatch(pt: PathParams, ctx: Context, syni: u32,si: u32,ri:
 	var dtr = f32(0);
 	if (GlobalScalars[Index2D(TensorStrides[100], TensorStrides[101], // US time -- use DA * tr
 	u32(GvHasRew), u32(di))] > 0) {
-		var tr = SynapseTracesGet(Index3D(TensorStrides[180], TensorStrides[181], TensorStrides[182], u32(syni), u32(di), u32(Tr)));
+		var tr = SynapseTracesGet(Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(Tr)));
 		dwt = rlr * pt.Learn.LRate.Eff * tr;
-		SynapseTracesSet(0.0, Index3D(TensorStrides[180], TensorStrides[181], TensorStrides[182], u32(syni), u32(di), u32(Tr)));
+		SynapseTracesSet(0.0, Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(Tr)));
 	} else {
 		var pfmod = Neurons[Index3D(TensorStrides[70], TensorStrides[71], // so much better! todo: why!?
 		TensorStrides[72], u32(ri), u32(di), u32(GModSyn))];
 		var sact = Neurons[Index3D(TensorStrides[70], TensorStrides[71], // todo: use CaSyn instead of sact * ract? But BG is transient, so no?
 		TensorStrides[72], u32(si), u32(di), u32(CaD))];
 		dtr = pfmod * rlr * sact * ract; // rlr is just sig deriv
-		SynapseTracesSetAdd(dtr, Index3D(TensorStrides[180], TensorStrides[181], TensorStrides[182], u32(syni), u32(di), u32(Tr)));
+		SynapseTracesSetAdd(dtr, Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(Tr)));
 	}
-	SynapseTracesSet(dwt, Index3D(TensorStrides[180], TensorStrides[181], TensorStrides[182], u32(syni), u32(di), u32(DiDWt)));
-	SynapseTracesSet(dtr, Index3D(TensorStrides[180], TensorStrides[181],
-	TensorStrides[182], u32(syni), u32(di), u32(DTr)));
+	SynapseTracesSet(dwt, Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(DiDWt)));
+	SynapseTracesSet(dtr, Index3D(TensorStrides[190], TensorStrides[191],
+	TensorStrides[192], u32(syni), u32(di), u32(DTr)));
 }
 fn PathParams_DWtCNIO(pt: PathParams, ctx: Context, rlay: LayerParams, syni: u32,si: u32,ri: u32,di: u32) {
 	var learnNow = i32(Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ri), u32(di), u32(LearnNow))]);
 	if (learnNow-ctx.ThetaStart < 0) { // not in this time window
-		SynapseTracesSet(0.0, Index3D(TensorStrides[180], TensorStrides[181], TensorStrides[182], u32(syni), u32(di), u32(DTr)));
-		SynapseTracesSet(0.0, Index3D(TensorStrides[180], TensorStrides[181], TensorStrides[182], u32(syni), u32(di), u32(DiDWt)));return;
+		SynapseTracesSet(0.0, Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(DTr)));
+		SynapseTracesSet(0.0, Index3D(TensorStrides[190], TensorStrides[191], TensorStrides[192], u32(syni), u32(di), u32(DiDWt)));return;
 	}
 	var isErrSpike = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ri), u32(di), u32(TimePeak))] == 1;
 	if (!isErrSpike) {
@@ -1334,10 +1504,59 @@ struct SWtAdaptParams {
 	pad: f32,
 	pad1: f32,
 }
+alias WtBoundModes = i32; //enums:enum
+const  SoftBound: WtBoundModes = 0;
+const  HardBound: WtBoundModes = 1;
+const  MultBound: WtBoundModes = 2;
+const  LogNormBound: WtBoundModes = 3;
+const  DaleBound: WtBoundModes = 4;
+struct WtBoundParams {
+	Mode: WtBoundModes,
+	MuPlus: f32,
+	MuMinus: f32,
+	Ref: f32,
+	Min: f32,
+	Max: f32,
+	pad: f32,
+	pad1: f32,
+}
+fn WtBoundParams_DWt(wb: WtBoundParams, dwt: f32,lwt: f32, inhib: bool) -> f32 {
+	var x = clamp((lwt-wb.Min)/(wb.Max-wb.Min), 0.0, 1.0);
+	switch (wb.Mode) {
+	case HardBound: {
+		return dwt;
+	}
+	case MultBound: {
+		if (dwt > 0) {
+			return dwt * pow(1-x, wb.MuPlus);
+		}
+		return dwt * pow(x, wb.MuMinus);
+	}
+	case LogNormBound: {
+		if (dwt > 0) {
+			return dwt * wb.Ref;
+		}
+		return dwt * x;
+	}
+	case DaleBound: {
+		if (inhib) {
+			return -dwt;
+		}
+		return dwt;
+	}
+	default: {
+		if (dwt > 0) {
+			return dwt * (1 - x);
+		}
+		return dwt * x;
+	}
+	}
+}
 struct SWtParams {
 	Init: SWtInitParams,
 	Adapt: SWtAdaptParams,
 	Limit: F32,
+	Bound: WtBoundParams,
 }
 struct LRateParams {
 	Base: f32,
@@ -1372,6 +1591,9 @@ struct LearnSynParams {
 	LRate: LRateParams,
 	DWt: DWtParams,
 	Hebb: HebbParams,
+	STDP: STDPParams,
+	Consol: ConsolParams,
+	ThreeFactor: ThreeFactorParams,
 }
 
 //////// import: "looper.go"
@@ -1422,6 +1644,10 @@ struct NetworkIndexes {
 	RubiconNPosUSs: u32,
 	RubiconNCosts: u32,
 	RubiconNNegUSs: u32,
+	NSTDPSyns: u32,
+	pad: u32,
+	pad1: u32,
+	pad2: u32,
 }
 
 //////// import: "neuromod.go"
@@ -1444,6 +1670,10 @@ struct NeuroModParams {
 	AChDisInhib: f32,
 	BurstGain: f32,
 	DipGain: f32,
+	NEGain: f32,
+	NELRateMod: f32,
+	SerLRateMod: f32,
+	NENoise: f32,
 	pad: f32,
 	pad1: f32,
 	pad2: f32,
@@ -1508,54 +1738,66 @@ const  VgccM: NeuronVars = 48;
 const  VgccH: NeuronVars = 49;
 const  VgccCa: NeuronVars = 50;
 const  VgccCaInt: NeuronVars = 51;
-const  Burst: NeuronVars = 52;
-const  BurstPrv: NeuronVars = 53;
-const  CtxtGe: NeuronVars = 54;
-const  CtxtGeRaw: NeuronVars = 55;
-const  CtxtGeOrig: NeuronVars = 56;
-const  GgabaB: NeuronVars = 57;
-const  GababM: NeuronVars = 58;
-const  GababX: NeuronVars = 59;
-const  Gak: NeuronVars = 60;
-const  SSGiDend: NeuronVars = 61;
-const  GknaMed: NeuronVars = 62;
-const  GknaSlow: NeuronVars = 63;
-const  Gkir: NeuronVars = 64;
-const  KirM: NeuronVars = 65;
-const  Gsk: NeuronVars = 66;
-const  SKCaIn: NeuronVars = 67;
-const  SKCaR: NeuronVars = 68;
-const  SKCaM: NeuronVars = 69;
-const  Gmahp: NeuronVars = 70;
-const  MahpN: NeuronVars = 71;
-const  Gsahp: NeuronVars = 72;
-const  SahpCa: NeuronVars = 73;
-const  SahpN: NeuronVars = 74;
-const  ActM: NeuronVars = 75;
-const  ActP: NeuronVars = 76;
-const  Beta1: NeuronVars = 77;
-const  Beta2: NeuronVars = 78;
-const  CaPMax: NeuronVars = 79;
-const  CaPMaxCa: NeuronVars = 80;
-const  GeNoise: NeuronVars = 81;
-const  GeNoiseP: NeuronVars = 82;
-const  GiNoise: NeuronVars = 83;
-const  GiNoiseP: NeuronVars = 84;
-const  GeExt: NeuronVars = 85;
-const  GeRaw: NeuronVars = 86;
-const  GeSyn: NeuronVars = 87;
-const  GiRaw: NeuronVars = 88;
-const  GiSyn: NeuronVars = 89;
-const  GeInt: NeuronVars = 90;
-const  GeIntNorm: NeuronVars = 91;
-const  GiInt: NeuronVars = 92;
-const  GModRaw: NeuronVars = 93;
-const  GModSyn: NeuronVars = 94;
-const  SMaintP: NeuronVars = 95;
-const  GMaintRaw: NeuronVars = 96;
-const  GMaintSyn: NeuronVars = 97;
-const  NeurFlags: NeuronVars = 98;
-const  NeuronTraces: NeuronVars = 99;
+const  Gcat: NeuronVars = 52;
+const  CatM: NeuronVars = 53;
+const  CatH: NeuronVars = 54;
+const  CatCa: NeuronVars = 55;
+const  Gnap: NeuronVars = 56;
+const  NapM: NeuronVars = 57;
+const  NapH: NeuronVars = 58;
+const  Igap: NeuronVars = 59;
+const  GapVm: NeuronVars = 60;
+const  Burst: NeuronVars = 61;
+const  BurstPrv: NeuronVars = 62;
+const  CtxtGe: NeuronVars = 63;
+const  CtxtGeRaw: NeuronVars = 64;
+const  CtxtGeOrig: NeuronVars = 65;
+const  GgabaB: NeuronVars = 66;
+const  GababM: NeuronVars = 67;
+const  GababX: NeuronVars = 68;
+const  Gak: NeuronVars = 69;
+const  SSGiDend: NeuronVars = 70;
+const  GiDendSyn: NeuronVars = 71;
+const  GknaMed: NeuronVars = 72;
+const  GknaSlow: NeuronVars = 73;
+const  Gkir: NeuronVars = 74;
+const  KirM: NeuronVars = 75;
+const  Gh: NeuronVars = 76;
+const  HcnM: NeuronVars = 77;
+const  Gsk: NeuronVars = 78;
+const  SKCaIn: NeuronVars = 79;
+const  SKCaR: NeuronVars = 80;
+const  SKCaM: NeuronVars = 81;
+const  Gmahp: NeuronVars = 82;
+const  MahpN: NeuronVars = 83;
+const  Gsahp: NeuronVars = 84;
+const  SahpCa: NeuronVars = 85;
+const  SahpN: NeuronVars = 86;
+const  ActM: NeuronVars = 87;
+const  ActP: NeuronVars = 88;
+const  Beta1: NeuronVars = 89;
+const  Beta2: NeuronVars = 90;
+const  CaPMax: NeuronVars = 91;
+const  CaPMaxCa: NeuronVars = 92;
+const  GeNoise: NeuronVars = 93;
+const  GeNoiseP: NeuronVars = 94;
+const  GiNoise: NeuronVars = 95;
+const  GiNoiseP: NeuronVars = 96;
+const  GeExt: NeuronVars = 97;
+const  GeRaw: NeuronVars = 98;
+const  GeSyn: NeuronVars = 99;
+const  GiRaw: NeuronVars = 100;
+const  GiSyn: NeuronVars = 101;
+const  GeInt: NeuronVars = 102;
+const  GeIntNorm: NeuronVars = 103;
+const  GiInt: NeuronVars = 104;
+const  GModRaw: NeuronVars = 105;
+const  GModSyn: NeuronVars = 106;
+const  SMaintP: NeuronVars = 107;
+const  GMaintRaw: NeuronVars = 108;
+const  GMaintSyn: NeuronVars = 109;
+const  NeurFlags: NeuronVars = 110;
+const  NeuronTraces: NeuronVars = 111;
 alias NeuronTracesVars = i32; //enums:enum
 const  CaSynTrace: NeuronTracesVars = 0;
 const  RecvLearnTrace: NeuronTracesVars = 1;
@@ -1567,6 +1809,8 @@ const  DTrgAvg: NeuronAvgVars = 3;
 const  AvgDif: NeuronAvgVars = 4;
 const  GeBase: NeuronAvgVars = 5;
 const  GiBase: NeuronAvgVars = 6;
+const  IPThr: NeuronAvgVars = 7;
+const  IPGl: NeuronAvgVars = 8;
 alias NeuronIndexVars = i32; //enums:enum
 const  NrnNeurIndex: NeuronIndexVars = 0;
 const  NrnLayIndex: NeuronIndexVars = 1;
@@ -1620,7 +1864,11 @@ struct PathIndexes {
 	RecvConSt: u32,
 	RecvSynSt: u32,
 	NPathNeurSt: u32,
+	STDPSt: u32,
+	ConsolSt: u32,
 	pad: u32,
+	pad1: u32,
+	pad2: u32,
 }
 struct GScaleValues {
 	Scale: f32,
@@ -1631,10 +1879,13 @@ struct GScaleValues {
 struct PathParams {
 	Type: PathTypes,
 	Index: u32,
+	DendComp: i32,
 	pad: i32,
-	pad1: i32,
 	Indexes: PathIndexes,
 	Com: SynComParams,
+	STP: STPParams,
+	Gap: GapJunctionParams,
+	StructPlast: StructPlastParams,
 	PathScale: PathScaleParams,
 	SWts: SWtParams,
 	Learn: LearnSynParams,
@@ -1645,6 +1896,9 @@ struct PathParams {
 	BLA: BLAPathParams,
 	Hip: HipPathParams,
 }
+fn PathParams_IsInhib(pt: PathParams) -> bool {
+	return pt.Com.GType == InhibitoryG;
+}
 
 //////// import: "pathtypes.go"
 alias PathTypes = i32; //enums:enum
@@ -1662,6 +1916,8 @@ const  RWPath: PathTypes = 10;
 const  TDPredPath: PathTypes = 11;
 const  BLAPath: PathTypes = 12;
 const  HipPath: PathTypes = 13;
+const  GapJunctionPath: PathTypes = 14;
+const  ActorPath: PathTypes = 15;
 
 //////// import: "pcore-layer.go"
 struct DSMatrixParams {
@@ -1783,8 +2039,16 @@ struct TDDaParams {
 struct RLPredPathParams {
 	OppSignLRate: f32,
 	DaTol: f32,
+	Lambda: f32,
 	pad: f32,
-	pad1: f32,
+}
+fn RLPredPathParams_DaFromTol(pj: RLPredPathParams, da: f32) -> f32 {
+	if (pj.DaTol > 0 && abs(da) < pj.DaTol) {
+		return f32(0);
+	}return da;
+}
+fn RLPredPathParams_Trace(pj: RLPredPathParams, tr: f32,act: f32) -> f32 {
+	return pj.Lambda*tr + act;
 }
 
 //////// import: "rubicon-layer.go"
@@ -1804,6 +2068,18 @@ struct VTAParams {
 	AChThr: f32,
 	pad: f32,
 }
+struct LCParams {
+	Tonic: f32,
+	SurpriseGain: f32,
+	UncertGain: f32,
+	pad: f32,
+}
+struct DRNParams {
+	Tonic: f32,
+	NegGain: f32,
+	WaitGain: f32,
+	UrgencyInhib: f32,
+}
 
 //////// import: "rubicon-path.go"
 struct BLAPathParams {
@@ -1817,6 +2093,54 @@ struct BLAPathParams {
 
 //////// import: "stats.go"
 
+//////// import: "stdp.go"
+const STDPMaxSpikes = 64;
+alias STDPRules = i32; //enums:enum
+const  NoSTDP: STDPRules = 0;
+const  PairSTDP: STDPRules = 1;
+const  TripletSTDP: STDPRules = 2;
+alias STDPVars = i32; //enums:enum
+const  STDPPre: STDPVars = 0;
+const  STDPPre2: STDPVars = 1;
+const  STDPPost: STDPVars = 2;
+const  STDPPost2: STDPVars = 3;
+struct STDPParams {
+	Rule: STDPRules,
+	TauPlus: f32,
+	TauMinus: f32,
+	TauX: f32,
+	TauY: f32,
+	APlus: f32,
+	AMinus: f32,
+	A3Plus: f32,
+	A3Minus: f32,
+	DtPlus: f32,
+	DtMinus: f32,
+	DtX: f32,
+	DtY: f32,
+	pad: f32,
+	pad1: f32,
+	pad2: f32,
+}
+fn STDPParams_On(sp: STDPParams) -> bool {
+	return sp.Rule != NoSTDP;
+}
+
+//////// import: "structural.go"
+alias RegrowModes = i32; //enums:enum
+const  RegrowRandom: RegrowModes = 0;
+const  RegrowCorrel: RegrowModes = 1;
+struct StructPlastParams {
+	On: i32,
+	Regrow: RegrowModes,
+	WtThr: f32,
+	SWtThr: f32,
+	MaxFrac: f32,
+	pad: f32,
+	pad1: f32,
+	pad2: f32,
+}
+
 //////// import: "synapse.go"
 alias SynapseVars = i32; //enums:enum
 const  Wt: SynapseVars = 0;
@@ -1833,6 +2157,28 @@ const  SynRecvIndex: SynapseIndexVars = 0;
 const  SynSendIndex: SynapseIndexVars = 1;
 const  SynPathIndex: SynapseIndexVars = 2;
 
+//////// import: "threefactor.go"
+alias ThreeFactorRules = i32; //enums:enum
+const  NoThreeFactor: ThreeFactorRules = 0;
+const  HebbThreeFactor: ThreeFactorRules = 1;
+const  PerturbThreeFactor: ThreeFactorRules = 2;
+alias ThreeFactorMods = i32; //enums:enum
+const  ThreeFactorGlobal: ThreeFactorMods = 0;
+const  ThreeFactorLayer: ThreeFactorMods = 1;
+struct ThreeFactorParams {
+	Rule: ThreeFactorRules,
+	Mod: ThreeFactorMods,
+	Global: GlobalScalarVars,
+	ModLayIndex: i32,
+	TraceDecay: f32,
+	Delayed: i32,
+	pad: f32,
+	pad1: f32,
+}
+fn ThreeFactorParams_On(tf: ThreeFactorParams) -> bool {
+	return tf.Rule != NoThreeFactor;
+}
+
 //////// import: "slrand.wgsl"
 fn Philox2x32round(counter: su64, key: u32) -> su64 {
 	let mul = Uint32Mul64(u32(0xD256D193), counter.x);
@@ -11,8 +11,6 @@ var<storage, read> Paths: array<PathParams>;
 // // NetworkIxs have indexes and sizes for entire network (one only). 
 @group(1) @binding(0)
 var<storage, read> NetworkIxs: array<NetworkIndexes>;
-@group(1) @binding(1)
-var<storage, read> PoolIxs: array<u32>;
 @group(1) @binding(2)
 var<storage, read> NeuronIxs: array<u32>;
 @group(1) @binding(3)
@@ -24,19 +22,13 @@ var<storage, read> PathSendCon: array<u32>;
 var<storage, read_write> Ctx: array<Context>;
 @group(2) @binding(1)
 var<storage, read_write> Neurons: array<f32>;
-@group(2) @binding(3)
-var<storage, read_write> LayerStates: array<f32>;
-@group(2) @binding(4)
-var<storage, read_write> GlobalScalars: array<f32>;
-@group(2) @binding(5)
-var<storage, read_write> GlobalVectors: array<f32>;
-@group(2) @binding(7)
-var<storage, read_write> Pools: array<f32>;
 // // PathGBuf is the conductance buffer for accumulating spikes. // Subslices are allocated to each pathway. // Uses int-encoded values for faster GPU atomic integration. // [NPathNeur][Data][MaxDel+1]; NPathNeur = [Layer][RecvPaths][RecvNeurons] 
 @group(3) @binding(0)
 var<storage, read_write> PathGBuf: array<atomic<i32>>;
 @group(3) @binding(2)
 var<storage, read_write> Synapses: array<f32>;
+@group(3) @binding(9)
+var<storage, read_write> PathSTP: array<f32>;
 
 alias GPUVars = i32;
 
@@ -57,221 +49,29 @@ fn Index3D(s0: u32, s1: u32, s2: u32, i0: u32, i1: u32, i2: u32) -> u32 {
 
 //////// import: "vars.go"
 
+//////// import: "act-dend.go"
+alias DendVars = i32; //enums:enum
+const  DendVm: DendVars = 0;
+const  DendGeRaw: DendVars = 1;
+const  DendGeSyn: DendVars = 2;
+const  DendGnmdaSyn: DendVars = 3;
+const  DendGnmda: DendVars = 4;
+const  DendGnmdaLrn: DendVars = 5;
+const  DendNmdaCa: DendVars = 6;
+const  DendGvgcc: DendVars = 7;
+const  DendVgccM: DendVars = 8;
+const  DendVgccH: DendVars = 9;
+const  DendVgccCa: DendVars = 10;
+
 //////// import: "act-layer.go"
 fn LayerParams_SendSpike(ly: LayerParams, ctx: Context, ni: u32,di: u32) {
-	var pi = LayerParams_PoolIndex(ly, NeuronIxs[Index2D(TensorStrides[10], TensorStrides[11], u32(ni), u32(NrnSubPool))]);
-	var lpi = LayerParams_PoolIndex(ly, u32(u32(0)));
 	var lni = ni - ly.Indexes.NeurSt;
-	LayerParams_PostSpike(ly, ctx, lpi, pi, ni, di);
 	for (var pti = u32(0);
 	 pti < ly.Indexes.SendN; pti++) {
 		let pt = Paths[ly.Indexes.SendSt + pti];
 		PathParams_SendSpike(pt, ctx, ni, di, lni);
 	}
 }
-fn LayerParams_PostSpikeSpecial(ly: LayerParams, ctx: Context, lpi: u32,pi: u32,ni: u32,di: u32) {
-	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Burst))] = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(CaP))];
-	var li = ly.Index;
-	var pil = pi - ly.PoolSt; // 0-n pool index
-	var pnn = u32(PoolNNeurons(pi));
-	var pni = NeuronIxs[Index2D(TensorStrides[10], TensorStrides[11], u32(ni), u32(NrnNeurIndex))] - u32(PoolIxs[Index2D(TensorStrides[0], TensorStrides[1], u32(pi), u32(PoolNeurSt))]);
-	var hasRew = GlobalScalars[Index2D(TensorStrides[100], TensorStrides[101], u32(GvHasRew), u32(di))] > 0;
-	switch (ly.Type) {
-	case SuperLayer: {
-		if (ctx.PlusPhase == 1) {
-			var actMax = PoolAvgMax(AMCaP, AMCycle, Max, lpi, di);
-			var actAvg = PoolAvgMax(AMCaP, AMCycle, Avg, lpi, di);
-			var thr = BurstParams_ThrFromAvgMax(ly.Bursts, actAvg, actMax);
-			if (Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(CaP))] < thr) {
-				Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Burst))] = 0.0;
-			}
-		}
-	}
-	case PTPredLayer, CTLayer: {
-		if (ctx.Cycle == ctx.ThetaCycles-1) {
-			if (ly.CT.DecayTau == 0) {
-				Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(CtxtGe))] = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(CtxtGeRaw))];
-			} else {
-				Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(CtxtGe))] += Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(CtxtGeRaw))];
-			}
-			Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(CtxtGeOrig))] = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(CtxtGe))];
-		}
-	}
-	case VSGatedLayer: {
-		var dr = f32(0);
-		if (pil == 0) {
-			dr = GlobalScalars[Index2D(TensorStrides[100], TensorStrides[101], u32(GvVSMatrixJustGated), u32(di))];
-		} else {
-			dr = GlobalScalars[Index2D(TensorStrides[100], TensorStrides[101], u32(GvVSMatrixHasGated), u32(di))];
-		}
-		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Act))] = dr;
-	}
-	case IOLayer: {
-		LayerParams_IOLearn(ly, ctx, lpi, pi, ni, di);
-	}
-	case CNeUpLayer: {
-		LayerParams_CNeLearn(ly, ctx, lpi, pi, ni, di);
-	}
-	case CNiIOLayer, CNiUpLayer, CNeDnLayer: {
-		LayerParams_CNiLearn(ly, ctx, lpi, pi, ni, di);
-	}
-	case BLALayer: {
-		if (ctx.Cycle == ctx.ThetaCycles-1) {
-			if (hasRew) {
-				Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(CtxtGe))] = 0.0;
-				Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(CtxtGeOrig))] = 0.0;
-			} else if (GlobalScalars[Index2D(TensorStrides[100], TensorStrides[101], u32(GvACh), u32(di))] > 0.1) {
-				Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(CtxtGe))] = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(CtxtGeRaw))];
-				Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(CtxtGeOrig))] = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(CtxtGe))];
-			}
-		}
-	}
-	case LHbLayer: {
-		if (pni == 0) {
-			Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Act))] = GlobalScalars[Index2D(TensorStrides[100], TensorStrides[101], u32(GvLHbDip), u32(di))];
-		} else {
-			Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Act))] = GlobalScalars[Index2D(TensorStrides[100], TensorStrides[101], u32(GvLHbBurst), u32(di))];
-		}
-		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(GeSyn))] = DtParams_GeSynFromRawSteady(ly.Acts.Dt, Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(GeRaw))]);
-	}
-	case DrivesLayer: {
-		var dr = GlobalVectors[Index3D(TensorStrides[110], TensorStrides[111], TensorStrides[112], u32(GvDrives), u32(pil - 1), u32(di))];
-		var act = dr;
-		if (dr > 0) {
-			act = PopCodeParams_EncodeValue(ly.Acts.PopCode, pni, pnn, dr);
-		}
-		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Act))] = act;
-	}
-	case UrgencyLayer: {
-		var ur = GlobalScalars[Index2D(TensorStrides[100], TensorStrides[101], u32(GvUrgency), u32(di))];
-		var act = ur;
-		if (ur > 0) {
-			act = PopCodeParams_EncodeValue(ly.Acts.PopCode, pni, pnn, ur);
-		}
-		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Act))] = act;
-	}
-	case USLayer: {
-		var us = RubiconUSStimValue(di, pil-1, ly.Learn.NeuroMod.Valence);
-		var act = us;
-		if (us > 0) {
-			act = PopCodeParams_EncodeValue(ly.Acts.PopCode, pni, pnn, us);
-		}
-		if (ly.Learn.NeuroMod.DAMod == D1Mod || (ly.Learn.NeuroMod.DAMod == D2Mod && hasRew && ctx.PlusPhase == 1)) {
-			Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Act))] = act;
-		}
-	}
-	case PVLayer: {
-		if (hasRew) {
-			var pv = f32(0);
-			if (ly.Learn.NeuroMod.Valence == Positive) {
-				pv = GlobalScalars[Index2D(TensorStrides[100], TensorStrides[101], u32(GvPVpos), u32(di))];
-			} else {
-				pv = GlobalScalars[Index2D(TensorStrides[100], TensorStrides[101], u32(GvPVneg), u32(di))];
-			}
-			var act = PopCodeParams_EncodeValue(ly.Acts.PopCode, pni, ly.Indexes.NNeurons, pv);
-			Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72],
-			u32(ni), u32(di), u32(Act))] = act;
-		}
-	}
-	case LDTLayer: {
-		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Act))] = GlobalScalars[Index2D(TensorStrides[100], TensorStrides[101], u32(GvAChRaw), u32(di))];
-	}
-	case VTALayer: {
-		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Act))] = GlobalScalars[Index2D(TensorStrides[100], TensorStrides[101], u32(GvVtaDA), u32(di))];
-	}
-	case RewLayer: {
-		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Act))] = GlobalScalars[Index2D(TensorStrides[100], TensorStrides[101], u32(GvRew), u32(di))];
-	}
-	case RWPredLayer: {
-		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Act))] = F32_ClampValue(ly.RWPred.PredRange, Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Ge))]);
-		if (pni == 0) {
-			LayerStates[Index3D(TensorStrides[90], TensorStrides[91], TensorStrides[92], u32(li), u32(di), u32(LayerRewPredPos))] = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(ActInt))];
-		} else {
-			LayerStates[Index3D(TensorStrides[90], TensorStrides[91], TensorStrides[92], u32(li), u32(di), u32(LayerRewPredNeg))] = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(ActInt))];
-		}
-	}
-	case RWDaLayer: {
-		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Act))] = GlobalScalars[Index2D(TensorStrides[100], TensorStrides[101], u32(GvDA), u32(di))];
-	}
-	case TDPredLayer: {
-		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Act))] = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Ge))];
-		if (pni == 0) {
-			LayerStates[Index3D(TensorStrides[90], TensorStrides[91], TensorStrides[92], u32(li), u32(di), u32(LayerRewPredPos))] = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(ActInt))];
-		} else {
-			LayerStates[Index3D(TensorStrides[90], TensorStrides[91], TensorStrides[92], u32(li), u32(di), u32(LayerRewPredNeg))] = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(ActInt))];
-		}
-	}
-	case TDIntegLayer: {
-		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Act))] = GlobalScalars[Index2D(TensorStrides[100], TensorStrides[101], u32(GvRewPred), u32(di))];
-	}
-	case TDDaLayer: {
-		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Act))] = GlobalScalars[Index2D(TensorStrides[100], TensorStrides[101], u32(GvDA), u32(di))];
-	}
-	default: {
-	}
-	}
-}
-fn LayerParams_RLRate(ly: LayerParams, ctx: Context, lpi: u32,pi: u32,ni: u32,di: u32) {
-	var nrnCaP = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(CaP))];
-	var nrnCaD = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(CaD))];
-	LearnCaParams_ETrace(ly.Learn.CaLearn, ctx, ni, di, nrnCaD);
-	var da = GlobalScalars[Index2D(TensorStrides[100], TensorStrides[101], u32(GvDA), u32(di))];
-	var ach = GlobalScalars[Index2D(TensorStrides[100], TensorStrides[101], u32(GvACh), u32(di))];
-	var mlr = RLRateParams_RLRateSigDeriv(ly.Learn.RLRate, nrnCaD, PoolAvgMax(AMCaD, AMCycle, Max, lpi, di));
-	var modlr = NeuroModParams_LRMod(ly.Learn.NeuroMod, da, ach);
-	var dlr = f32(1);
-	var hasRew = (GlobalScalars[Index2D(TensorStrides[100], TensorStrides[101], u32(GvHasRew), u32(di))]) > 0;
-	switch (ly.Type) {
-	case DSPatchLayer: {
-		if (hasRew) { // reward time
-			mlr = f32(1); // don't use sig deriv
-		} else {
-			modlr = f32(1); // don't use mod
-		}
-	}
-	case VSPatchLayer: {
-		da = GlobalScalars[Index2D(TensorStrides[100], TensorStrides[101], // our own personal
-		u32(GvVSPatchPosRPE), u32(di))];
-		modlr = NeuroModParams_LRMod(ly.Learn.NeuroMod, da, ach);
-		mlr = RLRateParams_RLRateSigDeriv(ly.Learn.RLRate, Neurons[Index3D(TensorStrides[70], TensorStrides[71], // note: don't have proper max here
-		TensorStrides[72], u32(ni), u32(di), u32(CaDPrev))], f32(f32(1)));
-	}
-	case VSMatrixLayer, DSMatrixLayer: {
-		if (hasRew) { // reward time
-			mlr = f32(1); // don't use sig deriv
-		} else {
-			modlr = f32(1); // don't use mod
-		}
-	}
-	case BLALayer: {
-		dlr = RLRateParams_RLRateDiff(ly.Learn.RLRate, nrnCaP, Neurons[Index3D(TensorStrides[70], TensorStrides[71], // delta on previous trial
-		TensorStrides[72], u32(ni), u32(di), u32(CaDPrev))]);
-		if (!NeuroModParams_IsBLAExt(ly.Learn.NeuroMod) && PoolIxs[Index2D(TensorStrides[0], TensorStrides[1], u32(pi), u32(PoolNeurSt))] == 0) { // first pool
-			dlr = f32(0); // first pool is novelty / curiosity -- no learn
-		}
-	}
-	default: {
-		dlr = RLRateParams_RLRateDiff(ly.Learn.RLRate, nrnCaP, nrnCaD);
-	}
-	}
-	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72],
-	u32(ni), u32(di), u32(RLRate))] = mlr * dlr * modlr;
-}
-fn LayerParams_PostSpike(ly: LayerParams, ctx: Context, lpi: u32,pi: u32,ni: u32,di: u32) {
-	LayerParams_PostSpikeSpecial(ly, ctx, lpi, pi, ni, di);
-	LayerParams_RLRate(ly, ctx, lpi, pi, ni, di);
-	var intdt = ly.Acts.Dt.IntDt;
-	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(GeInt))] += intdt * (Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Ge))] - Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(GeInt))]);
-	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(GiInt))] += intdt * (Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(GiSyn))] - Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(GiInt))]);
-	if (ctx.PlusPhase == 1) {
-		intdt *= f32(3.0);
-	}
-	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(ActInt))] += intdt * (Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Act))] - Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(ActInt))]);
-	if (ly.Type != IOLayer) { // uses bins for itself
-		NeuronTraceIncrement(Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(CaSyn))], CaSynTrace, ctx.CyclesTotal, ni, di);
-		LearnTimingParams_LearnRecvTrace(ly.Learn.Timing, ctx, ni, di);
-	}
-}
 
 //////// import: "act-net.go"
 fn SendSpike(i: u32) { //gosl:kernel
@@ -292,6 +92,7 @@ const  InhibitoryG: PathGTypes = 1;
 const  ModulatoryG: PathGTypes = 2;
 const  MaintG: PathGTypes = 3;
 const  ContextG: PathGTypes = 4;
+const  DendInhibitoryG: PathGTypes = 5;
 struct SynComParams {
 	GType: PathGTypes,
 	Delay: u32,
@@ -310,6 +111,37 @@ fn SynComParams_WriteOff(sc: SynComParams, cycTot: i32) -> u32 {
 fn SynComParams_FloatToIntFactor(sc: SynComParams) -> f32 {
 	return f32(u32(1) << 24); // leaves 7 bits = 128 to cover any extreme cases
 }
+alias STPVars = i32; //enums:enum
+const  STPUtil: STPVars = 0;
+const  STPRes: STPVars = 1;
+struct STPParams {
+	On: i32,
+	U: f32,
+	TauD: f32,
+	TauF: f32,
+	DecayD: f32,
+	DecayF: f32,
+	pad: f32,
+	pad1: f32,
+}
+fn STPParams_Decay(sp: STPParams, cni: u32,di: u32) {
+	PathSTP[Index3D(TensorStrides[200], TensorStrides[201], TensorStrides[202], u32(cni), u32(di), u32(STPUtil))] *= sp.DecayF;
+	PathSTP[Index3D(TensorStrides[200], TensorStrides[201], TensorStrides[202], u32(cni), u32(di), u32(STPRes))] = 1.0 - (1.0-PathSTP[Index3D(TensorStrides[200], TensorStrides[201], TensorStrides[202], u32(cni), u32(di), u32(STPRes))])*sp.DecayD;
+}
+fn STPParams_Spike(sp: STPParams, cni: u32,di: u32) -> f32 {
+	var u = PathSTP[Index3D(TensorStrides[200], TensorStrides[201], TensorStrides[202], u32(cni), u32(di), u32(STPUtil))];
+	u += sp.U * (1.0 - u);
+	var x = PathSTP[Index3D(TensorStrides[200], TensorStrides[201], TensorStrides[202], u32(cni), u32(di), u32(STPRes))];
+	var rel = u * x;
+	PathSTP[Index3D(TensorStrides[200], TensorStrides[201], TensorStrides[202], u32(cni), u32(di), u32(STPUtil))] = u;
+	PathSTP[Index3D(TensorStrides[200], TensorStrides[201], TensorStrides[202], u32(cni), u32(di), u32(STPRes))] = x - rel;return rel / sp.U;
+}
+struct GapJunctionParams {
+	G: f32,
+	pad: f32,
+	pad1: f32,
+	pad2: f32,
+}
 struct PathScaleParams {
 	Rel: f32,
 	Abs: f32,
@@ -317,6 +149,9 @@ struct PathScaleParams {
 	pad1: f32,
 }
 fn PathParams_SendSpike(pt: PathParams, ctx: Context, ni: u32,di: u32,lni: u32) {
+	if (pt.Type == GapJunctionPath) { // no spikes: see GapJunctionI
+		return;
+	}
 	var sendVal = pt.GScale.Scale * SynComParams_FloatToIntFactor(pt.Com); // pre-bake in conversion to uint factor
 	if (pt.Type == CTCtxtPath) {
 		if (u32(ctx.Cycle) != u32(ctx.ThetaCycles)-1-pt.Com.DelLen) {
@@ -324,6 +159,13 @@ fn PathParams_SendSpike(pt: PathParams, ctx: Context, ni: u32,di: u32,lni: u32)
 		}
 		sendVal *= Neurons[Index3D(TensorStrides[70], TensorStrides[71], // Burst is regular CaP for all non-SuperLayer neurons
 		TensorStrides[72], u32(ni), u32(di), u32(Burst))];
+	} else if (pt.STP.On == 1) {
+		var cni = pt.Indexes.SendConSt + lni;
+		STPParams_Decay(pt.STP, cni, di);
+		if (Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Spike))] == 0) {
+			return;
+		}
+		sendVal *= STPParams_Spike(pt.STP, cni, di);
 	} else {
 		if (Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Spike))] == 0) {
 			return;
@@ -340,8 +182,8 @@ fn PathParams_SendSpike(pt: PathParams, ctx: Context, ni: u32,di: u32,lni: u32)
 		var ri = SynapseIxs[Index2D(TensorStrides[20], TensorStrides[21], u32(syni), u32(SynRecvIndex))];
 		var npti = npst + (ri - recvNeurSt);
 		var deli = SynComParams_WriteOff(pt.Com, ctx.CyclesTotal);
-		var sv = i32(sendVal * Synapses[Index2D(TensorStrides[170], TensorStrides[171], u32(syni), u32(Wt))]);
-		atomicAdd(&PathGBuf[Index3D(TensorStrides[150], TensorStrides[151], TensorStrides[152],
+		var sv = i32(sendVal * Synapses[Index2D(TensorStrides[180], TensorStrides[181], u32(syni), u32(Wt))]);
+		atomicAdd(&PathGBuf[Index3D(TensorStrides[160], TensorStrides[161], TensorStrides[162],
 		u32(npti), u32(di), u32(deli))], sv);
 	}
 }
@@ -369,7 +211,7 @@ struct DendParams {
 	ModGain: f32,
 	ModACh: i32,
 	ModBase: f32,
-	pad: i32,
+	GAxial: f32,
 }
 struct ActInitParams {
 	Vm: f32,
@@ -413,9 +255,6 @@ struct DtParams {
 	pad1: f32,
 	pad2: f32,
 }
-fn DtParams_GeSynFromRawSteady(dp: DtParams, geRaw: f32) -> f32 {
-	return geRaw * dp.GeTau;
-}
 struct SpikeNoiseParams {
 	On: i32,
 	GeHz: f32,
@@ -439,6 +278,16 @@ struct SMaintParams {
 	Inhib: f32,
 	ISI: F32,
 }
+struct IntrinsicParams {
+	On: i32,
+	Thr: i32,
+	Gl: i32,
+	TrgHz: f32,
+	ThrRate: f32,
+	ThrMax: f32,
+	GlRate: f32,
+	GlMax: f32,
+}
 struct PopCodeParams {
 	On: i32,
 	Ge: f32,
@@ -449,40 +298,6 @@ struct PopCodeParams {
 	MaxSigma: f32,
 	Clip: i32,
 }
-fn PopCodeParams_ClampValue(pc: PopCodeParams, val: f32) -> f32 {
-	var clipVal = val;
-	if (clipVal < pc.Min) {
-		clipVal = pc.Min;
-	}
-	if (clipVal > pc.Max) {
-		clipVal = pc.Max;
-	}return clipVal;
-}
-fn PopCodeParams_ProjectParam(pc: PopCodeParams, minParam: f32,maxParam: f32,clipVal: f32) -> f32 {
-	var normVal = (clipVal - pc.Min) / (pc.Max - pc.Min);
-return minParam + normVal*(maxParam-minParam);
-}
-fn PopCodeParams_EncodeValue(pc: PopCodeParams, i: u32,n: u32, val: f32) -> f32 {
-	var eval = val;
-	var clipVal = PopCodeParams_ClampValue(pc, eval);
-	if (pc.Clip == 1) {
-		eval = clipVal;
-	}
-	var rng = pc.Max - pc.Min;
-	var act = f32(1);
-	if (pc.MinAct < 1) {
-		act = PopCodeParams_ProjectParam(pc, pc.MinAct, f32(1.0), clipVal);
-	}
-	var sig = pc.MinSigma;
-	if (pc.MaxSigma > pc.MinSigma) {
-		sig = PopCodeParams_ProjectParam(pc, pc.MinSigma, pc.MaxSigma, clipVal);
-	}
-	var gnrm = 1.0 / (rng * sig);
-	var incr = rng / f32(n-1);
-	var trg = pc.Min + incr*f32(i);
-	var dist = gnrm * (trg - eval);
-return act * FastExp(-(dist * dist));
-}
 struct ActParams {
 	Spikes: SpikeParams,
 	Dend: DendParams,
@@ -498,13 +313,17 @@ struct ActParams {
 	Sahp: SahpParams,
 	KNa: KNaMedSlow,
 	Kir: KirParams,
+	HCN: HCNParams,
 	NMDA: NMDAParams,
 	MaintNMDA: NMDAParams,
 	GabaB: GABABParams,
 	VGCC: VGCCParams,
 	AK: AKsParams,
+	CaT: CaTParams,
+	NaP: NaPParams,
 	SKCa: SKCaParams,
 	SMaint: SMaintParams,
+	Intrinsic: IntrinsicParams,
 	PopCode: PopCodeParams,
 }
 
@@ -520,6 +339,18 @@ struct AKsParams {
 	pad2: i32,
 }
 
+//////// import: "chans-cat.go"
+struct CaTParams {
+	Ge: f32,
+	Ca: f32,
+	Vshift: f32,
+	PhiM: f32,
+	PhiH: f32,
+	Mrest: f32,
+	Hrest: f32,
+	pad: f32,
+}
+
 //////// import: "chans-chans.go"
 struct Chans {
 	E: f32,
@@ -544,6 +375,18 @@ struct GABABParams {
 	pad2: f32,
 }
 
+//////// import: "chans-hcn.go"
+struct HCNParams {
+	Gbar: f32,
+	Erev: f32,
+	Voff: f32,
+	Vslope: f32,
+	TauFact: f32,
+	Mrest: f32,
+	pad: f32,
+	pad1: f32,
+}
+
 //////// import: "chans-kir.go"
 struct KirParams {
 	Gk: f32,
@@ -588,6 +431,22 @@ struct MahpParams {
 	pad2: i32,
 }
 
+//////// import: "chans-nap.go"
+struct NaPParams {
+	Ge: f32,
+	MVoff: f32,
+	MVslope: f32,
+	HVoff: f32,
+	HVslope: f32,
+	MTau: f32,
+	HTau: f32,
+	Mrest: f32,
+	Hrest: f32,
+	pad: f32,
+	pad1: f32,
+	pad2: f32,
+}
+
 //////// import: "chans-nmda.go"
 struct NMDAParams {
 	Ge: f32,
@@ -636,6 +495,26 @@ struct VGCCParams {
 	pad1: i32,
 }
 
+//////// import: "consol.go"
+alias ConsolRules = i32; //enums:enum
+const  NoConsol: ConsolRules = 0;
+const  ConsolEWC: ConsolRules = 1;
+const  ConsolSI: ConsolRules = 2;
+alias ConsolVars = i32; //enums:enum
+const  ConsolAnchor: ConsolVars = 0;
+const  ConsolImp: ConsolVars = 1;
+const  ConsolAcc: ConsolVars = 2;
+struct ConsolParams {
+	Rule: ConsolRules,
+	Strength: f32,
+	Tau: f32,
+	Xi: f32,
+	Keep: f32,
+	Dt: f32,
+	pad: f32,
+	pad1: f32,
+}
+
 //////// import: "context.go"
 const  NeuronTraceCycles = 10;
 const  NeuronTraceThetas = 3;
@@ -668,28 +547,6 @@ fn Context_ItemIndex(ctx: Context, idx: u32) -> u32 {
 fn Context_DataIndex(ctx: Context, idx: u32) -> u32 {
 	return idx % ctx.NData;
 }
-fn NeuronTraceIndex(trVar: NeuronTracesVars, cycle: i32) -> i32 {
-	var binsPer = NetworkIxs[0].NNeuronTraceBins;
-	var cbin = (cycle / NeuronTraceCycles) % binsPer;
-return i32(trVar)*binsPer + cbin;
-}
-fn NeuronTraceBinIndex(trVar: NeuronTracesVars, bin: i32) -> i32 {
-	var binsPer = NetworkIxs[0].NNeuronTraceBins;
-return i32(trVar)*binsPer + bin;
-}
-fn NeuronTraceIncrement(incr: f32, trVar: NeuronTracesVars, cycle: i32, ni: u32,di: u32) {
-	var bin = NeuronTraceIndex(trVar, cycle);
-	var incn = incr / f32(NeuronTraceCycles);
-	if ((cycle % NeuronTraceCycles) == 0) {
-		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(NeuronTraces + NeuronVars(bin)))] = incn;
-	} else {
-		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(NeuronTraces + NeuronVars(bin)))] += incn;
-	}
-}
-fn NeuronTraceSet(val: f32, trVar: NeuronTracesVars, cycle: i32, ni: u32,di: u32) {
-	var bin = NeuronTraceIndex(trVar, cycle);
-	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(NeuronTraces + NeuronVars(bin)))] = val;
-}
 
 //////// import: "deep-layer.go"
 struct BurstParams {
@@ -698,11 +555,6 @@ struct BurstParams {
 	pad: f32,
 	pad1: f32,
 }
-fn BurstParams_ThrFromAvgMax(bp: BurstParams, avg: f32,mx: f32) -> f32 {
-	var thr = avg + bp.ThrRel*(mx-avg);
-	thr = max(thr, bp.ThrAbs);
-return thr;
-}
 struct CTParams {
 	GeGain: f32,
 	DecayTau: f32,
@@ -717,21 +569,21 @@ struct PulvinarParams {
 }
 
 //////// import: "enumgen.go"
-const PathGTypesN: PathGTypes = 5;
+const PathGTypesN: PathGTypes = 6;
 const GlobalScalarVarsN: GlobalScalarVars = 58;
 const GlobalVectorVarsN: GlobalVectorVars = 10;
-const GPUVarsN: GPUVars = 23;
-const LayerTypesN: LayerTypes = 37;
+const GPUVarsN: GPUVars = 28;
+const LayerTypesN: LayerTypes = 42;
 const LayerVarsN: LayerVars = 12;
 const ViewTimesN: ViewTimes = 7;
 const DAModTypesN: DAModTypes = 4;
 const ValenceTypesN: ValenceTypes = 3;
 const NeuronFlagsN: NeuronFlags = 9;
-const NeuronVarsN: NeuronVars = 100;
+const NeuronVarsN: NeuronVars = 112;
 const NeuronTracesVarsN: NeuronTracesVars = 2;
-const NeuronAvgVarsN: NeuronAvgVars = 7;
+const NeuronAvgVarsN: NeuronAvgVars = 9;
 const NeuronIndexVarsN: NeuronIndexVars = 3;
-const PathTypesN: PathTypes = 14;
+const PathTypesN: PathTypes = 16;
 const GPLayerTypesN: GPLayerTypes = 3;
 const PoolIndexVarsN: PoolIndexVars = 4;
 const PoolIntVarsN: PoolIntVars = 6;
@@ -741,6 +593,16 @@ const AvgMaxVarsN: AvgMaxVars = 7;
 const SynapseVarsN: SynapseVars = 5;
 const SynapseTraceVarsN: SynapseTraceVars = 3;
 const SynapseIndexVarsN: SynapseIndexVars = 3;
+const STPVarsN: STPVars = 2;
+const DendVarsN: DendVars = 11;
+const RegrowModesN: RegrowModes = 2;
+const STDPRulesN: STDPRules = 3;
+const STDPVarsN: STDPVars = 4;
+const ConsolRulesN: ConsolRules = 3;
+const ConsolVarsN: ConsolVars = 3;
+const ThreeFactorRulesN: ThreeFactorRules = 3;
+const ThreeFactorModsN: ThreeFactorMods = 2;
+const WtBoundModesN: WtBoundModes = 5;
 
 //////// import: "fsfffb-enumgen.go"
 const InhibVarsN: InhibVars = 19;
@@ -887,10 +749,34 @@ struct InhibParams {
 	ActAvg: ActAvgParams,
 	Layer: GiParams,
 	Pool: GiParams,
+	Interneurons: i32,
+	pad: i32,
+	pad1: i32,
+	pad2: i32,
 }
 
 //////// import: "init-layer.go"
 
+//////// import: "kinase-cathr.go"
+struct CaThrParams { //types:add
+	Tau: f32,
+	CaTau: f32,
+	CaPre: f32,
+	CaPost: f32,
+	PreDelay: i32,
+	ThrD: f32,
+	ThrP: f32,
+	GainD: f32,
+	GainP: f32,
+	RhoStar: f32,
+	Noise: f32,
+	CaDt: f32,
+	Dt: f32,
+	NoiseDt: f32,
+	pad: f32,
+	pad1: f32,
+}
+
 //////// import: "kinase-params.go"
 struct CaDtParams { //types:add
 	MTau: f32,
@@ -924,6 +810,10 @@ struct LayerIndexes {
 	ShpPlX: i32,
 	ShpUnY: i32,
 	ShpUnX: i32,
+	DendSt: u32,
+	DendN: u32,
+	pad: u32,
+	pad1: u32,
 }
 struct LayerInhibIndexes {
 	Index1: i32,
@@ -950,15 +840,14 @@ struct LayerParams {
 	Nuclear: NuclearParams,
 	LDT: LDTParams,
 	VTA: VTAParams,
+	LC: LCParams,
+	DRN: DRNParams,
 	RWPred: RWPredParams,
 	RWDa: RWDaParams,
 	TDInteg: TDIntegParams,
 	TDDa: TDDaParams,
 	Indexes: LayerIndexes,
 }
-fn LayerParams_PoolIndex(ly: LayerParams, pi: u32) -> u32 {
-	return ly.PoolSt + pi;
-}
 
 //////// import: "layertypes.go"
 alias LayerTypes = i32; //enums:enum
@@ -999,6 +888,11 @@ const  RWDaLayer: LayerTypes = 33;
 const  TDPredLayer: LayerTypes = 34;
 const  TDIntegLayer: LayerTypes = 35;
 const  TDDaLayer: LayerTypes = 36;
+const  InhibPVLayer: LayerTypes = 37;
+const  InhibSSTLayer: LayerTypes = 38;
+const  InhibVIPLayer: LayerTypes = 39;
+const  LCLayer: LayerTypes = 40;
+const  DRNLayer: LayerTypes = 41;
 
 //////// import: "layervars.go"
 alias LayerVars = i32; //enums:enum
@@ -1037,18 +931,6 @@ struct LearnCaParams {
 	NormInv: f32,
 	pad2: f32,
 }
-fn LearnCaParams_ETrace(lc: LearnCaParams, ctx: Context, ni: u32,di: u32, cad: f32) {
-	var tr = cad - Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(CaDPrev))];
-	var et = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(ETrace))];
-	et += lc.ETraceDt * (tr - et);
-	var etLrn = 1 + lc.ETraceScale*et;
-	if (etLrn < 0) {
-		etLrn = f32(0);
-	}
-	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(ETrace))] = et;
-	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72],
-	u32(ni), u32(di), u32(ETrLearn))] = etLrn;
-}
 struct LearnTimingParams {
 	SynCaCycles: i32,
 	LearnThr: f32,
@@ -1063,30 +945,6 @@ struct LearnTimingParams {
 	TimeDiffTau: f32,
 	TimeDiffDt: f32,
 }
-fn LearnTimingParams_LearnRecvTrace(lt: LearnTimingParams, ctx: Context, ni: u32,di: u32) {
-	var lrn = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(CaDiff))] * Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(RLRate))] * Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(ETrLearn))];
-	if (lt.On == 0) {
-		if (ctx.Cycle == ctx.ThetaCycles-1) {
-			NeuronTraceSet(lrn, RecvLearnTrace, ctx.CyclesTotal, ni, di);
-		}return;
-	}
-	var learnNow = i32(Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(LearnNow))]);
-	if (lt.LearnCycles >= 0) { // learn immediately after enabled
-		if (learnNow > 0 && ctx.CyclesTotal == learnNow+1) {
-			NeuronTraceSet(lrn, RecvLearnTrace, learnNow, ni, di);
-		}return;
-	}
-	var ppOff = ctx.ThetaStart + ctx.ISICycles + ctx.MinusCycles + 10; // have to constrain due to RecvTrace recording
-	var enabled = i32(Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Enabled))]);
-	if (enabled == 0) {
-		return;
-	}
-	var bin = ctx.CyclesTotal - ppOff;
-	if (bin >= 0 && bin < 60) { // 60 is min for 3 * 20 bins
-		var bi = NeuronTraceBinIndex(RecvLearnTrace, bin);
-		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(i32(NeuronTraces + NeuronVars(bi))))] = lrn;
-	}
-}
 struct TrgAvgActParams {
 	GiBaseInit: f32,
 	RescaleOn: i32,
@@ -1108,37 +966,6 @@ struct RLRateParams {
 	Min: f32,
 	pad: i32,
 }
-fn RLRateParams_RLRateSigDeriv(rl: RLRateParams, act: f32, laymax: f32) -> f32 {
-	if (rl.On == 0 || laymax == 0) {
-		return f32(1.0);
-	}
-	var ca = min(act/laymax, 1.0);
-	var lr: f32;
-	if (rl.SigmoidLinear == 1) {
-		if (ca < 0.5) {
-			lr = 2 * ca;
-		} else {
-			lr = 2 * (1 - ca);
-		}
-	} else {
-		lr = 4.0 * ca * (1 - ca); // .5 * .5 = .25 = peak
-	}
-	if (lr < rl.SigmoidMin) {
-		lr = rl.SigmoidMin;
-	}return lr;
-}
-fn RLRateParams_RLRateDiff(rl: RLRateParams, scap: f32,scad: f32) -> f32 {
-	if (rl.On == 0 || rl.Diff == 0) {
-		return f32(1.0);
-	}
-	var smax = max(scap, scad);
-	if (smax > rl.SpikeThr) { // avoid div by 0
-		var dif = abs(scap - scad);
-		if (dif < rl.DiffThr) {
-			return rl.Min;
-		}return (dif / smax);
-	}return rl.Min;
-}
 struct LearnNeuronParams {
 	CaLearn: LearnCaParams,
 	Timing: LearnTimingParams,
@@ -1164,10 +991,27 @@ struct SWtAdaptParams {
 	pad: f32,
 	pad1: f32,
 }
+alias WtBoundModes = i32; //enums:enum
+const  SoftBound: WtBoundModes = 0;
+const  HardBound: WtBoundModes = 1;
+const  MultBound: WtBoundModes = 2;
+const  LogNormBound: WtBoundModes = 3;
+const  DaleBound: WtBoundModes = 4;
+struct WtBoundParams {
+	Mode: WtBoundModes,
+	MuPlus: f32,
+	MuMinus: f32,
+	Ref: f32,
+	Min: f32,
+	Max: f32,
+	pad: f32,
+	pad1: f32,
+}
 struct SWtParams {
 	Init: SWtInitParams,
 	Adapt: SWtAdaptParams,
 	Limit: F32,
+	Bound: WtBoundParams,
 }
 struct LRateParams {
 	Base: f32,
@@ -1199,6 +1043,9 @@ struct LearnSynParams {
 	LRate: LRateParams,
 	DWt: DWtParams,
 	Hebb: HebbParams,
+	STDP: STDPParams,
+	Consol: ConsolParams,
+	ThreeFactor: ThreeFactorParams,
 }
 
 //////// import: "looper.go"
@@ -1212,15 +1059,6 @@ const  Phase: ViewTimes = 5;
 const  Theta: ViewTimes = 6;
 
 //////// import: "math32-fastexp.go"
-fn FastExp(x: f32) -> f32 {
-	if (x <= -88.02969) { // this doesn't add anything and -exp is main use-case anyway
-		return f32(0.0);
-	}
-	var i = i32(12102203*x) + i32(127)*(i32(1)<<23);
-	var m = (i >> 7) & 0xFFFF; // copy mantissa
-	i += (((((((((((3537 * m) >> 16) + 13668) * m) >> 18) + 15817) * m) >> 14) - 80470) * m) >> 11);
-return bitcast<f32>(u32(i));
-}
 
 //////// import: "minmax-avgmax.go"
 const  MaxFloat32: f32 = 3.402823466e+38;
@@ -1243,14 +1081,6 @@ struct F32 {
 	pad: i32,
 	pad1: i32, // for gpu use
 }
-fn F32_ClampValue(mr: F32, val: f32) -> f32 {
-	if (val < mr.Min) {
-		return mr.Min;
-	}
-	if (val > mr.Max) {
-		return mr.Max;
-	}return val;
-}
 
 //////// import: "network.go"
 struct NetworkIndexes {
@@ -1266,6 +1096,10 @@ struct NetworkIndexes {
 	RubiconNPosUSs: u32,
 	RubiconNCosts: u32,
 	RubiconNNegUSs: u32,
+	NSTDPSyns: u32,
+	pad: u32,
+	pad1: u32,
+	pad2: u32,
 }
 
 //////// import: "neuromod.go"
@@ -1288,39 +1122,14 @@ struct NeuroModParams {
 	AChDisInhib: f32,
 	BurstGain: f32,
 	DipGain: f32,
+	NEGain: f32,
+	NELRateMod: f32,
+	SerLRateMod: f32,
+	NENoise: f32,
 	pad: f32,
 	pad1: f32,
 	pad2: f32,
 }
-fn NeuroModParams_IsBLAExt(nm: NeuroModParams) -> bool {
-	return (nm.Valence == Positive && nm.DAMod == D2Mod) ||
-		(nm.Valence == Negative && nm.DAMod == D1Mod);
-}
-fn NeuroModParams_LRModFact(nm: NeuroModParams, pct: f32,val: f32) -> f32 {
-	var aval = clamp(abs(val), 0.0, 1.0);
-return 1.0 - pct*(1.0-aval);
-}
-fn NeuroModParams_DAGain(nm: NeuroModParams, da: f32) -> f32 {
-	var ada = da;
-	if (da > 0) {
-		ada *= nm.BurstGain;
-	} else {
-		ada *= nm.DipGain;
-	}return ada;
-}
-fn NeuroModParams_DASign(nm: NeuroModParams) -> f32 {
-	if (nm.DAMod == D2Mod) {
-		return -1.0;
-	}return f32(1.0);
-}
-fn NeuroModParams_LRMod(nm: NeuroModParams, da: f32,ach: f32) -> f32 {
-	var lmod = NeuroModParams_LRModFact(nm, nm.AChLRateMod, ach);
-	if (nm.DALRateSign == 1) {
-		lmod *= NeuroModParams_DAGain(nm, da) * NeuroModParams_DASign(nm);
-	} else {
-		lmod *= NeuroModParams_LRModFact(nm, nm.DALRateMod, da);
-	}return lmod;
-}
 
 //////// import: "neuron.go"
 alias NeuronFlags = i32; //enums:enum
@@ -1381,54 +1190,66 @@ const  VgccM: NeuronVars = 48;
 const  VgccH: NeuronVars = 49;
 const  VgccCa: NeuronVars = 50;
 const  VgccCaInt: NeuronVars = 51;
-const  Burst: NeuronVars = 52;
-const  BurstPrv: NeuronVars = 53;
-const  CtxtGe: NeuronVars = 54;
-const  CtxtGeRaw: NeuronVars = 55;
-const  CtxtGeOrig: NeuronVars = 56;
-const  GgabaB: NeuronVars = 57;
-const  GababM: NeuronVars = 58;
-const  GababX: NeuronVars = 59;
-const  Gak: NeuronVars = 60;
-const  SSGiDend: NeuronVars = 61;
-const  GknaMed: NeuronVars = 62;
-const  GknaSlow: NeuronVars = 63;
-const  Gkir: NeuronVars = 64;
-const  KirM: NeuronVars = 65;
-const  Gsk: NeuronVars = 66;
-const  SKCaIn: NeuronVars = 67;
-const  SKCaR: NeuronVars = 68;
-const  SKCaM: NeuronVars = 69;
-const  Gmahp: NeuronVars = 70;
-const  MahpN: NeuronVars = 71;
-const  Gsahp: NeuronVars = 72;
-const  SahpCa: NeuronVars = 73;
-const  SahpN: NeuronVars = 74;
-const  ActM: NeuronVars = 75;
-const  ActP: NeuronVars = 76;
-const  Beta1: NeuronVars = 77;
-const  Beta2: NeuronVars = 78;
-const  CaPMax: NeuronVars = 79;
-const  CaPMaxCa: NeuronVars = 80;
-const  GeNoise: NeuronVars = 81;
-const  GeNoiseP: NeuronVars = 82;
-const  GiNoise: NeuronVars = 83;
-const  GiNoiseP: NeuronVars = 84;
-const  GeExt: NeuronVars = 85;
-const  GeRaw: NeuronVars = 86;
-const  GeSyn: NeuronVars = 87;
-const  GiRaw: NeuronVars = 88;
-const  GiSyn: NeuronVars = 89;
-const  GeInt: NeuronVars = 90;
-const  GeIntNorm: NeuronVars = 91;
-const  GiInt: NeuronVars = 92;
-const  GModRaw: NeuronVars = 93;
-const  GModSyn: NeuronVars = 94;
-const  SMaintP: NeuronVars = 95;
-const  GMaintRaw: NeuronVars = 96;
-const  GMaintSyn: NeuronVars = 97;
-const  NeurFlags: NeuronVars = 98;
-const  NeuronTraces: NeuronVars = 99;
+const  Gcat: NeuronVars = 52;
+const  CatM: NeuronVars = 53;
+const  CatH: NeuronVars = 54;
+const  CatCa: NeuronVars = 55;
+const  Gnap: NeuronVars = 56;
+const  NapM: NeuronVars = 57;
+const  NapH: NeuronVars = 58;
+const  Igap: NeuronVars = 59;
+const  GapVm: NeuronVars = 60;
+const  Burst: NeuronVars = 61;
+const  BurstPrv: NeuronVars = 62;
+const  CtxtGe: NeuronVars = 63;
+const  CtxtGeRaw: NeuronVars = 64;
+const  CtxtGeOrig: NeuronVars = 65;
+const  GgabaB: NeuronVars = 66;
+const  GababM: NeuronVars = 67;
+const  GababX: NeuronVars = 68;
+const  Gak: NeuronVars = 69;
+const  SSGiDend: NeuronVars = 70;
+const  GiDendSyn: NeuronVars = 71;
+const  GknaMed: NeuronVars = 72;
+const  GknaSlow: NeuronVars = 73;
+const  Gkir: NeuronVars = 74;
+const  KirM: NeuronVars = 75;
+const  Gh: NeuronVars = 76;
+const  HcnM: NeuronVars = 77;
+const  Gsk: NeuronVars = 78;
+const  SKCaIn: NeuronVars = 79;
+const  SKCaR: NeuronVars = 80;
+const  SKCaM: NeuronVars = 81;
+const  Gmahp: NeuronVars = 82;
+const  MahpN: NeuronVars = 83;
+const  Gsahp: NeuronVars = 84;
+const  SahpCa: NeuronVars = 85;
+const  SahpN: NeuronVars = 86;
+const  ActM: NeuronVars = 87;
+const  ActP: NeuronVars = 88;
+const  Beta1: NeuronVars = 89;
+const  Beta2: NeuronVars = 90;
+const  CaPMax: NeuronVars = 91;
+const  CaPMaxCa: NeuronVars = 92;
+const  GeNoise: NeuronVars = 93;
+const  GeNoiseP: NeuronVars = 94;
+const  GiNoise: NeuronVars = 95;
+const  GiNoiseP: NeuronVars = 96;
+const  GeExt: NeuronVars = 97;
+const  GeRaw: NeuronVars = 98;
+const  GeSyn: NeuronVars = 99;
+const  GiRaw: NeuronVars = 100;
+const  GiSyn: NeuronVars = 101;
+const  GeInt: NeuronVars = 102;
+const  GeIntNorm: NeuronVars = 103;
+const  GiInt: NeuronVars = 104;
+const  GModRaw: NeuronVars = 105;
+const  GModSyn: NeuronVars = 106;
+const  SMaintP: NeuronVars = 107;
+const  GMaintRaw: NeuronVars = 108;
+const  GMaintSyn: NeuronVars = 109;
+const  NeurFlags: NeuronVars = 110;
+const  NeuronTraces: NeuronVars = 111;
 alias NeuronTracesVars = i32; //enums:enum
 const  CaSynTrace: NeuronTracesVars = 0;
 const  RecvLearnTrace: NeuronTracesVars = 1;
@@ -1440,6 +1261,8 @@ const  DTrgAvg: NeuronAvgVars = 3;
 const  AvgDif: NeuronAvgVars = 4;
 const  GeBase: NeuronAvgVars = 5;
 const  GiBase: NeuronAvgVars = 6;
+const  IPThr: NeuronAvgVars = 7;
+const  IPGl: NeuronAvgVars = 8;
 alias NeuronIndexVars = i32; //enums:enum
 const  NrnNeurIndex: NeuronIndexVars = 0;
 const  NrnLayIndex: NeuronIndexVars = 1;
@@ -1470,122 +1293,6 @@ struct IOParams {
 	GDt: f32,
 	pad: f32,
 }
-fn LayerParams_IOCopy(ly: LayerParams, ctx: Context, lpi: u32,pi: u32,ni: u32,di: u32) {
-	var lni = ni - ly.Indexes.NeurSt;
-	var ioi = u32(ly.Nuclear.IOLayIndex);
-	let ioly = Layers[ioi];
-	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(LearnNow))] = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ioly.Indexes.NeurSt + lni), u32(di), u32(LearnNow))];
-	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(TimePeak))] = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ioly.Indexes.NeurSt + lni), u32(di), u32(TimePeak))];
-	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(MinusCycle))] = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ioly.Indexes.NeurSt + lni), u32(di), u32(MinusCycle))];
-}
-fn LayerParams_IOLearn(ly: LayerParams, ctx: Context, lpi: u32,pi: u32,ni: u32,di: u32) {
-	var cycTot = f32(ctx.CyclesTotal);
-	var effAct = i32(Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(MinusCycle))]);
-	var envCyc = ctx.CyclesTotal - effAct; // cycle within envelope
-	var gMod = Neurons[Index3D(TensorStrides[70], TensorStrides[71], // modulatory = shunting from control layers
-	TensorStrides[72], u32(ni), u32(di), u32(GModSyn))];
-	var modFact = f32(1.0) - min(gMod, 1.0);
-	var gaP = modFact * Neurons[Index3D(TensorStrides[70], TensorStrides[71], // IOe excitatory input
-	TensorStrides[72], u32(ni), u32(di), u32(GaP))];
-	gaP += ly.IO.GDt * (Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(GeSyn))] - gaP);
-	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(GaP))] = gaP;
-	var gaM = modFact * Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(GaM))];
-	gaM += ly.IO.GDt * (Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(GiSyn))] - gaM);
-	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(GaM))] = gaM;
-	Neurons[Index3D(TensorStrides[70], TensorStrides[71], // set below for display
-	TensorStrides[72], u32(ni), u32(di), u32(TimeDiff))] = 0.0;
-	Neurons[Index3D(TensorStrides[70], TensorStrides[71], // default is no spike
-	TensorStrides[72], u32(ni), u32(di), u32(Spike))] = 0.0;
-	if (effAct > 0 && envCyc <= ly.IO.EfferentOff+NeuronTraceCycles) {
-		NeuronTraceSet(gaM, CaSynTrace, ctx.CyclesTotal, ni, di);
-	} else {
-		NeuronTraceIncrement(gaM, CaSynTrace, ctx.CyclesTotal, ni, di);
-	}
-	var oldInhib = f32(0);
-	var oldInhibP = f32(0);
-	var nbins = ly.IO.TimeOff / NeuronTraceCycles;
-	nbins = max(2, nbins+1);
-	var stcyc = ctx.CyclesTotal - ly.IO.TimeOff;
-	for (var i=0; i<nbins; i++) {
-		var bi = NeuronTraceIndex(CaSynTrace, stcyc+i*NeuronTraceCycles);
-		var tr = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(NeuronTraces + NeuronVars(bi)))];
-		if (i < nbins-1) {
-			oldInhib += tr;
-		}
-		if (i > 0) {
-			oldInhibP += tr;
-		}
-	}
-	oldInhibP /= f32(nbins - 1);
-	oldInhib /= f32(nbins - 1);
-	var cySt = stcyc - ((stcyc / NeuronTraceCycles) * NeuronTraceCycles);
-	var mix = f32(cySt) / f32(NeuronTraceCycles);
-	oldInhib = ly.IO.InhibGain * ((1-mix)*oldInhib + mix*oldInhibP);
-	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(GaD))] = oldInhib;
-	if (Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], // already learned, done until cleared in NuclearLearnReset
-	u32(ni), u32(di), u32(LearnNow))] > 0) {
-		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Spike))] = 0.0;return;
-	}
-	if (effAct == 0) {
-		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(Spike))] = 0.0;
-		if (Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(GMaintRaw))] > ly.IO.EfferentThr) { // efferent always activates.
-			Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], // efferent activation cycle
-			u32(ni), u32(di), u32(MinusCycle))] = cycTot;
-			Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(TimePeak))] = 0.0;
-			Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(LearnNow))] = 0.0;
-		}return;
-	}
-	if (envCyc <= (ly.IO.TimeOff + ly.IO.EfferentOff)) { // nothing until min
-		return;
-	}
-	if (envCyc >= ly.Nuclear.ActionEnv) { // no errors until the end of envelope: baseline spike
-		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(LearnNow))] = cycTot;
-		Neurons[Index3D(TensorStrides[70], TensorStrides[71], // baseline spike
-		TensorStrides[72], u32(ni), u32(di), u32(Spike))] = 1.0;return;
-	}
-	var errVal = gaP - oldInhib;
-	Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(TimeDiff))] = errVal;
-	if (gaP > ly.Learn.Timing.LearnThr && errVal > ly.IO.ErrThr) {
-		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], // error spike
-		u32(ni), u32(di), u32(Spike))] = 1.0;
-		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], // record point of error
-		u32(ni), u32(di), u32(LearnNow))] = cycTot;
-		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], // records that we got err spike
-		u32(ni), u32(di), u32(TimePeak))] = 1.0;
-	}
-}
-fn LayerParams_CNeLearn(ly: LayerParams, ctx: Context, lpi: u32,pi: u32,ni: u32,di: u32) {
-	var cycTot = f32(ctx.CyclesTotal);
-	var act = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(CaP))];
-	var dev = ly.Nuclear.ActTarget - act; // deviation
-	var adev = abs(dev);
-	if (adev > Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(GaP))]) {
-		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(GaP))] = adev;
-		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], // actual direction for learning
-		u32(ni), u32(di), u32(GaM))] = dev;
-		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], // learn at max
-		u32(ni), u32(di), u32(LearnNow))] = cycTot;
-		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], // for visualization
-		u32(ni), u32(di), u32(TimePeak))] = 1.0;
-	} else {
-		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72],
-		u32(ni), u32(di), u32(TimePeak))] = 0.0;
-	}
-}
-fn LayerParams_CNiLearn(ly: LayerParams, ctx: Context, lpi: u32,pi: u32,ni: u32,di: u32) {
-	LayerParams_IOCopy(ly, ctx, lpi, pi, ni, di);
-	var cycTot = f32(ctx.CyclesTotal);
-	var act = Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(CaP))];
-	if (act > Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(GaP))]) {
-		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(GaP))] = act;
-		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], // learn at max
-		u32(ni), u32(di), u32(MinusCycle))] = cycTot;
-		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], // for visualization
-		u32(ni), u32(di), u32(GaD))] = 1.0;
-	} else {
-		Neurons[Index3D(TensorStrides[70], TensorStrides[71], TensorStrides[72], u32(ni), u32(di), u32(GaD))] = 0.0;
-	}
-}
 
 //////// import: "pathparams.go"
 const  StartOff: i32 = 0;
@@ -1609,7 +1316,11 @@ struct PathIndexes {
 	RecvConSt: u32,
 	RecvSynSt: u32,
 	NPathNeurSt: u32,
+	STDPSt: u32,
+	ConsolSt: u32,
 	pad: u32,
+	pad1: u32,
+	pad2: u32,
 }
 struct GScaleValues {
 	Scale: f32,
@@ -1620,10 +1331,13 @@ struct GScaleValues {
 struct PathParams {
 	Type: PathTypes,
 	Index: u32,
+	DendComp: i32,
 	pad: i32,
-	pad1: i32,
 	Indexes: PathIndexes,
 	Com: SynComParams,
+	STP: STPParams,
+	Gap: GapJunctionParams,
+	StructPlast: StructPlastParams,
 	PathScale: PathScaleParams,
 	SWts: SWtParams,
 	Learn: LearnSynParams,
@@ -1651,6 +1365,8 @@ const  RWPath: PathTypes = 10;
 const  TDPredPath: PathTypes = 11;
 const  BLAPath: PathTypes = 12;
 const  HipPath: PathTypes = 13;
+const  GapJunctionPath: PathTypes = 14;
+const  ActorPath: PathTypes = 15;
 
 //////// import: "pcore-layer.go"
 struct DSMatrixParams {
@@ -1737,17 +1453,6 @@ const  poolFloatAvgMaxStart = InhibVarsN;
 const  PoolVarsTotal = poolFloatAvgMaxStart + InhibVars(i32(AvgMaxVarsN)*i32(AvgMaxN)*i32(AvgMaxPhasesN));
 const  PoolIntVarsTot = PoolIntAvgMaxStart + PoolIntVars(i32(AvgMaxVarsN)*i32(AvgMaxN));
 const avgMaxToNeuron = array(CaP, CaD, CaPMax, Act, GeInt, GiInt);
-fn AvgMaxVarIndex(vr: AvgMaxVars, phase: AvgMaxPhases, am: AvgMax) -> u32 {
-	return u32(poolFloatAvgMaxStart) + u32(vr)*u32(AvgMaxN)*u32(AvgMaxPhasesN) + u32(phase)*u32(AvgMaxN) + u32(am);
-}
-fn PoolAvgMax(vr: AvgMaxVars, phase: AvgMaxPhases, am: AvgMax, pi: u32,di: u32) -> f32 {
-	return Pools[Index3D(TensorStrides[130], TensorStrides[131], TensorStrides[132],
-	u32(pi), u32(di), u32(AvgMaxVarIndex(vr, phase, am)))];
-}
-fn PoolNNeurons(pi: u32) -> i32 {
-	return i32(PoolIxs[Index2D(TensorStrides[0], TensorStrides[1], u32(pi), u32(PoolNeurEd))] - PoolIxs[Index2D(TensorStrides[0], TensorStrides[1],
-	u32(pi), u32(PoolNeurSt))]);
-}
 
 //////// import: "rand.go"
 alias RandFunIndex = u32;
@@ -1783,8 +1488,8 @@ struct TDDaParams {
 struct RLPredPathParams {
 	OppSignLRate: f32,
 	DaTol: f32,
+	Lambda: f32,
 	pad: f32,
-	pad1: f32,
 }
 
 //////// import: "rubicon-layer.go"
@@ -1804,6 +1509,18 @@ struct VTAParams {
 	AChThr: f32,
 	pad: f32,
 }
+struct LCParams {
+	Tonic: f32,
+	SurpriseGain: f32,
+	UncertGain: f32,
+	pad: f32,
+}
+struct DRNParams {
+	Tonic: f32,
+	NegGain: f32,
+	WaitGain: f32,
+	UrgencyInhib: f32,
+}
 
 //////// import: "rubicon-path.go"
 struct BLAPathParams {
@@ -1814,32 +1531,54 @@ struct BLAPathParams {
 }
 
 //////// import: "rubicon.go"
-fn RubiconUSStimValue(di: u32, usIndex: u32, valence: ValenceTypes) -> f32 {
-	let nix = NetworkIxs[0];
-	var us = f32(0);
-	switch (valence) {
-	case Positive: {
-		if (usIndex < nix.RubiconNPosUSs) {
-			us = GlobalVectors[Index3D(TensorStrides[110], TensorStrides[111], TensorStrides[112], u32(GvUSpos), u32(usIndex), u32(di))];
-		}
-	}
-	case Negative: {
-		if (usIndex < nix.RubiconNNegUSs) {
-			us = GlobalVectors[Index3D(TensorStrides[110], TensorStrides[111], TensorStrides[112], u32(GvUSneg), u32(usIndex), u32(di))];
-		}
-	}
-	case Cost: {
-		if (usIndex < nix.RubiconNCosts) {
-			us = GlobalVectors[Index3D(TensorStrides[110], TensorStrides[111], TensorStrides[112], u32(GvCost), u32(usIndex), u32(di))];
-		}
-	}
-	default: {
-	}
-	}return us;
-}
 
 //////// import: "stats.go"
 
+//////// import: "stdp.go"
+const STDPMaxSpikes = 64;
+alias STDPRules = i32; //enums:enum
+const  NoSTDP: STDPRules = 0;
+const  PairSTDP: STDPRules = 1;
+const  TripletSTDP: STDPRules = 2;
+alias STDPVars = i32; //enums:enum
+const  STDPPre: STDPVars = 0;
+const  STDPPre2: STDPVars = 1;
+const  STDPPost: STDPVars = 2;
+const  STDPPost2: STDPVars = 3;
+struct STDPParams {
+	Rule: STDPRules,
+	TauPlus: f32,
+	TauMinus: f32,
+	TauX: f32,
+	TauY: f32,
+	APlus: f32,
+	AMinus: f32,
+	A3Plus: f32,
+	A3Minus: f32,
+	DtPlus: f32,
+	DtMinus: f32,
+	DtX: f32,
+	DtY: f32,
+	pad: f32,
+	pad1: f32,
+	pad2: f32,
+}
+
+//////// import: "structural.go"
+alias RegrowModes = i32; //enums:enum
+const  RegrowRandom: RegrowModes = 0;
+const  RegrowCorrel: RegrowModes = 1;
+struct StructPlastParams {
+	On: i32,
+	Regrow: RegrowModes,
+	WtThr: f32,
+	SWtThr: f32,
+	MaxFrac: f32,
+	pad: f32,
+	pad1: f32,
+	pad2: f32,
+}
+
 //////// import: "synapse.go"
 alias SynapseVars = i32; //enums:enum
 const  Wt: SynapseVars = 0;
@@ -1856,6 +1595,25 @@ const  SynRecvIndex: SynapseIndexVars = 0;
 const  SynSendIndex: SynapseIndexVars = 1;
 const  SynPathIndex: SynapseIndexVars = 2;
 
+//////// import: "threefactor.go"
+alias ThreeFactorRules = i32; //enums:enum
+const  NoThreeFactor: ThreeFactorRules = 0;
+const  HebbThreeFactor: ThreeFactorRules = 1;
+const  PerturbThreeFactor: ThreeFactorRules = 2;
+alias ThreeFactorMods = i32; //enums:enum
+const  ThreeFactorGlobal: ThreeFactorMods = 0;
+const  ThreeFactorLayer: ThreeFactorMods = 1;
+struct ThreeFactorParams {
+	Rule: ThreeFactorRules,
+	Mod: ThreeFactorMods,
+	Global: GlobalScalarVars,
+	ModLayIndex: i32,
+	TraceDecay: f32,
+	Delayed: i32,
+	pad: f32,
+	pad1: f32,
+}
+
 //////// import: "slrand.wgsl"
 fn Philox2x32round(counter: su64, key: u32) -> su64 {
 	let mul = Uint32Mul64(u32(0xD256D193), counter.x);
@@ -415,6 +415,63 @@ func StatLayerGiMult(statsDir *tensorfs.Node, net *Network, trainMode, epochLeve
 	}
 }
 
+// StatInterneuronGi returns a Stats function that computes the average
+// inhibitory conductance from explicit interneurons in given layers
+// (see [Network.AddInterneurons]), and the equivalent FS-FFFB inhibition
+// computed for the same activity, using [Layer.InterneuronGi] at the end
+// of the trial. It only runs for given trainMode at given trialLevel and
+// above, with higher levels computing the Mean of lower levels.
+func StatInterneuronGi(statsDir *tensorfs.Node, net *Network, trainMode, trialLevel, runLevel enums.Enum, layerNames ...string) func(mode, level enums.Enum, start bool) {
+	statNames := []string{"InterGi", "FFFBGi"}
+	statDocs := map[string]string{
+		"InterGi": "Average inhibitory synaptic conductance (soma GiSyn + dendrite GiDendSyn) in given layer, which reflects inhibition from explicit PV and SST interneurons. Compare with FFFBGi to calibrate the interneuron pathway strengths.",
+		"FFFBGi":  "Average FS-FFFB inhibitory conductance that would be applied in given layer for the same activity, computed from the Inhib.Layer and Inhib.Pool parameters, for comparison with InterGi.",
+	}
+	levels := make([]enums.Enum, 10) // should be enough
+	return func(mode, level enums.Enum, start bool) {
+		levi := int(level.Int64() - trialLevel.Int64())
+		if mode.Int64() != trainMode.Int64() || levi < 0 {
+			return
+		}
+		levels[levi] = level
+		modeDir := statsDir.Dir(mode.String())
+		levelDir := modeDir.Dir(level.String())
+		ndata := net.Context().NData
+		for _, lnm := range layerNames {
+			ly := net.LayerByName(lnm)
+			for si, statName := range statNames {
+				name := lnm + "_" + statName
+				tsr := levelDir.Float64(name)
+				if start {
+					tsr.SetNumRows(0)
+					plot.SetFirstStyler(tsr, func(s *plot.Style) {
+						s.Range.SetMin(0)
+					})
+					metadata.SetDoc(tsr, statDocs[statName])
+					continue
+				}
+				switch levi {
+				case 0:
+					for di := range ndata {
+						gi, fffbGi := ly.InterneuronGi(int(di))
+						stat := gi
+						if si == 1 {
+							stat = fffbGi
+						}
+						tsr.AppendRowFloat(float64(stat))
+					}
+				case int(runLevel.Int64() - trialLevel.Int64()):
+					subDir := modeDir.Dir(levels[levi-1].String())
+					tsr.AppendRow(stats.StatFinal.Call(subDir.Value(name)))
+				default:
+					subDir := modeDir.Dir(levels[levi-1].String())
+					tsr.AppendRow(stats.StatMean.Call(subDir.Value(name)))
+				}
+			}
+		}
+	}
+}
+
 // StatLayerState returns a Stats function that records layer state
 // It runs for given mode and level, recording given variable
 // for given layer names. if isTrialLevel is true, the level is a
@@ -0,0 +1,464 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package axon
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+
+	"cogentcore.org/core/base/metadata"
+	"cogentcore.org/core/enums"
+	"cogentcore.org/lab/plot"
+	"cogentcore.org/lab/tensor"
+	"cogentcore.org/lab/tensorfs"
+	"github.com/emer/emergent/v2/looper"
+)
+
+// SleepModes are the types of offline activity during a [Sleep] phase.
+type SleepModes int32 //enums:enum
+
+const (
+	// SleepSpontaneous runs spontaneous activity driven only by the
+	// Sleep.Noise background spiking, without any external input.
+	SleepSpontaneous SleepModes = iota
+
+	// SleepReplay replays patterns recorded in the Sleep.Replay buffer
+	// during training, as partial cues to the recorded layers, on top
+	// of the Sleep.Noise background spiking. For a hippocampal model
+	// ([Network.AddHip]), recording the cortical Input layer patterns
+	// allows the hippocampus to complete and reinstate the full
+	// patterns in cortex.
+	SleepReplay
+)
+
+// Sleep has parameters and state for an offline sleep phase, in which the
+// network runs without normal external input, to consolidate learning.
+// During sleep, the layer Acts.Noise parameters are replaced with the
+// Noise parameters here, the global neuromodulators are set to the
+// sleep levels, and the learning rate schedule multiplier (LRate.Sched)
+// follows its own schedule, from LRateStart to LRateEnd across the trials
+// of the sleep phase. All of these are restored at the end of the phase.
+// Use [LooperSleep] to configure a looper stack to run sleep phases.
+// Sleep implements [CheckpointState] to save the Replay buffer.
+type Sleep struct {
+
+	// Mode is the type of offline activity to run.
+	Mode SleepModes
+
+	// Noise are the background spiking noise parameters applied to all
+	// layers during sleep, which are typically much stronger than during
+	// waking, to drive spontaneous activity.
+	Noise SpikeNoiseParams `display:"inline"`
+
+	// ACh is the acetylcholine level during sleep, which is low in
+	// slow-wave sleep, reducing the influence of external inputs.
+	ACh float32 `default:"0"`
+
+	// NE is the norepinephrine level during sleep, as the LC
+	// is largely silent during sleep.
+	NE float32 `default:"0"`
+
+	// Ser is the serotonin level during sleep, as the DRN
+	// is largely silent during sleep.
+	Ser float32 `default:"0"`
+
+	// DA is the tonic dopamine level during sleep.
+	DA float32 `default:"0"`
+
+	// LRateStart is the learning rate schedule multiplier (LRate.Sched)
+	// at the start of the sleep phase.
+	LRateStart float32 `default:"0.5"`
+
+	// LRateEnd is the learning rate schedule multiplier (LRate.Sched)
+	// at the end of the sleep phase, which is linearly interpolated
+	// from LRateStart over the trials of the sleep phase.
+	LRateEnd float32 `default:"0.1"`
+
+	// CuePct is the proportion of units in each replayed pattern
+	// that are presented as a partial cue, for SleepReplay.
+	CuePct float32 `default:"0.5" min:"0" max:"1"`
+
+	// Replay is the buffer of patterns recorded during training, for SleepReplay.
+	Replay ReplayBuffer `display:"inline"`
+
+	// Active is true during a sleep phase, between Start and End.
+	Active bool `edit:"-"`
+
+	// noise are the saved layer noise parameters to restore at End.
+	noise []SpikeNoiseParams
+
+	// sched are the saved path LRate.Sched values to restore at End.
+	sched []float32
+
+	// globals are the saved sleepGlobals values for each data parallel
+	// index, to restore at End.
+	globals []float32
+}
+
+// sleepGlobals are the global neuromodulators set by [Sleep.ApplyInputs].
+var sleepGlobals = []GlobalScalarVars{GvACh, GvNE, GvSer, GvDAtonic}
+
+func (sl *Sleep) Defaults() {
+	sl.Noise.Defaults()
+	sl.Noise.On.SetBool(true)
+	sl.Noise.Ge = 0.01
+	sl.Noise.Gi = 0.005
+	sl.Noise.Update()
+	sl.ACh = 0
+	sl.NE = 0
+	sl.Ser = 0
+	sl.DA = 0
+	sl.LRateStart = 0.5
+	sl.LRateEnd = 0.1
+	sl.CuePct = 0.5
+	if sl.Replay.Max == 0 {
+		sl.Replay.Max = 100
+	}
+}
+
+func (sl *Sleep) ShouldDisplay(field string) bool {
+	switch field {
+	case "CuePct", "Replay":
+		return sl.Mode == SleepReplay
+	default:
+		return true
+	}
+}
+
+// Start starts a sleep phase, saving the current layer noise and path
+// learning rate schedule parameters and global neuromodulators,
+// and applying the sleep values.
+func (sl *Sleep) Start(net *Network) {
+	if sl.Active {
+		return
+	}
+	sl.Active = true
+	sl.noise = make([]SpikeNoiseParams, len(net.Layers))
+	for li, ly := range net.Layers {
+		sl.noise[li] = ly.Params.Acts.Noise
+		ly.Params.Acts.Noise = sl.Noise
+		ly.Params.Acts.Noise.MaintGe = sl.noise[li].MaintGe
+		ly.Params.Acts.Noise.Update()
+	}
+	sl.sched = make([]float32, len(net.Paths))
+	for pi, pt := range net.Paths {
+		sl.sched[pi] = pt.Params.Learn.LRate.Sched
+	}
+	nd := GlobalScalars.DimSize(1)
+	sl.globals = make([]float32, len(sleepGlobals)*nd)
+	for gi, gv := range sleepGlobals {
+		for di := range nd {
+			sl.globals[gi*nd+di] = GlobalScalars.Value(int(gv), di)
+		}
+	}
+	sl.SetProgress(net, 0)
+}
+
+// SetProgress sets the learning rate schedule multiplier according to
+// given proportion of the sleep phase completed (0-1), multiplying the
+// waking schedule multiplier in place at the Start of the phase.
+// Call ToGPUParams after this if not calling Start.
+func (sl *Sleep) SetProgress(net *Network, prog float32) {
+	if !sl.Active {
+		return
+	}
+	lr := sl.LRateStart + prog*(sl.LRateEnd-sl.LRateStart)
+	for pi, pt := range net.Paths {
+		pt.LRateSched(sl.sched[pi] * lr)
+	}
+	ToGPUParams()
+}
+
+// End ends a sleep phase, restoring the saved layer noise and path
+// learning rate schedule parameters and global neuromodulators.
+func (sl *Sleep) End(net *Network) {
+	if !sl.Active {
+		return
+	}
+	sl.Active = false
+	for li, ly := range net.Layers {
+		ly.Params.Acts.Noise = sl.noise[li]
+	}
+	for pi, pt := range net.Paths {
+		pt.LRateSched(sl.sched[pi])
+	}
+	nd := GlobalScalars.DimSize(1)
+	for gi, gv := range sleepGlobals {
+		for di := range nd {
+			GlobalScalars.Set(sl.globals[gi*nd+di], int(gv), di)
+		}
+	}
+	sl.noise = nil
+	sl.sched = nil
+	sl.globals = nil
+	ToGPUParams()
+	ToGPU(GlobalScalarsVar)
+}
+
+// ApplyInputs applies the sleep inputs for given data parallel index:
+// setting the global neuromodulators to their sleep levels, and,
+// for SleepReplay, applying a partial cue from a randomly selected
+// pattern in the Replay buffer. Must be called after [Network.InitExt]
+// and before [Network.ApplyExts], in place of the usual environment
+// inputs. Returns a trial name describing the inputs.
+func (sl *Sleep) ApplyInputs(net *Network, di uint32) string {
+	GlobalScalars.Set(sl.ACh, int(GvACh), int(di))
+	GlobalScalars.Set(sl.NE, int(GvNE), int(di))
+	GlobalScalars.Set(sl.Ser, int(GvSer), int(di))
+	GlobalScalars.Set(sl.DA, int(GvDAtonic), int(di))
+	if sl.Mode != SleepReplay || sl.Replay.Len() == 0 {
+		return "Sleep"
+	}
+	idx := sl.Replay.Apply(net, di, sl.CuePct)
+	return fmt.Sprintf("Replay_%d", idx)
+}
+
+// ReplayBuffer records activity patterns in given layers during training,
+// for replay during an offline [Sleep] phase. When the buffer is full,
+// new patterns replace the oldest ones.
+type ReplayBuffer struct {
+
+	// Layers are the names of the layers to record and replay, which
+	// should generally be InputLayer types so the replayed patterns
+	// are clamped as external inputs.
+	Layers []string
+
+	// Max is the maximum number of patterns to store.
+	Max int `default:"100"`
+
+	// Patterns are the recorded ActP patterns, for each recorded trial,
+	// and each layer, in the shape of the layer.
+	Patterns [][]*tensor.Float32 `display:"-"`
+
+	// next is the index of the next pattern to replace when full.
+	next int
+}
+
+// Config sets the layers to record and the maximum number of patterns.
+func (rb *ReplayBuffer) Config(max int, layers ...string) {
+	rb.Max = max
+	rb.Layers = layers
+	rb.Reset()
+}
+
+// Reset removes all recorded patterns.
+func (rb *ReplayBuffer) Reset() {
+	rb.Patterns = nil
+	rb.next = 0
+}
+
+// Len returns the number of recorded patterns.
+func (rb *ReplayBuffer) Len() int {
+	return len(rb.Patterns)
+}
+
+// Record records the current ActP patterns in the Layers for given
+// data parallel index, which is typically done at the end of each
+// training trial.
+func (rb *ReplayBuffer) Record(net *Network, di uint32) {
+	if len(rb.Layers) == 0 || rb.Max <= 0 {
+		return
+	}
+	pats := make([]*tensor.Float32, len(rb.Layers))
+	for i, lnm := range rb.Layers {
+		ly := net.LayerByName(lnm)
+		pats[i] = tensor.NewFloat32(ly.Shape.Sizes...)
+		ly.UnitValuesTensor(pats[i], "ActP", int(di))
+	}
+	if len(rb.Patterns) < rb.Max {
+		rb.Patterns = append(rb.Patterns, pats)
+		return
+	}
+	rb.Patterns[rb.next] = pats
+	rb.next = (rb.next + 1) % rb.Max
+}
+
+// Apply applies a randomly selected pattern as external input to the
+// Layers for given data parallel index, where each unit is included
+// with probability cuePct, and returns the index of the pattern.
+// The random numbers come from [Context.RandCPU], so that runs can be
+// resumed exactly from a checkpoint.
+func (rb *ReplayBuffer) Apply(net *Network, di uint32, cuePct float32) int {
+	rnd := net.Context().RandCPU(RandFunSleepReplay)
+	idx := rnd.Intn(len(rb.Patterns))
+	for i, lnm := range rb.Layers {
+		ly := net.LayerByName(lnm)
+		cue := rb.Patterns[idx][i].Clone().(*tensor.Float32)
+		for j := range cue.Len() {
+			if rnd.Float32() >= cuePct {
+				cue.SetFloat1D(0, j)
+			}
+		}
+		ly.ApplyExt(di, cue)
+	}
+	return idx
+}
+
+// SaveCheckpoint writes the Replay buffer patterns,
+// implementing [CheckpointState].
+func (sl *Sleep) SaveCheckpoint(w io.Writer) error {
+	return sl.Replay.SaveCheckpoint(w)
+}
+
+// LoadCheckpoint reads the Replay buffer patterns saved by
+// [Sleep.SaveCheckpoint], implementing [CheckpointState].
+func (sl *Sleep) LoadCheckpoint(r io.Reader) error {
+	return sl.Replay.LoadCheckpoint(r)
+}
+
+// SaveCheckpoint writes the recorded patterns and the index of the next
+// pattern to replace, implementing [CheckpointState].
+func (rb *ReplayBuffer) SaveCheckpoint(w io.Writer) error {
+	hdr := []int64{int64(rb.next), int64(len(rb.Patterns)), int64(len(rb.Layers))}
+	if err := binary.Write(w, binary.LittleEndian, hdr); err != nil {
+		return err
+	}
+	for _, pats := range rb.Patterns {
+		for _, pat := range pats {
+			sz := []int64{int64(pat.NumDims())}
+			for _, d := range pat.ShapeSizes() {
+				sz = append(sz, int64(d))
+			}
+			if err := binary.Write(w, binary.LittleEndian, sz); err != nil {
+				return err
+			}
+			if err := binary.Write(w, binary.LittleEndian, pat.Values); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// LoadCheckpoint reads the patterns saved by [ReplayBuffer.SaveCheckpoint],
+// which must have the same number of Layers, implementing [CheckpointState].
+func (rb *ReplayBuffer) LoadCheckpoint(r io.Reader) error {
+	var hdr [3]int64
+	if err := binary.Read(r, binary.LittleEndian, hdr[:]); err != nil {
+		return err
+	}
+	next, npat := hdr[0], hdr[1]
+	if int(hdr[2]) != len(rb.Layers) {
+		return fmt.Errorf("ReplayBuffer: number of layers in checkpoint: %d != current: %d", hdr[2], len(rb.Layers))
+	}
+	if npat < 0 || npat > int64(max(rb.Max, 0)) || next < 0 || (next > 0 && next >= npat) {
+		return fmt.Errorf("ReplayBuffer: invalid number of patterns in checkpoint: %d, next: %d, for Max: %d", npat, next, rb.Max)
+	}
+	pats := make([][]*tensor.Float32, npat)
+	for pi := range pats {
+		pats[pi] = make([]*tensor.Float32, len(rb.Layers))
+		for i := range rb.Layers {
+			var nd int64
+			if err := binary.Read(r, binary.LittleEndian, &nd); err != nil {
+				return err
+			}
+			if nd < 1 || nd > 5 {
+				return fmt.Errorf("ReplayBuffer: invalid pattern dimensions in checkpoint: %d", nd)
+			}
+			sz := make([]int64, nd)
+			if err := binary.Read(r, binary.LittleEndian, sz); err != nil {
+				return err
+			}
+			sizes := make([]int, nd)
+			n := int64(1)
+			for d, v := range sz {
+				n *= v
+				if v < 0 || n > maxCheckpointStateSize/4 {
+					return fmt.Errorf("ReplayBuffer: invalid pattern shape in checkpoint: %v", sz)
+				}
+				sizes[d] = int(v)
+			}
+			pat := tensor.NewFloat32(sizes...)
+			if err := binary.Read(r, binary.LittleEndian, pat.Values); err != nil {
+				return err
+			}
+			pats[pi][i] = pat
+		}
+	}
+	rb.Patterns = pats
+	rb.next = int(next)
+	return nil
+}
+
+// LooperSleep configures the given sleepMode stack of the looper to run
+// an offline [Sleep] phase, at given epoch and trial levels. The stack must
+// also be configured with [LooperStandard] for the standard theta cycle
+// processing calls, where the applyInputs function must call
+// [Sleep.ApplyInputs] for the sleepMode. The epoch level is expected to
+// have just 1 iteration, and it calls Sleep.Start and Sleep.End, while the
+// learning rate schedule is updated at the start of each trial, and the
+// weights are updated at the end of each trial. If test is non-nil,
+// it is called just before the sleep phase starts and just after it ends,
+// to measure the effects of sleep on test performance (see [StatSleep]).
+func LooperSleep(ls *looper.Stacks, net *Network, sl *Sleep, viewFunc func(mode enums.Enum) *NetViewUpdate, sleepMode, epoch, trial, cycle enums.Enum, test func()) {
+	epcLoop := ls.Loop(sleepMode, epoch)
+	trlLoop := ls.Loop(sleepMode, trial)
+	cycLoop := ls.Loop(sleepMode, cycle)
+	if test != nil {
+		epcLoop.OnStart.Add("Sleep:TestPre", test)
+	}
+	epcLoop.OnStart.Add("Sleep:Start", func() { sl.Start(net) })
+	trlLoop.OnStart.Add("Sleep:LRate", func() {
+		prog := float32(trlLoop.Counter.Cur) / float32(max(trlLoop.Counter.Max, 1))
+		sl.SetProgress(net, prog)
+	})
+	isiCycles := int(net.Context().ISICycles)
+	if isiCycles > 0 {
+		cycLoop.AddEvent("UpdateWeights", isiCycles, LooperUpdateWeightsFunc(ls, net, viewFunc, sleepMode))
+	} else {
+		trlLoop.OnEnd.Add("UpdateWeights", LooperUpdateWeightsFunc(ls, net, viewFunc, sleepMode))
+	}
+	epcLoop.OnEnd.Add("Sleep:End", func() { sl.End(net) })
+	if test != nil {
+		epcLoop.OnEnd.Add("Sleep:TestPost", test)
+	}
+}
+
+// StatSleep returns a Stats function that records the effects of an offline
+// sleep phase (see [LooperSleep]) on test performance, for given stat names
+// that are computed at the testLevel of the testMode (e.g., Test Epoch).
+// It runs at the sleepLevel of the sleepMode (e.g., Sleep Epoch), and
+// assumes that testing was run just before and after the sleep phase,
+// so that the last two rows of each test stat are the pre and post sleep
+// values. For each stat, it records the Pre and Post values, and the
+// Delta = Post - Pre.
+func StatSleep(statsDir *tensorfs.Node, testMode, testLevel, sleepMode, sleepLevel enums.Enum, statNames ...string) func(mode, level enums.Enum, start bool) {
+	sfxs := []string{"Pre", "Post", "Delta"}
+	sfxDocs := []string{"before", "after", "change due to"}
+	return func(mode, level enums.Enum, start bool) {
+		if mode.Int64() != sleepMode.Int64() || level.Int64() != sleepLevel.Int64() {
+			return
+		}
+		levelDir := StatsNode(statsDir, mode, level)
+		testDir := StatsNode(statsDir, testMode, testLevel)
+		for _, name := range statNames {
+			vals := []float64{math.NaN(), math.NaN(), math.NaN()}
+			if !start {
+				if src := testDir.Node(name); src != nil {
+					tv := src.Tensor
+					if n := tv.DimSize(0); n >= 2 {
+						vals[0] = tv.Float1D(n - 2)
+						vals[1] = tv.Float1D(n - 1)
+						vals[2] = vals[1] - vals[0]
+					}
+				}
+			}
+			for si, sfx := range sfxs {
+				tsr := levelDir.Float64(name + sfx)
+				if start {
+					tsr.SetNumRows(0)
+					plot.SetFirstStyler(tsr, func(s *plot.Style) {
+						s.On = si == 2
+					})
+					metadata.SetDoc(tsr, fmt.Sprintf("Test %s %s sleep phase", name, sfxDocs[si]))
+					continue
+				}
+				tsr.AppendRowFloat(vals[si])
+			}
+		}
+	}
+}
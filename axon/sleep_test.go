@@ -0,0 +1,173 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package axon
+
+import (
+	"bufio"
+	"bytes"
+	"testing"
+
+	"github.com/emer/emergent/v2/etime"
+	"github.com/stretchr/testify/assert"
+)
+
+// runSleepTrial runs one sleep trial with the inputs from given Sleep.
+func runSleepTrial(net *Network, sl *Sleep) string {
+	net.ThetaCycleStart(etime.Train, true)
+	net.MinusPhaseStart()
+	net.InitExt()
+	name := sl.ApplyInputs(net, 0)
+	net.ApplyExts()
+	for cyc := range 200 {
+		net.Cycle(false)
+		if cyc == 149 {
+			net.MinusPhaseEnd()
+			net.PlusPhaseStart()
+		}
+	}
+	net.PlusPhaseEnd()
+	net.DWtToWt()
+	return name
+}
+
+func TestSleepStartEnd(t *testing.T) {
+	net := newTestNet(1)
+	hid := net.LayerByName("Hidden")
+	pt := hid.RecvPaths[0]
+	noise := hid.Params.Acts.Noise
+	sched := pt.Params.Learn.LRate.Sched
+	globals := map[GlobalScalarVars]float32{}
+	for i, gv := range sleepGlobals {
+		GlobalScalars.Set(0.5+0.1*float32(i), int(gv), 0)
+		globals[gv] = GlobalScalars.Value(int(gv), 0)
+	}
+
+	sl := &Sleep{}
+	sl.Defaults()
+	sl.Start(net)
+	assert.True(t, sl.Active)
+	assert.True(t, hid.Params.Acts.Noise.On.IsTrue())
+	assert.Equal(t, sl.Noise.Ge, hid.Params.Acts.Noise.Ge)
+	assert.InDelta(t, sched*sl.LRateStart, pt.Params.Learn.LRate.Sched, 1.0e-6)
+
+	sl.SetProgress(net, 0.5)
+	assert.InDelta(t, sched*0.5*(sl.LRateStart+sl.LRateEnd), pt.Params.Learn.LRate.Sched, 1.0e-6)
+	sl.SetProgress(net, 1)
+	assert.InDelta(t, sched*sl.LRateEnd, pt.Params.Learn.LRate.Sched, 1.0e-6)
+
+	sl.ACh = 0.2
+	sl.NE = 0.1
+	runSleepTrial(net, sl)
+	assert.InDelta(t, 0.2, GlobalScalars.Value(int(GvACh), 0), 1.0e-6)
+	assert.InDelta(t, 0.1, GlobalScalars.Value(int(GvNE), 0), 1.0e-6)
+
+	sl.End(net)
+	assert.False(t, sl.Active)
+	assert.Equal(t, noise, hid.Params.Acts.Noise)
+	assert.Equal(t, sched, pt.Params.Learn.LRate.Sched)
+	for _, gv := range sleepGlobals {
+		assert.Equal(t, globals[gv], GlobalScalars.Value(int(gv), 0))
+	}
+}
+
+func TestReplayBuffer(t *testing.T) {
+	net := newTestNet(1)
+	inLay := net.LayerByName("Input")
+	inPats := newInPats()
+
+	rb := &ReplayBuffer{}
+	rb.Config(2, "Input")
+	for pi := range 3 {
+		runTestTrials(net, pi, 1)
+		rb.Record(net, 0)
+	}
+	assert.Equal(t, 2, rb.Len())
+	// the oldest pattern (0) was replaced by the newest (2)
+	for i := range 4 {
+		assert.Equal(t, inPats.SubSpace(2).Float1D(i) > 0, rb.Patterns[0][0].Float1D(i) > 0.5)
+		assert.Equal(t, inPats.SubSpace(1).Float1D(i) > 0, rb.Patterns[1][0].Float1D(i) > 0.5)
+	}
+
+	net.InitExt()
+	idx := rb.Apply(net, 0, 1)
+	for lni := range inLay.NNeurons {
+		ni := int(inLay.NeurStIndex + lni)
+		assert.Equal(t, rb.Patterns[idx][0].Float1D(int(lni)) > 0.5, Neurons.Value(ni, 0, int(Ext)) > 0.5)
+	}
+	net.InitExt()
+	rb.Apply(net, 0, 0)
+	for lni := range inLay.NNeurons {
+		assert.Equal(t, float32(0), Neurons.Value(int(inLay.NeurStIndex+lni), 0, int(Ext)))
+	}
+
+	rb.Reset()
+	assert.Equal(t, 0, rb.Len())
+}
+
+func TestSleepReplay(t *testing.T) {
+	net := newTestNet(1)
+	sl := &Sleep{}
+	sl.Defaults()
+	sl.Mode = SleepReplay
+	sl.CuePct = 1
+	sl.Replay.Config(4, "Input")
+	for pi := range 4 {
+		runTestTrials(net, pi, 1)
+		sl.Replay.Record(net, 0)
+	}
+	hash := net.WeightsHash()
+	sl.Start(net)
+	assert.Contains(t, runSleepTrial(net, sl), "Replay_")
+	sl.End(net)
+	assert.NotEqual(t, hash, net.WeightsHash())
+}
+
+func TestSleepReplayCheckpoint(t *testing.T) {
+	net := newTestNet(1)
+	sl := &Sleep{}
+	sl.Defaults()
+	sl.Mode = SleepReplay
+	sl.CuePct = 0.5
+	sl.Replay.Config(3, "Input")
+	for pi := range 4 {
+		runTestTrials(net, pi, 1)
+		sl.Replay.Record(net, 0)
+	}
+	sl.Start(net)
+	runSleepTrial(net, sl)
+
+	var b bytes.Buffer
+	assert.NoError(t, net.SaveCheckpoint(&b))
+	assert.NoError(t, sl.SaveCheckpoint(&b))
+	var names []string
+	for range 3 {
+		names = append(names, runSleepTrial(net, sl))
+	}
+	hash := net.WeightsHash()
+
+	resNet := newTestNet(1)
+	resNet.SetRandSeed(7) // replay does not depend on the Network Rand
+	resSl := &Sleep{}
+	resSl.Defaults()
+	resSl.Mode = SleepReplay
+	resSl.CuePct = 0.5
+	resSl.Replay.Config(3, "Input")
+	br := bufio.NewReader(&b)
+	assert.NoError(t, resNet.LoadCheckpoint(br))
+	assert.NoError(t, resSl.LoadCheckpoint(br))
+	assert.Equal(t, sl.Replay.Patterns, resSl.Replay.Patterns)
+	assert.Equal(t, sl.Replay.next, resSl.Replay.next)
+	resSl.Start(resNet)
+	for i := range 3 {
+		assert.Equal(t, names[i], runSleepTrial(resNet, resSl))
+	}
+	assert.Equal(t, hash, resNet.WeightsHash())
+
+	other := &ReplayBuffer{}
+	other.Config(3, "Input", "Output")
+	var rb bytes.Buffer
+	assert.NoError(t, sl.Replay.SaveCheckpoint(&rb))
+	assert.Error(t, other.LoadCheckpoint(&rb))
+}
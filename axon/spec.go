@@ -0,0 +1,914 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package axon
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+
+	"cogentcore.org/core/base/errors"
+	"cogentcore.org/core/base/iox/jsonx"
+	"cogentcore.org/core/base/iox/tomlx"
+	"cogentcore.org/core/core"
+	"github.com/emer/emergent/v2/paths"
+	"github.com/emer/emergent/v2/relpos"
+)
+
+// NetSpec is a declarative specification of the layers and pathways
+// of a network, which can be saved to and loaded from TOML or JSON
+// files, so that model variants can be expressed as differences in
+// config files instead of Go code. Use [BuildFromSpec] or
+// [Network.ConfigFromSpec] to make a network from a spec, and
+// [Network.ExportSpec] to get the spec for an existing network.
+// Parameters are not part of the spec: they are applied
+// in the usual way after the network is built, while the default
+// parameters of composite layers come from their Builder.
+type NetSpec struct {
+
+	// Name is the name of the network.
+	Name string
+
+	// MaxData is the maximum number of data parallel elements
+	// to allocate, if > 0.
+	MaxData int `toml:",omitempty" json:",omitempty"`
+
+	// RubiconPosUSs is the number of simulation-specific positive USs
+	// passed to [Rubicon.SetNUSs], if > 0. Must be set for networks
+	// that include Rubicon layers.
+	RubiconPosUSs int `toml:",omitempty" json:",omitempty"`
+
+	// RubiconNegUSs is the number of simulation-specific negative USs
+	// passed to [Rubicon.SetNUSs].
+	RubiconNegUSs int `toml:",omitempty" json:",omitempty"`
+
+	// Layers are the layers, in the order in which they are added.
+	// Entries with a Builder add all of the layers and pathways
+	// of the corresponding composite builder method.
+	Layers []*LayerSpec
+
+	// Paths are the pathways, in the order in which they are connected,
+	// which is after all the Layers have been added, except for those
+	// connected before a Builder, as given by its PathsBefore.
+	Paths []*PathSpec
+}
+
+// LayerSpec is the specification for one layer in a [NetSpec],
+// or for a set of layers made by a composite builder method.
+type LayerSpec struct {
+
+	// Name is the name of the layer, or the name or prefix
+	// passed to the Builder.
+	Name string
+
+	// Type is the type of layer. Not used for a Builder.
+	Type LayerTypes `toml:",omitempty" json:",omitempty"`
+
+	// Shape is the shape of the layer, with 2D and 4D shapes generally
+	// preferred, or the shape passed to the Builder.
+	Shape []int `toml:",omitempty" json:",omitempty"`
+
+	// Class has additional CSS-style class names for the layer,
+	// space separated.
+	Class string `toml:",omitempty" json:",omitempty"`
+
+	// Doc is the documentation for the layer, if different from the
+	// default for its Type.
+	Doc string `toml:",omitempty" json:",omitempty"`
+
+	// Off inactivates the layer. For a Builder, it applies
+	// to all of the layers added.
+	Off bool `toml:",omitempty" json:",omitempty"`
+
+	// Pos is the relative position of the layer. For a Builder,
+	// it applies to the first layer added.
+	Pos *relpos.Pos `toml:",omitempty" json:",omitempty"`
+
+	// SampleIndexes are the current set of "sample" unit indexes,
+	// used for display and stats. See [emer.LayerBase.SetSampleShape].
+	SampleIndexes []int `toml:",omitempty" json:",omitempty"`
+
+	// SampleShape is the shape to use for the SampleIndexes.
+	SampleShape []int `toml:",omitempty" json:",omitempty"`
+
+	// DendComps is the number of dendritic compartments per neuron.
+	// See [Layer.DendComps]. For a Builder, it applies to all of
+	// the layers added.
+	DendComps int `toml:",omitempty" json:",omitempty"`
+
+	// BuildConfig has configuration data set when the network is configured,
+	// that is used during the network Build() process.
+	BuildConfig map[string]string `toml:",omitempty" json:",omitempty"`
+
+	// Builder, if set, calls a composite builder method instead of
+	// adding a single layer.
+	Builder *BuilderSpec `toml:",omitempty" json:",omitempty"`
+}
+
+// PathSpec is the specification for one pathway in a [NetSpec].
+type PathSpec struct {
+
+	// Send is the name of the sending layer.
+	Send string
+
+	// Recv is the name of the receiving layer.
+	Recv string
+
+	// Type is the type of pathway.
+	Type PathTypes
+
+	// Pattern is the pattern of connectivity.
+	Pattern *PatternSpec
+
+	// Class has additional CSS-style class names for the pathway,
+	// space separated.
+	Class string `toml:",omitempty" json:",omitempty"`
+
+	// Doc is the documentation for the pathway.
+	Doc string `toml:",omitempty" json:",omitempty"`
+
+	// Off inactivates the pathway.
+	Off bool `toml:",omitempty" json:",omitempty"`
+}
+
+// PatternSpec is the specification for a [paths.Pattern],
+// with the name of the pattern type and the values of any
+// fields that differ from the defaults for that type.
+type PatternSpec struct {
+
+	// Type is the name of the pattern type, as returned by its Name()
+	// method, which must be registered in [PatternTypes].
+	Type string
+
+	// Params are the values of fields in the pattern, by field name,
+	// using the same structure as JSON encoding of the pattern.
+	Params map[string]any `toml:",omitempty" json:",omitempty"`
+}
+
+// PatternTypes are the functions returning a new [paths.Pattern]
+// with default values, by pattern type name, used for [PatternSpec].
+// Add to this map to use other pattern types in a [NetSpec].
+var PatternTypes = map[string]func() paths.Pattern{
+	"Full":            func() paths.Pattern { return paths.NewFull() },
+	"OneToOne":        func() paths.Pattern { return paths.NewOneToOne() },
+	"PoolOneToOne":    func() paths.Pattern { return paths.NewPoolOneToOne() },
+	"PoolRect":        func() paths.Pattern { return paths.NewPoolRect() },
+	"PoolSameUnit":    func() paths.Pattern { return paths.NewPoolSameUnit() },
+	"PoolTile":        func() paths.Pattern { return paths.NewPoolTile() },
+	"PoolTileSub":     func() paths.Pattern { return paths.NewPoolTileSub() },
+	"PoolUniformRand": func() paths.Pattern { return paths.NewPoolUniformRand() },
+	"Rect":            func() paths.Pattern { return paths.NewRect() },
+	"UniformRand":     func() paths.Pattern { return paths.NewUniformRand() },
+	"Circle":          func() paths.Pattern { return paths.NewCircle() },
+	"BLANovelPath":    func() paths.Pattern { return NewBLANovelPath() },
+}
+
+// BuilderTypes are the composite network builder methods
+// that can be used in a [LayerSpec].
+type BuilderTypes int32 //enums:enum -trim-prefix Build
+
+const (
+	// BuildSuperCT calls [Network.AddSuperCT2D] or [Network.AddSuperCT4D]
+	// depending on the number of Shape dimensions, with PathClass and Pattern.
+	BuildSuperCT BuilderTypes = iota
+
+	// BuildPulvForSuper calls [Network.AddPulvForSuper] for the Super layer
+	// with the Name of the LayerSpec.
+	BuildPulvForSuper
+
+	// BuildInputPulv calls [Network.AddInputPulv2D] or [Network.AddInputPulv4D]
+	// depending on the number of Shape dimensions.
+	BuildInputPulv
+
+	// BuildPFC calls [Network.AddPFC2D] or [Network.AddPFC4D]
+	// depending on the number of Shape dimensions, with ThalSuffix,
+	// DecayOnRew and SelfMaint.
+	BuildPFC
+
+	// BuildVentralBG calls [Network.AddVentralBG] with the 4D Shape
+	// and the 2D GPShape, using the Name as the prefix.
+	BuildVentralBG
+
+	// BuildDorsalBG calls [Network.AddDorsalBG] with the 4D Shape,
+	// the 2D GPShape and PoolSTN, using the Name as the prefix.
+	BuildDorsalBG
+
+	// BuildRubicon calls [Network.AddRubicon] with NYneur and the
+	// 2D PopShape, BGShape and PFCShape. The Name is not used.
+	// The [NetSpec] RubiconPosUSs must be set.
+	BuildRubicon
+
+	// BuildHip calls [Network.AddHip] with the Hip config.
+	// The Name is not used.
+	BuildHip
+
+	// BuildTDLayers calls [Network.AddTDLayers] with Rel,
+	// using the Name as the prefix.
+	BuildTDLayers
+
+	// BuildRWLayers calls [Network.AddRWLayers] with Rel,
+	// using the Name as the prefix.
+	BuildRWLayers
+)
+
+// BuilderSpec has the parameters for a composite builder method
+// used in a [LayerSpec], which also provides the Name and Shape.
+// Only the parameters relevant for the given Type are used.
+type BuilderSpec struct {
+
+	// Type is the builder method to call.
+	Type BuilderTypes
+
+	// Space is the spacing between layers placed by the builder.
+	Space float32 `toml:",omitempty" json:",omitempty"`
+
+	// PathsBefore is the number of the [NetSpec] Paths that are
+	// connected before calling the builder, so that pathways connected
+	// before the builder in Go code keep the same order relative to
+	// those made by the builder.
+	PathsBefore int `toml:",omitempty" json:",omitempty"`
+
+	// PathClass is the class added to pathways, for SuperCT.
+	PathClass string `toml:",omitempty" json:",omitempty"`
+
+	// Pattern is the pattern of connectivity from Super to CT, for SuperCT.
+	Pattern *PatternSpec `toml:",omitempty" json:",omitempty"`
+
+	// ThalSuffix is the suffix for the thalamus layer, for PFC.
+	ThalSuffix string `toml:",omitempty" json:",omitempty"`
+
+	// DecayOnRew decays the PFC state on reward, for PFC.
+	DecayOnRew bool `toml:",omitempty" json:",omitempty"`
+
+	// SelfMaint adds self-maintenance pathways in the PT layer, for PFC.
+	SelfMaint bool `toml:",omitempty" json:",omitempty"`
+
+	// GPShape is the 2D shape of the GP and STN layers, for VentralBG and DorsalBG.
+	GPShape []int `toml:",omitempty" json:",omitempty"`
+
+	// PoolSTN uses a pooled STN layer, for DorsalBG.
+	PoolSTN bool `toml:",omitempty" json:",omitempty"`
+
+	// NYneur is the number of neurons in the Y dimension of
+	// population codes, for Rubicon.
+	NYneur int `toml:",omitempty" json:",omitempty"`
+
+	// PopShape is the 2D shape of population code layers, for Rubicon.
+	PopShape []int `toml:",omitempty" json:",omitempty"`
+
+	// BGShape is the 2D shape of basal ganglia pools, for Rubicon.
+	BGShape []int `toml:",omitempty" json:",omitempty"`
+
+	// PFCShape is the 2D shape of PFC pools, for Rubicon.
+	PFCShape []int `toml:",omitempty" json:",omitempty"`
+
+	// Hip is the hippocampus configuration, for Hip.
+	Hip *HipConfig `toml:",omitempty" json:",omitempty"`
+
+	// Rel is the placement relationship of the layers, for TDLayers
+	// and RWLayers.
+	Rel relpos.Relations `toml:",omitempty" json:",omitempty"`
+}
+
+// specBuilder records the layers and pathways made by a builder
+// method of one of the [BuilderTypes], so that [Network.ExportSpec]
+// can export the builder instead.
+type specBuilder struct {
+	spec   *LayerSpec
+	layers []*Layer
+	paths  []*Path
+
+	// err is an error in making the spec, for ExportSpec.
+	err error
+
+	// nLayers is the number of layers prior to the builder.
+	nLayers int
+
+	// prev are the pathways made prior to the builder.
+	prev map[*Path]bool
+
+	// classes are the Class of each layer made by the builder,
+	// so that any classes added later can be detected.
+	classes []string
+
+	// nLayerDefaults and nPathDefaults are the number of DefaultParams functions
+	// added by the builder for each of the layers and paths,
+	// so that any added later in Go can be detected.
+	nLayerDefaults []int
+	nPathDefaults  []int
+}
+
+// startSpecBuilder starts recording the layers and pathways made by the
+// builder method in given spec, which [Network.endSpecBuilder] must be
+// deferred to finish. Returns nil if a builder is already being recorded,
+// so that only the outermost builder is recorded, e.g., for the builders
+// called by [Network.AddRubicon].
+func (nt *Network) startSpecBuilder(ls *LayerSpec) *specBuilder {
+	if nt.specBuilding {
+		return nil
+	}
+	nt.specBuilding = true
+	sb := &specBuilder{spec: ls, nLayers: len(nt.Layers), prev: make(map[*Path]bool)}
+	for _, ly := range nt.Layers {
+		for _, pt := range ly.SendPaths {
+			sb.prev[pt] = true
+		}
+	}
+	return sb
+}
+
+// endSpecBuilder finishes recording the builder started by
+// [Network.startSpecBuilder], if not nil.
+func (nt *Network) endSpecBuilder(sb *specBuilder) {
+	if sb == nil {
+		return
+	}
+	nt.specBuilding = false
+	sb.layers = slices.Clone(nt.Layers[sb.nLayers:])
+	if len(sb.layers) == 0 {
+		return
+	}
+	for _, ly := range nt.Layers {
+		for _, pt := range ly.SendPaths {
+			if !sb.prev[pt] {
+				sb.paths = append(sb.paths, pt)
+			}
+		}
+	}
+	for _, ly := range sb.layers {
+		sb.nLayerDefaults = append(sb.nLayerDefaults, len(ly.DefaultParams))
+		sb.classes = append(sb.classes, ly.Class)
+	}
+	for _, pt := range sb.paths {
+		sb.nPathDefaults = append(sb.nPathDefaults, len(pt.DefaultParams))
+	}
+	nt.specBuilders = append(nt.specBuilders, sb)
+}
+
+// setPattern sets the Pattern of the builder spec from given pattern.
+func (sb *specBuilder) setPattern(pat paths.Pattern) {
+	if sb == nil {
+		return
+	}
+	sb.spec.Builder.Pattern, sb.err = NewPatternSpec(pat)
+}
+
+// Open opens the spec from given file, in TOML format if it has
+// a .toml extension, and JSON otherwise.
+func (sp *NetSpec) Open(filename core.Filename) error {
+	if filepath.Ext(string(filename)) == ".toml" {
+		return errors.Log(tomlx.Open(sp, string(filename)))
+	}
+	return errors.Log(jsonx.Open(sp, string(filename)))
+}
+
+// Save saves the spec to given file, in TOML format if it has
+// a .toml extension, and JSON otherwise.
+func (sp *NetSpec) Save(filename core.Filename) error {
+	if filepath.Ext(string(filename)) == ".toml" {
+		return errors.Log(tomlx.Save(sp, string(filename)))
+	}
+	return errors.Log(jsonx.Save(sp, string(filename)))
+}
+
+// OpenNetSpec returns a new [NetSpec] opened from given file,
+// in TOML or JSON format depending on the extension.
+func OpenNetSpec(filename core.Filename) (*NetSpec, error) {
+	sp := &NetSpec{}
+	err := sp.Open(filename)
+	return sp, err
+}
+
+// BuildFromSpec returns a new network made and built from given spec.
+// Parameters must then be set in the usual way, followed by InitWeights.
+// Use [Network.ConfigFromSpec] to configure a network that needs other
+// settings prior to Build, e.g., in the [Context].
+func BuildFromSpec(spec *NetSpec) (*Network, error) {
+	net := NewNetwork(spec.Name)
+	if spec.MaxData > 0 {
+		net.SetMaxData(spec.MaxData)
+	}
+	if err := net.ConfigFromSpec(spec); err != nil {
+		return net, err
+	}
+	return net, net.Build()
+}
+
+// ConfigFromSpec adds the layers and pathways from given spec to the
+// network, and sets the Rubicon number of USs if specified.
+// Build must be called after this.
+func (nt *Network) ConfigFromSpec(spec *NetSpec) error {
+	if spec.RubiconPosUSs > 0 {
+		nt.Rubicon.SetNUSs(spec.RubiconPosUSs, spec.RubiconNegUSs)
+	}
+	var errs []error
+	np := 0
+	connect := func(n int) {
+		for ; np < min(n, len(spec.Paths)); np++ {
+			errs = append(errs, nt.connectSpecPath(spec.Paths[np]))
+		}
+	}
+	for _, ls := range spec.Layers {
+		if ls.Builder != nil {
+			connect(ls.Builder.PathsBefore)
+			errs = append(errs, nt.addSpecBuilder(ls))
+			continue
+		}
+		if nt.LayerByName(ls.Name) != nil {
+			errs = append(errs, fmt.Errorf("ConfigFromSpec: layer %q already exists", ls.Name))
+			continue
+		}
+		ly := nt.AddLayer(ls.Name, ls.Type, ls.Shape...)
+		ls.configLayer(ly)
+		if ls.Doc != "" {
+			ly.Doc = ls.Doc
+		}
+		for k, v := range ls.BuildConfig {
+			ly.SetBuildConfig(k, v)
+		}
+	}
+	connect(len(spec.Paths))
+	return errors.Join(errs...)
+}
+
+// connectSpecPath connects the pathway in given spec.
+func (nt *Network) connectSpecPath(ps *PathSpec) error {
+	slay := nt.LayerByName(ps.Send)
+	if slay == nil {
+		return fmt.Errorf("ConfigFromSpec: sending layer %q not found", ps.Send)
+	}
+	rlay := nt.LayerByName(ps.Recv)
+	if rlay == nil {
+		return fmt.Errorf("ConfigFromSpec: receiving layer %q not found", ps.Recv)
+	}
+	pat, err := ps.Pattern.NewPattern()
+	if err != nil {
+		return err
+	}
+	pt := nt.ConnectLayers(slay, rlay, pat, ps.Type)
+	if ps.Class != "" {
+		pt.AddClass(ps.Class)
+	}
+	pt.Doc = ps.Doc
+	pt.Off = ps.Off
+	return nil
+}
+
+// configLayer applies the Class, Off, Pos, Sample and DendComps settings to given layer.
+func (ls *LayerSpec) configLayer(ly *Layer) {
+	if ls.Class != "" {
+		ly.AddClass(ls.Class)
+	}
+	ly.Off = ls.Off
+	if ls.Pos != nil {
+		ly.Pos = *ls.Pos
+	}
+	if len(ls.SampleIndexes) > 0 {
+		ly.SetSampleShape(ls.SampleIndexes, ls.SampleShape)
+	}
+	ly.DendComps = ls.DendComps
+}
+
+// addSpecBuilder calls the builder method for given layer spec,
+// which records the layers and pathways it makes, and applies the
+// Pos and Class to the first layer, and Off and DendComps to all layers.
+func (nt *Network) addSpecBuilder(ls *LayerSpec) error {
+	bs := ls.Builder
+	nsb := len(nt.specBuilders)
+	shp := ls.Shape
+	errShape := func(nd ...int) error {
+		if slices.Contains(nd, len(shp)) {
+			return nil
+		}
+		return fmt.Errorf("ConfigFromSpec: %s builder %q Shape: %v must have %v dimensions", bs.Type, ls.Name, shp, nd)
+	}
+	errShape2D := func(nm string, s []int) error {
+		if len(s) == 2 {
+			return nil
+		}
+		return fmt.Errorf("ConfigFromSpec: %s builder %q %s: %v must have 2 dimensions", bs.Type, ls.Name, nm, s)
+	}
+	switch bs.Type {
+	case BuildSuperCT:
+		if err := errShape(2, 4); err != nil {
+			return err
+		}
+		pat, err := bs.Pattern.NewPattern()
+		if err != nil {
+			return err
+		}
+		if len(shp) == 2 {
+			nt.AddSuperCT2D(ls.Name, bs.PathClass, shp[0], shp[1], bs.Space, pat)
+		} else {
+			nt.AddSuperCT4D(ls.Name, bs.PathClass, shp[0], shp[1], shp[2], shp[3], bs.Space, pat)
+		}
+	case BuildPulvForSuper:
+		super := nt.LayerByName(ls.Name)
+		if super == nil {
+			return fmt.Errorf("ConfigFromSpec: %s builder super layer %q not found", bs.Type, ls.Name)
+		}
+		nt.AddPulvForSuper(super, bs.Space)
+	case BuildInputPulv:
+		if err := errShape(2, 4); err != nil {
+			return err
+		}
+		if len(shp) == 2 {
+			nt.AddInputPulv2D(ls.Name, shp[0], shp[1], bs.Space)
+		} else {
+			nt.AddInputPulv4D(ls.Name, shp[0], shp[1], shp[2], shp[3], bs.Space)
+		}
+	case BuildPFC:
+		if err := errShape(2, 4); err != nil {
+			return err
+		}
+		if len(shp) == 2 {
+			nt.AddPFC2D(ls.Name, bs.ThalSuffix, shp[0], shp[1], bs.DecayOnRew, bs.SelfMaint, bs.Space)
+		} else {
+			nt.AddPFC4D(ls.Name, bs.ThalSuffix, shp[0], shp[1], shp[2], shp[3], bs.DecayOnRew, bs.SelfMaint, bs.Space)
+		}
+	case BuildVentralBG, BuildDorsalBG:
+		if err := errors.Join(errShape(4), errShape2D("GPShape", bs.GPShape)); err != nil {
+			return err
+		}
+		gp := bs.GPShape
+		if bs.Type == BuildVentralBG {
+			nt.AddVentralBG(ls.Name, shp[0], shp[1], shp[2], shp[3], gp[0], gp[1], bs.Space)
+		} else {
+			nt.AddDorsalBG(ls.Name, bs.PoolSTN, shp[0], shp[1], shp[2], shp[3], gp[0], gp[1], bs.Space)
+		}
+	case BuildRubicon:
+		if err := errors.Join(errShape2D("PopShape", bs.PopShape), errShape2D("BGShape", bs.BGShape), errShape2D("PFCShape", bs.PFCShape)); err != nil {
+			return err
+		}
+		if nt.Rubicon.NPosUSs == 0 {
+			return fmt.Errorf("ConfigFromSpec: %s builder requires RubiconPosUSs to be set", bs.Type)
+		}
+		nt.AddRubicon(bs.NYneur, bs.PopShape[0], bs.PopShape[1], bs.BGShape[0], bs.BGShape[1], bs.PFCShape[0], bs.PFCShape[1], bs.Space)
+	case BuildHip:
+		if bs.Hip == nil {
+			bs.Hip = &HipConfig{}
+			bs.Hip.Defaults()
+		}
+		nt.AddHip(bs.Hip, bs.Space)
+	case BuildTDLayers:
+		nt.AddTDLayers(ls.Name, bs.Rel, bs.Space)
+	case BuildRWLayers:
+		nt.AddRWLayers(ls.Name, bs.Rel, bs.Space)
+	default:
+		return fmt.Errorf("ConfigFromSpec: builder type %v not valid", bs.Type)
+	}
+	if len(nt.specBuilders) == nsb {
+		return nil
+	}
+	sb := nt.specBuilders[nsb]
+	for _, ly := range sb.layers {
+		ly.Off = ls.Off
+		ly.DendComps = ls.DendComps
+	}
+	fly := sb.layers[0]
+	if ls.Pos != nil {
+		fly.Pos = *ls.Pos
+	}
+	if ls.Class != "" {
+		fly.AddClass(ls.Class)
+	}
+	return nil
+}
+
+// NewPattern returns a new [paths.Pattern] of the Type in the spec,
+// with Params applied to it.
+func (ps *PatternSpec) NewPattern() (paths.Pattern, error) {
+	if ps == nil {
+		return paths.NewFull(), nil
+	}
+	fun, ok := PatternTypes[ps.Type]
+	if !ok {
+		return nil, fmt.Errorf("PatternSpec: pattern type %q not found in PatternTypes", ps.Type)
+	}
+	pat := fun()
+	if len(ps.Params) == 0 {
+		return pat, nil
+	}
+	b, err := json.Marshal(ps.Params)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, pat); err != nil {
+		return nil, fmt.Errorf("PatternSpec: setting %s Params: %w", ps.Type, err)
+	}
+	return pat, nil
+}
+
+// NewPatternSpec returns a [PatternSpec] for given pattern, with Params
+// for all of the fields that differ from the defaults for that type.
+// Fields of interface, func and chan type, such as random number
+// sources, are not included. Returns an error if the pattern type
+// is not registered in [PatternTypes].
+func NewPatternSpec(pat paths.Pattern) (*PatternSpec, error) {
+	nm := pat.Name()
+	fun, ok := PatternTypes[nm]
+	if !ok {
+		return nil, fmt.Errorf("NewPatternSpec: pattern type %q not found in PatternTypes", nm)
+	}
+	ps := &PatternSpec{Type: nm}
+	cur, err := patternValues(pat)
+	if err != nil {
+		return nil, err
+	}
+	def, err := patternValues(fun())
+	if err != nil {
+		return nil, err
+	}
+	skip := patternSkipFields(reflect.TypeOf(pat))
+	for k, v := range cur {
+		if skip[k] || reflect.DeepEqual(v, def[k]) {
+			continue
+		}
+		if ps.Params == nil {
+			ps.Params = make(map[string]any)
+		}
+		ps.Params[k] = v
+	}
+	return ps, nil
+}
+
+// patternValues returns the JSON encoding of the pattern as a map.
+func patternValues(pat paths.Pattern) (map[string]any, error) {
+	b, err := json.Marshal(pat)
+	if err != nil {
+		return nil, err
+	}
+	vals := make(map[string]any)
+	err = json.Unmarshal(b, &vals)
+	return vals, err
+}
+
+// patternSkipFields returns the names of the fields in given pattern
+// type, including those in embedded structs, that cannot be represented
+// in a [PatternSpec].
+func patternSkipFields(typ reflect.Type) map[string]bool {
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	skip := make(map[string]bool)
+	if typ.Kind() != reflect.Struct {
+		return skip
+	}
+	for i := range typ.NumField() {
+		f := typ.Field(i)
+		if f.Anonymous {
+			for k := range patternSkipFields(f.Type) {
+				skip[k] = true
+			}
+			continue
+		}
+		switch f.Type.Kind() {
+		case reflect.Interface, reflect.Func, reflect.Chan:
+			skip[f.Name] = true
+		}
+	}
+	return skip
+}
+
+// ExportSpec returns a [NetSpec] for the current network configuration,
+// such that [BuildFromSpec] makes a network with the same layers and
+// pathways, in the same order, and thus the same synapse layout.
+// Layers and pathways made by one of the builder methods in [BuilderTypes],
+// whether called in Go code or by [Network.ConfigFromSpec], are exported
+// as that builder, with pathways connected before it exported before it,
+// while everything else is exported as individual layers and pathways.
+// Parameters set by the DefaultParams functions of builders are thus
+// reproduced, but those added in Go code cannot be captured in the spec,
+// so an error naming the layers and pathways that have them is returned,
+// along with the spec, which then only matches the structure of the network.
+// Also returns an error if a pathway uses a pattern type that is not
+// registered in [PatternTypes], if the layers of a builder differ in
+// settings that the spec applies to all of them, or if the pathways
+// cannot be ordered consistently with the order of pathways in each layer.
+func (nt *Network) ExportSpec() (*NetSpec, error) {
+	spec := &NetSpec{Name: nt.Name, MaxData: nt.MaxParallelData()}
+	if nt.Rubicon.NPosUSs > 0 {
+		spec.RubiconPosUSs = int(nt.Rubicon.NPosUSs) - 1
+		spec.RubiconNegUSs = int(nt.Rubicon.NNegUSs)
+	}
+	lyBuilder := make(map[*Layer]*specBuilder)
+	ptBuilder := make(map[*Path]bool)
+	for _, sb := range nt.specBuilders {
+		for _, ly := range sb.layers {
+			lyBuilder[ly] = sb
+		}
+		for _, pt := range sb.paths {
+			ptBuilder[pt] = true
+		}
+	}
+	order, pathsBefore, err := nt.exportPathOrder(ptBuilder)
+	errs := []error{err, nt.exportDefaultParamsError()}
+	for _, ly := range nt.Layers {
+		if sb, ok := lyBuilder[ly]; ok {
+			if sb.layers[0] == ly {
+				ls, err := sb.exportSpec(pathsBefore[slices.Index(nt.specBuilders, sb)])
+				spec.Layers = append(spec.Layers, ls)
+				errs = append(errs, err)
+			}
+			continue
+		}
+		spec.Layers = append(spec.Layers, ly.exportSpec())
+	}
+	for _, pt := range order {
+		ps, err := pt.exportSpec()
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		spec.Paths = append(spec.Paths, ps)
+	}
+	return spec, errors.Join(errs...)
+}
+
+// exportDefaultParamsError returns an error naming the layers and
+// pathways with DefaultParams functions that were not added by a builder
+// in [Network.ConfigFromSpec], which cannot be exported.
+func (nt *Network) exportDefaultParamsError() error {
+	nLayer := make(map[*Layer]int)
+	nPath := make(map[*Path]int)
+	for _, sb := range nt.specBuilders {
+		for i, ly := range sb.layers {
+			nLayer[ly] = sb.nLayerDefaults[i]
+		}
+		for i, pt := range sb.paths {
+			nPath[pt] = sb.nPathDefaults[i]
+		}
+	}
+	var nms []string
+	for _, ly := range nt.Layers {
+		if len(ly.DefaultParams) > nLayer[ly] {
+			nms = append(nms, ly.Name)
+		}
+		for _, pt := range ly.RecvPaths {
+			if len(pt.DefaultParams) > nPath[pt] {
+				nms = append(nms, pt.Name)
+			}
+		}
+	}
+	if len(nms) == 0 {
+		return nil
+	}
+	return fmt.Errorf("ExportSpec: DefaultParams functions set in Go cannot be exported for: %s", strings.Join(nms, ", "))
+}
+
+// exportSpec returns the [LayerSpec] for this layer.
+func (ly *Layer) exportSpec() *LayerSpec {
+	ls := &LayerSpec{Name: ly.Name, Type: ly.Type, Shape: slices.Clone(ly.Shape.Sizes), Off: ly.Off, DendComps: ly.DendComps}
+	ls.Class = ly.Class
+	if ly.Doc != ly.Type.Desc() {
+		ls.Doc = ly.Doc
+	}
+	if ly.Pos.Rel != relpos.NoRel {
+		pos := ly.Pos
+		ls.Pos = &pos
+	}
+	if len(ly.SampleIndexes) > 0 {
+		ls.SampleIndexes = slices.Clone(ly.SampleIndexes)
+		ls.SampleShape = slices.Clone(ly.SampleShape.Sizes)
+	}
+	if len(ly.BuildConfig) > 0 {
+		ls.BuildConfig = make(map[string]string, len(ly.BuildConfig))
+		for k, v := range ly.BuildConfig {
+			ls.BuildConfig[k] = v
+		}
+	}
+	return ls
+}
+
+// exportSpec returns the [LayerSpec] for this builder, with given
+// number of pathways connected before it, and the current position,
+// class, Off and DendComps of the first layer. Returns an error if
+// the other layers differ in Off or DendComps, which the spec applies
+// to all of the layers, or if their Class was changed, or the Class
+// of the first layer was changed other than by adding to it.
+func (sb *specBuilder) exportSpec(pathsBefore int) (*LayerSpec, error) {
+	ls := *sb.spec
+	bs := *ls.Builder
+	bs.PathsBefore = pathsBefore
+	ls.Builder = &bs
+	fly := sb.layers[0]
+	ls.Pos = nil
+	if fly.Pos.Rel != relpos.NoRel {
+		pos := fly.Pos
+		ls.Pos = &pos
+	}
+	ls.Off = fly.Off
+	ls.DendComps = fly.DendComps
+	errs := []error{sb.err}
+	if cls, ok := strings.CutPrefix(fly.Class, sb.classes[0]); ok {
+		ls.Class = strings.TrimSpace(cls)
+	} else {
+		errs = append(errs, fmt.Errorf("ExportSpec: %s builder layer %s Class %q does not start with the Class set by the builder: %q", bs.Type, fly.Name, fly.Class, sb.classes[0]))
+	}
+	var nms []string
+	for i, ly := range sb.layers[1:] {
+		if ly.Off != fly.Off || ly.DendComps != fly.DendComps || ly.Class != sb.classes[i+1] {
+			nms = append(nms, ly.Name)
+		}
+	}
+	if len(nms) > 0 {
+		errs = append(errs, fmt.Errorf("ExportSpec: %s builder layers differ from %s in Off or DendComps, or have a changed Class: %s", bs.Type, fly.Name, strings.Join(nms, ", ")))
+	}
+	return &ls, errors.Join(errs...)
+}
+
+// exportSpec returns the [PathSpec] for this pathway.
+func (pt *Path) exportSpec() (*PathSpec, error) {
+	ps := &PathSpec{Send: pt.Send.Name, Recv: pt.Recv.Name, Type: pt.Type, Doc: pt.Doc, Off: pt.Off}
+	ps.Class = strings.TrimSpace(pt.Class)
+	pat, err := NewPatternSpec(pt.Pattern)
+	if err != nil {
+		return nil, fmt.Errorf("ExportSpec: pathway %s: %w", pt.Name, err)
+	}
+	ps.Pattern = pat
+	return ps, nil
+}
+
+// exportPathOrder returns all the pathways not made by builders, in an
+// order consistent with the order of pathways in the RecvPaths and
+// SendPaths of each layer, and with the builders in specBuilders,
+// so that connecting them in this order reproduces the same synapse
+// layout. Also returns the number of these pathways connected before
+// each builder. Returns an error naming the pathways that cannot be
+// ordered in this way, which are not included.
+func (nt *Network) exportPathOrder(ptBuilder map[*Path]bool) ([]*Path, []int, error) {
+	prevSend := make(map[*Path]*Path)
+	prevRecv := make(map[*Path]*Path)
+	n := 0
+	for _, ly := range nt.Layers {
+		var ps, pr *Path
+		for _, pt := range ly.SendPaths {
+			prevSend[pt] = ps
+			ps = pt
+			if !ptBuilder[pt] {
+				n++
+			}
+		}
+		for _, pt := range ly.RecvPaths {
+			prevRecv[pt] = pr
+			pr = pt
+		}
+	}
+	// stage is the number of builders called before the pathway was connected.
+	stage := func(pt *Path) int {
+		for i, sb := range nt.specBuilders {
+			if sb.prev[pt] {
+				return i
+			}
+		}
+		return len(nt.specBuilders)
+	}
+	done := make(map[*Path]bool, len(prevSend))
+	order := make([]*Path, 0, n)
+	pathsBefore := make([]int, len(nt.specBuilders))
+	ready := func(pt *Path) bool {
+		ps, pr := prevSend[pt], prevRecv[pt]
+		return (ps == nil || done[ps]) && (pr == nil || done[pr])
+	}
+	for st := range len(nt.specBuilders) + 1 {
+		for {
+			added := false
+			for _, ly := range nt.Layers {
+				for _, pt := range ly.SendPaths {
+					if ptBuilder[pt] || done[pt] || !ready(pt) || stage(pt) != st {
+						continue
+					}
+					done[pt] = true
+					order = append(order, pt)
+					added = true
+				}
+			}
+			if !added {
+				break
+			}
+		}
+		if st < len(nt.specBuilders) {
+			for _, pt := range nt.specBuilders[st].paths {
+				done[pt] = true
+			}
+			pathsBefore[st] = len(order)
+		}
+	}
+	if len(order) == n {
+		return order, pathsBefore, nil
+	}
+	var nms []string
+	for _, ly := range nt.Layers {
+		for _, pt := range ly.SendPaths {
+			if !ptBuilder[pt] && !done[pt] {
+				nms = append(nms, pt.Name)
+			}
+		}
+	}
+	return order, pathsBefore, fmt.Errorf("ExportSpec: pathways cannot be ordered consistently with the layer SendPaths and RecvPaths: %s", strings.Join(nms, ", "))
+}
@@ -0,0 +1,201 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package axon
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"cogentcore.org/core/core"
+	"github.com/emer/emergent/v2/paths"
+	"github.com/emer/emergent/v2/relpos"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestNetSpec makes a network from given spec in the same way as newTestNet.
+func newTestNetSpec(t *testing.T, spec *NetSpec) *Network {
+	net := NewNetwork(spec.Name)
+	net.SetRandSeed(42)
+	net.SetMaxData(spec.MaxData)
+	assert.NoError(t, net.ConfigFromSpec(spec))
+	net.Rubicon.Defaults()
+	assert.NoError(t, net.Build())
+	net.Defaults()
+	ApplyParamSheets(net, layerParams["Base"], pathParams["Base"])
+	net.InitWeights()
+	return net
+}
+
+// layerPathNames returns the names of all layers and their
+// pathways, in sending and receiving order.
+func layerPathNames(net *Network) []string {
+	var nms []string
+	for _, ly := range net.Layers {
+		nms = append(nms, ly.Name+":"+ly.Type.String())
+		for _, pt := range ly.SendPaths {
+			nms = append(nms, "s:"+pt.Name)
+		}
+		for _, pt := range ly.RecvPaths {
+			nms = append(nms, "r:"+pt.Name)
+		}
+	}
+	return nms
+}
+
+func TestSpecExport(t *testing.T) {
+	net := newTestNet(1)
+	spec, err := net.ExportSpec()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(spec.Layers))
+	assert.Equal(t, 3, len(spec.Paths))
+	assert.Equal(t, 4, spec.RubiconPosUSs)
+	assert.Equal(t, 3, spec.RubiconNegUSs)
+
+	for _, ext := range []string{".toml", ".json"} {
+		fn := core.Filename(filepath.Join(t.TempDir(), "net"+ext))
+		assert.NoError(t, spec.Save(fn))
+		lspec, err := OpenNetSpec(fn)
+		assert.NoError(t, err)
+		snet := newTestNetSpec(t, lspec)
+		assert.Equal(t, layerPathNames(net), layerPathNames(snet))
+		assert.Equal(t, net.NetIxs().NSyns, snet.NetIxs().NSyns)
+		assert.Equal(t, net.WeightsHash(), snet.WeightsHash())
+		assert.Equal(t, layerPathParams(net), layerPathParams(snet))
+	}
+}
+
+// layerPathParams returns the parameters of all layers and their pathways.
+func layerPathParams(net *Network) []string {
+	prs := make([]string, len(net.Layers))
+	for i, ly := range net.Layers {
+		prs[i] = ly.ParamsString(false)
+	}
+	return prs
+}
+
+func TestSpecExportOrder(t *testing.T) {
+	net := NewNetwork("orderNet")
+	inLay := net.AddLayer("Input", InputLayer, 4, 1)
+	hidLay := net.AddLayer("Hidden", SuperLayer, 4, 1)
+	net.ConnectLayers(inLay, hidLay, paths.NewFull(), ForwardPath).AddClass("First")
+	net.ConnectLayers(inLay, hidLay, paths.NewOneToOne(), ForwardPath).AddClass("Second")
+	// receiving order is now the reverse of the sending order
+	hidLay.RecvPaths[0], hidLay.RecvPaths[1] = hidLay.RecvPaths[1], hidLay.RecvPaths[0]
+	assert.NoError(t, net.Build())
+	spec, err := net.ExportSpec()
+	assert.ErrorContains(t, err, "InputToHidden")
+	assert.Equal(t, 0, len(spec.Paths))
+}
+
+func TestSpecBuilders(t *testing.T) {
+	spec := &NetSpec{Name: "specNet", MaxData: 1}
+	spec.Layers = []*LayerSpec{
+		{Name: "Input", Type: InputLayer, Shape: []int{4, 4}},
+		{Name: "Hidden", Shape: []int{2, 2, 3, 3}, Pos: &relpos.Pos{Rel: relpos.RightOf, Other: "Input", Space: 2},
+			Builder: &BuilderSpec{Type: BuildSuperCT, PathClass: "HidCtxt", Space: 2,
+				Pattern: &PatternSpec{Type: "PoolOneToOne"}}},
+		{Name: "Hidden", Builder: &BuilderSpec{Type: BuildPulvForSuper, Space: 2}},
+		{Name: "Output", Type: TargetLayer, Shape: []int{4, 4}, Class: "Out",
+			Pos: &relpos.Pos{Rel: relpos.Above, Other: "Hidden", Space: 2}},
+	}
+	spec.Paths = []*PathSpec{
+		{Send: "Input", Recv: "Hidden", Type: ForwardPath,
+			Pattern: &PatternSpec{Type: "UniformRand", Params: map[string]any{"PCon": 0.25}}},
+		{Send: "Hidden", Recv: "Output", Type: ForwardPath, Pattern: &PatternSpec{Type: "Full"}},
+		{Send: "Output", Recv: "Hidden", Type: BackPath, Class: "FmOut"},
+		{Send: "HiddenCT", Recv: "HiddenP", Type: ForwardPath, Pattern: &PatternSpec{Type: "Full"}},
+	}
+	net, err := BuildFromSpec(spec)
+	assert.NoError(t, err)
+	assert.Equal(t, "Input Hidden HiddenCT HiddenP Output", layerNames(net))
+	assert.Equal(t, relpos.RightOf, net.LayerByName("Hidden").Pos.Rel)
+	pt := net.LayerByName("Hidden").RecvPaths[0]
+	assert.Equal(t, "InputToHidden", pt.Name)
+	assert.Equal(t, float32(0.25), pt.Pattern.(*paths.UniformRand).PCon)
+
+	espec, err := net.ExportSpec()
+	assert.NoError(t, err)
+	assert.Equal(t, len(spec.Layers), len(espec.Layers))
+	assert.Equal(t, BuildSuperCT, espec.Layers[1].Builder.Type)
+	assert.Equal(t, len(spec.Paths), len(espec.Paths))
+	assert.Equal(t, 0.25, espec.Paths[0].Pattern.Params["PCon"])
+
+	fn := core.Filename(filepath.Join(t.TempDir(), "net.toml"))
+	assert.NoError(t, espec.Save(fn))
+	lspec, err := OpenNetSpec(fn)
+	assert.NoError(t, err)
+	snet, err := BuildFromSpec(lspec)
+	assert.NoError(t, err)
+	assert.Equal(t, layerPathNames(net), layerPathNames(snet))
+	assert.Equal(t, net.NetIxs().NSyns, snet.NetIxs().NSyns)
+	// the builders reproduce their default parameters
+	net.Defaults()
+	snet.Defaults()
+	assert.Equal(t, layerPathParams(net), layerPathParams(snet))
+
+	// default parameters added in Go cannot be exported
+	net.LayerByName("Output").AddDefaultParams(func(ly *LayerParams) {
+		ly.Inhib.Layer.Gi = 1.5
+	})
+	_, err = net.ExportSpec()
+	assert.ErrorContains(t, err, "DefaultParams functions set in Go cannot be exported for: Output")
+
+	// builders called in Go are exported as builders, with pathways
+	// connected before them in the same position
+	gnet := NewNetwork("goNet")
+	gin := gnet.AddLayer2D("Input", InputLayer, 4, 4)
+	gout := gnet.AddLayer2D("Output", TargetLayer, 4, 4)
+	gnet.ConnectLayers(gin, gout, paths.NewFull(), ForwardPath)
+	pfc, _, _, _, _ := gnet.AddPFC2D("PFC", "MD", 4, 4, true, false, 2)
+	pfc.AddClass("Ctx")
+	gnet.ConnectLayers(gin, pfc, paths.NewFull(), ForwardPath)
+	gnet.ConnectLayers(pfc, gout, paths.NewFull(), ForwardPath)
+	assert.NoError(t, gnet.Build())
+	gspec, err := gnet.ExportSpec()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(gspec.Layers))
+	assert.Equal(t, BuildPFC, gspec.Layers[2].Builder.Type)
+	assert.Equal(t, 1, gspec.Layers[2].Builder.PathsBefore)
+	assert.Equal(t, "Ctx", gspec.Layers[2].Class)
+	assert.Equal(t, 3, len(gspec.Paths))
+	snet, err = BuildFromSpec(gspec)
+	assert.NoError(t, err)
+	assert.Equal(t, layerPathNames(gnet), layerPathNames(snet))
+	assert.Equal(t, gnet.NetIxs().NSyns, snet.NetIxs().NSyns)
+	gnet.Defaults()
+	snet.Defaults()
+	assert.Equal(t, layerPathParams(gnet), layerPathParams(snet))
+
+	// settings that a builder spec applies to all of its layers
+	dnet := NewNetwork("dendNet")
+	dsuper, dct := dnet.AddSuperCT2D("Hidden", "", 4, 4, 2, paths.NewOneToOne())
+	dsuper.DendComps = 3
+	dct.DendComps = 3
+	assert.NoError(t, dnet.Build())
+	dspec, err := dnet.ExportSpec()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, dspec.Layers[0].DendComps)
+	snet, err = BuildFromSpec(dspec)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, snet.LayerByName("HiddenCT").DendComps)
+	dct.DendComps = 1
+	_, err = dnet.ExportSpec()
+	assert.ErrorContains(t, err, "HiddenCT")
+
+	bspec := &NetSpec{Name: "bad", Paths: []*PathSpec{{Send: "A", Recv: "B"}}}
+	bspec.Layers = []*LayerSpec{{Name: "A", Builder: &BuilderSpec{Type: BuildPFC}}}
+	_, err = BuildFromSpec(bspec)
+	assert.Error(t, err)
+}
+
+// layerNames returns the space-separated names of all layers.
+func layerNames(net *Network) string {
+	nms := make([]string, len(net.Layers))
+	for i, ly := range net.Layers {
+		nms[i] = ly.Name
+	}
+	return strings.Join(nms, " ")
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package axon
+
+import "sync/atomic"
+
+// SpikeSendModes are the modes for sending spikes on the CPU,
+// in [SpikeSend].
+type SpikeSendModes int32 //enums:enum
+
+const (
+	// SpikeSendAuto uses sparse event-driven sending when the fraction
+	// of neurons spiking on the last measured cycle is below Thr,
+	// and the dense [SendSpike] kernel otherwise.
+	SpikeSendAuto SpikeSendModes = iota
+
+	// SpikeSendSparse always uses sparse event-driven sending.
+	SpikeSendSparse
+
+	// SpikeSendDense always uses the dense [SendSpike] kernel,
+	// which visits the sending pathways of every neuron.
+	SpikeSendDense
+)
+
+// SpikeSend has the parameters and state for event-driven sparse sending
+// of spikes on the CPU. The dense [SendSpike] kernel visits all the sending
+// pathways of every neuron on each cycle, even though typically only a few
+// percent of neurons spike. In sparse mode, a first pass over neurons does
+// the neuron-level PostSpike updates and records the spiking neurons
+// in a list, and a second pass only visits the sending synapses of those
+// neurons. Pathways that must be visited for every neuron (see
+// [PathParams.SparseSend]) are sent in the first pass. Spikes are
+// accumulated with integer atomic adds into [PathGBuf], so the results
+// are identical to the dense mode, regardless of order.
+// This is not used on the GPU.
+type SpikeSend struct {
+
+	// Mode determines when sparse sending is used.
+	Mode SpikeSendModes
+
+	// Thr is the threshold fraction of neurons spiking, below which
+	// sparse sending is used in SpikeSendAuto mode.
+	Thr float32 `default:"0.2"`
+
+	// Interval is the number of cycles between measurements of the
+	// fraction of neurons spiking, when using dense sending in
+	// SpikeSendAuto mode: the fraction is only measured in sparse mode.
+	Interval int `default:"10"`
+
+	// Frac is the fraction of neurons spiking on the last sparse cycle.
+	Frac float32 `edit:"-"`
+
+	// NSparse is the number of cycles that used sparse sending.
+	NSparse int `edit:"-"`
+
+	// NDense is the number of cycles that used dense sending.
+	NDense int `edit:"-"`
+
+	// spikes is the list of neuron * data indexes that spiked.
+	spikes []uint32
+
+	// nSpikes is the number of spikes in the list.
+	nSpikes int32
+
+	// sinceCheck is the number of dense cycles since the last measurement.
+	sinceCheck int
+}
+
+func (ss *SpikeSend) Defaults() {
+	ss.Thr = 0.2
+	ss.Interval = 10
+}
+
+// ResetStats resets the NSparse and NDense counts.
+func (ss *SpikeSend) ResetStats() {
+	ss.NSparse = 0
+	ss.NDense = 0
+}
+
+// UseSparse returns true if sparse sending should be used on this cycle,
+// according to the Mode.
+func (ss *SpikeSend) UseSparse() bool {
+	switch ss.Mode {
+	case SpikeSendSparse:
+		return true
+	case SpikeSendDense:
+		return false
+	}
+	if ss.Frac < ss.Thr {
+		return true
+	}
+	ss.sinceCheck++
+	if ss.sinceCheck >= ss.Interval {
+		ss.sinceCheck = 0
+		return true
+	}
+	return false
+}
+
+// SparseSend returns true if this pathway only needs to be visited
+// for neurons that spiked, and can thus use sparse sending (see [SpikeSend]).
+// Pathways with short-term plasticity and [CTCtxtPath] update or send
+// for every neuron.
+func (pt *PathParams) SparseSend() bool {
+	return pt.Type != CTCtxtPath && pt.STP.On.IsFalse()
+}
+
+// SendSpikes sends spikes for all neurons, for nd = neurons * data,
+// using either the dense [SendSpike] kernel or sparse event-driven
+// sending on the CPU, as determined by [SpikeSend].
+func (nt *Network) SendSpikes(nd int) {
+	if UseGPU {
+		RunKernel("PostSpikeNeuron", nd, RunPostSpikeNeuronGPU, PostSpikeNeuron)
+		RunKernel("SendSpike", nd, RunSendSpikeGPU, SendSpike)
+		return
+	}
+	nt.FunTimerStart("SendSpike")
+	defer nt.FunTimerStop("SendSpike")
+	ss := &nt.SpikeSend
+	if !ss.UseSparse() {
+		ss.NDense++
+		RunKernel("PostSpikeNeuron", nd, RunPostSpikeNeuronGPU, PostSpikeNeuron)
+		RunKernel("SendSpike", nd, RunSendSpikeGPU, SendSpike)
+		return
+	}
+	ss.NSparse++
+	if len(ss.spikes) < nd {
+		ss.spikes = make([]uint32, nd)
+	}
+	ss.nSpikes = 0
+	VectorizeFunc("SendSpikePost", nd, ss.postSpike)
+	n := int(ss.nSpikes)
+	ss.Frac = float32(n) / float32(nd)
+	if n > 0 {
+		VectorizeFunc("SendSpikeSparse", n, ss.sendSparse)
+	}
+}
+
+// postSpike is the first pass of sparse sending, for given neuron * data
+// index, which does PostSpike, sends for pathways that are not
+// SparseSend, and records the neuron if it spiked.
+func (ss *SpikeSend) postSpike(i uint32) {
+	ctx := GetCtx(0)
+	ni := ctx.ItemIndex(i)
+	if ni >= NetworkIxs[0].NNeurons {
+		return
+	}
+	di := ctx.DataIndex(i)
+	ly := GetLayers(NeuronIxs.Value(int(ni), int(NrnLayIndex)))
+	pi := ly.PoolIndex(NeuronIxs.Value(int(ni), int(NrnSubPool)))
+	lpi := ly.PoolIndex(0)
+	lni := ni - ly.Indexes.NeurSt
+	ly.PostSpike(ctx, lpi, pi, ni, di)
+
+	for pti := uint32(0); pti < ly.Indexes.SendN; pti++ {
+		pt := GetPaths(ly.Indexes.SendSt + pti)
+		if !pt.SparseSend() {
+			pt.SendSpike(ctx, ni, di, lni)
+		}
+	}
+	if Neurons.Value(int(ni), int(di), int(Spike)) != 0 {
+		ss.spikes[atomic.AddInt32(&ss.nSpikes, 1)-1] = i
+	}
+}
+
+// sendSparse is the second pass of sparse sending, for given index
+// into the list of spiking neurons, which sends for SparseSend pathways.
+func (ss *SpikeSend) sendSparse(j uint32) {
+	i := ss.spikes[j]
+	ctx := GetCtx(0)
+	ni := ctx.ItemIndex(i)
+	di := ctx.DataIndex(i)
+	ly := GetLayers(NeuronIxs.Value(int(ni), int(NrnLayIndex)))
+	lni := ni - ly.Indexes.NeurSt
+	for pti := uint32(0); pti < ly.Indexes.SendN; pti++ {
+		pt := GetPaths(ly.Indexes.SendSt + pti)
+		if pt.SparseSend() {
+			pt.SendSpike(ctx, ni, di, lni)
+		}
+	}
+}
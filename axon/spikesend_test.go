@@ -0,0 +1,60 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package axon
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpikeSendUseSparse(t *testing.T) {
+	ss := &SpikeSend{}
+	ss.Defaults()
+	assert.True(t, ss.UseSparse())
+	ss.Frac = 0.5
+	for range ss.Interval - 1 {
+		assert.False(t, ss.UseSparse())
+	}
+	assert.True(t, ss.UseSparse()) // re-measure
+	ss.Mode = SpikeSendDense
+	ss.Frac = 0
+	assert.False(t, ss.UseSparse())
+	ss.Mode = SpikeSendSparse
+	ss.Frac = 1
+	assert.True(t, ss.UseSparse())
+}
+
+// runSpikeSendNet runs a network with given SpikeSend mode,
+// returning the resulting neuron state and weights.
+func runSpikeSendNet(t *testing.T, mode SpikeSendModes) (nrns, wts []float32, ss SpikeSend) {
+	net := newTestNetFull(2)
+	net.SpikeSend.Mode = mode
+	// STP paths are sent in the first pass
+	stp := net.LayerByName("Output").RecvPaths[0]
+	stp.Params.STP.On.SetBool(true)
+	assert.False(t, stp.Params.SparseSend())
+	net.UpdateParams()
+	net.InitWeights()
+	runTestTrials(net, 0, 4)
+	return slices.Clone(Neurons.Values), slices.Clone(Synapses.Values), net.SpikeSend
+}
+
+func TestSpikeSendIdentical(t *testing.T) {
+	dnrns, dwts, dss := runSpikeSendNet(t, SpikeSendDense)
+	assert.Equal(t, 0, dss.NSparse)
+	assert.Greater(t, dss.NDense, 0)
+
+	snrns, swts, sss := runSpikeSendNet(t, SpikeSendSparse)
+	assert.Equal(t, 0, sss.NDense)
+	assert.Greater(t, sss.NSparse, 0)
+	assert.Equal(t, dnrns, snrns)
+	assert.Equal(t, dwts, swts)
+
+	anrns, awts, _ := runSpikeSendNet(t, SpikeSendAuto)
+	assert.Equal(t, dnrns, anrns)
+	assert.Equal(t, dwts, awts)
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package axon
+
+import (
+	"testing"
+
+	"cogentcore.org/core/math32"
+	"github.com/emer/emergent/v2/etime"
+	"github.com/emer/emergent/v2/paths"
+	"github.com/stretchr/testify/assert"
+)
+
+// newSTDPTestNet returns the standard test network where the
+// Input to Hidden pathway uses given STDP rule, and returns that pathway.
+func newSTDPTestNet(rule STDPRules) (*Network, *Path) {
+	testNet := NewNetwork("testNetSTDP")
+	testNet.SetRandSeed(42)
+	testNet.SetMaxData(1)
+
+	inLay := testNet.AddLayer("Input", InputLayer, 4, 1)
+	hidLay := testNet.AddLayer("Hidden", SuperLayer, 4, 1)
+	outLay := testNet.AddLayer("Output", TargetLayer, 4, 1)
+
+	full := paths.NewFull()
+	pt := testNet.ConnectLayers(inLay, hidLay, full, ForwardPath)
+	pt.AddDefaultParams(func(pt *PathParams) {
+		pt.Learn.STDP.Rule = rule
+	})
+	testNet.ConnectLayers(hidLay, outLay, full, ForwardPath)
+	testNet.ConnectLayers(outLay, hidLay, full, BackPath)
+
+	testNet.Rubicon.SetNUSs(4, 3)
+	testNet.Rubicon.Defaults()
+
+	testNet.Build()
+	testNet.Defaults()
+	ApplyParamSheets(testNet, layerParams["Base"], pathParams["Base"])
+	testNet.InitWeights()
+	testNet.ThetaCycleStart(etime.Train, false)
+	return testNet, pt
+}
+
+// setSTDPSpikes sets the recorded spike times for given neuron.
+func setSTDPSpikes(ni uint32, times []float32) {
+	STDPSpikes.Set(float32(len(times)), int(ni), 0, 0)
+	for i, t := range times {
+		STDPSpikes.Set(t, int(ni), 0, 1+i)
+	}
+}
+
+// stdpProtocol is a kinasesim-style harness that imposes the given pre and
+// post spike times on the first synapse of the given pathway, starting from
+// zero traces, and returns the resulting STDP weight change, prior to the
+// learning rate and soft bounding.
+func stdpProtocol(net *Network, pt *Path, pre, post []float32, tEnd int32) float32 {
+	syni := pt.SynStIndex
+	si := SynapseIxs.Value(int(syni), int(SynSendIndex))
+	ri := SynapseIxs.Value(int(syni), int(SynRecvIndex))
+	setSTDPSpikes(si, pre)
+	setSTDPSpikes(ri, post)
+	sti := int(pt.Params.Indexes.STDPSt)
+	for v := range STDPVarsN {
+		SynapseSTDP.Set(0, sti, 0, int(v))
+	}
+	Synapses.Set(0.5, int(syni), int(LWt))
+	ctx := net.Context()
+	ctx.Cycle = tEnd
+	pt.Params.DWtSynSTDP(ctx, syni, si, ri, 0)
+	return SynapseTraces.Value(int(syni), 0, int(DiDWt)) / (0.5 * pt.Params.Learn.LRate.Eff)
+}
+
+func TestSTDPWindow(t *testing.T) {
+	net, pt := newSTDPTestNet(PairSTDP)
+	assert.Greater(t, net.NetIxs().NSTDPSyns, uint32(0))
+	sp := &pt.Params.Learn.STDP
+
+	// canonical STDP window: pre at 100, post at 100 + dt
+	var prev float32
+	for dt := -80; dt <= 80; dt += 5 {
+		dwt := stdpProtocol(net, pt, []float32{100}, []float32{float32(100 + dt)}, 200)
+		if dt >= 0 {
+			assert.Greater(t, dwt, float32(0))
+			assert.InDelta(t, sp.APlus*math32.Exp(-float32(dt)/sp.TauPlus), dwt, 1.0e-4)
+			if dt > 0 {
+				assert.Less(t, dwt, prev)
+			}
+		} else {
+			assert.Less(t, dwt, float32(0))
+			assert.InDelta(t, -sp.AMinus*math32.Exp(float32(dt)/sp.TauMinus), dwt, 1.0e-4)
+			if dt > -80 {
+				assert.Less(t, dwt, prev)
+			}
+		}
+		prev = dwt
+	}
+
+	// traces carry over to the next theta cycle
+	stdpProtocol(net, pt, []float32{190}, nil, 200)
+	sti := int(pt.Params.Indexes.STDPSt)
+	assert.InDelta(t, math32.Exp(-10/sp.TauPlus), SynapseSTDP.Value(sti, 0, int(STDPPre)), 1.0e-5)
+}
+
+func TestSTDPTriplet(t *testing.T) {
+	// per-pairing weight change for pre-post pairings at +10 cycles
+	// at given frequency in Hz (cycles = msec)
+	pairing := func(net *Network, pt *Path, hz float32) float32 {
+		n := 4
+		var pre, post []float32
+		for i := range n {
+			st := 10 + math32.Round(float32(i)*1000/hz)
+			pre = append(pre, st)
+			post = append(post, st+10)
+		}
+		return stdpProtocol(net, pt, pre, post, 300) / float32(n)
+	}
+
+	pnet, ppt := newSTDPTestNet(PairSTDP)
+	pair20 := pairing(pnet, ppt, 20)
+	pair50 := pairing(pnet, ppt, 50)
+	assert.Less(t, pair50, pair20) // pair rule: LTD from post-pre interactions
+
+	tnet, tpt := newSTDPTestNet(TripletSTDP)
+	trip20 := pairing(tnet, tpt, 20)
+	trip50 := pairing(tnet, tpt, 50)
+	assert.Greater(t, trip50, trip20) // triplet: more LTP at higher frequency
+	assert.Greater(t, trip50, pair50)
+
+	// a single pairing is the same under both rules
+	single := stdpProtocol(tnet, tpt, []float32{100}, []float32{110}, 200)
+	assert.InDelta(t, math32.Exp(-10/tpt.Params.Learn.STDP.TauPlus), single, 1.0e-4)
+}
+
+func TestSTDPLearn(t *testing.T) {
+	net, pt := newSTDPTestNet(PairSTDP)
+	assert.Equal(t, pt.NSyns, net.NetIxs().NSTDPSyns)
+	assert.Equal(t, int(net.NetIxs().NNeurons), STDPSpikes.DimSize(0))
+	wt0 := Synapses.Value(int(pt.SynStIndex), int(Wt))
+
+	runTestTrials(net, 0, 2)
+	inLay := net.LayerByName("Input")
+	nspk := float32(0)
+	for ni := range inLay.NNeurons {
+		nspk += STDPSpikes.Value(int(inLay.NeurStIndex+ni), 0, 0)
+	}
+	assert.Greater(t, nspk, float32(0))
+	nonzero := false
+	for syi := range pt.NSyns {
+		if SynapseSTDP.Value(int(syi), 0, int(STDPPre)) > 0 {
+			nonzero = true
+		}
+		// the triplet traces are not used in pair mode
+		assert.Equal(t, float32(0), SynapseSTDP.Value(int(syi), 0, int(STDPPre2)))
+		assert.Equal(t, float32(0), SynapseSTDP.Value(int(syi), 0, int(STDPPost2)))
+	}
+	assert.True(t, nonzero)
+	changed := false
+	for syi := range pt.NSyns {
+		if Synapses.Value(int(pt.SynStIndex+syi), int(Wt)) != wt0 {
+			changed = true
+		}
+	}
+	assert.True(t, changed)
+
+	// no STDP state is allocated when not used
+	nnet := newTestNet(1)
+	assert.Equal(t, uint32(0), nnet.NetIxs().NSTDPSyns)
+	assert.Equal(t, 1, STDPSpikes.DimSize(0))
+}
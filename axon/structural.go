@@ -0,0 +1,444 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package axon
+
+import (
+	"fmt"
+	"log"
+	"slices"
+
+	"cogentcore.org/core/math32"
+	"cogentcore.org/lab/base/randx"
+	"cogentcore.org/lab/gosl/slbool"
+	"cogentcore.org/lab/tensor"
+	"github.com/emer/emergent/v2/weights"
+)
+
+//gosl:start
+
+// RegrowModes are the rules for selecting the sending neuron for a new
+// synapse that replaces a pruned one, in [StructPlastParams].
+type RegrowModes int32 //enums:enum
+
+const (
+	// RegrowRandom selects the sending neuron uniformly at random from
+	// among those not already connected to the receiving neuron.
+	RegrowRandom RegrowModes = iota
+
+	// RegrowCorrel selects the sending neuron with a probability
+	// proportional to its co-activity with the receiving neuron,
+	// as the product of their CaD values summed over data parallel
+	// items, from among those not already connected. If there is no
+	// co-activity, it reverts to RegrowRandom.
+	RegrowCorrel
+)
+
+// StructPlastParams are structural plasticity parameters, for pruning
+// synapses whose weights remain weak, and regrowing new synapses to
+// replace them. This is performed on the CPU at the SlowInterval,
+// after SlowAdapt, by [Network.StructPlast]. The SWt values change only at
+// the SlowInterval, so a weak SWt reflects a persistently weak synapse.
+// The number of synapses on each receiving neuron is conserved, so that
+// the memory layout, including the GPU buffers, does not change:
+// each pruned synapse is replaced by a new synapse from a different
+// sending neuron, initialized according to the SWts.Init parameters.
+type StructPlastParams struct {
+
+	// On enables structural plasticity for this pathway.
+	On slbool.Bool
+
+	// Regrow is the rule for selecting the sending neuron of new synapses.
+	Regrow RegrowModes
+
+	// WtThr is the threshold on the effective Wt weight value below which
+	// a synapse is a candidate for pruning, if SWt is also below SWtThr.
+	WtThr float32 `default:"0.1"`
+
+	// SWtThr is the threshold on the slowly adapting structural SWt value
+	// below which a synapse is a candidate for pruning, if Wt is also below
+	// WtThr. The SWt values are limited by SWts.Limit, so this must be above
+	// the Limit.Min value to have any effect.
+	SWtThr float32 `default:"0.3"`
+
+	// MaxFrac is the maximum proportion of synapses in the pathway that are
+	// pruned in each update, taking the weakest synapses first.
+	MaxFrac float32 `default:"0.02" min:"0" max:"1"`
+
+	pad, pad1, pad2 float32
+}
+
+func (sp *StructPlastParams) Defaults() {
+	sp.Regrow = RegrowRandom
+	sp.WtThr = 0.1
+	sp.SWtThr = 0.3
+	sp.MaxFrac = 0.02
+}
+
+func (sp *StructPlastParams) Update() {
+}
+
+func (sp *StructPlastParams) ShouldDisplay(field string) bool {
+	switch field {
+	case "On":
+		return true
+	default:
+		return sp.On.IsTrue()
+	}
+}
+
+//gosl:end
+
+// synCon is a connection from given sending neuron index within the
+// sending layer, with the prior path-relative synapse index, or -1
+// for a new synapse.
+type synCon struct {
+	si  uint32
+	syi int32
+}
+
+// StructPlast performs structural plasticity for all pathways with
+// StructPlast.On, pruning weak synapses and regrowing new ones,
+// as described in [StructPlastParams], and updating the global
+// synapse index values. The random numbers come from [Context.RandCPU],
+// so that runs can be resumed exactly from a checkpoint.
+// The synapse state and Context must be current on the CPU,
+// and the caller must copy the indexes, synapses and Context to the GPU.
+// Returns the total number of synapses pruned (and regrown) across
+// all pathways, with per-path counts in [Path.StructPruned].
+func (nt *Network) StructPlast() int {
+	if !nt.structPlastOn() {
+		return 0
+	}
+	ctx := nt.Context()
+	rnd := ctx.RandCPU(RandFunStructPlast)
+	n := 0
+	for _, pt := range nt.Paths {
+		n += pt.StructPlast(ctx, rnd)
+	}
+	return n
+}
+
+// StructPlast performs structural plasticity for this pathway,
+// if StructPlast.On, returning the number of synapses pruned and
+// replaced by new synapses, which is also accumulated in StructPruned.
+// StructWeak is set to the number of synapses below the pruning thresholds.
+// The random numbers for regrowth come from given source.
+func (pt *Path) StructPlast(ctx *Context, rnd randx.Rand) int {
+	sp := &pt.Params.StructPlast
+	if pt.Off || sp.On.IsFalse() || pt.NSyns == 0 {
+		return 0
+	}
+	slay := pt.Send
+	rlay := pt.Recv
+	self := slay == rlay
+	slen := int(slay.NNeurons)
+	rcons := pt.recvSynCons()
+
+	type weakSyn struct {
+		ri, ci int
+		wt     float32
+	}
+	var weak []weakSyn
+	for ri, rc := range rcons {
+		for ci, sc := range rc {
+			syni := int(pt.SynStIndex) + int(sc.syi)
+			wt := Synapses.Value(syni, int(Wt))
+			if wt < sp.WtThr && Synapses.Value(syni, int(SWt)) < sp.SWtThr {
+				weak = append(weak, weakSyn{ri: ri, ci: ci, wt: wt})
+			}
+		}
+	}
+	pt.StructWeak = len(weak)
+	if len(weak) == 0 {
+		return 0
+	}
+	slices.SortStableFunc(weak, func(a, b weakSyn) int {
+		switch {
+		case a.wt < b.wt:
+			return -1
+		case a.wt > b.wt:
+			return 1
+		}
+		return 0
+	})
+	nmax := int(math32.Ceil(sp.MaxFrac * float32(pt.NSyns)))
+	weak = weak[:min(len(weak), nmax)]
+
+	// connected records all sending neurons connected to each receiver
+	// prior to or during this update, which are excluded from regrowth.
+	connected := make(map[int][]bool)
+	cands := make([]uint32, 0, slen)
+	wts := make([]float32, 0, slen)
+	pruned := 0
+	for _, ws := range weak {
+		conn, ok := connected[ws.ri]
+		if !ok {
+			conn = make([]bool, slen)
+			for _, sc := range rcons[ws.ri] {
+				conn[sc.si] = true
+			}
+			if self {
+				conn[ws.ri] = true
+			}
+			connected[ws.ri] = conn
+		}
+		cands = cands[:0]
+		for si := range slen {
+			if !conn[si] {
+				cands = append(cands, uint32(si))
+			}
+		}
+		if len(cands) == 0 {
+			continue
+		}
+		si := cands[rnd.Intn(len(cands))]
+		if sp.Regrow == RegrowCorrel {
+			wts = pt.coActivity(ctx, cands, uint32(ws.ri), wts[:0])
+			if csi, ok := sampleWeighted(rnd, cands, wts); ok {
+				si = csi
+			}
+		}
+		conn[si] = true
+		rcons[ws.ri][ws.ci] = synCon{si: si, syi: -1}
+		pruned++
+	}
+	if pruned == 0 {
+		return 0
+	}
+	for ri := range connected {
+		slices.SortFunc(rcons[ri], func(a, b synCon) int {
+			return int(a.si) - int(b.si)
+		})
+	}
+	pt.rewire(ctx, rnd, rcons)
+	pt.StructPruned += pruned
+	return pruned
+}
+
+// recvSynCons returns the current connections for each receiving neuron.
+func (pt *Path) recvSynCons() [][]synCon {
+	nr := int(pt.Recv.NNeurons)
+	rcons := make([][]synCon, nr)
+	for ri := range nr {
+		if ri >= len(pt.RecvCon) {
+			continue
+		}
+		rcon := pt.RecvCon[ri]
+		rc := make([]synCon, rcon.N)
+		for ci := range rcon.N {
+			rc[ci] = synCon{si: pt.RecvConIndex[rcon.Start+ci], syi: int32(pt.RecvSynIndex[rcon.Start+ci])}
+		}
+		rcons[ri] = rc
+	}
+	return rcons
+}
+
+// coActivity appends to wts the co-activity of each of the candidate
+// sending neurons with given receiving neuron, as the product of their
+// CaD values summed over data parallel items.
+func (pt *Path) coActivity(ctx *Context, cands []uint32, ri uint32, wts []float32) []float32 {
+	rni := int(pt.Recv.NeurStIndex + ri)
+	for _, si := range cands {
+		sni := int(pt.Send.NeurStIndex + si)
+		w := float32(0)
+		for di := range int(ctx.NData) {
+			w += Neurons.Value(sni, di, int(CaD)) * Neurons.Value(rni, di, int(CaD))
+		}
+		wts = append(wts, w)
+	}
+	return wts
+}
+
+// sampleWeighted returns one of the given candidates with probability
+// proportional to the corresponding weight, returning false if the
+// weights sum to 0.
+func sampleWeighted(rnd randx.Rand, cands []uint32, wts []float32) (uint32, bool) {
+	sum := float32(0)
+	for _, w := range wts {
+		sum += w
+	}
+	if sum <= 0 {
+		return 0, false
+	}
+	r := rnd.Float32() * sum
+	for i, w := range wts {
+		r -= w
+		if r < 0 {
+			return cands[i], true
+		}
+	}
+	return cands[len(cands)-1], true
+}
+
+// sameCons returns true if the connectivity in given weights
+// matches the current connectivity of this pathway.
+func (pt *Path) sameCons(pw *weights.Path) bool {
+	for i := range pw.Rs {
+		pr := &pw.Rs[i]
+		syIndexes := pt.RecvSynIxs(uint32(pr.Ri))
+		if len(syIndexes) != len(pr.Si) {
+			return false
+		}
+		for ci, si := range pr.Si {
+			if pt.RecvConIndex[pt.RecvCon[pr.Ri].Start+uint32(ci)] != uint32(si) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// setConsFromWeights rewires this pathway to have the connectivity in given
+// weights, for receiving neurons present in the weights, which must have the
+// same total number of synapses. Synapses that exist in both retain their
+// current state, and new ones are initialized, prior to setting the weights.
+func (pt *Path) setConsFromWeights(pw *weights.Path) error {
+	rcons := pt.recvSynCons()
+	slen := int(pt.Send.NNeurons)
+	for i := range pw.Rs {
+		pr := &pw.Rs[i]
+		if pr.Ri < 0 || pr.Ri >= len(rcons) {
+			return fmt.Errorf("Path.SetWeights: %s: receiving neuron index %d out of range", pt.Name, pr.Ri)
+		}
+		cur := make(map[uint32]int32, len(rcons[pr.Ri]))
+		for _, sc := range rcons[pr.Ri] {
+			cur[sc.si] = sc.syi
+		}
+		rc := make([]synCon, len(pr.Si))
+		for ci, si := range pr.Si {
+			if si < 0 || si >= slen {
+				return fmt.Errorf("Path.SetWeights: %s: sending neuron index %d out of range", pt.Name, si)
+			}
+			syi, ok := cur[uint32(si)]
+			if !ok {
+				syi = -1
+			}
+			rc[ci] = synCon{si: uint32(si), syi: syi}
+		}
+		slices.SortFunc(rc, func(a, b synCon) int {
+			return int(a.si) - int(b.si)
+		})
+		rcons[pr.Ri] = rc
+	}
+	nsyn := 0
+	for _, rc := range rcons {
+		nsyn += len(rc)
+	}
+	if nsyn != int(pt.NSyns) {
+		return fmt.Errorf("Path.SetWeights: %s: number of synapses in weights: %d != number in pathway: %d", pt.Name, nsyn, pt.NSyns)
+	}
+	pt.rewire(pt.Recv.Network.Context(), pt.Recv.Network.Rand, rcons)
+	return nil
+}
+
+// rewire rebuilds the connectivity of this pathway in place, from given
+// connections for each receiving neuron, sorted by sending neuron index,
+// where the synapse index in each connection is the prior path-relative
+// synapse index, from which the synapse state is copied, or -1 for a new
+// synapse, which is initialized per InitWeights, using given random source.
+// The total number of synapses must be the same as before.
+// Updates the CPU-side connectivity and the global synapse indexes,
+// which must then be copied to the GPU along with the synapses.
+func (pt *Path) rewire(ctx *Context, rnd randx.Rand, rcons [][]synCon) {
+	slay := pt.Send
+	slen := int(slay.NNeurons)
+	rlen := int(pt.Recv.NNeurons)
+	sendn := tensor.NewInt32(slen)
+	recvn := tensor.NewInt32(rlen)
+	for ri, rc := range rcons {
+		recvn.Values[ri] = int32(len(rc))
+		for _, sc := range rc {
+			sendn.Values[sc.si]++
+		}
+	}
+	tcons := pt.SetConStartN(&pt.SendCon, &pt.SendConNAvgMax, sendn)
+	tconr := pt.SetConStartN(&pt.RecvCon, &pt.RecvConNAvgMax, recvn)
+	if tcons != pt.NSyns || tconr != pt.NSyns {
+		log.Printf("%v programmer error: Rewire total send cons %v, recv cons %v != number of synapses %v\n", pt.String(), tcons, tconr, pt.NSyns)
+		return
+	}
+	nsyn := int(pt.NSyns)
+	synSt := int(pt.SynStIndex)
+	nvar := int(SynapseVarsN)
+	ntr := SynapseTraces.Len() / SynapseTraces.DimSize(0)
+	syns := slices.Clone(Synapses.Values[synSt*nvar : (synSt+nsyn)*nvar])
+	trs := slices.Clone(SynapseTraces.Values[synSt*ntr : (synSt+nsyn)*ntr])
+	stdp := pt.Params.Learn.STDP.On()
+	stdpSt := int(pt.Params.Indexes.STDPSt)
+	nstdp := SynapseSTDP.Len() / SynapseSTDP.DimSize(0)
+	var stdps []float32
+	if stdp {
+		stdps = slices.Clone(SynapseSTDP.Values[stdpSt*nstdp : (stdpSt+nsyn)*nstdp])
+	}
+	consol := pt.Params.Learn.Consol.On()
+	consSt := int(pt.Params.Indexes.ConsolSt)
+	ncons := int(ConsolVarsN)
+	var conss []float32
+	if consol {
+		conss = slices.Clone(SynapseConsol.Values[consSt*ncons : (consSt+nsyn)*ncons])
+	}
+
+	sconN := make([]uint32, slen)
+	var newSyns []uint32
+	for ri, rc := range rcons {
+		rcon := pt.RecvCon[ri]
+		for ci, sc := range rc {
+			scon := pt.SendCon[sc.si]
+			syi := scon.Start + sconN[sc.si]
+			sconN[sc.si]++
+			pt.RecvConIndex[rcon.Start+uint32(ci)] = sc.si
+			pt.RecvSynIndex[rcon.Start+uint32(ci)] = syi
+			pt.SendConIndex[syi] = uint32(ri)
+			syni := synSt + int(syi)
+			sti := stdpSt + int(syi)
+			csi := consSt + int(syi)
+			if sc.syi < 0 {
+				newSyns = append(newSyns, uint32(syni))
+				if stdp {
+					clear(SynapseSTDP.Values[sti*nstdp : (sti+1)*nstdp])
+				}
+				if consol {
+					clear(SynapseConsol.Values[csi*ncons : (csi+1)*ncons])
+				}
+				continue
+			}
+			osyi := int(sc.syi)
+			copy(Synapses.Values[syni*nvar:(syni+1)*nvar], syns[osyi*nvar:(osyi+1)*nvar])
+			copy(SynapseTraces.Values[syni*ntr:(syni+1)*ntr], trs[osyi*ntr:(osyi+1)*ntr])
+			if stdp {
+				copy(SynapseSTDP.Values[sti*nstdp:(sti+1)*nstdp], stdps[osyi*nstdp:(osyi+1)*nstdp])
+			}
+			if consol {
+				copy(SynapseConsol.Values[csi*ncons:(csi+1)*ncons], conss[osyi*ncons:(osyi+1)*ncons])
+			}
+		}
+	}
+	spct := pt.Params.SWts.Init.SPct
+	if pt.Recv.Params.IsTarget() {
+		spct = 0
+	}
+	for _, syni := range newSyns {
+		pt.InitWeightsSyn(ctx, syni, rnd, pt.Params.SWts.Init.Mean, spct)
+		for di := range uint32(SynapseTraces.DimSize(1)) {
+			pt.InitWeightsSynTrace(ctx, syni, di)
+		}
+		if consol { // new synapses are anchored at their initial weight, with no importance
+			csi := consSt + int(syni) - synSt
+			SynapseConsol.Set(Synapses.Value(int(syni), int(LWt)), csi, int(ConsolAnchor))
+		}
+	}
+	pt.SetSynapseIxs()
+	pt.SetRecvSynIxs()
+}
+
+// structPlastOn returns true if any pathway has StructPlast.On.
+func (nt *Network) structPlastOn() bool {
+	for _, pt := range nt.Paths {
+		if !pt.Off && pt.Params.StructPlast.On.IsTrue() {
+			return true
+		}
+	}
+	return false
+}
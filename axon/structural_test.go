@@ -0,0 +1,206 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package axon
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/emer/emergent/v2/etime"
+	"github.com/emer/emergent/v2/paths"
+	"github.com/stretchr/testify/assert"
+)
+
+// newStructTestNet returns a network with random partial connectivity
+// from Input to Hidden, with structural plasticity using given regrowth rule,
+// and also returns that pathway.
+func newStructTestNet(regrow RegrowModes) (*Network, *Path) {
+	testNet := NewNetwork("testNetStruct")
+	testNet.SetRandSeed(42)
+	testNet.SetMaxData(1)
+
+	inLay := testNet.AddLayer("Input", InputLayer, 4, 1)
+	hidLay := testNet.AddLayer("Hidden", SuperLayer, 8, 1)
+	outLay := testNet.AddLayer("Output", TargetLayer, 4, 1)
+
+	rnd := paths.NewUniformRand()
+	rnd.PCon = 0.5
+	full := paths.NewFull()
+	pt := testNet.ConnectLayers(inLay, hidLay, rnd, ForwardPath)
+	pt.AddDefaultParams(func(pt *PathParams) {
+		pt.StructPlast.On.SetBool(true)
+		pt.StructPlast.Regrow = regrow
+	})
+	testNet.ConnectLayers(hidLay, outLay, full, ForwardPath)
+	testNet.ConnectLayers(outLay, hidLay, full, BackPath)
+
+	testNet.Rubicon.SetNUSs(4, 3)
+	testNet.Rubicon.Defaults()
+
+	testNet.Build()
+	testNet.Defaults()
+	ApplyParamSheets(testNet, layerParams["Base"], pathParams["Base"])
+	testNet.InitWeights()
+	testNet.ThetaCycleStart(etime.Train, false)
+	return testNet, pt
+}
+
+// recvSenders returns the sending neuron indexes for each receiving neuron.
+func recvSenders(pt *Path) [][]uint32 {
+	rs := make([][]uint32, len(pt.RecvCon))
+	for ri, rcon := range pt.RecvCon {
+		rs[ri] = append([]uint32{}, pt.RecvConIndex[rcon.Start:rcon.Start+rcon.N]...)
+	}
+	return rs
+}
+
+// checkStructCons checks that the CPU and global connectivity of given
+// pathway is consistent.
+func checkStructCons(t *testing.T, pt *Path) {
+	idx := &pt.Params.Indexes
+	for si, scon := range pt.SendCon {
+		sci := int(idx.SendConSt) + si
+		assert.Equal(t, scon.Start, PathSendCon.Value(sci, int(StartOff)))
+		assert.Equal(t, scon.N, PathSendCon.Value(sci, int(Nitems)))
+		for syi := scon.Start; syi < scon.Start+scon.N; syi++ {
+			syni := int(pt.SynStIndex + syi)
+			assert.Equal(t, pt.Send.NeurStIndex+uint32(si), SynapseIxs.Value(syni, int(SynSendIndex)))
+			assert.Equal(t, pt.Recv.NeurStIndex+pt.SendConIndex[syi], SynapseIxs.Value(syni, int(SynRecvIndex)))
+		}
+	}
+	for ri, rcon := range pt.RecvCon {
+		rci := int(idx.RecvConSt) + ri
+		assert.Equal(t, rcon.Start, PathRecvCon.Value(rci, int(StartOff)))
+		assert.Equal(t, rcon.N, PathRecvCon.Value(rci, int(Nitems)))
+		sis := make(map[uint32]bool)
+		for ci := rcon.Start; ci < rcon.Start+rcon.N; ci++ {
+			si := pt.RecvConIndex[ci]
+			syi := pt.RecvSynIndex[ci]
+			assert.False(t, sis[si], "duplicate sender %d for receiver %d", si, ri)
+			sis[si] = true
+			assert.Equal(t, uint32(ri), pt.SendConIndex[syi])
+			assert.Equal(t, pt.SynStIndex+syi, RecvSynIxs.Value(int(idx.RecvSynSt+ci)))
+			scon := pt.SendCon[si]
+			assert.True(t, syi >= scon.Start && syi < scon.Start+scon.N)
+		}
+	}
+}
+
+func TestStructPlastPrune(t *testing.T) {
+	for _, regrow := range []RegrowModes{RegrowRandom, RegrowCorrel} {
+		net, pt := newStructTestNet(regrow)
+		pt.Params.StructPlast.MaxFrac = 1
+		nsyn := pt.NSyns
+		prior := recvSenders(pt)
+
+		// make the first synapse on each receiver weak
+		weak := make([]uint32, len(prior))
+		for ri := range pt.RecvCon {
+			syi := pt.RecvSynIxs(uint32(ri))[0]
+			syni := int(pt.SynStIndex + syi)
+			Synapses.Set(0.01, syni, int(Wt))
+			Synapses.Set(0.25, syni, int(SWt))
+			weak[ri] = prior[ri][0]
+		}
+		n := net.StructPlast()
+		assert.Equal(t, len(prior), n)
+		assert.Equal(t, n, pt.StructPruned)
+		assert.Equal(t, len(prior), pt.StructWeak)
+		assert.Equal(t, nsyn, pt.NSyns)
+		checkStructCons(t, pt)
+
+		post := recvSenders(pt)
+		for ri := range post {
+			assert.Equal(t, len(prior[ri]), len(post[ri]))
+			assert.NotContains(t, post[ri], weak[ri])
+			for _, si := range prior[ri][1:] {
+				assert.Contains(t, post[ri], si)
+			}
+		}
+		assert.Equal(t, 0, net.StructPlast()) // nothing weak now
+	}
+}
+
+func TestStructPlastSlowUpdate(t *testing.T) {
+	net, pt := newStructTestNet(RegrowRandom)
+	net.Context().SlowInterval = 1
+	pt.Params.StructPlast.WtThr = 1
+	pt.Params.StructPlast.SWtThr = 1
+	pt.Params.StructPlast.MaxFrac = 0.1
+	runTestTrials(net, 0, 4)
+	assert.Greater(t, pt.StructPruned, 0)
+	checkStructCons(t, pt)
+}
+
+func TestStructPlastWeights(t *testing.T) {
+	net, pt := newStructTestNet(RegrowRandom)
+	pt.Params.StructPlast.WtThr = 1
+	pt.Params.StructPlast.SWtThr = 1
+	pt.Params.StructPlast.MaxFrac = 0.5
+	assert.Greater(t, net.StructPlast(), 0)
+	cons := recvSenders(pt)
+	hash := net.WeightsHash()
+
+	var b bytes.Buffer
+	assert.NoError(t, net.WriteWeightsJSON(&b))
+	jsNet, jsPt := newStructTestNet(RegrowRandom)
+	assert.NotEqual(t, cons, recvSenders(jsPt))
+	assert.NoError(t, jsNet.ReadWeightsJSON(&b))
+	assert.Equal(t, cons, recvSenders(jsPt))
+	checkStructCons(t, jsPt)
+	for ri := range pt.RecvCon {
+		for ci, syi := range pt.RecvSynIxs(uint32(ri)) {
+			jsyi := jsPt.RecvSynIxs(uint32(ri))[ci]
+			assert.InDelta(t, net.Synapses.Value(int(pt.SynStIndex+syi), int(Wt)), jsNet.Synapses.Value(int(jsPt.SynStIndex+jsyi), int(Wt)), 1.0e-3)
+		}
+	}
+
+	b.Reset()
+	net.SetAsCurrent()
+	assert.NoError(t, net.WriteWeightsBinary(&b, false))
+	binNet, binPt := newStructTestNet(RegrowRandom)
+	assert.NoError(t, binNet.ReadWeightsBinary(&b))
+	assert.Equal(t, cons, recvSenders(binPt))
+	checkStructCons(t, binPt)
+	assert.Equal(t, hash, binNet.WeightsHash())
+
+	b.Reset()
+	net.SetAsCurrent()
+	assert.NoError(t, net.SaveCheckpoint(&b))
+	ckNet, ckPt := newStructTestNet(RegrowRandom)
+	assert.NoError(t, ckNet.LoadCheckpoint(&b))
+	assert.Equal(t, cons, recvSenders(ckPt))
+	checkStructCons(t, ckPt)
+	assert.Equal(t, hash, ckNet.WeightsHash())
+}
+
+func TestStructPlastResume(t *testing.T) {
+	for _, regrow := range []RegrowModes{RegrowRandom, RegrowCorrel} {
+		net, pt := newStructTestNet(regrow)
+		net.Context().SlowInterval = 1
+		pt.Params.StructPlast.WtThr = 1
+		pt.Params.StructPlast.SWtThr = 1
+		pt.Params.StructPlast.MaxFrac = 0.1
+		runTestTrials(net, 0, 2)
+
+		var b bytes.Buffer
+		assert.NoError(t, net.SaveCheckpoint(&b))
+		runTestTrials(net, 2, 4)
+		cons := recvSenders(pt)
+		hash := net.WeightsHash()
+
+		resNet, resPt := newStructTestNet(regrow)
+		resNet.Context().SlowInterval = 1
+		resPt.Params.StructPlast.WtThr = 1
+		resPt.Params.StructPlast.SWtThr = 1
+		resPt.Params.StructPlast.MaxFrac = 0.1
+		resNet.SetRandSeed(7) // regrowth does not depend on the Network Rand
+		assert.NoError(t, resNet.LoadCheckpoint(&b))
+		runTestTrials(resNet, 2, 4)
+		assert.Equal(t, cons, recvSenders(resPt))
+		checkStructCons(t, resPt)
+		assert.Equal(t, hash, resNet.WeightsHash())
+	}
+}
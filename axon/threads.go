@@ -17,7 +17,9 @@ import (
 
 // SetNThreads sets number of threads to use for CPU parallel processing.
 // pass 0 to use a default heuristic number based on current GOMAXPROCS
-// processors and the number of neurons in the network (call after building)
+// processors and the number of neurons in the network (call after building).
+// This sets the number of workers in the [CPUPool], which records
+// load imbalance stats if RecFunTimes is on.
 func (nt *Network) SetNThreads(nthr int) {
 	md := nt.NetIxs().MaxData
 	maxProcs := runtime.GOMAXPROCS(0) // query GOMAXPROCS
@@ -30,12 +32,15 @@ func (nt *Network) SetNThreads(nthr int) {
 	}
 	nt.NThreads = min(maxProcs, nthr)
 	gpu.NumThreads = nt.NThreads
+	CPUPool.SetNWorkers(nt.NThreads)
+	CPUPool.RecFunTimes = &nt.RecFunTimes
 }
 
 //////////////////////////////////////////////////////////////
 // Timing reports
 
-// TimerReport reports the amount of time spent in each function, and in each thread
+// TimerReport reports the amount of time spent in each function, and in each thread,
+// including the load imbalance stats for each CPU kernel in the [CPUPool].
 func (nt *Network) TimerReport() {
 	fmt.Printf("TimerReport: %v  %d threads\n", nt.Name, nt.NThreads)
 	fmt.Printf("\t%13s \t%7s\t%7s\n", "Function Name", "Secs", "Pct")
@@ -57,10 +62,21 @@ func (nt *Network) TimerReport() {
 		fmt.Printf("\t%13s \t%7.3f\t%7.1f\n", fn, pcts[i], 100*(pcts[i]/tot))
 	}
 	fmt.Printf("\t%13s \t%7.3f\n", "Total", tot)
+	ss := &nt.SpikeSend
+	if ncyc := ss.NSparse + ss.NDense; ncyc > 0 {
+		fmt.Printf("SendSpike: %s mode, %d of %d cycles sparse, last spiking fraction: %.3f\n", ss.Mode.String(), ss.NSparse, ncyc, ss.Frac)
+	}
+	if !UseGPU && len(CPUPool.Stats) > 0 {
+		fmt.Print(CPUPool.StatsReport())
+	}
 }
 
-// FunTimerStart starts function timer for given function name -- ensures creation of timer
+// FunTimerStart starts function timer for given function name -- ensures creation of timer.
+// Also records the start of the function in the Trace if On.
 func (nt *Network) FunTimerStart(fun string) {
+	if nt.Trace.On {
+		nt.Trace.Begin(TraceFunc, fun, "", 0)
+	}
 	if !nt.RecFunTimes {
 		return
 	}
@@ -72,8 +88,12 @@ func (nt *Network) FunTimerStart(fun string) {
 	ft.Start()
 }
 
-// FunTimerStop stops function timer -- timer must already exist
+// FunTimerStop stops function timer -- timer must already exist.
+// Also records the end of the function in the Trace if On.
 func (nt *Network) FunTimerStop(fun string) {
+	if nt.Trace.On {
+		nt.Trace.EndName(fun)
+	}
 	if !nt.RecFunTimes {
 		return
 	}
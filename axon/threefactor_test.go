@@ -0,0 +1,119 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package axon
+
+import (
+	"testing"
+
+	"cogentcore.org/core/math32"
+	"github.com/emer/emergent/v2/etime"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestThreeFactorParams(t *testing.T) {
+	tf := &ThreeFactorParams{}
+	tf.Defaults()
+	assert.False(t, tf.On())
+	assert.Equal(t, GvDA, tf.Global)
+	assert.Equal(t, int32(-1), tf.ModLayIndex)
+	assert.Equal(t, float32(1.25), tf.Trace(0.5, 1))
+	tf.TraceDecay = 0
+	assert.Equal(t, float32(1), tf.Trace(0.5, 1))
+	tf.Rule = PerturbThreeFactor
+	assert.True(t, tf.On())
+}
+
+// runTestTrialsMod runs trials as in runTestTrials, setting the DA
+// and HasRew global values prior to learning.
+func runTestTrialsMod(net *Network, nTrials int, da float32, hasRew bool) {
+	inPats := newInPats()
+	inLay := net.LayerByName("Input")
+	outLay := net.LayerByName("Output")
+	for trl := range nTrials {
+		pi := trl % 4
+		net.ThetaCycleStart(etime.Train, false)
+		net.MinusPhaseStart()
+		net.InitExt()
+		inLay.ApplyExt(0, inPats.SubSpace(pi))
+		outLay.ApplyExt(0, inPats.SubSpace(pi))
+		net.ApplyExts()
+		for cyc := range 200 {
+			net.Cycle(false)
+			if cyc == 149 {
+				net.MinusPhaseEnd()
+				net.PlusPhaseStart()
+			}
+		}
+		net.PlusPhaseEnd()
+		GlobalScalars.Set(da, int(GvDA), 0)
+		GlobalSetRew(0, 1, hasRew)
+		net.DWtToWt()
+	}
+}
+
+func TestThreeFactorDWt(t *testing.T) {
+	newNet := func(rule ThreeFactorRules, delayed bool) (*Network, *Path) {
+		net := newTestNet(1)
+		pt := net.LayerByName("Hidden").RecvPaths[0]
+		pt.Params.Learn.ThreeFactor.Rule = rule
+		pt.Params.Learn.ThreeFactor.Delayed.SetBool(delayed)
+		net.UpdateParams()
+		net.InitWeights()
+		return net, pt
+	}
+	lwts := func(pt *Path) []float32 {
+		var vals []float32
+		pt.SynValues(&vals, "LWt")
+		return vals
+	}
+	change := func(pt *Path, prv []float32) float32 {
+		d := float32(0)
+		for i, v := range lwts(pt) {
+			d += math32.Abs(v - prv[i])
+		}
+		return d
+	}
+	trSum := func(pt *Path) float32 {
+		s := float32(0)
+		for syi := range pt.NSyns {
+			s += math32.Abs(SynapseTraces.Value(int(pt.SynStIndex+syi), 0, int(Tr)))
+		}
+		return s
+	}
+
+	for _, rule := range []ThreeFactorRules{HebbThreeFactor, PerturbThreeFactor} {
+		// no modulator = no learning
+		net, pt := newNet(rule, false)
+		prv := lwts(pt)
+		runTestTrialsMod(net, 4, 0, false)
+		assert.Equal(t, float32(0), change(pt, prv))
+		assert.Greater(t, trSum(pt), float32(0))
+
+		runTestTrialsMod(net, 4, 1, false)
+		assert.Greater(t, change(pt, prv), float32(0))
+
+		// delayed only learns on reward trials, and then resets trace
+		net, pt = newNet(rule, true)
+		prv = lwts(pt)
+		runTestTrialsMod(net, 3, 1, false)
+		assert.Equal(t, float32(0), change(pt, prv))
+		assert.Greater(t, trSum(pt), float32(0))
+		runTestTrialsMod(net, 1, 1, true)
+		assert.Greater(t, change(pt, prv), float32(0))
+		assert.Equal(t, float32(0), trSum(pt))
+	}
+
+	// layer modulator persists through Defaults
+	net := newTestNet(1)
+	pt := net.LayerByName("Hidden").RecvPaths[0]
+	out := net.LayerByName("Output")
+	pt.SetThreeFactorLayer(out)
+	net.Defaults()
+	assert.Equal(t, ThreeFactorLayer, pt.Params.Learn.ThreeFactor.Mod)
+	assert.Equal(t, int32(out.Index), pt.Params.Learn.ThreeFactor.ModLayIndex)
+	LayerStates.Set(0.3, int(out.Index), 0, int(LayerPhaseDiffAvg))
+	LayerStates.Set(0.1, int(out.Index), 0, int(LayerPhaseDiff))
+	assert.InDelta(t, 0.2, pt.Params.Learn.ThreeFactor.ModValue(pt.Params.Indexes.RecvLayer, 0), 1.0e-6)
+}
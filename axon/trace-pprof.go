@@ -0,0 +1,252 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package axon
+
+import (
+	"compress/gzip"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// WritePprof writes the per-layer kernel work as a gzipped pprof profile,
+// which can be viewed with `go tool pprof`. Each sample has a stack
+// of the enclosing looper levels and function timers, the kernel, and
+// the layer, with labels for the kernel, layer, worker and looper mode,
+// and values for the number of events and the time spent by the workers.
+// Thus, the total time is the CPU time across workers, and `-tagfocus`
+// can be used to select particular kernels or layers.
+func (tr *Tracer) WritePprof(w io.Writer) error {
+	pb := &pprofBuilder{strings: map[string]int64{"": 0}, strs: []string{""}, locs: map[string]uint64{}, samples: map[string]*pprofSample{}}
+
+	// stacks of the enclosing loop and function events for each kernel invocation
+	kstacks := map[int][]string{}
+	kmodes := map[int]string{}
+	var main []TraceEvent
+	if len(tr.threads) > 0 {
+		main = tr.threads[0]
+	}
+	var open []*TraceEvent
+	for i := range main {
+		ev := &main[i]
+		for len(open) > 0 && open[len(open)-1].End() <= ev.Start {
+			open = open[:len(open)-1]
+		}
+		if ev.Cat == TraceKernel {
+			stack := make([]string, len(open))
+			mode := ""
+			for j, oe := range open {
+				stack[j] = oe.Name
+				if oe.Cat == TraceLoop {
+					stack[j] = oe.Mode + " " + oe.Name
+					mode = oe.Mode
+				}
+			}
+			kstacks[ev.inv] = stack
+			kmodes[ev.inv] = mode
+			continue
+		}
+		open = append(open, ev)
+	}
+
+	for t := 1; t < len(tr.threads); t++ {
+		for i := range tr.threads[t] {
+			ev := &tr.threads[t][i]
+			lnm := tr.LayerName(ev.Layer)
+			stack := slices.Concat(kstacks[ev.inv], []string{ev.Name})
+			if lnm != "" {
+				stack = append(stack, lnm)
+			}
+			pb.addSample(stack, ev.Name, lnm, kmodes[ev.inv], int64(t-1), ev.Dur)
+		}
+	}
+
+	gz := gzip.NewWriter(w)
+	if _, err := gz.Write(pb.encode(tr.start, tr.now())); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// pprofSample is an aggregated sample in a pprof profile.
+type pprofSample struct {
+	locs   []uint64
+	labels [][2]int64
+	worker int64
+	count  int64
+	time   int64
+}
+
+// pprofBuilder builds a pprof profile, as defined in
+// https://github.com/google/pprof/blob/main/proto/profile.proto,
+// using the minimal protobuf encoding needed for it.
+type pprofBuilder struct {
+	strings map[string]int64
+	strs    []string
+	locs    map[string]uint64
+	locList []string
+	samples map[string]*pprofSample
+	order   []string
+}
+
+// str returns the string table index for s.
+func (pb *pprofBuilder) str(s string) int64 {
+	if i, ok := pb.strings[s]; ok {
+		return i
+	}
+	i := int64(len(pb.strs))
+	pb.strs = append(pb.strs, s)
+	pb.strings[s] = i
+	return i
+}
+
+// loc returns the location id for the function name.
+func (pb *pprofBuilder) loc(name string) uint64 {
+	if id, ok := pb.locs[name]; ok {
+		return id
+	}
+	pb.locList = append(pb.locList, name)
+	id := uint64(len(pb.locList))
+	pb.locs[name] = id
+	return id
+}
+
+// addSample adds the time for given stack (root first) and labels,
+// aggregating with the same stack and labels.
+func (pb *pprofBuilder) addSample(stack []string, kernel, layer, mode string, worker int64, dur time.Duration) {
+	key := strings.Join(stack, ";") + "|" + mode + "|" + strconv.FormatInt(worker, 10)
+	sm, ok := pb.samples[key]
+	if !ok {
+		sm = &pprofSample{worker: worker}
+		for i := len(stack) - 1; i >= 0; i-- { // leaf first
+			sm.locs = append(sm.locs, pb.loc(stack[i]))
+		}
+		for _, kv := range [][2]string{{"kernel", kernel}, {"layer", layer}, {"mode", mode}} {
+			if kv[1] != "" {
+				sm.labels = append(sm.labels, [2]int64{pb.str(kv[0]), pb.str(kv[1])})
+			}
+		}
+		pb.samples[key] = sm
+		pb.order = append(pb.order, key)
+	}
+	sm.count++
+	sm.time += int64(dur)
+}
+
+// encode returns the encoded profile.
+func (pb *pprofBuilder) encode(start time.Time, dur time.Duration) []byte {
+	var p protoBuf
+	valueType := func(field int, typ, unit string) {
+		var vt protoBuf
+		vt.int64Field(1, pb.str(typ))
+		vt.int64Field(2, pb.str(unit))
+		p.bytesField(field, vt.b)
+	}
+	valueType(1, "events", "count")
+	valueType(1, "time", "nanoseconds")
+	workerKey := pb.str("worker")
+	for _, key := range pb.order {
+		sm := pb.samples[key]
+		var sp protoBuf
+		sp.packedUint64(1, sm.locs)
+		sp.packedInt64(2, []int64{sm.count, sm.time})
+		for _, lb := range sm.labels {
+			var lp protoBuf
+			lp.int64Field(1, lb[0])
+			lp.int64Field(2, lb[1])
+			sp.bytesField(3, lp.b)
+		}
+		var lp protoBuf
+		lp.int64Field(1, workerKey)
+		lp.tag(3, 0) // always include, as worker 0 is the default value
+		lp.varint(uint64(sm.worker))
+		sp.bytesField(3, lp.b)
+		p.bytesField(2, sp.b)
+	}
+	for i, name := range pb.locList {
+		id := uint64(i + 1)
+		var ln protoBuf
+		ln.uint64Field(1, id)
+		var lc protoBuf
+		lc.uint64Field(1, id)
+		lc.bytesField(4, ln.b)
+		p.bytesField(4, lc.b)
+		var fn protoBuf
+		fn.uint64Field(1, id)
+		fn.int64Field(2, pb.str(name))
+		fn.int64Field(3, pb.str(name))
+		p.bytesField(5, fn.b)
+	}
+	nsTyp := pb.str("time")
+	nsUnit := pb.str("nanoseconds")
+	for _, s := range pb.strs { // all strings must be added before this
+		p.bytesField(6, []byte(s))
+	}
+	p.int64Field(9, start.UnixNano())
+	p.int64Field(10, int64(dur))
+	var pt protoBuf
+	pt.int64Field(1, nsTyp)
+	pt.int64Field(2, nsUnit)
+	p.bytesField(11, pt.b)
+	p.int64Field(14, nsTyp)
+	return p.b
+}
+
+// protoBuf is a minimal protocol buffer encoder.
+type protoBuf struct {
+	b []byte
+}
+
+func (p *protoBuf) varint(x uint64) {
+	for x >= 0x80 {
+		p.b = append(p.b, byte(x)|0x80)
+		x >>= 7
+	}
+	p.b = append(p.b, byte(x))
+}
+
+func (p *protoBuf) tag(field, wire int) {
+	p.varint(uint64(field)<<3 | uint64(wire))
+}
+
+func (p *protoBuf) uint64Field(field int, x uint64) {
+	if x == 0 {
+		return
+	}
+	p.tag(field, 0)
+	p.varint(x)
+}
+
+func (p *protoBuf) int64Field(field int, x int64) {
+	if x == 0 {
+		return
+	}
+	p.tag(field, 0)
+	p.varint(uint64(x))
+}
+
+func (p *protoBuf) bytesField(field int, b []byte) {
+	p.tag(field, 2)
+	p.varint(uint64(len(b)))
+	p.b = append(p.b, b...)
+}
+
+func (p *protoBuf) packedUint64(field int, xs []uint64) {
+	var pk protoBuf
+	for _, x := range xs {
+		pk.varint(x)
+	}
+	p.bytesField(field, pk.b)
+}
+
+func (p *protoBuf) packedInt64(field int, xs []int64) {
+	var pk protoBuf
+	for _, x := range xs {
+		pk.varint(uint64(x))
+	}
+	p.bytesField(field, pk.b)
+}
@@ -0,0 +1,424 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package axon
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"cogentcore.org/core/enums"
+	"github.com/emer/emergent/v2/looper"
+)
+
+// TraceCats are the categories of events in a [Tracer] timeline.
+type TraceCats int32 //enums:enum
+
+const (
+	// TraceLoop is an iteration of a looper level, e.g., Trial or Epoch,
+	// recorded by [LooperTrace].
+	TraceLoop TraceCats = iota
+
+	// TraceFunc is a network function, e.g., Cycle or DWt, or a
+	// function timer from [Network.FunTimerStart] and [Network.FunTimerStop].
+	TraceFunc
+
+	// TraceKernel is an invocation of a compute kernel on the CPU,
+	// in the [CPUPool].
+	TraceKernel
+
+	// TraceLayer is the time that one worker spent running the items
+	// of a kernel invocation that belong to one layer.
+	TraceLayer
+)
+
+// TraceEvent is one event in a [Tracer] timeline.
+type TraceEvent struct {
+
+	// Cat is the category of event.
+	Cat TraceCats
+
+	// Name is the name of the looper level, function, or kernel.
+	Name string
+
+	// Mode is the looper mode, for TraceLoop events.
+	Mode string
+
+	// Layer is the index of the layer for TraceLayer events,
+	// and -1 if the items do not belong to a layer.
+	Layer int
+
+	// N is the loop counter for TraceLoop, and the number of items
+	// for TraceKernel.
+	N int
+
+	// Thread is 0 for events on the calling goroutine, and
+	// the worker index + 1 for TraceLayer events.
+	Thread int
+
+	// Start is the start time relative to the start of the trace.
+	Start time.Duration
+
+	// Dur is the duration of the event.
+	Dur time.Duration
+
+	// inv is the kernel invocation number, for TraceKernel and TraceLayer.
+	inv int
+}
+
+// End returns the end time of the event.
+func (ev *TraceEvent) End() time.Duration {
+	return ev.Start + ev.Dur
+}
+
+// Tracer records a timeline of the looper levels (see [LooperTrace]),
+// the network function timers, and the invocations of the compute kernels
+// on the CPU, including the time spent by each worker on the items of each
+// layer. The timeline can be exported as a Chrome trace-event JSON file
+// for viewing in chrome://tracing or https://ui.perfetto.dev, and as a
+// pprof profile with labels for each kernel and layer.
+// Use [Network.StartTrace] and [Network.StopTrace] to record.
+type Tracer struct {
+
+	// On is whether events are being recorded.
+	On bool
+
+	// MaxEvents is the maximum number of events to record,
+	// after which recording stops and Truncated is set.
+	MaxEvents int `default:"2000000"`
+
+	// Truncated is set if recording stopped at MaxEvents.
+	Truncated bool `edit:"-"`
+
+	// LayerNames are the names of the layers, for TraceLayer events.
+	LayerNames []string `display:"-"`
+
+	// LayerOf returns the layer index for item idx of kernel invocation
+	// with n items, or -1 if it does not belong to a layer.
+	LayerOf func(kernel string, n, idx int) int `display:"-"`
+
+	// start is the start time of the trace.
+	start time.Time
+
+	// threads are the events for each thread.
+	threads [][]TraceEvent
+
+	// open are the indexes of open events in thread 0.
+	open []int
+
+	// inv is the current kernel invocation number.
+	inv int
+
+	// kernel is the name of the current kernel.
+	kernel string
+
+	// n is the number of items in the current kernel invocation.
+	n int
+}
+
+func (tr *Tracer) Defaults() {
+	tr.MaxEvents = 2000000
+}
+
+// Start resets and starts recording of events.
+func (tr *Tracer) Start() {
+	if tr.MaxEvents == 0 {
+		tr.Defaults()
+	}
+	tr.start = time.Now()
+	tr.threads = make([][]TraceEvent, 1)
+	tr.open = nil
+	tr.inv = 0
+	tr.Truncated = false
+	tr.On = true
+}
+
+// Stop stops recording events. Events that are still open
+// end at the stop time.
+func (tr *Tracer) Stop() {
+	now := tr.now()
+	for _, ei := range tr.open {
+		ev := &tr.threads[0][ei]
+		ev.Dur = now - ev.Start
+	}
+	tr.open = nil
+	tr.On = false
+}
+
+// now returns the current time relative to the start.
+func (tr *Tracer) now() time.Duration {
+	return time.Since(tr.start)
+}
+
+// NEvents returns the total number of events.
+func (tr *Tracer) NEvents() int {
+	n := 0
+	for _, th := range tr.threads {
+		n += len(th)
+	}
+	return n
+}
+
+// Events returns the events for all threads, with those on the
+// calling goroutine first, in order of start time within each thread.
+func (tr *Tracer) Events() []TraceEvent {
+	evs := make([]TraceEvent, 0, tr.NEvents())
+	for _, th := range tr.threads {
+		evs = append(evs, th...)
+	}
+	return evs
+}
+
+// full returns true, and stops recording, if MaxEvents has been reached.
+func (tr *Tracer) full() bool {
+	if tr.NEvents() < tr.MaxEvents {
+		return false
+	}
+	tr.Stop()
+	tr.Truncated = true
+	return true
+}
+
+// Begin starts a new event of given category and name on the calling
+// goroutine, with mode and counter for TraceLoop events.
+// It must be ended by [Tracer.EndName].
+func (tr *Tracer) Begin(cat TraceCats, name, mode string, n int) {
+	if !tr.On || tr.full() {
+		return
+	}
+	tr.open = append(tr.open, len(tr.threads[0]))
+	tr.threads[0] = append(tr.threads[0], TraceEvent{Cat: cat, Name: name, Mode: mode, Layer: -1, N: n, Start: tr.now()})
+}
+
+// EndName ends the most recent open event with given name.
+func (tr *Tracer) EndName(name string) {
+	for i := len(tr.open) - 1; i >= 0; i-- {
+		ev := &tr.threads[0][tr.open[i]]
+		if ev.Name != name {
+			continue
+		}
+		ev.Dur = tr.now() - ev.Start
+		tr.open = append(tr.open[:i], tr.open[i+1:]...)
+		return
+	}
+}
+
+// startKernel starts a new kernel invocation with n items and
+// given number of workers, returning false if not recording.
+func (tr *Tracer) startKernel(name string, n, nworkers int) bool {
+	if !tr.On || tr.full() {
+		return false
+	}
+	for len(tr.threads) < nworkers+1 {
+		tr.threads = append(tr.threads, nil)
+	}
+	tr.inv++
+	tr.kernel = name
+	tr.n = n
+	tr.threads[0] = append(tr.threads[0], TraceEvent{Cat: TraceKernel, Name: name, Layer: -1, N: n, Start: tr.now(), inv: tr.inv})
+	return true
+}
+
+// endKernel ends the current kernel invocation.
+func (tr *Tracer) endKernel() {
+	th := tr.threads[0]
+	for i := len(th) - 1; i >= 0; i-- {
+		if ev := &th[i]; ev.Cat == TraceKernel && ev.inv == tr.inv {
+			ev.Dur = tr.now() - ev.Start
+			return
+		}
+	}
+}
+
+// layerOf returns the layer index for item idx of the current kernel.
+func (tr *Tracer) layerOf(idx int) int {
+	if tr.LayerOf == nil {
+		return -1
+	}
+	return tr.LayerOf(tr.kernel, tr.n, idx)
+}
+
+// runItems runs fun for items st to ed of the current kernel
+// on worker w, recording TraceLayer events for each layer.
+func (tr *Tracer) runItems(w, st, ed int, fun func(idx uint32)) {
+	li := tr.layerOf(st)
+	t0 := tr.now()
+	for idx := st; idx < ed; idx++ {
+		if l := tr.layerOf(idx); l != li {
+			t1 := tr.now()
+			tr.addLayer(w, li, t0, t1)
+			li, t0 = l, t1
+		}
+		fun(uint32(idx))
+	}
+	tr.addLayer(w, li, t0, tr.now())
+}
+
+// addLayer adds a TraceLayer event for worker w, merging with
+// the previous event for the same kernel invocation and layer.
+func (tr *Tracer) addLayer(w, li int, st, ed time.Duration) {
+	th := tr.threads[w+1]
+	if n := len(th); n > 0 {
+		if ev := &th[n-1]; ev.inv == tr.inv && ev.Layer == li {
+			ev.Dur = ed - ev.Start
+			return
+		}
+	}
+	tr.threads[w+1] = append(th, TraceEvent{Cat: TraceLayer, Name: tr.kernel, Layer: li, Thread: w + 1, Start: st, Dur: ed - st, inv: tr.inv})
+}
+
+// LayerName returns the name of the layer for TraceLayer events.
+func (tr *Tracer) LayerName(li int) string {
+	if li < 0 || li >= len(tr.LayerNames) {
+		return ""
+	}
+	return tr.LayerNames[li]
+}
+
+// chromeEvent is an event in the Chrome trace-event JSON format.
+type chromeEvent struct {
+	Name string         `json:"name"`
+	Cat  string         `json:"cat,omitempty"`
+	Ph   string         `json:"ph"`
+	Ts   float64        `json:"ts"`
+	Dur  float64        `json:"dur,omitempty"`
+	Pid  int            `json:"pid"`
+	Tid  int            `json:"tid"`
+	Args map[string]any `json:"args,omitempty"`
+}
+
+// WriteChromeTrace writes the events in the Chrome trace-event JSON format,
+// which can be viewed in chrome://tracing or https://ui.perfetto.dev.
+// The looper levels, function timers and kernels are on the Main thread,
+// and the per-layer work of each worker on separate Worker threads.
+func (tr *Tracer) WriteChromeTrace(w io.Writer) error {
+	evs := make([]chromeEvent, 0, tr.NEvents()+len(tr.threads))
+	for t := range tr.threads {
+		nm := "Main"
+		if t > 0 {
+			nm = fmt.Sprintf("Worker %d", t-1)
+		}
+		evs = append(evs, chromeEvent{Name: "thread_name", Ph: "M", Pid: 1, Tid: t, Args: map[string]any{"name": nm}})
+	}
+	us := func(d time.Duration) float64 { return float64(d) / float64(time.Microsecond) }
+	for _, ev := range tr.Events() {
+		ce := chromeEvent{Name: ev.Name, Cat: ev.Cat.String(), Ph: "X", Ts: us(ev.Start), Dur: us(ev.Dur), Pid: 1, Tid: ev.Thread}
+		switch ev.Cat {
+		case TraceLoop:
+			ce.Name = ev.Mode + " " + ev.Name
+			ce.Args = map[string]any{"mode": ev.Mode, "counter": ev.N}
+		case TraceKernel:
+			ce.Args = map[string]any{"n": ev.N}
+		case TraceLayer:
+			if lnm := tr.LayerName(ev.Layer); lnm != "" {
+				ce.Name = ev.Name + " " + lnm
+				ce.Args = map[string]any{"kernel": ev.Name, "layer": lnm}
+			}
+		}
+		evs = append(evs, ce)
+	}
+	return json.NewEncoder(w).Encode(map[string]any{"traceEvents": evs, "displayTimeUnit": "ms"})
+}
+
+// SaveChromeTrace saves the events to given file name
+// in the Chrome trace-event JSON format (see [Tracer.WriteChromeTrace]).
+func (tr *Tracer) SaveChromeTrace(filename string) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return tr.WriteChromeTrace(f)
+}
+
+// SavePprof saves the events to given file name as a gzipped
+// pprof profile (see [Tracer.WritePprof]).
+func (tr *Tracer) SavePprof(filename string) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return tr.WritePprof(f)
+}
+
+// StartTrace starts recording the [Network.Trace] timeline, including
+// the kernel invocations in the [CPUPool]. Use [LooperTrace] to
+// record the looper levels.
+func (nt *Network) StartTrace() {
+	tr := &nt.Trace
+	tr.LayerNames = make([]string, len(nt.Layers))
+	for li, ly := range nt.Layers {
+		tr.LayerNames[li] = ly.Name
+	}
+	tr.LayerOf = nt.traceLayerOf
+	tr.Start()
+	CPUPool.Trace = tr
+}
+
+// StopTrace stops recording the [Network.Trace] timeline.
+func (nt *Network) StopTrace() {
+	nt.Trace.Stop()
+	if CPUPool.Trace == &nt.Trace {
+		CPUPool.Trace = nil
+	}
+}
+
+// traceLayerOf returns the layer index for item idx of given kernel
+// with n items, based on whether n is the number of neurons, layers,
+// pools or synapses, times the number of data parallel items.
+func (nt *Network) traceLayerOf(kernel string, n, idx int) int {
+	nix := nt.NetIxs()
+	nd := int(nt.Context().NData)
+	if kernel == "SendSpikeSparse" {
+		idx = int(nt.SpikeSend.spikes[idx])
+		n = int(nix.NNeurons) * nd
+	}
+	switch {
+	case n == int(nix.NNeurons)*nd:
+		return int(NeuronIxs.Value(idx/nd, int(NrnLayIndex)))
+	case n == int(nix.NNeurons):
+		return int(NeuronIxs.Value(idx, int(NrnLayIndex)))
+	case n == int(nix.NLayers)*nd:
+		return idx / nd
+	case n == int(nix.NLayers):
+		return idx
+	case n == int(nix.NPools)*nd:
+		return int(PoolIxs.Value(idx/nd, int(PoolLayerIdx)))
+	case n == int(nix.NPools):
+		return int(PoolIxs.Value(idx, int(PoolLayerIdx)))
+	case n == int(nix.NSyns)*nd:
+		return int(GetPaths(SynapseIxs.Value(idx/nd, int(SynPathIndex))).Indexes.RecvLayer)
+	case n == int(nix.NSyns):
+		return int(GetPaths(SynapseIxs.Value(idx, int(SynPathIndex))).Indexes.RecvLayer)
+	}
+	return -1
+}
+
+// LooperTrace adds functions to record each iteration of the given
+// levels in all modes in the [Network.Trace] timeline, when it is On.
+// This should be called after all other functions have been added
+// to the loops, so that the recorded time includes them.
+func LooperTrace(ls *looper.Stacks, net *Network, levels ...enums.Enum) {
+	for mode, st := range ls.Stacks {
+		md := mode.String()
+		for _, level := range levels {
+			lp := st.Loops[level]
+			if lp == nil {
+				continue
+			}
+			nm := level.String()
+			lp.OnStart.Prepend("Trace", func() bool {
+				net.Trace.Begin(TraceLoop, nm, md, lp.Counter.Cur)
+				return false
+			})
+			lp.OnEnd.Add("Trace", func() {
+				net.Trace.EndName(nm)
+			})
+		}
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package axon
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/emer/emergent/v2/etime"
+	"github.com/emer/emergent/v2/looper"
+	"github.com/stretchr/testify/assert"
+)
+
+// runTraceNet runs the test network for 2 epochs of 2 trials
+// using looper, with tracing of the Epoch and Trial levels.
+func runTraceNet(net *Network) {
+	ls := looper.NewStacks()
+	ls.AddStack(etime.Train, etime.Trial).
+		AddLevel(etime.Epoch, 2).
+		AddLevel(etime.Trial, 2)
+	ls.Loop(etime.Train, etime.Trial).OnStart.Add("RunTrial", func() {
+		runTestTrials(net, 0, 1)
+	})
+	LooperTrace(ls, net, etime.Epoch, etime.Trial)
+	net.StartTrace()
+	ls.Run(etime.Train)
+	net.StopTrace()
+}
+
+// countEvents returns the number of events of given category and name.
+func countEvents(evs []TraceEvent, cat TraceCats, name string) int {
+	n := 0
+	for _, ev := range evs {
+		if ev.Cat == cat && ev.Name == name {
+			n++
+		}
+	}
+	return n
+}
+
+func TestTrace(t *testing.T) {
+	net := newTestNetFull(1)
+	net.RecFunTimes = true
+	runTraceNet(net)
+	tr := &net.Trace
+	assert.False(t, tr.On)
+	assert.False(t, tr.Truncated)
+	assert.Nil(t, CPUPool.Trace)
+
+	evs := tr.Events()
+	assert.Equal(t, 2, countEvents(evs, TraceLoop, "Epoch"))
+	assert.Equal(t, 4, countEvents(evs, TraceLoop, "Trial"))
+	assert.Equal(t, 4*200, countEvents(evs, TraceFunc, "Cycle"))
+	assert.Equal(t, 4, countEvents(evs, TraceFunc, "DWtToWt"))
+	assert.Equal(t, 4*200, countEvents(evs, TraceKernel, "CycleNeuron"))
+	assert.NotContains(t, net.FunTimes, "Cycle") // traced but not timed
+
+	var epoch, cycle *TraceEvent
+	layers := map[string]bool{}
+	for i := range evs {
+		ev := &evs[i]
+		switch {
+		case ev.Cat == TraceLoop && ev.Name == "Epoch" && epoch == nil:
+			epoch = ev
+		case ev.Cat == TraceFunc && ev.Name == "Cycle" && cycle == nil:
+			cycle = ev
+		case ev.Cat == TraceLayer && ev.Name == "CycleNeuron":
+			layers[tr.LayerName(ev.Layer)] = true
+			assert.Greater(t, ev.Thread, 0)
+		}
+	}
+	// nested within the first epoch
+	assert.Equal(t, "Train", epoch.Mode)
+	assert.GreaterOrEqual(t, cycle.Start, epoch.Start)
+	assert.LessOrEqual(t, cycle.End(), epoch.End())
+	assert.Equal(t, map[string]bool{"Input": true, "Hidden": true, "Output": true}, layers)
+
+	var b bytes.Buffer
+	assert.NoError(t, tr.WriteChromeTrace(&b))
+	var ct struct {
+		TraceEvents []map[string]any `json:"traceEvents"`
+	}
+	assert.NoError(t, json.Unmarshal(b.Bytes(), &ct))
+	assert.Greater(t, len(ct.TraceEvents), len(evs))
+	assert.Contains(t, b.String(), `"CycleNeuron Hidden"`)
+	assert.Contains(t, b.String(), `"Train Trial"`)
+
+	b.Reset()
+	assert.NoError(t, tr.WritePprof(&b))
+	gz, err := gzip.NewReader(&b)
+	assert.NoError(t, err)
+	pb, err := io.ReadAll(gz)
+	assert.NoError(t, err)
+	for _, s := range []string{"CycleNeuron", "Hidden", "Train Trial", "Cycle", "kernel", "layer", "worker"} {
+		assert.True(t, bytes.Contains(pb, []byte(s)), s)
+	}
+}
+
+func TestTraceTruncated(t *testing.T) {
+	net := newTestNetFull(1)
+	net.Trace.MaxEvents = 1000
+	runTraceNet(net)
+	assert.True(t, net.Trace.Truncated)
+	assert.Less(t, net.Trace.NEvents(), 1100)
+}
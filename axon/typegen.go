@@ -8,15 +8,23 @@ import (
 	"cogentcore.org/lab/gosl/slrand"
 )
 
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.DendVars", IDName: "dend-vars", Doc: "DendVars are the state variables for each additional dendritic compartment\nin [Dendrites], for layers with [Layer.DendComps] > 1. The first compartment\nis the standard VmDend dendrite, which uses the [NeuronVars] variables."})
+
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.PathGTypes", IDName: "path-g-types", Doc: "PathGTypes represents the conductance (G) effects of a given pathway,\nincluding excitatory, inhibitory, and modulatory."})
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.SynComParams", IDName: "syn-com-params", Doc: "SynComParams are synaptic communication parameters:\nused in the Path parameters.  Includes delay and\nprobability of failure, and Inhib for inhibitory connections,\nand modulatory pathways that have multiplicative-like effects.", Fields: []types.Field{{Name: "GType", Doc: "type of conductance (G) communicated by this pathway"}, {Name: "Delay", Doc: "additional synaptic delay in msec for inputs arriving at this pathway.\nMust be <= MaxDelay which is set during network building based on MaxDelay\nof any existing Path in the network. Delay = 0 means a spike reaches\nreceivers in the next Cycle, which is the minimum time (1 msec).\nBiologically, subtract 1 from biological synaptic delay values to set\ncorresponding Delay value."}, {Name: "MaxDelay", Doc: "maximum value of Delay, based on MaxDelay values when the BuildGBuf\nfunction was called during [Network.Build]. Cannot set it longer than this,\nexcept by calling BuildGBuf on network after changing MaxDelay to a larger\nvalue in any pathway in the network."}, {Name: "DelLen", Doc: "delay length = actual length of the GBuf buffer per neuron = Delay+1; just for speed"}}})
 
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.STPVars", IDName: "stp-vars", Doc: "STPVars are the short-term plasticity state variables in [PathSTP],\nfor each sending neuron in each pathway, for each data parallel index."})
+
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.STPParams", IDName: "stp-params", Doc: "STPParams are Tsodyks-Markram style short-term plasticity parameters,\nproducing synaptic depression and / or facilitation as a function of\nthe recent spiking of each sending neuron. The presynaptic state is\nmaintained in [PathSTP] for each sending neuron in the pathway:\nat each spike, the utilization u is incremented by U * (1 - u), and\nthe fraction u * x of the available resources x is released, which\nmultiplies the spike sent to all receivers. Between spikes, u decays\nback to 0 with TauF, and x recovers to 1 with TauD. The released\namount is normalized by U, so that the first spike after a long period\nof inactivity has the same effect as without short-term plasticity.\nWith TauF = 0, u is always U and there is only depression, where the\nsteady-state efficacy for Poisson spiking at rate r is 1 / (1 + U r TauD).", Fields: []types.Field{{Name: "On", Doc: "On enables short-term plasticity for this pathway."}, {Name: "U", Doc: "U is the increment in utilization (release probability) at each spike,\nwhich is the utilization for the first spike after a period of inactivity.\nHigh values produce mostly depression, while low values with a\nlonger TauF produce facilitation."}, {Name: "TauD", Doc: "TauD is the time constant in cycles (msec) for recovery of the\nsynaptic resources after release, which determines the duration of depression."}, {Name: "TauF", Doc: "TauF is the time constant in cycles (msec) for decay of utilization\nback to 0, which determines the duration of facilitation.\n0 = no facilitation, with utilization always equal to U."}, {Name: "DecayD", Doc: "DecayD = exp(-1 / TauD) is the per-cycle factor for remaining resource depletion."}, {Name: "DecayF", Doc: "DecayF = exp(-1 / TauF) is the per-cycle factor for utilization decay,\n0 if TauF = 0."}, {Name: "pad"}, {Name: "pad1"}}})
+
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.GapJunctionParams", IDName: "gap-junction-params", Doc: "GapJunctionParams are parameters for the electrical coupling of neurons\nvia gap junctions in a [GapJunctionPath], which produces a current in\neach receiving neuron of G * Wt * (Vm_s - Vm_r) for each sending neuron,\nusing the Vm values from the end of the prior cycle (GapVm).\nBecause connectivity is symmetric, the coupling current is conserved\nbetween each pair of neurons, and drives them toward the same Vm.", Fields: []types.Field{{Name: "G", Doc: "G is the conductance of each gap junction in nS, multiplied by the\nsynaptic weight, in the same units as Gbar.L (20 nS) and Dend.GAxial.\nThe total coupling strength increases with the number of connections."}, {Name: "pad"}, {Name: "pad1"}, {Name: "pad2"}}})
+
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.PathScaleParams", IDName: "path-scale-params", Doc: "PathScaleParams are pathway scaling parameters: modulates overall strength of pathway,\nusing both absolute and relative factors.", Fields: []types.Field{{Name: "Rel", Doc: "relative scaling that shifts balance between different pathways -- this is subject to normalization across all other pathways into receiving neuron, and determines the GScale.Target for adapting scaling"}, {Name: "Abs", Doc: "absolute multiplier adjustment factor for the path scaling -- can be used to adjust for idiosyncrasies not accommodated by the standard scaling based on initial target activation level and relative scaling factors -- any adaptation operates by directly adjusting scaling factor from the initially computed value"}, {Name: "pad"}, {Name: "pad1"}}})
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.SpikeParams", IDName: "spike-params", Doc: "SpikeParams contains spiking activation function params.\nImplements a basic thresholded Vm model, and optionally\nthe AdEx adaptive exponential function.", Fields: []types.Field{{Name: "Thr", Doc: "Thr is the spiking threshold value Theta (Θ) for firing output activation,\nin mV (millivolts). See also ExpThr for the AdEx implementation,\nin which case this threshold is the V_t parameters for the exponential function."}, {Name: "VmR", Doc: "VmR is the post-spiking membrane potential to reset to, in mV.\nThis produces refractory effect if lower than VmInit.\n-70 is appropriate biologically based value for AdEx (Brette & Gurstner, 2005)\nparameters. See also RTau."}, {Name: "Tr", Doc: "Tr is the post-spiking explicit refractory period, in cycles.\nPrevents Vm updating for this number of cycles post firing.\nVm is reduced in exponential steps over this period according to RTau,\nbeing fixed at Tr to VmR exactly."}, {Name: "RTau", Doc: "RTau is the time constant for decaying Vm down to VmR. At end of Tr it is set\nto VmR exactly. This provides a more realistic shape of the post-spiking\nVm which is only relevant for more realistic channels that key off of Vm.\nDoes not otherwise affect standard computation."}, {Name: "Exp", Doc: "Exp turns on the AdEx exponential excitatory current that drives Vm rapidly\nupward for spiking as it gets past its nominal firing threshold (Thr).\nEfficiently captures the Hodgkin Huxley dynamics of Na and K channels\n(Brette & Gurstner 2005)."}, {Name: "ExpSlope", Doc: "ExpSlope is the slope in mV for extra exponential excitatory current in AdEx."}, {Name: "ExpThr", Doc: "ExpThr is the membrane potential threshold (mV) for actually triggering\na spike when using the exponential mechanism. Due to 1 ms time integration,\nthis doesn't have much impact as long as it is above nominal spike threshold,\nand inside the VmRange for clipping Vm."}, {Name: "MaxHz", Doc: "MaxHz is for translating spiking interval (rate) into rate-code activation\nequivalent, as the maximum firing rate associated with a maximum\nactivation value of 1."}, {Name: "ISITau", Doc: "ISITau is the time constant for integrating the spiking interval in\nestimating spiking rate."}, {Name: "ISIDt", Doc: "ISIDt = 1 / tau"}, {Name: "RDt", Doc: "RDt = 1 / tau"}, {Name: "pad"}}})
 
-var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.DendParams", IDName: "dend-params", Doc: "DendParams are the parameters for updating dendrite-specific dynamics", Fields: []types.Field{{Name: "GExp", Doc: "GExp is the dendrite-specific strength multiplier of the exponential\nspiking drive on Vm. E.g., .5 makes it half as strong as at the soma."}, {Name: "GR", Doc: "GR is the dendrite-specific additional conductance of Kdr delayed\nrectifier currents, used to reset membrane potential for dendrite.\nApplied for Tr cycles (ms)."}, {Name: "SSGi", Doc: "SSGi is the SST+ somatostatin positive slow spiking inhibition level\nspecifically affecting dendritic Vm (VmDend). This is important for countering\na positive feedback loop from NMDA getting stronger over the course\nof learning. Also typically requires SubMean = 1 for TrgAvgAct and\nlearning to fully counter this feedback loop."}, {Name: "HasMod", Doc: "HasMod is set automatically based on whether this layer has any recv pathways\nthat have a GType conductance type of Modulatory.\nIf so, then multiply GeSyn etc by GModSyn."}, {Name: "ModGain", Doc: "ModGain is a multiplicative gain factor on the total modulatory input.\nThis can also be controlled by the PathScale.Abs factor on\nModulatoryG inputs, but it is convenient to be able to control\non the layer as well."}, {Name: "ModACh", Doc: "ModACh if true, modulatory signal also includes ACh multiplicative factor."}, {Name: "ModBase", Doc: "ModBase is the baseline modulatory level for modulatory effects.\nNet modulation is ModBase + ModGain * GModSyn"}, {Name: "pad"}}})
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.DendParams", IDName: "dend-params", Doc: "DendParams are the parameters for updating dendrite-specific dynamics", Fields: []types.Field{{Name: "GExp", Doc: "GExp is the dendrite-specific strength multiplier of the exponential\nspiking drive on Vm. E.g., .5 makes it half as strong as at the soma."}, {Name: "GR", Doc: "GR is the dendrite-specific additional conductance of Kdr delayed\nrectifier currents, used to reset membrane potential for dendrite.\nApplied for Tr cycles (ms)."}, {Name: "SSGi", Doc: "SSGi is the SST+ somatostatin positive slow spiking inhibition level\nspecifically affecting dendritic Vm (VmDend). This is important for countering\na positive feedback loop from NMDA getting stronger over the course\nof learning. Also typically requires SubMean = 1 for TrgAvgAct and\nlearning to fully counter this feedback loop."}, {Name: "HasMod", Doc: "HasMod is set automatically based on whether this layer has any recv pathways\nthat have a GType conductance type of Modulatory.\nIf so, then multiply GeSyn etc by GModSyn."}, {Name: "ModGain", Doc: "ModGain is a multiplicative gain factor on the total modulatory input.\nThis can also be controlled by the PathScale.Abs factor on\nModulatoryG inputs, but it is convenient to be able to control\non the layer as well."}, {Name: "ModACh", Doc: "ModACh if true, modulatory signal also includes ACh multiplicative factor."}, {Name: "ModBase", Doc: "ModBase is the baseline modulatory level for modulatory effects.\nNet modulation is ModBase + ModGain * GModSyn"}, {Name: "GAxial", Doc: "GAxial is the axial conductance (in nS) coupling adjacent dendritic\ncompartments, for layers with [Layer.DendComps] > 1, which is directly\ncomparable to Gbar.L = 20 for leak. Larger values make the compartments\nmore electrotonically compact, converging on a single compartment."}}})
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.ActInitParams", IDName: "act-init-params", Doc: "ActInitParams are initial values for key network state variables.\nInitialized in InitActs called by InitWeights, and provides target values\nfor DecayState.", Fields: []types.Field{{Name: "Vm", Doc: "Vm initial membrane potential in mV (millivolts).\nSee Erev.L for the resting potential, typically -70."}, {Name: "Act", Doc: "Act is the initial activation value. Typically 0."}, {Name: "GeBase", Doc: "GeBase is the baseline level of excitatory conductance (net input).\nGe is initialized to this value, and it is added in as a constant\nbackground level of excitatory input, to capture all the other\ninputs not represented in the model, and intrinsic excitability, etc."}, {Name: "GiBase", Doc: "GiBase baseline level of inhibitory conductance (net input)\nGi is initialized to this value, and it is added in as a constant\nbackground level of inhibitory input. Captures all the other inputs\nnot represented in the model."}, {Name: "GeVar", Doc: "GeVar is the variance (sigma) of gaussian distribution around baseline\nGe values, per neuron, to establish variability in intrinsic excitability.\nValue never goes < 0."}, {Name: "GiVar", Doc: "GiVar is the variance (sigma) of gaussian distribution around baseline\nGi values, per neuron, to establish variability in intrinsic excitability.\nValue never goes < 0"}, {Name: "pad"}, {Name: "pad1"}}})
 
@@ -30,14 +38,28 @@ var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.ClampParam
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.SMaintParams", IDName: "s-maint-params", Doc: "SMaintParams for self-maintenance simulating a population of\nNMDA-interconnected spiking neurons", Fields: []types.Field{{Name: "On", Doc: "On switch for self maintenance."}, {Name: "NNeurons", Doc: "NNeurons is the number of neurons within the self-maintenance pool,\neach of which is assumed to have the same probability of spiking."}, {Name: "Ge", Doc: "Ge is the excitatory conductance multiplier for self maintenance synapses."}, {Name: "Inhib", Doc: "Inhib controls how much of the extra maintenance conductance goes\nto the GeExt, which drives extra proportional inhibition."}, {Name: "ISI", Doc: "ISI (inter spike interval) range. Min is used as min ISIAvg\nfor poisson spike rate expected from the population,\nand above Max, no additional maintenance conductance is added."}}})
 
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.IntrinsicParams", IDName: "intrinsic-params", Doc: "IntrinsicParams are parameters for per-neuron intrinsic plasticity,\nwhich slowly adapts the excitability of each neuron so that its\nlong-term average activity [ActAvg] tracks a target firing rate.\nNeurons that are too active raise their spike threshold (via the\n[IPThr] offset) and / or leak conductance (via [IPGl]), and vice-versa.\nThis is complementary to synaptic scaling via [TrgAvgActParams], and to the\nlayer-level GiMult adaptation in [ActAvgParams]. Updating happens at the\nslow adaptation interval (Network.SlowInterval) in SlowAdaptNeuron.", Fields: []types.Field{{Name: "On", Doc: "On switch for intrinsic plasticity."}, {Name: "Thr", Doc: "Thr adapts the spike threshold offset [IPThr]."}, {Name: "Gl", Doc: "Gl adapts the leak conductance offset [IPGl]."}, {Name: "TrgHz", Doc: "TrgHz is the target firing rate in Hz, which is converted into\nnormalized activity using Spikes.MaxHz for comparison with [ActAvg]."}, {Name: "ThrRate", Doc: "ThrRate is the rate of change in the spike threshold offset [IPThr],\nin mV per unit of normalized activity error, per slow update."}, {Name: "ThrMax", Doc: "ThrMax is the maximum magnitude of the spike threshold offset, in mV."}, {Name: "GlRate", Doc: "GlRate is the rate of change in the leak conductance offset [IPGl],\nas a proportion of Gbar.L per unit of normalized activity error,\nper slow update."}, {Name: "GlMax", Doc: "GlMax is the maximum magnitude of the leak conductance offset,\nas a proportion of Gbar.L, which must be less than 1."}}})
+
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.PopCodeParams", IDName: "pop-code-params", Doc: "PopCodeParams provides an encoding of scalar value using population code,\nwhere a single continuous (scalar) value is encoded as a gaussian bump\nacross a population of neurons (1 dimensional).\nIt can also modulate rate code and number of neurons active according to the value.\nThis is for layers that represent values as in the Rubicon system.\nBoth normalized activation values (1 max) and Ge conductance values can be generated.", Fields: []types.Field{{Name: "On", Doc: "On toggles use of popcode encoding of variable(s) that this layer represents."}, {Name: "Ge", Doc: "Ge multiplier for driving excitatory conductance based on PopCode.\nMultiplies normalized activation values and adds to total Ge(t)\nwhich is later multiplied by Gbar.E for pA unit scaling."}, {Name: "Min", Doc: "Min is the minimum value representable. For GaussBump, typically include\nextra to allow mean with activity on either side to represent\nthe lowest value you want to encode."}, {Name: "Max", Doc: "Max is the maximum value representable. For GaussBump, typically include\nextra to allow mean with activity on either side to represent\nthe lowest value you want to encode."}, {Name: "MinAct", Doc: "MinAct is an activation multiplier for values at Min end of range,\nwhere values at Max end have an activation of 1.\nIf this is < 1, then there is a rate code proportional\nto the value in addition to the popcode pattern. See also MinSigma, MaxSigma."}, {Name: "MinSigma", Doc: "MinSigma is the sigma parameter of a gaussian specifying the tuning width\nof the coarse-coded units, in normalized 0-1 range, for values at the Min\nend of the range. If MinSigma < MaxSigma then more units are activated\nfor Max values vs. Min values, proportionally."}, {Name: "MaxSigma", Doc: "MaxSigma is the sigma parameter of a gaussian specifying the tuning width\nof the coarse-coded units, in normalized 0-1 range, for values at the Max\nend of the range. If MinSigma < MaxSigma then more units are activated\nfor Max values vs. Min values, proportionally."}, {Name: "Clip", Doc: "Clip ensures that encoded and decoded value remains within specified range."}}})
 
-var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.ActParams", IDName: "act-params", Doc: "ActParams contains all the neural activity computation params and functions\nfor Axon, at the neuron level. This is included in [LayerParams].", Fields: []types.Field{{Name: "Spikes", Doc: "Spikes are spiking function parameter, including the AdEx spiking function."}, {Name: "Dend", Doc: "Dend are dendrite-specific parameters, which more accurately approximate\nthe electrical dynamics present in dendrites vs the soma."}, {Name: "Init", Doc: "Init has initial values for key network state variables.\nInitialized in InitActs called by InitWeights, and provides target\nvalues for DecayState."}, {Name: "Decay", Doc: "Decay is the amount to decay between theta cycles, simulating the passage\nof time and effects of saccades etc. It is especially important for\nenvironments with random temporal structure (e.g., most standard neural net\ntraining corpora)."}, {Name: "Dt", Doc: "Dt has time and rate constants for temporal derivatives / updating of\nactivation state."}, {Name: "Gbar", Doc: "Gbar has maximal conductances levels for channels, in nS (nanosiemens).\nMost other conductances are computed as time-varying proportions of these\nvalues (strict 1 max is not enforced and can be exceeded)."}, {Name: "Erev", Doc: "Erev are reversal / driving potentials for each channel, in mV (millivolts).\nCurrent is a function of the difference between these driving potentials\nand the membrane potential Vm, and goes to 0 (and reverses sign) as it\ncrosses equality."}, {Name: "Clamp", Doc: "Clamp determines how external inputs drive excitatory conductance."}, {Name: "Noise", Doc: "Noise specifies how, where, when, and how much noise to add."}, {Name: "VmRange", Doc: "VmRange constrains the range of the Vm membrane potential,\nwhich helps to prevent numerical instability."}, {Name: "Mahp", Doc: "Mahp is the M-type medium time-scale afterhyperpolarization (mAHP) current.\nThis is the primary form of adaptation on the time scale of\nmultiple sequences of spikes."}, {Name: "Sahp", Doc: "Sahp is the slow time-scale afterhyperpolarization (sAHP) current.\nIt integrates CaD at theta cycle intervals and produces a hard cutoff\non sustained activity for any neuron."}, {Name: "KNa", Doc: "KNa has the sodium-gated potassium channel adaptation parameters.\nIt activates a leak-like current as a function of neural activity\n(firing = Na influx) at two different time-scales (Slick = medium, Slack = slow)."}, {Name: "Kir", Doc: "Kir is the potassium (K) inwardly rectifying (ir) current, which\nis similar to GABA-B (which is a GABA modulated Kir channel).\nThis channel is off by default but plays a critical role in making medium\nspiny neurons (MSNs) relatively quiet in the striatum."}, {Name: "NMDA", Doc: "NMDA has channel parameters used in computing the Gnmda conductance\nthat is maximal for more depolarized neurons (due to unblocking of\nMg++ ions), and thus helps keep active neurons active, thereby promoting\noverall neural stability over time. See also Learn.LearnNMDA for\ndistinct parameters used for Ca++ influx driving learning, and\nMaintNMDA for specialized NMDA driven by maintenance pathways."}, {Name: "MaintNMDA", Doc: "MaintNMDA has channel parameters used in computing the Gnmda conductance\nbased on pathways of the MaintG conductance type, e.g., in the PT PFC neurons.\nThis is typically stronger and longer lasting than standard NMDA."}, {Name: "GabaB", Doc: "GabaB has GABA-B channel parameters for long-lasting inhibition\nthat is inwardly rectified (GIRK coupled) and maximal for more hyperpolarized\nneurons, thus keeping inactive neurons inactive. This is synergistic with\nNMDA for supporting stable activity patterns over the theta cycle."}, {Name: "VGCC", Doc: "VGCC are voltage gated calcium channels, which provide a key additional\nsource of Ca for learning and positive-feedback loop upstate for active\nneurons when they are spiking."}, {Name: "AK", Doc: "AK is the A-type potassium (K) channel that is particularly important\nfor limiting the runaway excitation from VGCC channels."}, {Name: "SKCa", Doc: "SKCa is the small-conductance calcium-activated potassium channel produces\nthe pausing function as a consequence of rapid bursting. These are not active\nby default but are critical for subthalamic nucleus (STN) neurons."}, {Name: "SMaint", Doc: "SMaint provides a simplified self-maintenance current for a population of\nNMDA-interconnected spiking neurons."}, {Name: "PopCode", Doc: "PopCode provides encoding population codes, used to represent a single\ncontinuous (scalar) value, across a population of units / neurons\n(1 dimensional)."}}})
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.ActParams", IDName: "act-params", Doc: "ActParams contains all the neural activity computation params and functions\nfor Axon, at the neuron level. This is included in [LayerParams].", Fields: []types.Field{{Name: "Spikes", Doc: "Spikes are spiking function parameter, including the AdEx spiking function."}, {Name: "Dend", Doc: "Dend are dendrite-specific parameters, which more accurately approximate\nthe electrical dynamics present in dendrites vs the soma."}, {Name: "Init", Doc: "Init has initial values for key network state variables.\nInitialized in InitActs called by InitWeights, and provides target\nvalues for DecayState."}, {Name: "Decay", Doc: "Decay is the amount to decay between theta cycles, simulating the passage\nof time and effects of saccades etc. It is especially important for\nenvironments with random temporal structure (e.g., most standard neural net\ntraining corpora)."}, {Name: "Dt", Doc: "Dt has time and rate constants for temporal derivatives / updating of\nactivation state."}, {Name: "Gbar", Doc: "Gbar has maximal conductances levels for channels, in nS (nanosiemens).\nMost other conductances are computed as time-varying proportions of these\nvalues (strict 1 max is not enforced and can be exceeded)."}, {Name: "Erev", Doc: "Erev are reversal / driving potentials for each channel, in mV (millivolts).\nCurrent is a function of the difference between these driving potentials\nand the membrane potential Vm, and goes to 0 (and reverses sign) as it\ncrosses equality."}, {Name: "Clamp", Doc: "Clamp determines how external inputs drive excitatory conductance."}, {Name: "Noise", Doc: "Noise specifies how, where, when, and how much noise to add."}, {Name: "VmRange", Doc: "VmRange constrains the range of the Vm membrane potential,\nwhich helps to prevent numerical instability."}, {Name: "Mahp", Doc: "Mahp is the M-type medium time-scale afterhyperpolarization (mAHP) current.\nThis is the primary form of adaptation on the time scale of\nmultiple sequences of spikes."}, {Name: "Sahp", Doc: "Sahp is the slow time-scale afterhyperpolarization (sAHP) current.\nIt integrates CaD at theta cycle intervals and produces a hard cutoff\non sustained activity for any neuron."}, {Name: "KNa", Doc: "KNa has the sodium-gated potassium channel adaptation parameters.\nIt activates a leak-like current as a function of neural activity\n(firing = Na influx) at two different time-scales (Slick = medium, Slack = slow)."}, {Name: "Kir", Doc: "Kir is the potassium (K) inwardly rectifying (ir) current, which\nis similar to GABA-B (which is a GABA modulated Kir channel).\nThis channel is off by default but plays a critical role in making medium\nspiny neurons (MSNs) relatively quiet in the striatum."}, {Name: "HCN", Doc: "HCN is the hyperpolarization-activated cation channel that produces\nthe Ih current, which slowly opens with hyperpolarization and drives\nthe neuron back toward firing, with its own Gbar and Erev values.\nThis channel is off by default but is important for rebound bursting in\nthalamic relay neurons, and resonance and dendritic integration in\nentorhinal and hippocampal neurons."}, {Name: "NMDA", Doc: "NMDA has channel parameters used in computing the Gnmda conductance\nthat is maximal for more depolarized neurons (due to unblocking of\nMg++ ions), and thus helps keep active neurons active, thereby promoting\noverall neural stability over time. See also Learn.LearnNMDA for\ndistinct parameters used for Ca++ influx driving learning, and\nMaintNMDA for specialized NMDA driven by maintenance pathways."}, {Name: "MaintNMDA", Doc: "MaintNMDA has channel parameters used in computing the Gnmda conductance\nbased on pathways of the MaintG conductance type, e.g., in the PT PFC neurons.\nThis is typically stronger and longer lasting than standard NMDA."}, {Name: "GabaB", Doc: "GabaB has GABA-B channel parameters for long-lasting inhibition\nthat is inwardly rectified (GIRK coupled) and maximal for more hyperpolarized\nneurons, thus keeping inactive neurons inactive. This is synergistic with\nNMDA for supporting stable activity patterns over the theta cycle."}, {Name: "VGCC", Doc: "VGCC are voltage gated calcium channels, which provide a key additional\nsource of Ca for learning and positive-feedback loop upstate for active\nneurons when they are spiking."}, {Name: "AK", Doc: "AK is the A-type potassium (K) channel that is particularly important\nfor limiting the runaway excitation from VGCC channels."}, {Name: "CaT", Doc: "CaT is the low-threshold T-type calcium channel, which is de-inactivated\nby hyperpolarization and drives a low-threshold calcium spike and burst\nfiring on subsequent depolarization, contributing Ca to [LearnCa].\nThis channel is off by default, but is important for burst firing in\nthalamic relay, TRN and STN neurons."}, {Name: "NaP", Doc: "NaP is the persistent sodium channel, which provides a sustained\ndepolarizing drive at subthreshold potentials that slowly inactivates.\nThis channel is off by default, but supports burst firing and plateau\npotentials, e.g., in STN neurons."}, {Name: "SKCa", Doc: "SKCa is the small-conductance calcium-activated potassium channel produces\nthe pausing function as a consequence of rapid bursting. These are not active\nby default but are critical for subthalamic nucleus (STN) neurons."}, {Name: "SMaint", Doc: "SMaint provides a simplified self-maintenance current for a population of\nNMDA-interconnected spiking neurons."}, {Name: "Intrinsic", Doc: "Intrinsic has parameters for per-neuron intrinsic plasticity, which\nslowly adapts the spike threshold and / or leak conductance of each\nneuron toward a target firing rate."}, {Name: "PopCode", Doc: "PopCode provides encoding population codes, used to represent a single\ncontinuous (scalar) value, across a population of units / neurons\n(1 dimensional)."}}})
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.BLANovelPath", IDName: "bla-novel-path", Doc: "BLANovelPath connects all other pools to the first, Novelty, pool in a BLA layer.\nThis allows the known US representations to specifically inhibit the novelty pool."})
 
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.ConsolRules", IDName: "consol-rules", Doc: "ConsolRules are the weight consolidation rules that protect weights\nthat were important for previously learned tasks, selected in [ConsolParams]."})
+
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.ConsolVars", IDName: "consol-vars", Doc: "ConsolVars are the per-synapse weight consolidation state variables\nin [SynapseConsol]."})
+
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.ConsolParams", IDName: "consol-params", Doc: "ConsolParams are parameters for optional weight consolidation, which\nprotects the weights that were important for earlier tasks when tasks\nare learned sequentially (continual learning). A per-synapse importance\nis accumulated during learning, and at each task boundary,\n[Network.ConsolSnapshot] adds it to the consolidated importance and\nrecords the current [LWt] as the anchor weight. Thereafter, [WtFromDWt]\npulls each [LWt] back toward its anchor in proportion to Strength times\nthe importance, in addition to the normal weight change, in the style of\nEWC or synaptic intelligence. The per-synapse state in [SynapseConsol]\nis only allocated for pathways using consolidation.", Fields: []types.Field{{Name: "Rule", Doc: "Rule is the consolidation rule to use, if any."}, {Name: "Strength", Doc: "Strength is the overall strength of the pull toward the anchor\nweights, multiplying the importance (lambda in EWC, c in SI).\nThe effective pull per weight update is also multiplied by the\nlearning rate, and is limited to 1 (i.e., all the way to the anchor)."}, {Name: "Tau", Doc: "Tau is the time constant in weight updates for the running average\nof the squared gradient, which estimates the Fisher information\nfor ConsolEWC."}, {Name: "Xi", Doc: "Xi is the damping term added to the squared total weight change\nover the task, which normalizes the path integral for ConsolSI."}, {Name: "Keep", Doc: "Keep is the proportion of the prior consolidated importance that\nis retained at each snapshot, to which the importance for the\nnew task is added. Values < 1 progressively release the weights\nfor older tasks (online EWC)."}, {Name: "Dt", Doc: "Dt = 1 / Tau"}, {Name: "pad"}, {Name: "pad1"}}})
+
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.Context", IDName: "context", Doc: "Context contains all of the global context state info\nthat is shared across every step of the computation.\nIt is passed around to all relevant computational functions,\nand is updated on the CPU and synced to the GPU after every cycle.\nIt contains timing, Testing vs. Training mode, random number context, etc.\nThere is one canonical instance on the network as Ctx, always get it from\nthe network.Context() method.", Directives: []types.Directive{{Tool: "types", Directive: "add", Args: []string{"-setters"}}}, Fields: []types.Field{{Name: "NData", Doc: "number of data parallel items to process currently."}, {Name: "Mode", Doc: "current running mode, using sim-defined enum, e.g., Train, Test, etc."}, {Name: "Testing", Doc: "Testing is true if the model is being run in a testing mode,\nso no weight changes or other associated computations should be done.\nThis flag should only affect learning-related behavior."}, {Name: "MinusPhase", Doc: "MinusPhase is true if this is the minus phase, when a stimulus is present\nand learning is occuring. Could also be in a non-learning phase when\nno stimulus is present."}, {Name: "PlusPhase", Doc: "PlusPhase is true if this is the plus phase, when the outcome / bursting\nis occurring, driving positive learning; else minus or non-learning phase."}, {Name: "PhaseCycle", Doc: "Cycle within current phase, minus or plus."}, {Name: "Cycle", Doc: "Cycle within Trial: number of iterations of activation updating (settling)\non the current state. This is reset at NewState."}, {Name: "ThetaCycles", Doc: "ThetaCycles is the length of the theta cycle (i.e., Trial),\nin terms of 1 msec Cycles. Some network update steps depend on doing something\nat the end of the theta cycle (e.g., CTCtxtPath).\nShould be ISICycles + MinusCycles + PlusCycles"}, {Name: "ISICycles", Doc: "ISICycles is the number of inter-stimulus-interval cycles,\nwhich happen prior to the minus phase (i.e., after the last plus phase)."}, {Name: "MinusCycles", Doc: "MinusCycles is the number of cycles in the minus phase. Typically 150,\nbut may be set longer if ThetaCycles is above default of 200."}, {Name: "PlusCycles", Doc: "PlusCycles is the number of cycles in the plus phase. Typically 50,\nbut may be set longer if ThetaCycles is above default of 200."}, {Name: "ThetaStart", Doc: "ThetaStart is the cycle at which the current theta cycle started."}, {Name: "CyclesTotal", Doc: "CyclesTotal is the accumulated cycle count, which increments continuously\nfrom whenever it was last reset. Typically this is the number of milliseconds\nin simulation time."}, {Name: "Time", Doc: "Time is the accumulated amount of time the network has been running,\nin simulation-time (not real world time), in seconds."}, {Name: "TrialsTotal", Doc: "TrialsTotal is the total trial count, which increments continuously in NewState\n_only in Train mode_ from whenever it was last reset. Can be used for synchronizing\nweight updates across nodes."}, {Name: "TimePerCycle", Doc: "TimePerCycle is the amount of Time to increment per cycle."}, {Name: "SlowInterval", Doc: "SlowInterval is how frequently in Trials to perform slow adaptive processes\nsuch as synaptic scaling, associated in the brain with sleep,\nvia the SlowAdapt method.  This should be long enough for meaningful changes\nto accumulate. 100 is default but could easily be longer in larger models.\nBecause SlowCounter is incremented by NData, high NData cases (e.g. 16) likely need to\nincrease this value, e.g., 400 seems to produce overall consistent results in various models."}, {Name: "SlowCounter", Doc: "SlowCounter increments for each training trial, to trigger SlowAdapt at SlowInterval.\nThis is incremented by NData to maintain consistency across different values of this parameter."}, {Name: "AdaptGiInterval", Doc: "AdaptGiInterval is how frequently in Trials to perform inhibition adaptation,\nwhich needs to be even slower than the SlowInterval."}, {Name: "AdaptGiCounter", Doc: "AdaptGiCounter increments for each training trial, to trigger AdaptGi at AdaptGiInterval.\nThis is incremented by NData to maintain consistency across different values of this parameter."}, {Name: "RandCounter", Doc: "RandCounter is the random counter, incremented by maximum number of\npossible random numbers generated per cycle, regardless of how\nmany are actually used. This is shared across all layers so must\nencompass all possible param settings."}}})
 
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.DWtComm", IDName: "d-wt-comm", Doc: "DWtComm is a communicator for data-parallel learning across multiple\nprocesses, each of which learns on a different subset of the inputs,\nand the weight changes (DWts) are summed across processes before\nupdating the weights, using [Network.AllReduceDWts].\n[MPIComm] uses MPI, and [LocalComm] uses multiple processes\non the local machine, without requiring MPI."})
+
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.MPIComm", IDName: "mpi-comm", Doc: "MPIComm is a [DWtComm] using MPI, for processes launched with mpirun.\nThe sim must be built with the mpi build tag to actually use MPI,\nand otherwise it runs as a single process.", Embeds: []types.Field{{Name: "Comm"}}})
+
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.LocalComm", IDName: "local-comm", Doc: "LocalComm is a [DWtComm] for processes on the local machine,\nwhich communicate over a Unix domain socket, without requiring MPI.\nThe root process (rank 0) listens on the socket and each of the other\nprocesses connects to it. In AllReduceF32, the root process sums\nthe values from all processes, in rank order so that the results\nare deterministic, and sends the sum back to each process.\nUse [LocalInit] to start all of the processes from a single command,\nor [NewLocalComm] to connect processes started in other ways.", Fields: []types.Field{{Name: "Addr", Doc: "Addr is the path of the Unix domain socket of the root process."}, {Name: "rank", Doc: "rank of this process."}, {Name: "size", Doc: "size is the number of processes."}, {Name: "listener", Doc: "listener of the root process."}, {Name: "conns", Doc: "conns are the connections to each other process for the root,\nindexed by rank, and just conns[0] to the root for other processes."}, {Name: "bufs", Doc: "bufs are the encoding buffers for each connection."}, {Name: "vals", Doc: "vals are the values received from each process by the root."}, {Name: "procs", Doc: "procs are the worker processes started by [LocalInit]."}}})
+
 // SetNData sets the [Context.NData]:
 // number of data parallel items to process currently.
 func (t *Context) SetNData(v uint32) *Context { t.NData = v; return t }
@@ -168,21 +190,23 @@ var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.HipPathPar
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.ActAvgParams", IDName: "act-avg-params", Doc: "ActAvgParams represents the nominal average activity levels in the layer\nand parameters for adapting the computed Gi inhibition levels to maintain\naverage activity within a target range.", Directives: []types.Directive{{Tool: "gosl", Directive: "start"}, {Tool: "gosl", Directive: "import", Args: []string{"github.com/emer/axon/v2/fsfffb"}}}, Fields: []types.Field{{Name: "Nominal", Doc: "Nominal is the estimated average activity level in the layer, which is\nused in computing the scaling factor on sending pathways from this layer.\nIn general it should roughly match the layer ActAvg.ActMAvg value, which\ncan be logged using the axon.LogAddDiagnosticItems function.\nIf layers receiving from this layer are not getting enough Ge excitation,\nthen this Nominal level can be lowered to increase pathway strength\n(fewer active neurons means each one contributes more, so scaling factor\n\n\tgoes as the inverse of activity level), or vice-versa if Ge is too high.\n\nIt is also the basis for the target activity level used for the AdaptGi\n\n\toption: see the Offset which is added to this value."}, {Name: "RTThr", Doc: "RTThr is the reaction time (RT) threshold activity level in the layer,\nin terms of the maximum CaP level of any neuron in the layer. The\nLayerStates LayerRT value is recorded for the cycle at which this\nlevel is exceeded within a theta cycle, after Acts.Dt.MaxCycStart cycles."}, {Name: "AdaptGi", Doc: "AdaptGi enables adapting of layer inhibition Gi multiplier factor\n(stored in layer GiMult value) to maintain a target layer level of\nActAvg.Nominal. This generally works well and improves the long-term\nstability of the models. It is not enabled by default because it depends\non having established a reasonable Nominal + Offset target activity level."}, {Name: "Offset", Doc: "Offset is added to Nominal for the target average activity that drives\nadaptation of Gi for this layer.  Typically the Nominal level is good,\nbut sometimes Nominal must be adjusted up or down to achieve desired Ge\nscaling, so this Offset can compensate accordingly."}, {Name: "HiTol", Doc: "HiTol is the tolerance for higher than Target target average activation\nas a proportion of that target value (0 = exactly the target, 0.2 = 20%\nhigher than target). Only once activations move outside this tolerance\n\n\tare inhibitory values adapted."}, {Name: "LoTol", Doc: "LoTol is the tolerance for lower than Target target average activation\nas a proportion of that target value (0 = exactly the target, 0.5 = 50%\nlower than target). Only once activations move outside this tolerance are\n\n\tinhibitory values adapted."}, {Name: "AdaptRate", Doc: "AdaptRate is the rate of Gi adaptation as function of\nAdaptRate * (Target - ActMAvg) / Target. This occurs at spaced intervals\ndetermined by Network.SlowInterval value. Slower values such as 0.05 may\nbe needed for large networks and sparse layers."}, {Name: "AdaptMax", Doc: "AdaptMax is the maximum adaptation step magnitude to take at any point."}}})
 
-var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.InhibParams", IDName: "inhib-params", Doc: "InhibParams contains all the inhibition computation params and functions for basic Axon.\nThis is included in LayerParams to support computation.\nAlso includes the expected average activation in the layer, which is used for\nG conductance rescaling and potentially for adapting inhibition over time.", Fields: []types.Field{{Name: "ActAvg", Doc: "ActAvg has layer-level and pool-level average activation initial values\nand updating / adaptation thereof.\nInitial values help determine initial scaling factors."}, {Name: "Layer", Doc: "Layer determines inhibition across the entire layer.\nInput layers generally use Gi = 0.8 or 0.9, 1.3 or higher for sparse layers.\nIf the layer has sub-pools (4D shape) then this is effectively between-pool inhibition."}, {Name: "Pool", Doc: "Pool determines inhibition within sub-pools of units, for layers with 4D shape.\nThis is almost always necessary if the layer has sub-pools."}}})
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.InhibParams", IDName: "inhib-params", Doc: "InhibParams contains all the inhibition computation params and functions for basic Axon.\nThis is included in LayerParams to support computation.\nAlso includes the expected average activation in the layer, which is used for\nG conductance rescaling and potentially for adapting inhibition over time.", Fields: []types.Field{{Name: "ActAvg", Doc: "ActAvg has layer-level and pool-level average activation initial values\nand updating / adaptation thereof.\nInitial values help determine initial scaling factors."}, {Name: "Layer", Doc: "Layer determines inhibition across the entire layer.\nInput layers generally use Gi = 0.8 or 0.9, 1.3 or higher for sparse layers.\nIf the layer has sub-pools (4D shape) then this is effectively between-pool inhibition."}, {Name: "Pool", Doc: "Pool determines inhibition within sub-pools of units, for layers with 4D shape.\nThis is almost always necessary if the layer has sub-pools."}, {Name: "Interneurons", Doc: "Interneurons indicates that inhibition for this layer is provided by\nexplicit PV, SST and VIP interneuron layers via inhibitory pathways\n(see [Network.AddInterneurons]), so the FS-FFFB inhibition from the\nLayer and Pool parameters is not applied to the neurons. It is still\ncomputed in the pools, as the equivalent TotalGi for comparison\n(see [Layer.InterneuronGi])."}, {Name: "pad"}, {Name: "pad1"}, {Name: "pad2"}}})
+
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.InterneuronConfig", IDName: "interneuron-config", Doc: "InterneuronConfig has the configuration for [Network.AddInterneurons].", Fields: []types.Field{{Name: "NPV", Doc: "NPV is the number of PV+ fast-spiking interneurons."}, {Name: "NSST", Doc: "NSST is the number of SST+ dendrite-targeting interneurons."}, {Name: "NVIP", Doc: "NVIP is the number of VIP+ disinhibitory interneurons."}, {Name: "PVGi", Doc: "PVGi is the absolute strength (PathScale.Abs) of the\nPV to layer soma inhibition."}, {Name: "SSTGi", Doc: "SSTGi is the absolute strength (PathScale.Abs) of the\nSST to layer dendrite inhibition."}, {Name: "SSTTauF", Doc: "SSTTauF is the facilitation time constant for the short-term\nplasticity of the layer to SST excitation, which causes SST\ninterneurons to be recruited progressively by sustained activity."}, {Name: "Space", Doc: "Space is the spacing between layers for placement."}}})
 
-var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.Layer", IDName: "layer", Doc: "Layer implements the basic Axon spiking activation function,\nand manages learning in the pathways.", Methods: []types.Method{{Name: "InitWeights", Doc: "InitWeights initializes the weight values in the network, i.e., resetting learning\nAlso calls InitActs", Directives: []types.Directive{{Tool: "types", Directive: "add"}}, Args: []string{"ctx", "nt"}}, {Name: "InitActs", Doc: "InitActs fully initializes activation state -- only called automatically during InitWeights", Directives: []types.Directive{{Tool: "types", Directive: "add"}}, Args: []string{"ctx"}}, {Name: "Defaults", Directives: []types.Directive{{Tool: "types", Directive: "add"}}}, {Name: "UnLesionNeurons", Doc: "UnLesionNeurons unlesions (clears the Off flag) for all neurons in the layer", Directives: []types.Directive{{Tool: "types", Directive: "add"}}}, {Name: "LesionNeurons", Doc: "LesionNeurons lesions (sets the Off flag) for given proportion (0-1) of neurons in layer\nreturns number of neurons lesioned.  Emits error if prop > 1 as indication that percent\nmight have been passed", Directives: []types.Directive{{Tool: "types", Directive: "add"}}, Args: []string{"prop"}, Returns: []string{"int"}}}, Embeds: []types.Field{{Name: "LayerBase"}}, Fields: []types.Field{{Name: "Params", Doc: "Params are layer parameters (pointer to item in Network.LayerParams)."}, {Name: "Network", Doc: "our parent network, in case we need to use it to find\nother layers etc; set when added by network."}, {Name: "Type", Doc: "Type is the type of layer, which drives specialized computation as needed."}, {Name: "NNeurons", Doc: "NNeurons is the number of neurons in the layer."}, {Name: "NeurStIndex", Doc: "NeurStIndex is the starting index of neurons for this layer within\nthe global Network list."}, {Name: "NPools", Doc: "NPools is the number of inhibitory pools based on layer shape,\nwith the first one representing the entire set of neurons in the layer,\nand 4D shaped layers have sub-pools after that."}, {Name: "MaxData", Doc: "MaxData is the maximum amount of input data that can be processed in\nparallel in one pass of the network (copied from [NetworkIndexes]).\nNeuron, Pool, Values storage is allocated to hold this amount."}, {Name: "RecvPaths", Doc: "RecvPaths is the list of receiving pathways into this layer from other layers."}, {Name: "SendPaths", Doc: "SendPaths is the list of sending pathways from this layer to other layers."}, {Name: "BuildConfig", Doc: "BuildConfig has configuration data set when the network is configured,\nthat is used during the network Build() process via PostBuild method,\nafter all the structure of the network has been fully constructed.\nIn particular, the Params is nil until Build, so setting anything\nspecific in there (e.g., an index to another layer) must be done\nas a second pass.  Note that Params are all applied after Build\nand can set user-modifiable params, so this is for more special\nalgorithm structural parameters set during ConfigNet() methods."}, {Name: "DefaultParams", Doc: "DefaultParams are closures that apply default parameters\nprior to user-set parameters. These are useful for specific layer\nfunctionality in specialized brain areas (e.g., Rubicon, BG etc)\nnot associated with a layer type, which otherwise is used to hard-code\ninitial default parameters."}}})
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.Layer", IDName: "layer", Doc: "Layer implements the basic Axon spiking activation function,\nand manages learning in the pathways.", Methods: []types.Method{{Name: "InitWeights", Doc: "InitWeights initializes the weight values in the network, i.e., resetting learning\nAlso calls InitActs", Directives: []types.Directive{{Tool: "types", Directive: "add"}}, Args: []string{"ctx", "nt"}}, {Name: "InitActs", Doc: "InitActs fully initializes activation state -- only called automatically during InitWeights", Directives: []types.Directive{{Tool: "types", Directive: "add"}}, Args: []string{"ctx"}}, {Name: "Defaults", Directives: []types.Directive{{Tool: "types", Directive: "add"}}}, {Name: "UnLesionNeurons", Doc: "UnLesionNeurons unlesions (clears the Off flag) for all neurons in the layer", Directives: []types.Directive{{Tool: "types", Directive: "add"}}}, {Name: "LesionNeurons", Doc: "LesionNeurons lesions (sets the Off flag) for given proportion (0-1) of neurons in layer\nreturns number of neurons lesioned.  Emits error if prop > 1 as indication that percent\nmight have been passed", Directives: []types.Directive{{Tool: "types", Directive: "add"}}, Args: []string{"prop"}, Returns: []string{"int"}}}, Embeds: []types.Field{{Name: "LayerBase"}}, Fields: []types.Field{{Name: "Params", Doc: "Params are layer parameters (pointer to item in Network.LayerParams)."}, {Name: "Network", Doc: "our parent network, in case we need to use it to find\nother layers etc; set when added by network."}, {Name: "Type", Doc: "Type is the type of layer, which drives specialized computation as needed."}, {Name: "NNeurons", Doc: "NNeurons is the number of neurons in the layer."}, {Name: "NeurStIndex", Doc: "NeurStIndex is the starting index of neurons for this layer within\nthe global Network list."}, {Name: "NPools", Doc: "NPools is the number of inhibitory pools based on layer shape,\nwith the first one representing the entire set of neurons in the layer,\nand 4D shaped layers have sub-pools after that."}, {Name: "MaxData", Doc: "MaxData is the maximum amount of input data that can be processed in\nparallel in one pass of the network (copied from [NetworkIndexes]).\nNeuron, Pool, Values storage is allocated to hold this amount."}, {Name: "DendComps", Doc: "DendComps is the number of dendritic compartments per neuron, which must\nbe set prior to [Network.Build]. The first compartment is always the\nstandard VmDend, so values <= 1 produce the usual single dendrite.\nAdditional compartments are chained in sequence from proximal to distal\n(e.g., basal, apical trunk, apical tuft), coupled by [DendParams.GAxial]\nconductances, and each computes its own NMDA and VGCC channels.\nPathways target a specific compartment via [PathParams.DendComp]."}, {Name: "RecvPaths", Doc: "RecvPaths is the list of receiving pathways into this layer from other layers."}, {Name: "SendPaths", Doc: "SendPaths is the list of sending pathways from this layer to other layers."}, {Name: "BuildConfig", Doc: "BuildConfig has configuration data set when the network is configured,\nthat is used during the network Build() process via PostBuild method,\nafter all the structure of the network has been fully constructed.\nIn particular, the Params is nil until Build, so setting anything\nspecific in there (e.g., an index to another layer) must be done\nas a second pass.  Note that Params are all applied after Build\nand can set user-modifiable params, so this is for more special\nalgorithm structural parameters set during ConfigNet() methods."}, {Name: "DefaultParams", Doc: "DefaultParams are closures that apply default parameters\nprior to user-set parameters. These are useful for specific layer\nfunctionality in specialized brain areas (e.g., Rubicon, BG etc)\nnot associated with a layer type, which otherwise is used to hard-code\ninitial default parameters."}}})
 
-var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.LayerIndexes", IDName: "layer-indexes", Doc: "LayerIndexes contains index access into network global arrays for GPU.", Directives: []types.Directive{{Tool: "gosl", Directive: "start"}}, Fields: []types.Field{{Name: "NPools", Doc: "NPools is the total number of pools for this layer, including layer-wide."}, {Name: "NeurSt", Doc: "start of neurons for this layer in global array (same as Layer.NeurStIndex)"}, {Name: "NNeurons", Doc: "number of neurons in layer"}, {Name: "RecvSt", Doc: "start index into RecvPaths global array"}, {Name: "RecvN", Doc: "number of recv pathways"}, {Name: "SendSt", Doc: "start index into RecvPaths global array"}, {Name: "SendN", Doc: "number of recv pathways"}, {Name: "ExtsSt", Doc: "starting neuron index in global Exts list of external input for this layer.\nOnly for Input / Target / Compare layer types"}, {Name: "ShpPlY", Doc: "layer shape Pools Y dimension -- 1 for 2D"}, {Name: "ShpPlX", Doc: "layer shape Pools X dimension -- 1 for 2D"}, {Name: "ShpUnY", Doc: "layer shape Units Y dimension"}, {Name: "ShpUnX", Doc: "layer shape Units X dimension"}}})
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.LayerIndexes", IDName: "layer-indexes", Doc: "LayerIndexes contains index access into network global arrays for GPU.", Directives: []types.Directive{{Tool: "gosl", Directive: "start"}}, Fields: []types.Field{{Name: "NPools", Doc: "NPools is the total number of pools for this layer, including layer-wide."}, {Name: "NeurSt", Doc: "start of neurons for this layer in global array (same as Layer.NeurStIndex)"}, {Name: "NNeurons", Doc: "number of neurons in layer"}, {Name: "RecvSt", Doc: "start index into RecvPaths global array"}, {Name: "RecvN", Doc: "number of recv pathways"}, {Name: "SendSt", Doc: "start index into RecvPaths global array"}, {Name: "SendN", Doc: "number of recv pathways"}, {Name: "ExtsSt", Doc: "starting neuron index in global Exts list of external input for this layer.\nOnly for Input / Target / Compare layer types"}, {Name: "ShpPlY", Doc: "layer shape Pools Y dimension -- 1 for 2D"}, {Name: "ShpPlX", Doc: "layer shape Pools X dimension -- 1 for 2D"}, {Name: "ShpUnY", Doc: "layer shape Units Y dimension"}, {Name: "ShpUnX", Doc: "layer shape Units X dimension"}, {Name: "DendSt", Doc: "DendSt is the starting index in the global Dendrites list of\nadditional dendritic compartments for this layer."}, {Name: "DendN", Doc: "DendN is the number of additional dendritic compartments per neuron,\nbeyond the first VmDend compartment: [Layer.DendComps] - 1."}, {Name: "pad"}, {Name: "pad1"}}})
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.LayerInhibIndexes", IDName: "layer-inhib-indexes", Doc: "LayerInhibIndexes contains indexes of layers for between-layer inhibition.", Fields: []types.Field{{Name: "Index1", Doc: "idx of Layer to get layer-level inhibition from -- set during Build from BuildConfig LayInhib1Name if present -- -1 if not used"}, {Name: "Index2", Doc: "idx of Layer to get layer-level inhibition from -- set during Build from BuildConfig LayInhib2Name if present -- -1 if not used"}, {Name: "Index3", Doc: "idx of Layer to get layer-level inhibition from -- set during Build from BuildConfig LayInhib3Name if present -- -1 if not used"}, {Name: "Index4", Doc: "idx of Layer to geta layer-level inhibition from -- set during Build from BuildConfig LayInhib4Name if present -- -1 if not used"}}})
 
-var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.LayerParams", IDName: "layer-params", Doc: "LayerParams contains all of the layer parameters.\nThese values must remain constant over the course of computation.\nOn the GPU, they are loaded into a read-only data storage buffer.", Fields: []types.Field{{Name: "Type", Doc: "Type is the functional type of layer, which determines the code path\nfor specialized layer types, and is synchronized with [Layer.Type]."}, {Name: "Index", Doc: "Index of this layer in [Layers] list."}, {Name: "MaxData", Doc: "MaxData is the maximum number of data parallel elements."}, {Name: "PoolSt", Doc: "PoolSt is the start of pools for this layer; first one is always the layer-wide pool."}, {Name: "Acts", Doc: "Activation parameters and methods for computing activations"}, {Name: "Inhib", Doc: "Inhibition parameters and methods for computing layer-level inhibition"}, {Name: "LayInhib", Doc: "LayInhib has indexes of layers that contribute between-layer inhibition\nto this layer. Set these indexes via BuildConfig LayInhibXName (X = 1, 2...)."}, {Name: "Learn", Doc: "Learn has learning parameters and methods that operate at the neuron level."}, {Name: "Bursts", Doc: "Bursts has [BurstParams] that determine how the 5IB Burst activation\nis computed from CaP integrated spiking values in Super layers."}, {Name: "CT", Doc: "CT has params for the CT corticothalamic layer and PTPred layer that\ngenerates predictions over the Pulvinar using context. Uses the CtxtGe\nexcitatory input plus stronger NMDA channels to maintain context trace."}, {Name: "Pulvinar", Doc: "Pulvinar has parameters for how the plus-phase (outcome) state of Pulvinar\nthalamic relay cell neurons is computed from the corresponding driver\nneuron Burst activation (or CaP if not Super)."}, {Name: "DSMatrix", Doc: "DSMatrixParams has parameters for dorsal Matrix layers, for SPN / MSN\ndirect and indirect pathways."}, {Name: "Striatum", Doc: "Striatum has params and indexes for striatum layers: DSMatrix, VSMatrix, DSPatch."}, {Name: "GP", Doc: "GP has params for GP (globus pallidus) of the BG layers."}, {Name: "IO", Doc: "IOParams has parameters for the IO inferior olive neurons,\nwhich compute a temporal offset error signal between CNiIO inhibitory\npredictions and excitatory sensory input, contingent on initial\nabove-threshold efferent copy motor trigger input (modulatory)."}, {Name: "Nuclear", Doc: "Nuclear has parameters for learning in the cerebellum, according\nto the Nuclear model (not just nucleus neurons)."}, {Name: "LDT", Doc: "LDT has parameters for laterodorsal tegmentum ACh salience neuromodulatory\nsignal, driven by superior colliculus stimulus novelty, US input / absence,\nand OFC / ACC inhibition."}, {Name: "VTA", Doc: "VTA has parameters for ventral tegmental area dopamine (DA) based on\nLHb PVDA (primary value -- at US time, computed at start of each trial\nand stored in LHbPVDA global value) and Amygdala (CeM) CS / learned\nvalue (LV) activations, which update every cycle."}, {Name: "RWPred", Doc: "RWPred has parameters for reward prediction using a simple Rescorla-Wagner\nlearning rule (i.e., PV learning in the Rubicon framework)."}, {Name: "RWDa", Doc: "RWDa has parameters for reward prediction dopamine using a simple\nRescorla-Wagner learning rule (i.e., PV learning in the Rubicon framework)."}, {Name: "TDInteg", Doc: "TDInteg has parameters for temporal differences (TD) reward integration layer."}, {Name: "TDDa", Doc: "TDDa has parameters for dopamine (DA) signal as the temporal difference\n(TD) between the TDIntegLayer activations in the minus and plus phase."}, {Name: "Indexes", Doc: "Indexes has recv and send pathway array access info."}}})
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.LayerParams", IDName: "layer-params", Doc: "LayerParams contains all of the layer parameters.\nThese values must remain constant over the course of computation.\nOn the GPU, they are loaded into a read-only data storage buffer.", Fields: []types.Field{{Name: "Type", Doc: "Type is the functional type of layer, which determines the code path\nfor specialized layer types, and is synchronized with [Layer.Type]."}, {Name: "Index", Doc: "Index of this layer in [Layers] list."}, {Name: "MaxData", Doc: "MaxData is the maximum number of data parallel elements."}, {Name: "PoolSt", Doc: "PoolSt is the start of pools for this layer; first one is always the layer-wide pool."}, {Name: "Acts", Doc: "Activation parameters and methods for computing activations"}, {Name: "Inhib", Doc: "Inhibition parameters and methods for computing layer-level inhibition"}, {Name: "LayInhib", Doc: "LayInhib has indexes of layers that contribute between-layer inhibition\nto this layer. Set these indexes via BuildConfig LayInhibXName (X = 1, 2...)."}, {Name: "Learn", Doc: "Learn has learning parameters and methods that operate at the neuron level."}, {Name: "Bursts", Doc: "Bursts has [BurstParams] that determine how the 5IB Burst activation\nis computed from CaP integrated spiking values in Super layers."}, {Name: "CT", Doc: "CT has params for the CT corticothalamic layer and PTPred layer that\ngenerates predictions over the Pulvinar using context. Uses the CtxtGe\nexcitatory input plus stronger NMDA channels to maintain context trace."}, {Name: "Pulvinar", Doc: "Pulvinar has parameters for how the plus-phase (outcome) state of Pulvinar\nthalamic relay cell neurons is computed from the corresponding driver\nneuron Burst activation (or CaP if not Super)."}, {Name: "DSMatrix", Doc: "DSMatrixParams has parameters for dorsal Matrix layers, for SPN / MSN\ndirect and indirect pathways."}, {Name: "Striatum", Doc: "Striatum has params and indexes for striatum layers: DSMatrix, VSMatrix, DSPatch."}, {Name: "GP", Doc: "GP has params for GP (globus pallidus) of the BG layers."}, {Name: "IO", Doc: "IOParams has parameters for the IO inferior olive neurons,\nwhich compute a temporal offset error signal between CNiIO inhibitory\npredictions and excitatory sensory input, contingent on initial\nabove-threshold efferent copy motor trigger input (modulatory)."}, {Name: "Nuclear", Doc: "Nuclear has parameters for learning in the cerebellum, according\nto the Nuclear model (not just nucleus neurons)."}, {Name: "LDT", Doc: "LDT has parameters for laterodorsal tegmentum ACh salience neuromodulatory\nsignal, driven by superior colliculus stimulus novelty, US input / absence,\nand OFC / ACC inhibition."}, {Name: "VTA", Doc: "VTA has parameters for ventral tegmental area dopamine (DA) based on\nLHb PVDA (primary value -- at US time, computed at start of each trial\nand stored in LHbPVDA global value) and Amygdala (CeM) CS / learned\nvalue (LV) activations, which update every cycle."}, {Name: "LC", Doc: "LC has parameters for locus coeruleus norepinephrine (NE) based on\nsurprise and uncertainty."}, {Name: "DRN", Doc: "DRN has parameters for dorsal raphe nucleus serotonin (Ser) based on\naversive outcomes and patient waiting for expected rewards."}, {Name: "RWPred", Doc: "RWPred has parameters for reward prediction using a simple Rescorla-Wagner\nlearning rule (i.e., PV learning in the Rubicon framework)."}, {Name: "RWDa", Doc: "RWDa has parameters for reward prediction dopamine using a simple\nRescorla-Wagner learning rule (i.e., PV learning in the Rubicon framework)."}, {Name: "TDInteg", Doc: "TDInteg has parameters for temporal differences (TD) reward integration layer."}, {Name: "TDDa", Doc: "TDDa has parameters for dopamine (DA) signal as the temporal difference\n(TD) between the TDIntegLayer activations in the minus and plus phase."}, {Name: "Indexes", Doc: "Indexes has recv and send pathway array access info."}}})
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.LayerTypes", IDName: "layer-types", Doc: "LayerTypes enumerates all the different types of layers,\nfor the different algorithm types supported.\nClass parameter styles automatically key off of these types."})
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.LayerVars", IDName: "layer-vars", Doc: "LayerVars are layer-level state values."})
 
-var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.LearnCaParams", IDName: "learn-ca-params", Doc: "LearnCaParams parameterizes the neuron-level calcium signals driving learning:\nLearnCa = NMDA + VGCC Ca sources, where VGCC can be simulated from spiking or\nuse the more complex and dynamaic VGCC channel directly.\nLearnCa is then integrated in a cascading manner at multiple time scales:\nCaM (as in calmodulin), CaP (ltP, CaMKII, plus phase), CaD (ltD, DAPK1, minus phase).", Directives: []types.Directive{{Tool: "gosl", Directive: "start"}, {Tool: "gosl", Directive: "import", Args: []string{"github.com/emer/axon/v2/kinase"}}}, Fields: []types.Field{{Name: "Norm", Doc: "Norm is the denominator used for normalizing [LearnCa], so the\nmax is roughly 1 - 1.5 or so, which works best in terms of previous\nstandard learning rules, and overall learning performance."}, {Name: "SpikeVGCC", Doc: "SpikeVGCC uses spikes to generate VGCC instead of actual VGCC current.\nSee SpikeVGCCa for calcium contribution from each spike."}, {Name: "SpikeVgccCa", Doc: "SpikeVgccCa is the multiplier on spike for computing Ca contribution\nto [LearnCa], in SpikeVGCC mode."}, {Name: "VgccTau", Doc: "VgccTau is the time constant of decay for VgccCa calcium.\nIt is highly transient around spikes, so decay and diffusion\nfactors are more important than for long-lasting NMDA factor.\nVgccCa is integrated separately in [VgccCaInt] prior to adding\ninto NMDA Ca in [LearnCa]."}, {Name: "PosBias", Doc: "PosBias is a multiplier on [LearnCaP] in computing [CaDiff] that drives learning.\nIn some rare cases this can be useful in adjusting overall weight dynamics."}, {Name: "ETraceTau", Doc: "ETraceTau is the time constant for integrating an eligibility trace factor,\nwhich computes an exponential integrator of local neuron-wise error gradients."}, {Name: "ETraceScale", Doc: "ETraceScale multiplies the contribution of the ETrace to learning, determining\nthe strength of its effect. This is definitely beneficial in cases that can\nbenefit from longer traces, such as the deep music sim.\nWhere beneficial, 0.1 or so is a useful value."}, {Name: "pad"}, {Name: "Dt", Doc: "Dt are time constants for integrating [LearnCa] across\nM, P and D cascading levels."}, {Name: "VgccDt", Doc: "VgccDt rate = 1 / tau"}, {Name: "ETraceDt", Doc: "ETraceDt rate = 1 / tau"}, {Name: "NormInv", Doc: "NormInv = 1 / Norm"}, {Name: "pad2"}}})
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.LearnCaParams", IDName: "learn-ca-params", Doc: "LearnCaParams parameterizes the neuron-level calcium signals driving learning:\nLearnCa = NMDA + VGCC Ca sources, where VGCC can be simulated from spiking or\nuse the more complex and dynamaic VGCC channel directly.\nCa from the CaT T-type channel, if active, is integrated along with VGCC.\nLearnCa is then integrated in a cascading manner at multiple time scales:\nCaM (as in calmodulin), CaP (ltP, CaMKII, plus phase), CaD (ltD, DAPK1, minus phase).", Directives: []types.Directive{{Tool: "gosl", Directive: "start"}, {Tool: "gosl", Directive: "import", Args: []string{"\"github.com/emer/axon/v2/kinase\""}}}, Fields: []types.Field{{Name: "Norm", Doc: "Norm is the denominator used for normalizing [LearnCa], so the\nmax is roughly 1 - 1.5 or so, which works best in terms of previous\nstandard learning rules, and overall learning performance."}, {Name: "SpikeVGCC", Doc: "SpikeVGCC uses spikes to generate VGCC instead of actual VGCC current.\nSee SpikeVGCCa for calcium contribution from each spike."}, {Name: "SpikeVgccCa", Doc: "SpikeVgccCa is the multiplier on spike for computing Ca contribution\nto [LearnCa], in SpikeVGCC mode."}, {Name: "VgccTau", Doc: "VgccTau is the time constant of decay for VgccCa calcium.\nIt is highly transient around spikes, so decay and diffusion\nfactors are more important than for long-lasting NMDA factor.\nVgccCa is integrated separately in [VgccCaInt] prior to adding\ninto NMDA Ca in [LearnCa]."}, {Name: "PosBias", Doc: "PosBias is a multiplier on [LearnCaP] in computing [CaDiff] that drives learning.\nIn some rare cases this can be useful in adjusting overall weight dynamics."}, {Name: "ETraceTau", Doc: "ETraceTau is the time constant for integrating an eligibility trace factor,\nwhich computes an exponential integrator of local neuron-wise error gradients."}, {Name: "ETraceScale", Doc: "ETraceScale multiplies the contribution of the ETrace to learning, determining\nthe strength of its effect. This is definitely beneficial in cases that can\nbenefit from longer traces, such as the deep music sim.\nWhere beneficial, 0.1 or so is a useful value."}, {Name: "pad"}, {Name: "Dt", Doc: "Dt are time constants for integrating [LearnCa] across\nM, P and D cascading levels."}, {Name: "VgccDt", Doc: "VgccDt rate = 1 / tau"}, {Name: "ETraceDt", Doc: "ETraceDt rate = 1 / tau"}, {Name: "NormInv", Doc: "NormInv = 1 / Norm"}, {Name: "pad2"}}})
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.LearnTimingParams", IDName: "learn-timing-params", Doc: "LearnTimingParams parameterizes the timing of Ca-driven Kinase\nalgorithm learning, based on detecting the first major peak of\ndifferential fast - slow activity associated with the start of\nthe minus phases: [MinusPeak]. Learning is enabled if CaD is above\nthreshold a given number of Cycles (ms) after that peak.\nLearning can actually occur a given number of cycles after that,\nor at a negative offset from the _subsequent_ minus peak, which\nshould follow the subsequent plus phase, and thus work better in\nmore variable timing contexts.", Fields: []types.Field{{Name: "SynCaCycles", Doc: "SynCaCycles is the number of cycles over which to integrate the synaptic\npre * post calcium trace, which provides the credit assignment factor.\nMust be a multiple of NeuronTraceCycles (10). Used for all learning (timed or not)."}, {Name: "LearnThr", Doc: "LearnThr is the threshold on CaD to be eligible for learning.\nApplies to non-timing based learning too."}, {Name: "On", Doc: "On indicates whether to use the timing parameters to drive\nlearning timing, or instead just learn at the end of the trial\nautomatically."}, {Name: "Refractory", Doc: "Refractory makes new learning depend on dropping below the learning\nthreshold. Applies only to timing based learning."}, {Name: "EnableAtEnd", Doc: "EnableAtEnd indicates that the enabled determination happens only at\nthe end of the EnableWindow, otherwise it can happen at any point.\nThis is generally beneficial but not for deep layers such as PFC."}, {Name: "MinusWindow", Doc: "MinusWindow is the number of cycles (ms) for the minus phase\npeak-finding window: the highest [TimeDiff] value within this window\nbecomes the minus-phase peak, setting [MinusPeak] and [MinusCycles],\nand starting the window for [EnableCycles] to detect if the CaD is above\nthreshold. Generally keep this at default and adjust LearnCycles for longer\nor shorter windows."}, {Name: "NUps", Doc: "NUps is the number of successive (per MaxUpGap) increments in peak value\nrequired to detect a minus peak. This presumes a reasonably slow integration\nprocess so the curve is spread out a bit, but it does a good job of filtering\nmore transient blips. Set to 0 to disable, which is needed for deeper layers\nwith more persistent activity."}, {Name: "MaxUpGap", Doc: "MaxUpGap is the maximum gap in cycles between successive increments\nin peak value (per NUps). If longer than this, then the up counter\nis reset, and the peak values start to decay."}, {Name: "EnableWindow", Doc: "EnableWindow is the number of cycles (ms) relative to the minus phase\npeak, [MinusCycles], to check if the CaD level gets above the LearnThr\nthreshold, at which point [EnableCycles] is set. The default of 40 is\nis typically best."}, {Name: "LearnCycles", Doc: "LearnCycles is the time offset in cycles (ms) for when learning occurs.\nIf >= 0, then it is relative to the [MinusWindow] time.\nOtherwise it is relative to the [MinusCycles] peak time, if there was a\nprior [Enabled] event, which is then saved into [EnabledPrev], thus\ngoing back to the plus phase before the start of the current minus phase."}, {Name: "TimeDiffTau", Doc: "Time constant for integrating [TimeDiff] as the absolute value of\nCaDiff integrated over time to smooth out significant local bumps."}, {Name: "TimeDiffDt", Doc: "Dt is 1/Tau"}}})
 
@@ -196,7 +220,11 @@ var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.SWtInitPar
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.SWtAdaptParams", IDName: "s-wt-adapt-params", Doc: "SWtAdaptParams manages adaptation of the [SWt] (slow, structural weight) values.", Fields: []types.Field{{Name: "On", Doc: "On enables adaptation of [SWt] values at a slower time scale. If false, SWt\nvalues are not updated, in which case it is generally good to set Init.SPct=0 too."}, {Name: "LRate", Doc: "LRate is the learning rate multiplier on the accumulated [DWt] values\n(which already have fast LRate applied), to drive updating of [SWt]\nduring slow outer loop updating. Lower values impose stronger constraints,\nfor larger networks that need more structural support, e.g., 0.001 is better\nafter 1,000 epochs in large models. 0.1 is fine for smaller models."}, {Name: "SubMean", Doc: "SubMean is the amount of the mean to subtract from [SWt] delta when updating,\nto impose a zero-sum constraint on overall structural weight strengths.\nGenerally best to set to 1. There is a separate SubMean factor for [LWt]."}, {Name: "HiMeanDecay", Doc: "HiMeanDecay specifies a decay factor applied across all [LWt] weights\nin proportion to the deviation of the average effective weight value [Wt]\nabove the HiMeanThr threshold. This is applied at the slow learning interval\nand should be very slow, for counteracting a gradual accumulation in overall\nweights that can occur even with SubMean factors (which only operate on weights\nthat are actually changing on the current trial)."}, {Name: "HiMeanThr", Doc: "HiMeanThr specifies a decay factor applied across all [LWt] weights\nin proportion to the deviation of the average effective weight value [Wt]\naway from SWt.Init.Mean. This is applied at the slow learning interval\nand should be very slow, for counteracting a gradual accumulation in overall\nweights that can occur even with SubMean factors, which only operate on weights\nthat are actually changing on the current trial."}, {Name: "SigGain", Doc: "SigGain is the gain of the sigmoidal constrast enhancement function\nused to transform learned, linear [LWt] values into [Wt] values.\nThis is critical to offset the damping effect of exponential soft bounding,\nbut some special cases with different learning rules may benefit by making\nthis linear (1) instead."}, {Name: "pad"}, {Name: "pad1"}}})
 
-var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.SWtParams", IDName: "s-wt-params", Doc: "SWtParams manages structural, slowly adapting weight values [SWt],\nin terms of initialization and updating over course of learning.\nSWts impose initial and slowly adapting constraints on neuron connectivity\nto encourage differentiation of neuron representations and overall good behavior\nin terms of not hogging the representational space.\nThe [TrgAvg] activity constraint is not enforced through SWt: it needs to be\nmore dynamic and is supported by the regular learned weights [LWt].", Fields: []types.Field{{Name: "Init", Doc: "Init controls the initialization of [SWt] values."}, {Name: "Adapt", Doc: "Adapt controls adaptation of [SWt] values in response to [LWt] learning."}, {Name: "Limit", Doc: "Limit limits the range of [SWt] values, so that they do not fully\ndetermine the effective overall weight value."}}})
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.WtBoundModes", IDName: "wt-bound-modes", Doc: "WtBoundModes are the weight-dependence modes for learning in the\nlinear [LWt] weights, used in [WtBoundParams]."})
+
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.WtBoundParams", IDName: "wt-bound-params", Doc: "WtBoundParams are the weight bounds and weight-dependence mode for\nlearning in the linear [LWt] weights.", Fields: []types.Field{{Name: "Mode", Doc: "Mode is the weight-dependence mode for learning."}, {Name: "MuPlus", Doc: "MuPlus is the exponent on the distance to the Max bound for\nweight increases in MultBound mode."}, {Name: "MuMinus", Doc: "MuMinus is the exponent on the distance to the Min bound for\nweight decreases in MultBound mode."}, {Name: "Ref", Doc: "Ref is the normalized weight value (0-1 between Min and Max)\nwhere weight increases and decreases balance in LogNormBound mode,\nwhich is the center of the resulting weight distribution."}, {Name: "Min", Doc: "Min is the lower bound on [LWt] values. Must be >= 0."}, {Name: "Max", Doc: "Max is the upper bound on [LWt] values. Must be <= 1."}, {Name: "pad"}, {Name: "pad1"}}})
+
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.SWtParams", IDName: "s-wt-params", Doc: "SWtParams manages structural, slowly adapting weight values [SWt],\nin terms of initialization and updating over course of learning.\nSWts impose initial and slowly adapting constraints on neuron connectivity\nto encourage differentiation of neuron representations and overall good behavior\nin terms of not hogging the representational space.\nThe [TrgAvg] activity constraint is not enforced through SWt: it needs to be\nmore dynamic and is supported by the regular learned weights [LWt].", Fields: []types.Field{{Name: "Init", Doc: "Init controls the initialization of [SWt] values."}, {Name: "Adapt", Doc: "Adapt controls adaptation of [SWt] values in response to [LWt] learning."}, {Name: "Limit", Doc: "Limit limits the range of [SWt] values, so that they do not fully\ndetermine the effective overall weight value."}, {Name: "Bound", Doc: "Bound has the bounds and weight-dependence mode for learning\nin the linear [LWt] values."}}})
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.LRateParams", IDName: "l-rate-params", Doc: "LRateParams manages learning rate parameters for scaling [DWt] delta\nweight values that then update [LWt] online learned weights.\nIt has two optional modulation factors on top of a Base learning rate.", Directives: []types.Directive{{Tool: "gosl", Directive: "start"}}, Fields: []types.Field{{Name: "Base", Doc: "Base learning rate for this pathway, which can be modulated\nby the other factors below. Generally larger networks use slower rates."}, {Name: "Sched", Doc: "Sched is a scheduled learning rate multiplier, simulating reduction\nin plasticity over aging. Use the [Network.LRateSched] method to apply\na given value to all pathways in the network."}, {Name: "Mod", Doc: "Mod is a dynamic learning rate modulation factor, typically driven by\nneuromodulation (e.g., dopamine)."}, {Name: "Eff", Doc: "Eff is the net effective actual learning rate multiplier used in\ncomputing [DWt]: Eff = Mod * Sched * Base"}}})
 
@@ -204,7 +232,7 @@ var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.DWtParams"
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.HebbParams", IDName: "hebb-params", Doc: "HebbParams for optional hebbian learning that replaces the\ndefault learning rule, based on S = sending activity,\nR = receiving activity", Fields: []types.Field{{Name: "On", Doc: "On turns on the use of the Hebbian learning rule instead of the default."}, {Name: "Up", Doc: "Up is the strength multiplier for hebbian increases, based on R * S * (1-LWt)."}, {Name: "Down", Doc: "Down is the strength multiplier for hebbian decreases, based on R * (1 - S) * LWt."}, {Name: "pad"}}})
 
-var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.LearnSynParams", IDName: "learn-syn-params", Doc: "LearnSynParams manages learning-related parameters at the synapse-level.", Fields: []types.Field{{Name: "Learn", Doc: "Learn enables learning for this pathway."}, {Name: "pad"}, {Name: "pad1"}, {Name: "pad2"}, {Name: "LRate", Doc: "LRateParams manages learning rate parameters for scaling [DWt] delta\nweight values that then update [LWt] online learned weights.\nIt has two optional modulation factors on top of a Base learning rate."}, {Name: "DWt", Doc: "DWtParams has misc parameters for computing weight changes ([DWt]) for the default\ntrace-based cortical learning rule and for other specialized learning rules."}, {Name: "Hebb", Doc: "hebbian learning option, which overrides the default learning rules"}}})
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.LearnSynParams", IDName: "learn-syn-params", Doc: "LearnSynParams manages learning-related parameters at the synapse-level.", Fields: []types.Field{{Name: "Learn", Doc: "Learn enables learning for this pathway."}, {Name: "pad"}, {Name: "pad1"}, {Name: "pad2"}, {Name: "LRate", Doc: "LRateParams manages learning rate parameters for scaling [DWt] delta\nweight values that then update [LWt] online learned weights.\nIt has two optional modulation factors on top of a Base learning rate."}, {Name: "DWt", Doc: "DWtParams has misc parameters for computing weight changes ([DWt]) for the default\ntrace-based cortical learning rule and for other specialized learning rules."}, {Name: "Hebb", Doc: "hebbian learning option, which overrides the default learning rules"}, {Name: "STDP", Doc: "spike-timing-dependent plasticity option, which overrides the default learning rules"}, {Name: "Consol", Doc: "weight consolidation option, which protects weights that were\nimportant for previously learned tasks"}, {Name: "ThreeFactor", Doc: "three-factor learning option, which overrides the default learning rules\nwith an eligibility trace modulated by a global or layer-level factor"}}})
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.LRateMod", IDName: "l-rate-mod", Doc: "LRateMod implements global learning rate modulation, based on a performance-based\nfactor, for example error. Increasing levels of the factor = higher learning rate.\nThis can be added to a Sim and called prior to DWt() to dynamically change lrate\nbased on overall network performance. It is not used by default in the standard params.", Directives: []types.Directive{{Tool: "gosl", Directive: "end"}}, Fields: []types.Field{{Name: "On", Doc: "toggle use of this modulation factor"}, {Name: "Base", Doc: "baseline learning rate -- what you get for correct cases"}, {Name: "pad"}, {Name: "pad1"}, {Name: "Range", Doc: "defines the range over which modulation occurs for the modulator factor -- Min and below get the Base level of learning rate modulation, Max and above get a modulation of 1"}}})
 
@@ -212,15 +240,19 @@ var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.ViewTimes"
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.NetViewUpdate", IDName: "net-view-update", Doc: "NetViewUpdate manages time scales for updating the NetView.\nUse one of these for each mode you want to control separately.", Fields: []types.Field{{Name: "On", Doc: "On toggles update of display on"}, {Name: "Time", Doc: "Time scale to update the network view (Cycle to Trial timescales)."}, {Name: "CounterFunc", Doc: "CounterFunc returns the counter string showing current counters etc."}, {Name: "View", Doc: "View is the network view."}}})
 
-var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.NetworkIndexes", IDName: "network-indexes", Doc: "NetworkIndexes are indexes and sizes for processing network.", Directives: []types.Directive{{Tool: "gosl", Directive: "start"}}, Fields: []types.Field{{Name: "MaxData", Doc: "MaxData is the maximum number of data inputs that can be processed\nin parallel in one pass of the network.\nNeuron storage is allocated to hold this amount during\nBuild process, and this value reflects that."}, {Name: "MaxDelay", Doc: "MaxDelay is the maximum synaptic delay across all pathways at the time of\n[Network.Build]. This determines the size of the spike sending delay buffers."}, {Name: "NNeuronTraces", Doc: "NNeuronTraces is the total number of [NeuronTraces] in the neuron state variables.\nSet to Context.NNeuronTraces() in Build."}, {Name: "NNeuronTraceBins", Doc: "NNeuronTraceBins is the total number of [NeuronTraces] in the neuron state variables,\nper trace variable. Set to Context.NNeuronTraceBins() in Build."}, {Name: "NLayers", Doc: "NLayers is the number of layers in the network."}, {Name: "NNeurons", Doc: "NNeurons is the total number of neurons."}, {Name: "NPools", Doc: "NPools is the total number of pools."}, {Name: "NPaths", Doc: "NPaths is the total number of paths."}, {Name: "NSyns", Doc: "NSyns is the total number of synapses."}, {Name: "RubiconNPosUSs", Doc: "RubiconNPosUSs is the total number of Rubicon Drives / positive USs."}, {Name: "RubiconNCosts", Doc: "RubiconNCosts is the total number of Rubicon Costs."}, {Name: "RubiconNNegUSs", Doc: "RubiconNNegUSs is the total number of .Rubicon Negative USs."}}})
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.LRateSchedTypes", IDName: "l-rate-sched-types", Doc: "LRateSchedTypes are the types of learning rate schedule\ncomputed by [LRateSchedule]."})
+
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.LRateSchedule", IDName: "l-rate-schedule", Doc: "LRateSchedule is a declarative learning rate schedule, which sets the\n[LRateParams] Sched multiplier of the pathways selected by Sel and LayerSel,\nusing the same selector syntax as params sheets (.Class, #Name, or a Type\nname that matches all). Schedules are added to [Network.LRateScheds],\nand are driven by the training epoch (and optionally trial) counters\nvia [LooperLRateSched]. If multiple schedules select the same pathway,\ntheir values are multiplied. All schedules have an optional initial\nWarmup period, during which the learning rate ramps up linearly from\nWarmupStart, after which the decay of the schedule starts.", Fields: []types.Field{{Name: "On", Doc: "On enables this schedule."}, {Name: "Name", Doc: "Name of this schedule, which is used for its stat (LRate_Name)."}, {Name: "Sel", Doc: "Sel is the params selector for the pathways that this schedule\napplies to: .Class, #Name, or Path for all pathways."}, {Name: "LayerSel", Doc: "LayerSel is an optional params selector for the receiving layers\nof the pathways that this schedule applies to: .Class, #Name, or\nempty for all layers."}, {Name: "Type", Doc: "Type is the type of schedule."}, {Name: "PerTrial", Doc: "PerTrial updates the schedule every training trial, with steps\ncounted in trials, instead of every epoch. Does not apply to LRatePlateau."}, {Name: "Warmup", Doc: "Warmup is the number of initial steps over which the learning\nrate increases linearly from WarmupStart to 1."}, {Name: "WarmupStart", Doc: "WarmupStart is the initial learning rate multiplier for Warmup."}, {Name: "Interval", Doc: "Interval is the number of steps per Factor decrease for LRateStep\nand LRateExp, and the total number of decay steps for LRateCosine."}, {Name: "Factor", Doc: "Factor is the multiplier per Interval for LRateStep and LRateExp,\nand per plateau for LRatePlateau."}, {Name: "Min", Doc: "Min is the minimum learning rate multiplier, which is the final\nvalue for LRateCosine."}, {Name: "Stat", Doc: "Stat is the name of the training epoch-level stat that\nis monitored for LRatePlateau, where lower values are better\n(e.g., Err or UnitErr)."}, {Name: "Patience", Doc: "Patience is the number of epochs without improvement of Stat\nbefore the learning rate is decreased, for LRatePlateau."}, {Name: "Threshold", Doc: "Threshold is the minimum decrease in Stat that counts as an\nimprovement, for LRatePlateau."}, {Name: "Value", Doc: "Value is the current learning rate multiplier."}, {Name: "plateau", Doc: "plateau is the multiplier from plateaus so far, for LRatePlateau."}, {Name: "best", Doc: "best is the best Stat value so far, for LRatePlateau."}, {Name: "wait", Doc: "wait is the number of epochs since the last improvement, for LRatePlateau."}}})
 
-var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.Network", IDName: "network", Doc: "Network implements the Axon spiking model.\nMost of the fields are copied to the global vars, needed for GPU,\nvia the SetAsCurrent method, and must be slices or tensors so that\nthere is one canonical underlying instance of all such data.\nThere are also Layer and Path lists that are used to scaffold the\nbuilding and display of the network, but contain no data.", Directives: []types.Directive{{Tool: "gosl", Directive: "end"}}, Methods: []types.Method{{Name: "InitWeights", Doc: "InitWeights initializes synaptic weights and all other associated long-term state variables\nincluding running-average state values (e.g., layer running average activations etc)", Directives: []types.Directive{{Tool: "types", Directive: "add"}}}, {Name: "InitActs", Doc: "InitActs fully initializes activation state -- not automatically called", Directives: []types.Directive{{Tool: "types", Directive: "add"}}}, {Name: "ShowAllGlobals", Doc: "ShowAllGlobals shows a listing of all Global variables and values.", Directives: []types.Directive{{Tool: "types", Directive: "add"}}}, {Name: "Build", Doc: "Build constructs the layer and pathway state based on the layer shapes\nand patterns of interconnectivity. Everything in the network must have been\nconfigured by this point, including key values in Context such as ThetaCycles\nand NeuronTraceCycles which drive allocation of number of [NeuronTraces] neuron\nvariables and corresponding [GvSynCaWts] global scalar variables.", Directives: []types.Directive{{Tool: "types", Directive: "add"}}, Returns: []string{"error"}}}, Embeds: []types.Field{{Name: "NetworkBase"}}, Fields: []types.Field{{Name: "Rubicon", Doc: "Rubicon system for goal-driven motivated behavior,\nincluding Rubicon phasic dopamine signaling.\nManages internal drives, US outcomes. Core LHb (lateral habenula)\nand VTA (ventral tegmental area) dopamine are computed\nin equations using inputs from specialized network layers\n(LDTLayer driven by BLA, CeM layers, VSPatchLayer).\nRenders USLayer, PVLayer, DrivesLayer representations\nbased on state updated here."}, {Name: "Layers", Doc: "Layers is the array of layers, used for CPU initialization, not GPU computation."}, {Name: "Paths", Doc: "Paths has pointers to all pathways in the network, sender-based, for CPU initialization,\nnot GPU computation."}, {Name: "LayerClassMap", Doc: "LayerClassMap is a map from class name to layer names."}, {Name: "NThreads", Doc: "NThreads is number of threads to use for parallel processing."}, {Name: "RecFunTimes", Doc: "record function timer information."}, {Name: "FunTimes", Doc: "timers for each major function (step of processing)."}, {Name: "LayerParams", Doc: "LayerParams are all the layer parameters. [NLayers]"}, {Name: "PathParams", Doc: "PathParams are all the path parameters, in sending order. [NPaths]"}, {Name: "NetworkIxs", Doc: "NetworkIxs have indexes and sizes for entire network (one only)."}, {Name: "PoolIxs", Doc: "PoolIxs have index values for each Pool.\n[Layer * Pools][PoolIndexVars]"}, {Name: "NeuronIxs", Doc: "NeuronIxs have index values for each neuron: index into layer, pools.\n[Neurons][Indexes]"}, {Name: "SynapseIxs", Doc: "SynapseIxs have index values for each synapse:\nproviding index into recv, send neurons, path.\n[Indexes][NSyns]; NSyns = [Layer][SendPaths][SendNeurons][Syns]"}, {Name: "PathSendCon", Doc: "PathSendCon are starting offset and N cons for each sending neuron,\nfor indexing into the Syns synapses, which are organized sender-based.\n[NSendCon][StartNN]; NSendCon = [Layer][SendPaths][SendNeurons]"}, {Name: "RecvPathIxs", Doc: "RecvPathIxs indexes into Paths (organized by SendPath) organized\nby recv pathways. needed for iterating through recv paths efficiently on GPU.\n[NRecvPaths] = [Layer][RecvPaths]"}, {Name: "PathRecvCon", Doc: "PathRecvCon are the receiving path starting index and number of connections.\n[NRecvCon][StartNN]; NRecvCon = [Layer][RecvPaths][RecvNeurons]"}, {Name: "RecvSynIxs", Doc: "RecvSynIxs are the indexes into Synapses for each recv neuron, organized\ninto blocks according to PathRecvCon, for receiver-based access.\n[NSyns] = [Layer][RecvPaths][RecvNeurons][Syns]"}, {Name: "Ctx", Doc: "Ctx is the context state (one). Other copies of Context can be maintained\nand [SetContext] to update this one, but this instance is the canonical one."}, {Name: "Neurons", Doc: "Neurons are all the neuron state variables.\n[Neurons][Data][Vars]"}, {Name: "NeuronAvgs", Doc: "NeuronAvgs are variables with averages over the\nData parallel dimension for each neuron.\n[Neurons][Vars]"}, {Name: "Pools", Doc: "Pools are the [PoolVars] float32 state values for layer and sub-pool inhibition,\nIncluding the float32 AvgMax values by Phase and variable: use [AvgMaxVarIndex].\n[Layer * Pools][Data][PoolVars+AvgMax]"}, {Name: "PoolsInt", Doc: "PoolsInt are the [PoolIntVars] int32 state values for layer and sub-pool\ninhibition, AvgMax atomic integration, and other vars: use [AvgMaxIntVarIndex]\n[Layer * Pools][Data][PoolIntVars+AvgMax]"}, {Name: "LayerStates", Doc: "LayerStates holds layer-level state values, with variables defined in\n[LayerVars], for each layer and Data parallel index.\n[Layer][Data][LayerVarsN]"}, {Name: "GlobalScalars", Doc: "GlobalScalars are the global scalar state variables.\n[GlobalScalarVarsN+2*NSynCaWeights][Data]"}, {Name: "GlobalVectors", Doc: "GlobalVectors are the global vector state variables.\n[GlobalVectorsN][MaxGlobalVecN][Data]"}, {Name: "Exts", Doc: "Exts are external input values for all Input / Target / Compare layers\nin the network. The ApplyExt methods write to this per layer,\nand it is then actually applied in one consistent method.\n[NExts][Data]; NExts = [In / Out Layers][Neurons]"}, {Name: "PathGBuf", Doc: "PathGBuf is the conductance buffer for accumulating spikes.\nSubslices are allocated to each pathway.\nUses int-encoded values for faster GPU atomic integration.\n[NPathNeur][Data][MaxDel+1]; NPathNeur = [Layer][RecvPaths][RecvNeurons]"}, {Name: "PathGSyns", Doc: "PathGSyns are synaptic conductance integrated over time per pathway\nper recv neurons. spikes come in via PathBuf.\nsubslices are allocated to each pathway.\n[NPathNeur][Data]"}, {Name: "Synapses", Doc: "\tSynapses are the synapse level variables (weights etc).\n\nThese do not depend on the data parallel index, unlike [SynapseTraces].\n[NSyns][Vars]; NSyns = [Layer][SendPaths][SendNeurons][Syns]"}, {Name: "SynapseTraces", Doc: "SynapseTraces are synaptic variables that depend on the data\nparallel index, for accumulating learning traces and weight changes per data.\nThis is the largest data size, so multiple instances are used\nto handle larger networks.\n[NSyns][Data][Vars]; NSyns = [Layer][SendPaths][SendNeurons][Syns]"}}})
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.NetworkIndexes", IDName: "network-indexes", Doc: "NetworkIndexes are indexes and sizes for processing network.", Directives: []types.Directive{{Tool: "gosl", Directive: "start"}}, Fields: []types.Field{{Name: "MaxData", Doc: "MaxData is the maximum number of data inputs that can be processed\nin parallel in one pass of the network.\nNeuron storage is allocated to hold this amount during\nBuild process, and this value reflects that."}, {Name: "MaxDelay", Doc: "MaxDelay is the maximum synaptic delay across all pathways at the time of\n[Network.Build]. This determines the size of the spike sending delay buffers."}, {Name: "NNeuronTraces", Doc: "NNeuronTraces is the total number of [NeuronTraces] in the neuron state variables.\nSet to Context.NNeuronTraces() in Build."}, {Name: "NNeuronTraceBins", Doc: "NNeuronTraceBins is the total number of [NeuronTraces] in the neuron state variables,\nper trace variable. Set to Context.NNeuronTraceBins() in Build."}, {Name: "NLayers", Doc: "NLayers is the number of layers in the network."}, {Name: "NNeurons", Doc: "NNeurons is the total number of neurons."}, {Name: "NPools", Doc: "NPools is the total number of pools."}, {Name: "NPaths", Doc: "NPaths is the total number of paths."}, {Name: "NSyns", Doc: "NSyns is the total number of synapses."}, {Name: "RubiconNPosUSs", Doc: "RubiconNPosUSs is the total number of Rubicon Drives / positive USs."}, {Name: "RubiconNCosts", Doc: "RubiconNCosts is the total number of Rubicon Costs."}, {Name: "RubiconNNegUSs", Doc: "RubiconNNegUSs is the total number of .Rubicon Negative USs."}, {Name: "NSTDPSyns", Doc: "NSTDPSyns is the total number of synapses in pathways using\nSTDP learning, set in [Network.BuildSTDP]. If 0, spike times\nare not recorded."}}})
+
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.Network", IDName: "network", Doc: "Network implements the Axon spiking model.\nMost of the fields are copied to the global vars, needed for GPU,\nvia the SetAsCurrent method, and must be slices or tensors so that\nthere is one canonical underlying instance of all such data.\nThere are also Layer and Path lists that are used to scaffold the\nbuilding and display of the network, but contain no data.", Directives: []types.Directive{{Tool: "gosl", Directive: "end"}}, Methods: []types.Method{{Name: "ConsolSnapshot", Doc: "ConsolSnapshot marks a task boundary for weight consolidation\n(see [ConsolParams]), which should be called after learning each task\nin sequence: the importance accumulated over the task is added to the\nconsolidated importance of each synapse, the accumulator is reset,\nand the current [LWt] weights become the new anchor weights.\nIncrements the ConsolTasks counter.", Directives: []types.Directive{{Tool: "types", Directive: "add"}}}, {Name: "InitWeights", Doc: "InitWeights initializes synaptic weights and all other associated long-term state variables\nincluding running-average state values (e.g., layer running average activations etc)", Directives: []types.Directive{{Tool: "types", Directive: "add"}}}, {Name: "InitActs", Doc: "InitActs fully initializes activation state -- not automatically called", Directives: []types.Directive{{Tool: "types", Directive: "add"}}}, {Name: "ShowAllGlobals", Doc: "ShowAllGlobals shows a listing of all Global variables and values.", Directives: []types.Directive{{Tool: "types", Directive: "add"}}}, {Name: "Build", Doc: "Build constructs the layer and pathway state based on the layer shapes\nand patterns of interconnectivity. Everything in the network must have been\nconfigured by this point, including key values in Context such as ThetaCycles\nand NeuronTraceCycles which drive allocation of number of [NeuronTraces] neuron\nvariables and corresponding [GvSynCaWts] global scalar variables.", Directives: []types.Directive{{Tool: "types", Directive: "add"}}, Returns: []string{"error"}}}, Embeds: []types.Field{{Name: "NetworkBase"}}, Fields: []types.Field{{Name: "Rubicon", Doc: "Rubicon system for goal-driven motivated behavior,\nincluding Rubicon phasic dopamine signaling.\nManages internal drives, US outcomes. Core LHb (lateral habenula)\nand VTA (ventral tegmental area) dopamine are computed\nin equations using inputs from specialized network layers\n(LDTLayer driven by BLA, CeM layers, VSPatchLayer).\nRenders USLayer, PVLayer, DrivesLayer representations\nbased on state updated here."}, {Name: "Layers", Doc: "Layers is the array of layers, used for CPU initialization, not GPU computation."}, {Name: "Paths", Doc: "Paths has pointers to all pathways in the network, sender-based, for CPU initialization,\nnot GPU computation."}, {Name: "LayerClassMap", Doc: "LayerClassMap is a map from class name to layer names."}, {Name: "NThreads", Doc: "NThreads is number of threads to use for parallel processing."}, {Name: "WeightsFileExt", Doc: "WeightsFileExt is the file extension used by [WeightsFilename],\nwhich determines the format used by [SaveWeights]: the default\n(empty) is \".wts.gz\" for gzipped JSON, and [WeightsBinaryExt] + \".gz\"\nis the compact binary format."}, {Name: "SpikeSend", Doc: "SpikeSend has the parameters and state for event-driven sparse\nsending of spikes on the CPU."}, {Name: "Trace", Doc: "Trace records a timeline of the looper levels, function timers\nand CPU kernels, for export as a Chrome trace or pprof profile."}, {Name: "ConsolTasks", Doc: "ConsolTasks is the number of task boundaries marked by\n[Network.ConsolSnapshot] since InitWeights, for weight consolidation."}, {Name: "LRateScheds", Doc: "LRateScheds are learning rate schedules that set the LRate.Sched\nmultiplier of selected pathways over the course of training.\nSee [LRateSchedule] and [LooperLRateSched]."}, {Name: "specBuilders", Doc: "specBuilders are the builder methods called to make the network,\nfor ExportSpec."}, {Name: "specBuilding", Doc: "specBuilding is set while a builder method is being recorded\nin specBuilders."}, {Name: "RecFunTimes", Doc: "record function timer information."}, {Name: "FunTimes", Doc: "timers for each major function (step of processing)."}, {Name: "LayerParams", Doc: "LayerParams are all the layer parameters. [NLayers]"}, {Name: "PathParams", Doc: "PathParams are all the path parameters, in sending order. [NPaths]"}, {Name: "NetworkIxs", Doc: "NetworkIxs have indexes and sizes for entire network (one only)."}, {Name: "PoolIxs", Doc: "PoolIxs have index values for each Pool.\n[Layer * Pools][PoolIndexVars]"}, {Name: "NeuronIxs", Doc: "NeuronIxs have index values for each neuron: index into layer, pools.\n[Neurons][Indexes]"}, {Name: "SynapseIxs", Doc: "SynapseIxs have index values for each synapse:\nproviding index into recv, send neurons, path.\n[Indexes][NSyns]; NSyns = [Layer][SendPaths][SendNeurons][Syns]"}, {Name: "PathSendCon", Doc: "PathSendCon are starting offset and N cons for each sending neuron,\nfor indexing into the Syns synapses, which are organized sender-based.\n[NSendCon][StartNN]; NSendCon = [Layer][SendPaths][SendNeurons]"}, {Name: "RecvPathIxs", Doc: "RecvPathIxs indexes into Paths (organized by SendPath) organized\nby recv pathways. needed for iterating through recv paths efficiently on GPU.\n[NRecvPaths] = [Layer][RecvPaths]"}, {Name: "PathRecvCon", Doc: "PathRecvCon are the receiving path starting index and number of connections.\n[NRecvCon][StartNN]; NRecvCon = [Layer][RecvPaths][RecvNeurons]"}, {Name: "RecvSynIxs", Doc: "RecvSynIxs are the indexes into Synapses for each recv neuron, organized\ninto blocks according to PathRecvCon, for receiver-based access.\n[NSyns] = [Layer][RecvPaths][RecvNeurons][Syns]"}, {Name: "Ctx", Doc: "Ctx is the context state (one). Other copies of Context can be maintained\nand [SetContext] to update this one, but this instance is the canonical one."}, {Name: "Neurons", Doc: "Neurons are all the neuron state variables.\n[Neurons][Data][Vars]"}, {Name: "NeuronAvgs", Doc: "NeuronAvgs are variables with averages over the\nData parallel dimension for each neuron.\n[Neurons][Vars]"}, {Name: "Pools", Doc: "Pools are the [PoolVars] float32 state values for layer and sub-pool inhibition,\nIncluding the float32 AvgMax values by Phase and variable: use [AvgMaxVarIndex].\n[Layer * Pools][Data][PoolVars+AvgMax]"}, {Name: "PoolsInt", Doc: "PoolsInt are the [PoolIntVars] int32 state values for layer and sub-pool\ninhibition, AvgMax atomic integration, and other vars: use [AvgMaxIntVarIndex]\n[Layer * Pools][Data][PoolIntVars+AvgMax]"}, {Name: "LayerStates", Doc: "LayerStates holds layer-level state values, with variables defined in\n[LayerVars], for each layer and Data parallel index.\n[Layer][Data][LayerVarsN]"}, {Name: "GlobalScalars", Doc: "GlobalScalars are the global scalar state variables.\n[GlobalScalarVarsN+2*NSynCaWeights][Data]"}, {Name: "GlobalVectors", Doc: "GlobalVectors are the global vector state variables.\n[GlobalVectorsN][MaxGlobalVecN][Data]"}, {Name: "Exts", Doc: "Exts are external input values for all Input / Target / Compare layers\nin the network. The ApplyExt methods write to this per layer,\nand it is then actually applied in one consistent method.\n[NExts][Data]; NExts = [In / Out Layers][Neurons]"}, {Name: "Dendrites", Doc: "Dendrites are the [DendVars] state values for the additional dendritic\ncompartments beyond the first VmDend compartment, for layers with\n[Layer.DendComps] > 1. Use [LayerParams.DendIndex] to access.\n[NDendComps][Data][DendVarsN]; NDendComps = [Layer][Neurons][DendComps-1]"}, {Name: "PathGBuf", Doc: "PathGBuf is the conductance buffer for accumulating spikes.\nSubslices are allocated to each pathway.\nUses int-encoded values for faster GPU atomic integration.\n[NPathNeur][Data][MaxDel+1]; NPathNeur = [Layer][RecvPaths][RecvNeurons]"}, {Name: "PathGSyns", Doc: "PathGSyns are synaptic conductance integrated over time per pathway\nper recv neurons. spikes come in via PathBuf.\nsubslices are allocated to each pathway.\n[NPathNeur][Data]"}, {Name: "PathSTP", Doc: "PathSTP has the short-term plasticity state for each sending neuron\nin each pathway, with variables defined in [STPVars].\n[NSendCon][Data][STPVarsN]; NSendCon = [Layer][SendPaths][SendNeurons]"}, {Name: "STDPSpikes", Doc: "STDPSpikes records the spike times of each neuron within the\ntheta cycle, for STDP learning. Only allocated if used.\n[NNeurons][Data][STDPMaxSpikes+1]"}, {Name: "SynapseSTDP", Doc: "SynapseSTDP has the per-synapse STDP trace values for pathways\nusing STDP learning. Only allocated if used.\n[NSTDPSyns][Data][STDPVarsN]"}, {Name: "SynapseConsol", Doc: "SynapseConsol has the per-synapse weight consolidation state\nfor pathways using it. Only allocated if used.\n[NConsolSyns][ConsolVarsN]"}, {Name: "Synapses", Doc: "\tSynapses are the synapse level variables (weights etc).\n\nThese do not depend on the data parallel index, unlike [SynapseTraces].\n[NSyns][Vars]; NSyns = [Layer][SendPaths][SendNeurons][Syns]"}, {Name: "SynapseTraces", Doc: "SynapseTraces are synaptic variables that depend on the data\nparallel index, for accumulating learning traces and weight changes per data.\nThis is the largest data size, so multiple instances are used\nto handle larger networks.\n[NSyns][Data][Vars]; NSyns = [Layer][SendPaths][SendNeurons][Syns]"}}})
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.DAModTypes", IDName: "da-mod-types", Doc: "DAModTypes are types of dopamine modulation of neural activity."})
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.ValenceTypes", IDName: "valence-types", Doc: "ValenceTypes are types of valence coding: positive or negative."})
 
-var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.NeuroModParams", IDName: "neuro-mod-params", Doc: "NeuroModParams specifies the effects of neuromodulators on neural\nactivity and learning rate.  These can apply to any neuron type,\nand are applied in the core cycle update equations.", Fields: []types.Field{{Name: "DAMod", Doc: "dopamine receptor-based effects of dopamine modulation\non excitatory and inhibitory conductances: D1 is excitatory,\nD2 is inhibitory as a function of increasing dopamine."}, {Name: "Valence", Doc: "valence coding of this layer, which may affect specific layer\ntypes but does not directly affect neuromodulators currently."}, {Name: "DAModGain", Doc: "dopamine modulation of excitatory and inhibitory conductances\n(i.e., \"performance dopamine\" effect: this does NOT affect\nlearning dopamine modulation in terms of RLrate): g *= 1 + (DAModGain * DA)."}, {Name: "DALRateSign", Doc: "modulate the sign of the learning rate factor according to\nthe DA sign, taking into account the DAMod sign reversal for D2Mod,\nalso using BurstGain and DipGain to modulate DA value.\nOtherwise, only the magnitude of the learning rate is modulated\nas a function of raw DA magnitude according to DALRateMod\n(without additional gain factors)."}, {Name: "DALRateMod", Doc: "if not using DALRateSign, this is the proportion of maximum learning\nrate that Abs(DA) magnitude can modulate.\ne.g., if 0.2, then DA = 0 = 80% of std learning rate, 1 = 100%."}, {Name: "AChLRateMod", Doc: "proportion of maximum learning rate that ACh can modulate.\ne.g., if 0.2, then ACh = 0 = 80% of std learning rate, 1 = 100%."}, {Name: "AChDisInhib", Doc: "amount of extra Gi inhibition added in proportion to 1 - ACh level.\nmakes ACh disinhibitory"}, {Name: "BurstGain", Doc: "multiplicative gain factor applied to positive dopamine signals.\nThis operates on the raw dopamine signal prior to any effect\nof D2 receptors in reversing its sign!"}, {Name: "DipGain", Doc: "multiplicative gain factor applied to negative dopamine signals.\nThis operates on the raw dopamine signal prior to any effect\nof D2 receptors in reversing its sign!\nshould be small for acq, but roughly equal to burst for ext."}, {Name: "pad"}, {Name: "pad1"}, {Name: "pad2"}}})
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.NeuroModParams", IDName: "neuro-mod-params", Doc: "NeuroModParams specifies the effects of neuromodulators on neural\nactivity and learning rate.  These can apply to any neuron type,\nand are applied in the core cycle update equations.", Fields: []types.Field{{Name: "DAMod", Doc: "dopamine receptor-based effects of dopamine modulation\non excitatory and inhibitory conductances: D1 is excitatory,\nD2 is inhibitory as a function of increasing dopamine."}, {Name: "Valence", Doc: "valence coding of this layer, which may affect specific layer\ntypes but does not directly affect neuromodulators currently."}, {Name: "DAModGain", Doc: "dopamine modulation of excitatory and inhibitory conductances\n(i.e., \"performance dopamine\" effect: this does NOT affect\nlearning dopamine modulation in terms of RLrate): g *= 1 + (DAModGain * DA)."}, {Name: "DALRateSign", Doc: "modulate the sign of the learning rate factor according to\nthe DA sign, taking into account the DAMod sign reversal for D2Mod,\nalso using BurstGain and DipGain to modulate DA value.\nOtherwise, only the magnitude of the learning rate is modulated\nas a function of raw DA magnitude according to DALRateMod\n(without additional gain factors)."}, {Name: "DALRateMod", Doc: "if not using DALRateSign, this is the proportion of maximum learning\nrate that Abs(DA) magnitude can modulate.\ne.g., if 0.2, then DA = 0 = 80% of std learning rate, 1 = 100%."}, {Name: "AChLRateMod", Doc: "proportion of maximum learning rate that ACh can modulate.\ne.g., if 0.2, then ACh = 0 = 80% of std learning rate, 1 = 100%."}, {Name: "AChDisInhib", Doc: "amount of extra Gi inhibition added in proportion to 1 - ACh level.\nmakes ACh disinhibitory"}, {Name: "BurstGain", Doc: "multiplicative gain factor applied to positive dopamine signals.\nThis operates on the raw dopamine signal prior to any effect\nof D2 receptors in reversing its sign!"}, {Name: "DipGain", Doc: "multiplicative gain factor applied to negative dopamine signals.\nThis operates on the raw dopamine signal prior to any effect\nof D2 receptors in reversing its sign!\nshould be small for acq, but roughly equal to burst for ext."}, {Name: "NEGain", Doc: "NEGain is the norepinephrine (NE) modulation of excitatory conductance\ngain, reflecting the adaptive gain effects of locus coeruleus (LC)\nactivity: Ge *= 1 + (NEGain * NE). NE is computed by the LCLayer."}, {Name: "NELRateMod", Doc: "NELRateMod is the proportion of maximum learning rate that NE can modulate.\ne.g., if 0.2, then NE = 0 = 80% of std learning rate, 1 = 100%."}, {Name: "SerLRateMod", Doc: "SerLRateMod is the proportion of maximum learning rate that serotonin\n(Ser, 5-HT) can modulate. e.g., if 0.2, then Ser = 0 = 80% of std\nlearning rate, 1 = 100%. Ser is computed by the DRNLayer."}, {Name: "NENoise", Doc: "NENoise is the extent to which NE increases the background noise\nin excitatory conductance (GeNoise), driving more exploratory behavior\nwith increasing NE: Ge += (NENoise * NE) * GeNoise.\nRequires Acts.Noise to be On."}, {Name: "pad"}, {Name: "pad1"}, {Name: "pad2"}}})
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.NeuronFlags", IDName: "neuron-flags", Doc: "NeuronFlags are bit-flags encoding relevant binary state for neurons"})
 
@@ -254,15 +286,15 @@ var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.PathSearch
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.Params", IDName: "params", Doc: "Params contains the [LayerParams] and [PathParams] parameter setting functions\nprovided by the [emergent] [params] package.", Fields: []types.Field{{Name: "Layer", Doc: "Layer has the parameters to apply to the [LayerParams] for layers."}, {Name: "Path", Doc: "Path has the parameters to apply to the [PathParams] for paths."}, {Name: "ExtraSheets", Doc: "ExtraSheets has optional additional sheets of parameters to apply\nafter the default Base sheet. Use \"Script\" for default Script sheet.\nMultiple names separated by spaces can be used (don't put spaces in Sheet names!)"}, {Name: "Tag", Doc: "Tag is an optional additional tag to add to log file names to identify\na specific run of the model (typically set by a config file or args)."}, {Name: "Script", Doc: "Script is a parameter setting script, which adds to the Layer and Path sheets\ntypically using the \"Script\" set name."}, {Name: "Interp", Doc: "Interp is the yaegi interpreter for running the script."}}})
 
-var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.Path", IDName: "path", Doc: "Path implements axon spiking communication and learning.", Embeds: []types.Field{{Name: "PathBase"}}, Fields: []types.Field{{Name: "Params", Doc: "path parameters."}, {Name: "Send", Doc: "sending layer for this pathway."}, {Name: "Recv", Doc: "receiving layer for this pathway."}, {Name: "Type", Doc: "type of pathway."}, {Name: "DefaultParams", Doc: "DefaultParams are functions to apply parameters prior to user-set\nparameters. These are useful for specific functionality in specialized\nbrain areas (e.g., Rubicon, BG etc) not associated with a path type,\nwhich otherwise is used to hard-code initial default parameters."}, {Name: "RecvConNAvgMax", Doc: "average and maximum number of recv connections in the receiving layer"}, {Name: "SendConNAvgMax", Doc: "average and maximum number of sending connections in the sending layer"}, {Name: "SynStIndex", Doc: "start index into global Synapse array:"}, {Name: "NSyns", Doc: "number of synapses in this pathway"}, {Name: "RecvCon", Doc: "starting offset and N cons for each recv neuron, for indexing into the RecvSynIndex array of indexes into the Syns synapses, which are organized sender-based.  This is locally managed during build process, but also copied to network global PathRecvCons slice for GPU usage."}, {Name: "RecvSynIndex", Doc: "index into Syns synaptic state for each sending unit and connection within that, for the sending pathway which does not own the synapses, and instead indexes into recv-ordered list"}, {Name: "RecvConIndex", Doc: "for each recv synapse, this is index of *sending* neuron  It is generally preferable to use the Synapse SendIndex where needed, instead of this slice, because then the memory access will be close by other values on the synapse."}, {Name: "SendCon", Doc: "starting offset and N cons for each sending neuron, for indexing into the Syns synapses, which are organized sender-based.  This is locally managed during build process, but also copied to network global PathSendCons slice for GPU usage."}, {Name: "SendConIndex", Doc: "index of other neuron that receives the sender's synaptic input, ordered by the sending layer's order of units as the outer loop, and SendCon.N receiving units within that.  It is generally preferable to use the Synapse RecvIndex where needed, instead of this slice, because then the memory access will be close by other values on the synapse."}}})
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.Path", IDName: "path", Doc: "Path implements axon spiking communication and learning.", Embeds: []types.Field{{Name: "PathBase"}}, Fields: []types.Field{{Name: "Params", Doc: "path parameters."}, {Name: "Send", Doc: "sending layer for this pathway."}, {Name: "Recv", Doc: "receiving layer for this pathway."}, {Name: "Type", Doc: "type of pathway."}, {Name: "DefaultParams", Doc: "DefaultParams are functions to apply parameters prior to user-set\nparameters. These are useful for specific functionality in specialized\nbrain areas (e.g., Rubicon, BG etc) not associated with a path type,\nwhich otherwise is used to hard-code initial default parameters."}, {Name: "RecvConNAvgMax", Doc: "average and maximum number of recv connections in the receiving layer"}, {Name: "SendConNAvgMax", Doc: "average and maximum number of sending connections in the sending layer"}, {Name: "SynStIndex", Doc: "start index into global Synapse array:"}, {Name: "NSyns", Doc: "number of synapses in this pathway"}, {Name: "StructPruned", Doc: "StructPruned is the total number of synapses pruned and replaced by new\nsynapses through structural plasticity (see [StructPlastParams]),\nsince the last InitWeights."}, {Name: "StructWeak", Doc: "StructWeak is the number of synapses below the structural plasticity\npruning thresholds at the most recent update."}, {Name: "RecvCon", Doc: "starting offset and N cons for each recv neuron, for indexing into the RecvSynIndex array of indexes into the Syns synapses, which are organized sender-based.  This is locally managed during build process, but also copied to network global PathRecvCons slice for GPU usage."}, {Name: "RecvSynIndex", Doc: "index into Syns synaptic state for each sending unit and connection within that, for the sending pathway which does not own the synapses, and instead indexes into recv-ordered list"}, {Name: "RecvConIndex", Doc: "for each recv synapse, this is index of *sending* neuron  It is generally preferable to use the Synapse SendIndex where needed, instead of this slice, because then the memory access will be close by other values on the synapse."}, {Name: "SendCon", Doc: "starting offset and N cons for each sending neuron, for indexing into the Syns synapses, which are organized sender-based.  This is locally managed during build process, but also copied to network global PathSendCons slice for GPU usage."}, {Name: "SendConIndex", Doc: "index of other neuron that receives the sender's synaptic input, ordered by the sending layer's order of units as the outer loop, and SendCon.N receiving units within that.  It is generally preferable to use the Synapse RecvIndex where needed, instead of this slice, because then the memory access will be close by other values on the synapse."}}})
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.StartN", IDName: "start-n", Doc: "StartN holds a starting offset index and a number of items\narranged from Start to Start+N (exclusive).\nThis is not 16 byte padded and only for use on CPU side.", Fields: []types.Field{{Name: "Start", Doc: "starting offset"}, {Name: "N", Doc: "number of items --"}, {Name: "pad"}, {Name: "pad1"}}})
 
-var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.PathIndexes", IDName: "path-indexes", Doc: "PathIndexes contains path-level index information into global memory arrays", Fields: []types.Field{{Name: "RecvLayer", Doc: "RecvLayer is the index of the receiving layer in global list of layers."}, {Name: "RecvNeurSt", Doc: "RecvNeurSt is the starting index of neurons in recv layer,\nso we don't need layer to get to neurons."}, {Name: "RecvNeurN", Doc: "RecvNeurN is the number of neurons in recv layer."}, {Name: "SendLayer", Doc: "SendLayer is the index of the sending layer in global list of layers."}, {Name: "SendNeurSt", Doc: "SendNeurSt is the starting index of neurons in sending layer,\nso we don't need layer to get to neurons."}, {Name: "SendNeurN", Doc: "SendNeurN is the number of neurons in send layer"}, {Name: "SynapseSt", Doc: "SynapseSt is the start index into global Synapse array.\n[Layer][SendPaths][Synapses]."}, {Name: "SendConSt", Doc: "SendConSt is the start index into global PathSendCon array.\n[Layer][SendPaths][SendNeurons]"}, {Name: "RecvConSt", Doc: "RecvConSt is the start index into global PathRecvCon array.\n[Layer][RecvPaths][RecvNeurons]"}, {Name: "RecvSynSt", Doc: "RecvSynSt is the start index into global sender-based Synapse index array.\n[Layer][SendPaths][Synapses]"}, {Name: "NPathNeurSt", Doc: "NPathNeurSt is the start NPathNeur index into PathGBuf, PathGSyns global arrays.\n[Layer][RecvPaths][RecvNeurons]"}, {Name: "pad"}}})
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.PathIndexes", IDName: "path-indexes", Doc: "PathIndexes contains path-level index information into global memory arrays", Fields: []types.Field{{Name: "RecvLayer", Doc: "RecvLayer is the index of the receiving layer in global list of layers."}, {Name: "RecvNeurSt", Doc: "RecvNeurSt is the starting index of neurons in recv layer,\nso we don't need layer to get to neurons."}, {Name: "RecvNeurN", Doc: "RecvNeurN is the number of neurons in recv layer."}, {Name: "SendLayer", Doc: "SendLayer is the index of the sending layer in global list of layers."}, {Name: "SendNeurSt", Doc: "SendNeurSt is the starting index of neurons in sending layer,\nso we don't need layer to get to neurons."}, {Name: "SendNeurN", Doc: "SendNeurN is the number of neurons in send layer"}, {Name: "SynapseSt", Doc: "SynapseSt is the start index into global Synapse array.\n[Layer][SendPaths][Synapses]."}, {Name: "SendConSt", Doc: "SendConSt is the start index into global PathSendCon array.\n[Layer][SendPaths][SendNeurons]"}, {Name: "RecvConSt", Doc: "RecvConSt is the start index into global PathRecvCon array.\n[Layer][RecvPaths][RecvNeurons]"}, {Name: "RecvSynSt", Doc: "RecvSynSt is the start index into global sender-based Synapse index array.\n[Layer][SendPaths][Synapses]"}, {Name: "NPathNeurSt", Doc: "NPathNeurSt is the start NPathNeur index into PathGBuf, PathGSyns global arrays.\n[Layer][RecvPaths][RecvNeurons]"}, {Name: "STDPSt", Doc: "STDPSt is the start index into global SynapseSTDP array,\nfor pathways using STDP learning. Set in [Network.BuildSTDP]."}, {Name: "ConsolSt", Doc: "ConsolSt is the start index into global SynapseConsol array,\nfor pathways using weight consolidation. Set in [Network.BuildConsol]."}, {Name: "pad"}, {Name: "pad1"}, {Name: "pad2"}}})
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.GScaleValues", IDName: "g-scale-values", Doc: "GScaleValues holds the conductance scaling values.\nThese are computed once at start and remain constant thereafter,\nand therefore belong on Params and not on PathValues.", Fields: []types.Field{{Name: "Scale", Doc: "scaling factor for integrating synaptic input conductances (G's), originally computed as a function of sending layer activity and number of connections, and typically adapted from there -- see Path.PathScale adapt params"}, {Name: "Rel", Doc: "normalized relative proportion of total receiving conductance for this pathway: PathScale.Rel / sum(PathScale.Rel across relevant paths)"}, {Name: "pad"}, {Name: "pad1"}}})
 
-var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.PathParams", IDName: "path-params", Doc: "PathParams contains all of the path parameters.\nThese values must remain constant over the course of computation.\nOn the GPU, they are loaded into a read-only storage buffer.", Fields: []types.Field{{Name: "Type", Doc: "Type is the functional type of path, which determines the code path\nfor specialized types, and is synchronized with [Path.Type]."}, {Name: "Index", Doc: "Index is the index of the pathway in global path list: [Layer][SendPaths]"}, {Name: "pad"}, {Name: "pad1"}, {Name: "Indexes", Doc: "recv and send neuron-level pathway index array access info"}, {Name: "Com", Doc: "synaptic communication parameters: delay, probability of failure"}, {Name: "PathScale", Doc: "pathway scaling parameters for computing GScale:\nmodulates overall strength of pathway, using both\nabsolute and relative factors, with adaptation option to maintain target max conductances"}, {Name: "SWts", Doc: "slowly adapting, structural weight value parameters,\nwhich control initial weight values and slower outer-loop adjustments"}, {Name: "Learn", Doc: "synaptic-level learning parameters for learning in the fast LWt values."}, {Name: "GScale", Doc: "conductance scaling values"}, {Name: "RLPred", Doc: "Params for RWPath and TDPredPath for doing dopamine-modulated learning\nfor reward prediction: Da * Send activity.\nUse in RWPredLayer or TDPredLayer typically to generate reward predictions.\nIf the Da sign is positive, the first recv unit learns fully; for negative,\nsecond one learns fully.\nLower lrate applies for opposite cases.  Weights are positive-only."}, {Name: "VSMatrix", Doc: "VSMatrix has parameters for trace-based learning in the VSMatrixPath.\nA trace of synaptic co-activity is formed, and then modulated by\ndopamine whenever it occurs.\nThis bridges the temporal gap between gating activity and subsequent activity,\nand is based biologically on synaptic tags.\nDSPatch provides modulation of trace activity based on local critic signal."}, {Name: "DSMatrix", Doc: "DSMatrix has parameters for trace-based learning in the DSMatrixPath.\nA trace of synaptic co-activity is formed, and then modulated by\ndopamine whenever it occurs.\nThis bridges the temporal gap between gating activity and subsequent activity,\nand is based biologically on synaptic tags.\nDSPatch provides modulation of trace activity based on local critic signal."}, {Name: "BLA", Doc: "Basolateral Amygdala pathway parameters."}, {Name: "Hip", Doc: "Hip bench parameters."}}})
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.PathParams", IDName: "path-params", Doc: "PathParams contains all of the path parameters.\nThese values must remain constant over the course of computation.\nOn the GPU, they are loaded into a read-only storage buffer.", Fields: []types.Field{{Name: "Type", Doc: "Type is the functional type of path, which determines the code path\nfor specialized types, and is synchronized with [Path.Type]."}, {Name: "Index", Doc: "Index is the index of the pathway in global path list: [Layer][SendPaths]"}, {Name: "DendComp", Doc: "DendComp is the dendritic compartment that the excitatory input from this\npathway targets, for receiving layers with [Layer.DendComps] > 1.\n0 is the standard VmDend compartment (and soma), and higher values target\nthe additional, more distal compartments. Values beyond the number of\ncompartments in the receiving layer revert to 0."}, {Name: "pad"}, {Name: "Indexes", Doc: "recv and send neuron-level pathway index array access info"}, {Name: "Com", Doc: "synaptic communication parameters: delay, probability of failure"}, {Name: "STP", Doc: "short-term plasticity parameters, for Tsodyks-Markram style\nsynaptic depression and facilitation based on sending spikes."}, {Name: "Gap", Doc: "Gap has the gap junction parameters for [GapJunctionPath] electrical coupling."}, {Name: "StructPlast", Doc: "StructPlast has the structural plasticity parameters, for pruning\npersistently weak synapses and regrowing new ones."}, {Name: "PathScale", Doc: "pathway scaling parameters for computing GScale:\nmodulates overall strength of pathway, using both\nabsolute and relative factors, with adaptation option to maintain target max conductances"}, {Name: "SWts", Doc: "slowly adapting, structural weight value parameters,\nwhich control initial weight values and slower outer-loop adjustments"}, {Name: "Learn", Doc: "synaptic-level learning parameters for learning in the fast LWt values."}, {Name: "GScale", Doc: "conductance scaling values"}, {Name: "RLPred", Doc: "Params for RWPath, TDPredPath and ActorPath for doing dopamine-modulated\nlearning for reward prediction: Da * Send activity.\nUse in RWPredLayer or TDPredLayer typically to generate reward predictions.\nIf the Da sign is positive, the first recv unit learns fully; for negative,\nsecond one learns fully.\nLower lrate applies for opposite cases.  Weights are positive-only."}, {Name: "VSMatrix", Doc: "VSMatrix has parameters for trace-based learning in the VSMatrixPath.\nA trace of synaptic co-activity is formed, and then modulated by\ndopamine whenever it occurs.\nThis bridges the temporal gap between gating activity and subsequent activity,\nand is based biologically on synaptic tags.\nDSPatch provides modulation of trace activity based on local critic signal."}, {Name: "DSMatrix", Doc: "DSMatrix has parameters for trace-based learning in the DSMatrixPath.\nA trace of synaptic co-activity is formed, and then modulated by\ndopamine whenever it occurs.\nThis bridges the temporal gap between gating activity and subsequent activity,\nand is based biologically on synaptic tags.\nDSPatch provides modulation of trace activity based on local critic signal."}, {Name: "BLA", Doc: "Basolateral Amygdala pathway parameters."}, {Name: "Hip", Doc: "Hip bench parameters."}}})
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.PathTypes", IDName: "path-types", Doc: "PathTypes enumerates all the different types of axon pathways,\nfor the different algorithm types supported.\nClass parameter styles automatically key off of these types."})
 
@@ -290,6 +322,8 @@ var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.AvgMaxVars
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.RandFunIndex", IDName: "rand-fun-index", Directives: []types.Directive{{Tool: "gosl", Directive: "start"}}})
 
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.CounterRand", IDName: "counter-rand", Doc: "CounterRand is a [randx.Rand] that returns successive counter-based\nrandom numbers from [GetRandomNumber], using the Key as the index,\nwhich is incremented for each number. Only the Float32, Float64 and Intn\nmethods are supported: the others panic.", Embeds: []types.Field{{Name: "Rand"}}, Fields: []types.Field{{Name: "Counter", Doc: "Counter is the random counter."}, {Name: "FunIndex", Doc: "FunIndex is the random function index."}, {Name: "Key", Doc: "Key is the index for the next random number."}}})
+
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.RWPredParams", IDName: "rw-pred-params", Doc: "RWPredParams parameterizes reward prediction for a simple Rescorla-Wagner\nlearning dynamic (i.e., PV learning in the Rubicon framework).", Directives: []types.Directive{{Tool: "gosl", Directive: "start"}}, Fields: []types.Field{{Name: "PredRange", Doc: "default 0.1..0.99 range of predictions that can be represented -- having a truncated range preserves some sensitivity in dopamine at the extremes of good or poor performance"}}})
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.RWDaParams", IDName: "rw-da-params", Doc: "RWDaParams computes a dopamine (DA) signal using simple Rescorla-Wagner\nlearning dynamic (i.e., PV learning in the Rubicon framework).", Fields: []types.Field{{Name: "TonicGe", Doc: "tonic baseline Ge level for DA = 0 -- +/- are between 0 and 2*TonicGe -- just for spiking display of computed DA value"}, {Name: "RWPredLayIndex", Doc: "idx of RWPredLayer to get reward prediction from -- set during Build from BuildConfig RWPredLayName"}, {Name: "pad"}, {Name: "pad1"}}})
@@ -298,30 +332,74 @@ var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.TDIntegPar
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.TDDaParams", IDName: "td-da-params", Doc: "TDDaParams are params for dopamine (DA) signal as the temporal difference (TD)\nbetween the TDIntegLayer activations in the minus and plus phase.", Fields: []types.Field{{Name: "TonicGe", Doc: "tonic baseline Ge level for DA = 0 -- +/- are between 0 and 2*TonicGe -- just for spiking display of computed DA value"}, {Name: "TDIntegLayIndex", Doc: "idx of TDIntegLayer to get reward prediction from -- set during Build from BuildConfig TDIntegLayName"}, {Name: "pad"}, {Name: "pad1"}}})
 
-var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.RLPredPathParams", IDName: "rl-pred-path-params", Doc: "RLPredPathParams does dopamine-modulated learning for reward prediction: Da * Send.Act\nUsed by RWPath and TDPredPath within corresponding RWPredLayer or TDPredLayer\nto generate reward predictions based on its incoming weights, using linear activation\nfunction. Has no weight bounds or limits on sign etc.", Directives: []types.Directive{{Tool: "gosl", Directive: "start"}}, Fields: []types.Field{{Name: "OppSignLRate", Doc: "how much to learn on opposite DA sign coding neuron (0..1)"}, {Name: "DaTol", Doc: "tolerance on DA -- if below this abs value, then DA goes to zero and there is no learning -- prevents prediction from exactly learning to cancel out reward value, retaining a residual valence of signal"}, {Name: "pad"}, {Name: "pad1"}}})
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.RLPredPathParams", IDName: "rl-pred-path-params", Doc: "RLPredPathParams does dopamine-modulated learning for reward prediction: Da * Send.Act\nUsed by RWPath and TDPredPath within corresponding RWPredLayer or TDPredLayer\nto generate reward predictions based on its incoming weights, using linear activation\nfunction. Has no weight bounds or limits on sign etc.\nAlso used by ActorPath for the actor in an actor-critic pairing.", Directives: []types.Directive{{Tool: "gosl", Directive: "start"}}, Fields: []types.Field{{Name: "OppSignLRate", Doc: "how much to learn on opposite DA sign coding neuron (0..1)"}, {Name: "DaTol", Doc: "tolerance on DA -- if below this abs value, then DA goes to zero and there is no learning -- prevents prediction from exactly learning to cancel out reward value, retaining a residual valence of signal"}, {Name: "Lambda", Doc: "Lambda is the decay factor per time step (trial) for the eligibility trace\nof sending activity used in TDPredPath and ActorPath learning, which\ncorresponds to the product of the discount gamma and lambda in TD(lambda).\n0 = standard TD(0) learning from the previous time step only.\nLarger values propagate the TD error back to earlier states much faster."}, {Name: "pad"}}})
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.LDTParams", IDName: "ldt-params", Doc: "LDTParams compute reward salience as ACh global neuromodulatory signal\nas a function of the MAX activation of its inputs from salience detecting\nlayers (e.g., the superior colliculus: SC), and whenever there is an external\nUS outcome input (signalled by the global GvHasRew flag).\nACh from salience inputs is discounted by GoalMaint activity,\nreducing distraction when pursuing a goal, but US ACh activity is not so reduced.\nACh modulates excitability of goal-gating layers.", Directives: []types.Directive{{Tool: "gosl", Directive: "start"}}, Fields: []types.Field{{Name: "SrcThr", Doc: "SrcThr is the threshold per input source, on absolute value (magnitude),\nto count as a significant reward event, which then drives maximal ACh.\nSet to 0 to disable this nonlinear behavior."}, {Name: "Rew", Doc: "Rew uses the global Context.NeuroMod.HasRew flag to drive ACh:\nif there is some kind of external reward being given, then\nACh goes to 1, else 0 for this component."}, {Name: "MaintInhib", Doc: "MaintInhib is the extent to which active goal maintenance (via Global GoalMaint)\ninhibits ACh signals: when goal engaged, distractability is lower."}, {Name: "SrcLay1Index", Doc: "index of Layer to get max activity from; set during Build from BuildConfig\nSrcLay1Name if present -- -1 if not used."}, {Name: "SrcLay2Index", Doc: "index of Layer to get max activity from; set during Build from BuildConfig\nSrcLay2Name if present -- -1 if not used."}, {Name: "SrcLay3Index", Doc: "index of Layer to get max activity from; set during Build from BuildConfig\nSrcLay3Name if present -- -1 if not used."}, {Name: "SrcLay4Index", Doc: "index of Layer to get max activity from; set during Build from BuildConfig\nSrcLay4Name if present -- -1 if not used."}, {Name: "pad"}}})
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.VTAParams", IDName: "vta-params", Doc: "VTAParams are for computing overall VTA DA based on LHb PVDA\n(primary value -- at US time, computed at start of each trial\nand stored in LHbPVDA global value)\nand Amygdala (CeM) CS / learned value (LV) activations, which update\nevery cycle.", Fields: []types.Field{{Name: "CeMGain", Doc: "gain on CeM activity difference (CeMPos - CeMNeg) for generating LV CS-driven dopamine values"}, {Name: "LHbGain", Doc: "gain on computed LHb DA (Burst - Dip) -- for controlling DA levels"}, {Name: "AChThr", Doc: "threshold on ACh level required to generate LV CS-driven dopamine burst"}, {Name: "pad"}}})
 
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.LCParams", IDName: "lc-params", Doc: "LCParams are for computing the norepinephrine (NE) global neuromodulatory\nsignal from the locus coeruleus (LC), as a function of surprise,\ni.e., the magnitude of phasic dopamine (positive or negative reward\nprediction errors), and the uncertainty in the expected positive\noutcome (PVposVar), on top of a tonic baseline level.\nNE modulates neural gain, learning rate, and exploration (noise)\nvia NeuroModParams, and drives giving up on the current goal in Rubicon.", Fields: []types.Field{{Name: "Tonic", Doc: "Tonic is the baseline tonic level of NE."}, {Name: "SurpriseGain", Doc: "SurpriseGain is the gain on the absolute value of phasic dopamine,\nreflecting reward prediction error surprise."}, {Name: "UncertGain", Doc: "UncertGain is the gain on the uncertainty (variance) in the expected\npositive outcome, GvPVposVar."}, {Name: "pad"}}})
+
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.DRNParams", IDName: "drn-params", Doc: "DRNParams are for computing the serotonin (Ser, 5-HT) global\nneuromodulatory signal from the dorsal raphe nucleus (DRN),\nas a function of aversive outcomes (negative USs), and patient\nwaiting for an expected positive outcome while goal engaged,\nwhich is suppressed by urgency (i.e., a temporal discounting signal).\nSer modulates learning rate via NeuroModParams, and reduces the\nimpact of costs and urgency in Rubicon.", Fields: []types.Field{{Name: "Tonic", Doc: "Tonic is the baseline tonic level of Ser."}, {Name: "NegGain", Doc: "NegGain is the gain on the negative primary value (PVneg)\nwhen a negative US outcome is experienced."}, {Name: "WaitGain", Doc: "WaitGain is the gain on the estimated positive outcome (PVposEst)\nwhile goal engaged (VSMatrixHasGated), supporting patient waiting."}, {Name: "UrgencyInhib", Doc: "UrgencyInhib is the proportion by which urgency (GvUrgency)\nreduces Ser, producing steeper temporal discounting."}}})
+
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.BLAPathParams", IDName: "bla-path-params", Doc: "BLAPathParams has parameters for basolateral amygdala learning.\nLearning is driven by the Tr trace as function of ACh * Send Act\nrecorded prior to US, and at US, recv unit delta: CaP - CaDPrev\ntimes normalized GeIntNorm for recv unit credit assignment.", Directives: []types.Directive{{Tool: "gosl", Directive: "start"}}, Fields: []types.Field{{Name: "NegDeltaLRate", Doc: "use 0.01 for acquisition (don't unlearn) and 1 for extinction.\nnegative delta learning rate multiplier"}, {Name: "AChThr", Doc: "threshold on this layer's ACh level for trace learning updates"}, {Name: "USTrace", Doc: "proportion of US time stimulus activity to use for the trace component of"}, {Name: "pad"}}})
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.DriveParams", IDName: "drive-params", Doc: "DriveParams manages the drive parameters for computing and updating drive state.\nMost of the params are for optional case where drives are automatically\nupdated based on US consumption (which satisfies drives) and time passing\n(which increases drives).", Fields: []types.Field{{Name: "DriveMin", Doc: "minimum effective drive value, which is an automatic baseline ensuring\nthat a positive US results in at least some minimal level of reward.\nUnlike Base values, this is not reflected in the activity of the drive\nvalues, and applies at the time of reward calculation as a minimum baseline."}, {Name: "Base", Doc: "baseline levels for each drive, which is what they naturally trend toward\nin the absence of any input.  Set inactive drives to 0 baseline,\nactive ones typically elevated baseline (0-1 range)."}, {Name: "Tau", Doc: "time constants in ThetaCycle (trial) units for natural update toward\nBase values. 0 values means no natural update (can be updated externally)."}, {Name: "Satisfaction", Doc: "decrement in drive value when US is consumed, thus partially satisfying\nthe drive. Positive values are subtracted from current Drive value."}, {Name: "Dt", Doc: "1/Tau"}}})
 
-var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.UrgencyParams", IDName: "urgency-params", Doc: "UrgencyParams has urgency (increasing pressure to do something)\nand parameters for updating it.\nRaw urgency integrates effort when _not_ goal engaged\nwhile effort (negative US 0) integrates when a goal _is_ engaged.", Fields: []types.Field{{Name: "U50", Doc: "value of raw urgency where the urgency activation level is 50%"}, {Name: "Power", Doc: "exponent on the urge factor -- valid numbers are 1,2,4,6"}, {Name: "Thr", Doc: "threshold for urge -- cuts off small baseline values"}, {Name: "DAtonic", Doc: "gain factor for driving tonic DA levels as a function of urgency"}}})
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.UrgencyParams", IDName: "urgency-params", Doc: "UrgencyParams has urgency (increasing pressure to do something)\nand parameters for updating it.\nRaw urgency integrates effort when _not_ goal engaged\nwhile effort (negative US 0) integrates when a goal _is_ engaged.", Fields: []types.Field{{Name: "U50", Doc: "value of raw urgency where the urgency activation level is 50%"}, {Name: "Power", Doc: "exponent on the urge factor -- valid numbers are 1,2,4,6"}, {Name: "Thr", Doc: "threshold for urge -- cuts off small baseline values"}, {Name: "DAtonic", Doc: "gain factor for driving tonic DA levels as a function of urgency"}, {Name: "SerInhib", Doc: "SerInhib is the proportion by which serotonin (GvSer, from DRNLayer)\nreduces the tonic DA driven by urgency, producing more patient behavior.\nDefaults to 0 so that existing models are unaffected."}}})
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.USParams", IDName: "us-params", Doc: "USParams control how positive and negative USs and Costs are\nweighted and integrated to compute an overall PV primary value.", Fields: []types.Field{{Name: "PVposGain", Doc: "gain factor applied to sum of weighted, drive-scaled positive USs\nto compute PVpos primary summary value.\nThis is multiplied prior to 1/(1+x) normalization.\nUse this to adjust the overall scaling of PVpos reward within 0-1\nnormalized range (see also PVnegGain).\nEach USpos is assumed to be in 0-1 range, with a default of 1."}, {Name: "PVnegGain", Doc: "gain factor applied to sum of weighted negative USs and Costs\nto compute PVneg primary summary value.\nThis is multiplied prior to 1/(1+x) normalization.\nUse this to adjust overall scaling of PVneg within 0-1\nnormalized range (see also PVposGain)."}, {Name: "USnegGains", Doc: "Negative US gain factor for encoding each individual negative US,\nwithin their own separate input pools, multiplied prior to 1/(1+x)\nnormalization of each term for activating the USneg pools.\nThese gains are _not_ applied in computing summary PVneg value\n(see PVnegWts), and generally must be larger than the weights to leverage\nthe dynamic range within each US pool."}, {Name: "CostGains", Doc: "Cost gain factor for encoding the individual Time, Effort etc costs\nwithin their own separate input pools, multiplied prior to 1/(1+x)\nnormalization of each term for activating the Cost pools.\nThese gains are _not_ applied in computing summary PVneg value\n(see CostWts), and generally must be larger than the weights to use\nthe full dynamic range within each US pool."}, {Name: "PVposWts", Doc: "weight factor applied to each separate positive US on the way to computing\nthe overall PVpos summary value, to control the weighting of each US\nrelative to the others. Each pos US is also multiplied by its dynamic\nDrive factor as well.\nUse PVposGain to control the overall scaling of the PVpos value."}, {Name: "PVnegWts", Doc: "weight factor applied to each separate negative US on the way to computing\nthe overall PVneg summary value, to control the weighting of each US\nrelative to the others, and to the Costs.  These default to 1."}, {Name: "PVcostWts", Doc: "weight factor applied to each separate Cost (Time, Effort, etc) on the\nway to computing the overall PVneg summary value, to control the weighting\nof each Cost relative to the others, and relative to the negative USs.\nThe first pool is Time, second is Effort, and these are typically weighted\nlower (.02) than salient simulation-specific USs (1)."}, {Name: "USposEst", Doc: "computed estimated US values, based on OFCposPT and VSMatrix gating, in PVposEst"}}})
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.LHbParams", IDName: "l-hb-params", Doc: "LHbParams has values for computing LHb & RMTg which drives dips / pauses in DA firing.\nLHb handles all US-related (PV = primary value) processing.\nPositive net LHb activity drives dips / pauses in VTA DA activity,\ne.g., when predicted pos > actual or actual neg > predicted.\nNegative net LHb activity drives bursts in VTA DA activity,\ne.g., when actual pos > predicted (redundant with LV / Amygdala)\nor \"relief\" burst when actual neg < predicted.", Fields: []types.Field{{Name: "VSPatchNonRewThr", Doc: "threshold on VSPatch prediction during a non-reward trial"}, {Name: "VSPatchGain", Doc: "gain on the VSPatchD1 - D2 difference to drive the net VSPatch DA\nprediction signal, which goes in VSPatchPos and RewPred global variables"}, {Name: "VSPatchVarTau", Doc: "decay time constant for computing the temporal variance in VSPatch\nvalues over time"}, {Name: "NegThr", Doc: "threshold factor that multiplies integrated pvNeg value\nto establish a threshold for whether the integrated pvPos value\nis good enough to drive overall net positive reward.\nIf pvPos wins, it is then multiplicatively discounted by pvNeg;\notherwise, pvNeg is discounted by pvPos."}, {Name: "BurstGain", Doc: "gain multiplier on PVpos for purposes of generating bursts\n(not for discounting negative dips)."}, {Name: "DipGain", Doc: "gain multiplier on PVneg for purposes of generating dips\n(not for discounting positive bursts)."}, {Name: "VSPatchVarDt", Doc: "1/tau"}}})
 
-var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.GiveUpParams", IDName: "give-up-params", Doc: "GiveUpParams are parameters for computing when to give up,\nbased on Utility, Timing and Progress factors.", Fields: []types.Field{{Name: "ProbThr", Doc: "threshold on GiveUp probability, below which no give up is triggered"}, {Name: "MinGiveUpSum", Doc: "minimum GiveUpSum value, which is the denominator in the sigmoidal function.\nThis minimum prevents division by zero and any other degenerate values."}, {Name: "Utility", Doc: "the factor multiplying utility values: cost and expected positive outcome"}, {Name: "Timing", Doc: "the factor multiplying timing values from VSPatch"}, {Name: "Progress", Doc: "the factor multiplying progress values based on time-integrated progress\ntoward the goal"}, {Name: "MinUtility", Doc: "minimum utility cost and reward estimate values -- when they are below\nthese levels (at the start) then utility is effectively neutral,\nso the other factors take precedence."}, {Name: "VSPatchSumMax", Doc: "maximum VSPatchPosSum for normalizing the value for give-up weighing"}, {Name: "VSPatchVarMax", Doc: "maximum VSPatchPosVar for normalizing the value for give-up weighing"}, {Name: "ProgressRateTau", Doc: "time constant for integrating the ProgressRate\nvalues over time"}, {Name: "ProgressRateDt", Doc: "1/tau"}}})
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.GiveUpParams", IDName: "give-up-params", Doc: "GiveUpParams are parameters for computing when to give up,\nbased on Utility, Timing and Progress factors.", Fields: []types.Field{{Name: "ProbThr", Doc: "threshold on GiveUp probability, below which no give up is triggered"}, {Name: "MinGiveUpSum", Doc: "minimum GiveUpSum value, which is the denominator in the sigmoidal function.\nThis minimum prevents division by zero and any other degenerate values."}, {Name: "Utility", Doc: "the factor multiplying utility values: cost and expected positive outcome"}, {Name: "Timing", Doc: "the factor multiplying timing values from VSPatch"}, {Name: "Progress", Doc: "the factor multiplying progress values based on time-integrated progress\ntoward the goal"}, {Name: "MinUtility", Doc: "minimum utility cost and reward estimate values -- when they are below\nthese levels (at the start) then utility is effectively neutral,\nso the other factors take precedence."}, {Name: "VSPatchSumMax", Doc: "maximum VSPatchPosSum for normalizing the value for give-up weighing"}, {Name: "VSPatchVarMax", Doc: "maximum VSPatchPosVar for normalizing the value for give-up weighing"}, {Name: "ProgressRateTau", Doc: "time constant for integrating the ProgressRate\nvalues over time"}, {Name: "NE", Doc: "NE is the factor multiplying norepinephrine (GvNE, from LCLayer)\nas a give up factor: high NE levels drive disengagement from the\ncurrent goal and exploration of alternatives.\nDefaults to 0 so that existing models are unaffected."}, {Name: "SerPatience", Doc: "SerPatience is the proportion by which serotonin (GvSer, from DRNLayer)\nreduces the give up utility cost factor, supporting patience.\nDefaults to 0 so that existing models are unaffected."}, {Name: "ProgressRateDt", Doc: "1/tau"}}})
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.Rubicon", IDName: "rubicon", Doc: "Rubicon implements core elements of the Rubicon goal-directed motivational\nmodel, representing the core brainstem-level (hypothalamus) bodily drives\nand resulting dopamine from US (unconditioned stimulus) inputs,\nsubsuming the earlier Rubicon model of primary value (PV)\nand learned value (LV), describing the functions of the Amygala,\nVentral Striatum, VTA and associated midbrain nuclei (LDT, LHb, RMTg).\nCore LHb (lateral habenula) and VTA (ventral tegmental area) dopamine\nare computed in equations using inputs from specialized network layers\n(LDTLayer driven by BLA, CeM layers, VSPatchLayer).\nThe Drives, Effort, US and resulting LHb PV dopamine computation all happens at the\nat the start of each trial (NewState, Step).  The LV / CS dopamine is computed\ncycle-by-cycle by the VTA layer using parameters set by the VTA layer.\nRenders USLayer, PVLayer, DrivesLayer representations based on state updated here.", Fields: []types.Field{{Name: "NPosUSs", Doc: "number of possible positive US states and corresponding drives.\nThe first is always reserved for novelty / curiosity.\nMust be set programmatically via SetNUSs method,\nwhich allocates corresponding parameters."}, {Name: "NNegUSs", Doc: "number of possible phasic negative US states (e.g., shock, impact etc).\nMust be set programmatically via SetNUSs method, which allocates corresponding\nparameters."}, {Name: "NCosts", Doc: "number of possible costs, typically including accumulated time and effort costs.\nMust be set programmatically via SetNUSs method, which allocates corresponding\nparameters."}, {Name: "Drive", Doc: "parameters and state for built-in drives that form the core motivations\nof the agent, controlled by lateral hypothalamus and associated\nbody state monitoring such as glucose levels and thirst."}, {Name: "Urgency", Doc: "urgency (increasing pressure to do something) and parameters for\n\n\tupdating it. Raw urgency is incremented by same units as effort,\n\nbut is only reset with a positive US."}, {Name: "USs", Doc: "controls how positive and negative USs are weighted and integrated to\ncompute an overall PV primary value."}, {Name: "LHb", Doc: "lateral habenula (LHb) parameters and state, which drives\ndipping / pausing in dopamine when the predicted positive\noutcome > actual, or actual negative outcome > predicted.\nCan also drive bursting for the converse, and via matrix phasic firing."}, {Name: "GiveUp", Doc: "parameters for giving up based on PV pos - neg difference"}, {Name: "ValDecode", Doc: "population code decoding parameters for estimates from layers"}, {Name: "decodeActs"}}})
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.FieldValue", IDName: "field-value", Doc: "FieldValue holds the value of a field in a struct.", Fields: []types.Field{{Name: "Path"}, {Name: "Field"}, {Name: "Value"}, {Name: "Parent"}}})
 
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.SleepModes", IDName: "sleep-modes", Doc: "SleepModes are the types of offline activity during a [Sleep] phase."})
+
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.Sleep", IDName: "sleep", Doc: "Sleep has parameters and state for an offline sleep phase, in which the\nnetwork runs without normal external input, to consolidate learning.\nDuring sleep, the layer Acts.Noise parameters are replaced with the\nNoise parameters here, the global neuromodulators are set to the\nsleep levels, and the learning rate schedule multiplier (LRate.Sched)\nfollows its own schedule, from LRateStart to LRateEnd across the trials\nof the sleep phase. All of these are restored at the end of the phase.\nUse [LooperSleep] to configure a looper stack to run sleep phases.\nSleep implements [CheckpointState] to save the Replay buffer.", Fields: []types.Field{{Name: "Mode", Doc: "Mode is the type of offline activity to run."}, {Name: "Noise", Doc: "Noise are the background spiking noise parameters applied to all\nlayers during sleep, which are typically much stronger than during\nwaking, to drive spontaneous activity."}, {Name: "ACh", Doc: "ACh is the acetylcholine level during sleep, which is low in\nslow-wave sleep, reducing the influence of external inputs."}, {Name: "NE", Doc: "NE is the norepinephrine level during sleep, as the LC\nis largely silent during sleep."}, {Name: "Ser", Doc: "Ser is the serotonin level during sleep, as the DRN\nis largely silent during sleep."}, {Name: "DA", Doc: "DA is the tonic dopamine level during sleep."}, {Name: "LRateStart", Doc: "LRateStart is the learning rate schedule multiplier (LRate.Sched)\nat the start of the sleep phase."}, {Name: "LRateEnd", Doc: "LRateEnd is the learning rate schedule multiplier (LRate.Sched)\nat the end of the sleep phase, which is linearly interpolated\nfrom LRateStart over the trials of the sleep phase."}, {Name: "CuePct", Doc: "CuePct is the proportion of units in each replayed pattern\nthat are presented as a partial cue, for SleepReplay."}, {Name: "Replay", Doc: "Replay is the buffer of patterns recorded during training, for SleepReplay."}, {Name: "Active", Doc: "Active is true during a sleep phase, between Start and End."}, {Name: "noise", Doc: "noise are the saved layer noise parameters to restore at End."}, {Name: "sched", Doc: "sched are the saved path LRate.Sched values to restore at End."}}})
+
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.ReplayBuffer", IDName: "replay-buffer", Doc: "ReplayBuffer records activity patterns in given layers during training,\nfor replay during an offline [Sleep] phase. When the buffer is full,\nnew patterns replace the oldest ones.", Fields: []types.Field{{Name: "Layers", Doc: "Layers are the names of the layers to record and replay, which\nshould generally be InputLayer types so the replayed patterns\nare clamped as external inputs."}, {Name: "Max", Doc: "Max is the maximum number of patterns to store."}, {Name: "Patterns", Doc: "Patterns are the recorded ActP patterns, for each recorded trial,\nand each layer, in the shape of the layer."}, {Name: "next", Doc: "next is the index of the next pattern to replace when full."}}})
+
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.NetSpec", IDName: "net-spec", Doc: "NetSpec is a declarative specification of the layers and pathways\nof a network, which can be saved to and loaded from TOML or JSON\nfiles, so that model variants can be expressed as differences in\nconfig files instead of Go code. Use [BuildFromSpec] or\n[Network.ConfigFromSpec] to make a network from a spec, and\n[Network.ExportSpec] to get the spec for an existing network.\nParameters are not part of the spec: they are applied\nin the usual way after the network is built.", Fields: []types.Field{{Name: "Name", Doc: "Name is the name of the network."}, {Name: "MaxData", Doc: "MaxData is the maximum number of data parallel elements\nto allocate, if > 0."}, {Name: "RubiconPosUSs", Doc: "RubiconPosUSs is the number of simulation-specific positive USs\npassed to [Rubicon.SetNUSs], if > 0. Must be set for networks\nthat include Rubicon layers."}, {Name: "RubiconNegUSs", Doc: "RubiconNegUSs is the number of simulation-specific negative USs\npassed to [Rubicon.SetNUSs]."}, {Name: "Layers", Doc: "Layers are the layers, in the order in which they are added.\nEntries with a Builder add all of the layers and pathways\nof the corresponding composite builder method."}, {Name: "Paths", Doc: "Paths are the pathways, in the order in which they are connected,\nwhich is after all the Layers have been added, except for those\nconnected before a Builder, as given by its PathsBefore."}}})
+
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.LayerSpec", IDName: "layer-spec", Doc: "LayerSpec is the specification for one layer in a [NetSpec],\nor for a set of layers made by a composite builder method.", Fields: []types.Field{{Name: "Name", Doc: "Name is the name of the layer, or the name or prefix\npassed to the Builder."}, {Name: "Type", Doc: "Type is the type of layer. Not used for a Builder."}, {Name: "Shape", Doc: "Shape is the shape of the layer, with 2D and 4D shapes generally\npreferred, or the shape passed to the Builder."}, {Name: "Class", Doc: "Class has additional CSS-style class names for the layer,\nspace separated."}, {Name: "Doc", Doc: "Doc is the documentation for the layer, if different from the\ndefault for its Type."}, {Name: "Off", Doc: "Off inactivates the layer. For a Builder, it applies\nto all of the layers added."}, {Name: "Pos", Doc: "Pos is the relative position of the layer. For a Builder,\nit applies to the first layer added."}, {Name: "SampleIndexes", Doc: "SampleIndexes are the current set of \"sample\" unit indexes,\nused for display and stats. See [emer.LayerBase.SetSampleShape]."}, {Name: "SampleShape", Doc: "SampleShape is the shape to use for the SampleIndexes."}, {Name: "DendComps", Doc: "DendComps is the number of dendritic compartments per neuron.\nSee [Layer.DendComps]. For a Builder, it applies to all of\nthe layers added."}, {Name: "BuildConfig", Doc: "BuildConfig has configuration data set when the network is configured,\nthat is used during the network Build() process."}, {Name: "Builder", Doc: "Builder, if set, calls a composite builder method instead of\nadding a single layer."}}})
+
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.PathSpec", IDName: "path-spec", Doc: "PathSpec is the specification for one pathway in a [NetSpec].", Fields: []types.Field{{Name: "Send", Doc: "Send is the name of the sending layer."}, {Name: "Recv", Doc: "Recv is the name of the receiving layer."}, {Name: "Type", Doc: "Type is the type of pathway."}, {Name: "Pattern", Doc: "Pattern is the pattern of connectivity."}, {Name: "Class", Doc: "Class has additional CSS-style class names for the pathway,\nspace separated."}, {Name: "Doc", Doc: "Doc is the documentation for the pathway."}, {Name: "Off", Doc: "Off inactivates the pathway."}}})
+
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.PatternSpec", IDName: "pattern-spec", Doc: "PatternSpec is the specification for a [paths.Pattern],\nwith the name of the pattern type and the values of any\nfields that differ from the defaults for that type.", Fields: []types.Field{{Name: "Type", Doc: "Type is the name of the pattern type, as returned by its Name()\nmethod, which must be registered in [PatternTypes]."}, {Name: "Params", Doc: "Params are the values of fields in the pattern, by field name,\nusing the same structure as JSON encoding of the pattern."}}})
+
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.BuilderSpec", IDName: "builder-spec", Doc: "BuilderSpec has the parameters for a composite builder method\nused in a [LayerSpec], which also provides the Name and Shape.\nOnly the parameters relevant for the given Type are used.", Fields: []types.Field{{Name: "Type", Doc: "Type is the builder method to call."}, {Name: "Space", Doc: "Space is the spacing between layers placed by the builder."}, {Name: "PathsBefore", Doc: "PathsBefore is the number of the [NetSpec] Paths that are\nconnected before calling the builder, so that pathways connected\nbefore the builder in Go code keep the same order relative to\nthose made by the builder."}, {Name: "PathClass", Doc: "PathClass is the class added to pathways, for SuperCT."}, {Name: "Pattern", Doc: "Pattern is the pattern of connectivity from Super to CT, for SuperCT."}, {Name: "ThalSuffix", Doc: "ThalSuffix is the suffix for the thalamus layer, for PFC."}, {Name: "DecayOnRew", Doc: "DecayOnRew decays the PFC state on reward, for PFC."}, {Name: "SelfMaint", Doc: "SelfMaint adds self-maintenance pathways in the PT layer, for PFC."}, {Name: "GPShape", Doc: "GPShape is the 2D shape of the GP and STN layers, for VentralBG and DorsalBG."}, {Name: "PoolSTN", Doc: "PoolSTN uses a pooled STN layer, for DorsalBG."}, {Name: "NYneur", Doc: "NYneur is the number of neurons in the Y dimension of\npopulation codes, for Rubicon."}, {Name: "PopShape", Doc: "PopShape is the 2D shape of population code layers, for Rubicon."}, {Name: "BGShape", Doc: "BGShape is the 2D shape of basal ganglia pools, for Rubicon."}, {Name: "PFCShape", Doc: "PFCShape is the 2D shape of PFC pools, for Rubicon."}, {Name: "Hip", Doc: "Hip is the hippocampus configuration, for Hip."}, {Name: "Rel", Doc: "Rel is the placement relationship of the layers, for TDLayers\nand RWLayers."}}})
+
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.SpikeSendModes", IDName: "spike-send-modes", Doc: "SpikeSendModes are the modes for sending spikes on the CPU,\nin [SpikeSend]."})
+
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.SpikeSend", IDName: "spike-send", Doc: "SpikeSend has the parameters and state for event-driven sparse sending\nof spikes on the CPU. The dense [SendSpike] kernel visits all the sending\npathways of every neuron on each cycle, even though typically only a few\npercent of neurons spike. In sparse mode, a first pass over neurons does\nthe neuron-level PostSpike updates and records the spiking neurons\nin a list, and a second pass only visits the sending synapses of those\nneurons. Pathways that must be visited for every neuron (see\n[PathParams.SparseSend]) are sent in the first pass. Spikes are\naccumulated with integer atomic adds into [PathGBuf], so the results\nare identical to the dense mode, regardless of order.\nThis is not used on the GPU.", Fields: []types.Field{{Name: "Mode", Doc: "Mode determines when sparse sending is used."}, {Name: "Thr", Doc: "Thr is the threshold fraction of neurons spiking, below which\nsparse sending is used in SpikeSendAuto mode."}, {Name: "Interval", Doc: "Interval is the number of cycles between measurements of the\nfraction of neurons spiking, when using dense sending in\nSpikeSendAuto mode: the fraction is only measured in sparse mode."}, {Name: "Frac", Doc: "Frac is the fraction of neurons spiking on the last sparse cycle."}, {Name: "NSparse", Doc: "NSparse is the number of cycles that used sparse sending."}, {Name: "NDense", Doc: "NDense is the number of cycles that used dense sending."}, {Name: "spikes", Doc: "spikes is the list of neuron * data indexes that spiked."}, {Name: "nSpikes", Doc: "nSpikes is the number of spikes in the list."}, {Name: "sinceCheck", Doc: "sinceCheck is the number of dense cycles since the last measurement."}}})
+
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.STDPParams", IDName: "stdp-params", Doc: "STDPParams are parameters for optional spike-timing-dependent plasticity\nlearning rules that replace the default learning rule, for comparison\nand teaching purposes. The spike times of each neuron within the theta\ncycle are recorded in [STDPSpikes], and at the time of [DWtSyn] these are\nprocessed in order, using exponentially decaying traces that are carried\nacross theta cycles in [SynapseSTDP]. These per-synapse traces are only\nallocated for pathways using STDP. Default parameters are the\nall-to-all visual cortex fits from Pfister & Gerstner (2006),\nwith cycles = msec.", Fields: []types.Field{{Name: "Rule", Doc: "Rule is the STDP learning rule to use, if any."}, {Name: "TauPlus", Doc: "TauPlus is the time constant in cycles (msec) of the fast\npresynaptic trace, determining the width of the LTP window."}, {Name: "TauMinus", Doc: "TauMinus is the time constant in cycles (msec) of the fast\npostsynaptic trace, determining the width of the LTD window."}, {Name: "TauX", Doc: "TauX is the time constant in cycles (msec) of the slow\npresynaptic trace, for the triplet LTD term."}, {Name: "TauY", Doc: "TauY is the time constant in cycles (msec) of the slow\npostsynaptic trace, for the triplet LTP term."}, {Name: "APlus", Doc: "APlus is the pair-based LTP amplitude, for post after pre spikes."}, {Name: "AMinus", Doc: "AMinus is the pair-based LTD amplitude, for pre after post spikes."}, {Name: "A3Plus", Doc: "A3Plus is the triplet LTP amplitude, multiplying the slow\npostsynaptic trace from prior post spikes."}, {Name: "A3Minus", Doc: "A3Minus is the triplet LTD amplitude, multiplying the slow\npresynaptic trace from prior pre spikes."}, {Name: "DtPlus", Doc: "DtPlus = 1 / TauPlus"}, {Name: "DtMinus", Doc: "DtMinus = 1 / TauMinus"}, {Name: "DtX", Doc: "DtX = 1 / TauX"}, {Name: "DtY", Doc: "DtY = 1 / TauY"}, {Name: "pad"}, {Name: "pad1"}, {Name: "pad2"}}})
+
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.StructPlastParams", IDName: "struct-plast-params", Doc: "StructPlastParams are structural plasticity parameters, for pruning\nsynapses whose weights remain weak, and regrowing new synapses to\nreplace them. This is performed on the CPU at the SlowInterval,\nafter SlowAdapt, by [Network.StructPlast]. The SWt values change only at\nthe SlowInterval, so a weak SWt reflects a persistently weak synapse.\nThe number of synapses on each receiving neuron is conserved, so that\nthe memory layout, including the GPU buffers, does not change:\neach pruned synapse is replaced by a new synapse from a different\nsending neuron, initialized according to the SWts.Init parameters.", Fields: []types.Field{{Name: "On", Doc: "On enables structural plasticity for this pathway."}, {Name: "Regrow", Doc: "Regrow is the rule for selecting the sending neuron of new synapses."}, {Name: "WtThr", Doc: "WtThr is the threshold on the effective Wt weight value below which\na synapse is a candidate for pruning, if SWt is also below SWtThr."}, {Name: "SWtThr", Doc: "SWtThr is the threshold on the slowly adapting structural SWt value\nbelow which a synapse is a candidate for pruning, if Wt is also below\nWtThr. The SWt values are limited by SWts.Limit, so this must be above\nthe Limit.Min value to have any effect."}, {Name: "MaxFrac", Doc: "MaxFrac is the maximum proportion of synapses in the pathway that are\npruned in each update, taking the weakest synapses first."}, {Name: "pad"}, {Name: "pad1"}, {Name: "pad2"}}})
+
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.SynapseVars", IDName: "synapse-vars", Doc: "SynapseVars are the synapse variables representing synaptic weights, etc.\nThese do not depend on the data parallel index (di).\nSee [SynapseTraceVars] for variables that do depend on di."})
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.SynapseTraceVars", IDName: "synapse-trace-vars", Doc: "SynapseTraceVars are synaptic variables that depend on the data\nparallel index, for accumulating learning traces and weight changes per data."})
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.SynapseIndexVars", IDName: "synapse-index-vars", Doc: "SynapseIndexVars are synapse-level indexes used to access neurons and paths\nfrom the individual synapse level of processing."})
+
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.ThreeFactorRules", IDName: "three-factor-rules", Doc: "ThreeFactorRules are the synaptic eligibility rules for the optional\nthree-factor learning mode in [ThreeFactorParams], which replaces the\ndefault error-driven kinase learning rule with an eligibility trace\nthat is converted into a weight change by a modulatory factor."})
+
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.ThreeFactorMods", IDName: "three-factor-mods", Doc: "ThreeFactorMods are the sources of the modulatory (third) factor\nfor three-factor learning in [ThreeFactorParams]."})
+
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.ThreeFactorParams", IDName: "three-factor-params", Doc: "ThreeFactorParams are parameters for an optional three-factor learning\nmode that replaces the default error-driven learning rule, for comparison\nand for learning purely from reward or other global signals.\nA synaptic eligibility trace (stored in [SynapseTraces] Tr) accumulates the\nRule eligibility over trials, decaying by TraceDecay per trial, and the\nweight change is the learning rate times the modulatory factor times the trace.\nIf Delayed is on, the modulatory factor is only applied on trials when it\nis available, as indicated by [GvHasRew], e.g., at the end of a sequence,\nand the trace is reset after that.\nIn contrast, the [DWtParams] SynTraceTau trace integrates the error-driven\ncredit assignment factor, and is always applied on each trial.", Fields: []types.Field{{Name: "Rule", Doc: "Rule is the synaptic eligibility rule to use, if any."}, {Name: "Mod", Doc: "Mod is the source of the modulatory factor."}, {Name: "Global", Doc: "Global is the global scalar variable used as the modulatory factor\nfor ThreeFactorGlobal."}, {Name: "ModLayIndex", Doc: "ModLayIndex is the index of the layer that broadcasts its error signal\nfor ThreeFactorLayer. -1 = the receiving layer. Use\n[Path.SetThreeFactorLayer] to set from a layer."}, {Name: "TraceDecay", Doc: "TraceDecay is the decay factor per trial of the eligibility trace,\nwhere 0 = only the current trial eligibility is used."}, {Name: "Delayed", Doc: "Delayed only applies the modulatory factor on trials with [GvHasRew]\nset, to the eligibility trace accumulated since the last such trial,\nwhich is then reset. Otherwise the modulatory factor is applied\non every trial."}, {Name: "pad"}, {Name: "pad1"}}})
+
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.TraceCats", IDName: "trace-cats", Doc: "TraceCats are the categories of events in a [Tracer] timeline."})
+
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.TraceEvent", IDName: "trace-event", Doc: "TraceEvent is one event in a [Tracer] timeline.", Fields: []types.Field{{Name: "Cat", Doc: "Cat is the category of event."}, {Name: "Name", Doc: "Name is the name of the looper level, function, or kernel."}, {Name: "Mode", Doc: "Mode is the looper mode, for TraceLoop events."}, {Name: "Layer", Doc: "Layer is the index of the layer for TraceLayer events,\nand -1 if the items do not belong to a layer."}, {Name: "N", Doc: "N is the loop counter for TraceLoop, and the number of items\nfor TraceKernel."}, {Name: "Thread", Doc: "Thread is 0 for events on the calling goroutine, and\nthe worker index + 1 for TraceLayer events."}, {Name: "Start", Doc: "Start is the start time relative to the start of the trace."}, {Name: "Dur", Doc: "Dur is the duration of the event."}, {Name: "inv", Doc: "inv is the kernel invocation number, for TraceKernel and TraceLayer."}}})
+
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.Tracer", IDName: "tracer", Doc: "Tracer records a timeline of the looper levels (see [LooperTrace]),\nthe network function timers, and the invocations of the compute kernels\non the CPU, including the time spent by each worker on the items of each\nlayer. The timeline can be exported as a Chrome trace-event JSON file\nfor viewing in chrome://tracing or https://ui.perfetto.dev, and as a\npprof profile with labels for each kernel and layer.\nUse [Network.StartTrace] and [Network.StopTrace] to record.", Fields: []types.Field{{Name: "On", Doc: "On is whether events are being recorded."}, {Name: "MaxEvents", Doc: "MaxEvents is the maximum number of events to record,\nafter which recording stops and Truncated is set."}, {Name: "Truncated", Doc: "Truncated is set if recording stopped at MaxEvents."}, {Name: "LayerNames", Doc: "LayerNames are the names of the layers, for TraceLayer events."}, {Name: "LayerOf", Doc: "LayerOf returns the layer index for item idx of kernel invocation\nwith n items, or -1 if it does not belong to a layer."}, {Name: "start", Doc: "start is the start time of the trace."}, {Name: "threads", Doc: "threads are the events for each thread."}, {Name: "open", Doc: "open are the indexes of open events in thread 0."}, {Name: "inv", Doc: "inv is the current kernel invocation number."}, {Name: "kernel", Doc: "kernel is the name of the current kernel."}, {Name: "n", Doc: "n is the number of items in the current kernel invocation."}}})
+
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.WorkPool", IDName: "work-pool", Doc: "WorkPool is a persistent pool of worker goroutines that runs the\nCPU versions of the compute kernels, shared by all kernels.\nEach kernel invocation divides its n items into Grain chunks per worker,\nwhich are assigned to the workers in contiguous blocks. Each worker\nprocesses its own chunks in order, and then steals chunks from the\nend of the other workers' blocks, so that workers with cheaper items\ntake over work from those with more expensive ones (e.g., neurons in\nlayers with heavy channel dynamics). With NUMA on, the workers are\ngrouped by NUMA node, so that each node processes a contiguous range of\nitems in proportion to its number of workers, and workers steal from\nothers on the same node first. With Pin on (linux only), each worker\ngoroutine is locked to its own OS thread, which is restricted to the\nCPUs of the worker's node, so that the items stay on that node.\nThe calling goroutine runs as worker 0, and is not pinned,\nwhile the others wait for the next invocation, so no goroutines are\ncreated per kernel.", Fields: []types.Field{{Name: "NWorkers", Doc: "NWorkers is the number of workers, including the calling goroutine."}, {Name: "Grain", Doc: "Grain is the number of chunks per worker that the items are divided\ninto: more chunks give finer-grained balancing, with more overhead."}, {Name: "MinChunk", Doc: "MinChunk is the minimum number of items per chunk. Kernels with\nfewer than two chunks of items are run directly on the calling\ngoroutine. Values less than 1 are treated as 1."}, {Name: "Static", Doc: "Static turns off work stealing, so that each worker only processes\nits own chunks, as in a static partition of the items.\nThis is useful for measuring the load imbalance across workers."}, {Name: "PerCall", Doc: "PerCall runs each invocation on NWorkers new goroutines, one per\ncontiguous block of items, without stealing, as in the\ngpu.VectorizeFunc scheduling used before the pool.\nThis is only useful for comparing against the pool."}, {Name: "NUMA", Doc: "NUMA groups the workers by NUMA node, as determined by NodeWorkers."}, {Name: "Pin", Doc: "Pin pins the worker goroutines to the CPUs of their NUMA node,\nwhen there is more than one node (linux only). It takes effect\nwhen the workers are started by SetNWorkers."}, {Name: "NodeWorkers", Doc: "NodeWorkers is the number of workers on each NUMA node, in order,\nwhich is set from the CPUs per node on linux, and is otherwise one node."}, {Name: "RecStats", Doc: "RecStats records the per-kernel load imbalance stats in Stats."}, {Name: "RecFunTimes", Doc: "RecFunTimes, if set, is the [Network.RecFunTimes] flag, which also\nturns on the recording of Stats while it is on. It is read at the\nstart of each invocation, so it can be changed at any time."}, {Name: "Stats", Doc: "Stats are the per-kernel load imbalance stats, when RecStats\nor RecFunTimes is on."}, {Name: "Trace", Doc: "Trace records the kernel invocations and the work of each worker\non each layer, when set and On (see [Network.StartTrace])."}, {Name: "workers", Doc: "workers are the per-worker state."}, {Name: "steal", Doc: "steal is the order of other workers to steal from, for each worker."}, {Name: "fun", Doc: "fun is the kernel function being run."}, {Name: "n", Doc: "n is the number of items in the current invocation."}, {Name: "chunk", Doc: "chunk is the number of items per chunk in the current invocation."}, {Name: "trace", Doc: "trace is the Trace if recording the current invocation."}, {Name: "rec", Doc: "rec is true if recording the Stats for the current invocation."}, {Name: "running", Doc: "running is true during an invocation, so that any nested invocations\nare run directly on the calling goroutine."}, {Name: "wait", Doc: "wait is used to wait for the other workers to finish."}}})
+
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/axon.KernelStats", IDName: "kernel-stats", Doc: "KernelStats are the load imbalance stats for one kernel in a [WorkPool].\nThe imbalance for each invocation is the maximum over workers of the\ntime spent processing items, divided by the mean, so that 1 is a\nperfect balance, and NWorkers means that one worker did all the work.", Fields: []types.Field{{Name: "N", Doc: "N is the number of invocations."}, {Name: "Time", Doc: "Time is the total time across invocations."}, {Name: "Imbal", Doc: "Imbal is the sum of the imbalance across invocations."}, {Name: "MaxImbal", Doc: "MaxImbal is the maximum imbalance across invocations."}, {Name: "Chunks", Doc: "Chunks is the total number of chunks across invocations."}, {Name: "Steals", Doc: "Steals is the total number of stolen chunks across invocations."}}})
@@ -137,6 +137,13 @@ var (
 	//gosl:dims 3
 	PoolsInt *tensor.Int32
 
+	// Dendrites are the [DendVars] state values for the additional dendritic
+	// compartments beyond the first VmDend compartment, for layers with
+	// [Layer.DendComps] > 1. Use [LayerParams.DendIndex] to access.
+	// [NDendComps][Data][DendVarsN]; NDendComps = [Layer][Neurons][DendComps-1]
+	//gosl:dims 3
+	Dendrites *tensor.Float32
+
 	//////// Synapse State
 
 	// PathGBuf is the conductance buffer for accumulating spikes.
@@ -168,6 +175,40 @@ var (
 	//gosl:dims 3
 	//gosl:nbuffs 6
 	SynapseTraces *tensor.Float32
+
+	// PathSTP has the short-term plasticity state for each sending neuron
+	// in each pathway, with variables defined in [STPVars].
+	// [NSendCon][Data][STPVarsN]; NSendCon = [Layer][SendPaths][SendNeurons]
+	//gosl:dims 3
+	PathSTP *tensor.Float32
+
+	//////// STDP
+
+	// STDPSpikes records the spike times (cycles within the theta cycle)
+	// of each neuron, for computing STDP learning in [STDPParams].
+	// Index 0 holds the number of spikes recorded, followed by up to
+	// [STDPMaxSpikes] spike times. Only allocated if there are
+	// pathways using STDP learning.
+	// [NNeurons][Data][STDPMaxSpikes+1]
+	//gosl:dims 3
+	STDPSpikes *tensor.Float32
+
+	// SynapseSTDP has the per-synapse STDP trace values carried across
+	// theta cycles, with variables defined in [STDPVars]. Only allocated
+	// for pathways using STDP learning, indexed from [PathIndexes.STDPSt].
+	// [NSTDPSyns][Data][STDPVarsN]
+	//gosl:dims 3
+	SynapseSTDP *tensor.Float32
+
+	//////// Consolidation
+
+	// SynapseConsol has the per-synapse weight consolidation state,
+	// with variables defined in [ConsolVars]. Only allocated for
+	// pathways using weight consolidation ([ConsolParams]),
+	// indexed from [PathIndexes.ConsolSt].
+	// [NConsolSyns][ConsolVarsN]
+	//gosl:dims 2
+	SynapseConsol *tensor.Float32
 )
 
 //gosl:end
@@ -0,0 +1,810 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package axon
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"maps"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"cogentcore.org/core/base/errors"
+	"cogentcore.org/core/core"
+	"github.com/emer/emergent/v2/weights"
+)
+
+// The binary weights format is a compact alternative to the JSON weights
+// format, for large networks with millions of synapses. It is organized
+// the same way as the JSON format and the [weights.Network] structure,
+// with all values in little-endian byte order:
+//
+//   - Header: "AXONWTSB" tag, uint32 version, network name and MetaData.
+//   - uint32 number of layers, each of which has its name, shape sizes,
+//     MetaData, Units values (always float32), and receiving paths.
+//   - Each path has the sending layer name, MetaData, MetaValues, number of
+//     receiving neurons and total synapses, the Ri, N and Si connectivity
+//     indexes, and then the synaptic variables (Wt, Wt1 = SWt), each with a
+//     precision code and a payload of float32 or float16 values.
+//   - A final uint32 CRC-32 (IEEE) checksum of all preceding bytes.
+//
+// Strings are written as a uint32 length followed by the bytes.
+// Values are allocated only as they are read, so memory only grows
+// with the data actually present in the file.
+
+// WeightsBinaryVersion is the current version of the binary weights format.
+const WeightsBinaryVersion uint32 = 1
+
+// weightsBinaryMagic is the identifying tag at the start of a binary weights file.
+const weightsBinaryMagic = "AXONWTSB"
+
+// WeightsBinaryExt is the file extension for binary weights files,
+// as used by [Network.SaveWeights] to select the format.
+// A .gz extension can be added for gzip compression.
+const WeightsBinaryExt = ".wtsb"
+
+// weights precision codes for synaptic values in the binary format.
+const (
+	weightsFloat32 uint8 = 0
+	weightsFloat16 uint8 = 1
+)
+
+// WriteWeightsBinary writes the weights from this network in the compact
+// binary weights format. If half is true, the synaptic weight values are
+// stored as float16 instead of float32, which halves the file size at the
+// cost of ~3 significant digits of precision. Layer-level values are always
+// float32.
+func (nt *Network) WriteWeightsBinary(w io.Writer, half bool) error {
+	RunGPUSync()
+	RunDoneLayersSynapses()
+	ww := newWeightsWriter(w)
+	ww.string(weightsBinaryMagic)
+	ww.uint32s(WeightsBinaryVersion)
+	ww.string(nt.Name)
+	ww.metaData(nt.MetaData)
+	var onls []*Layer
+	for _, ly := range nt.Layers {
+		if !ly.Off {
+			onls = append(onls, ly)
+		}
+	}
+	ww.uint32s(uint32(len(onls)))
+	for _, ly := range onls {
+		lw := ly.WeightsLayer()
+		ww.layer(lw, ly.Shape.Sizes, half)
+		if ww.err != nil {
+			return ww.err
+		}
+	}
+	return ww.finish()
+}
+
+// ReadWeightsBinary reads the weights for this network from the compact
+// binary weights format written by [Network.WriteWeightsBinary].
+// All of the weights are read and the checksum is verified before any
+// of them are applied, so the network is unchanged if the file is
+// truncated or corrupted. The layer shapes must match, and all lengths
+// in the file are limited by the size of the network, so that a corrupted
+// file cannot cause large allocations before the checksum is checked.
+func (nt *Network) ReadWeightsBinary(r io.Reader) error {
+	wr := newWeightsReader(r)
+	nix := nt.NetIxs()
+	wr.maxLen = int(max(nix.NNeurons, nix.NSyns, nix.NLayers))
+	nw, nl := wr.header()
+	if wr.err != nil {
+		return wr.err
+	}
+	var errs []error
+	var lys []*Layer
+	var lws []*weights.Layer
+	for range nl {
+		lw, shape := wr.layer()
+		if wr.err != nil {
+			return wr.err
+		}
+		ly := nt.LayerByName(lw.Layer)
+		if ly == nil {
+			errs = append(errs, fmt.Errorf("ReadWeightsBinary: layer not found: %s", lw.Layer))
+			continue
+		}
+		if n := weightsShapeLen(shape); n != 0 && n != int(ly.NNeurons) {
+			errs = append(errs, fmt.Errorf("ReadWeightsBinary: layer %s shape: %v != weights shape: %v", ly.Name, ly.Shape.Sizes, shape))
+			continue
+		}
+		lys = append(lys, ly)
+		lws = append(lws, lw)
+	}
+	if err := wr.finish(); err != nil {
+		return err
+	}
+	if nw.Network != "" {
+		nt.Name = nw.Network
+	}
+	if nw.MetaData != nil {
+		if nt.MetaData == nil {
+			nt.MetaData = nw.MetaData
+		} else {
+			maps.Copy(nt.MetaData, nw.MetaData)
+		}
+	}
+	for i, ly := range lys {
+		if err := ly.SetWeights(lws[i]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	ToGPULayers()
+	ToGPUSynapsesIndexes()
+	RunGPUSync()
+	RunDone()
+	return errors.Join(errs...)
+}
+
+// SaveWeights saves network weights to given file name, selecting the
+// format based on the file extension: [WeightsBinaryExt] (.wtsb) uses
+// the binary format with float32 values, and anything else uses JSON.
+// If filename has .gz extension, then the file is gzip compressed.
+func (nt *Network) SaveWeights(filename core.Filename) error {
+	if !IsWeightsBinaryFile(string(filename)) {
+		return nt.SaveWeightsJSON(filename)
+	}
+	return writeCheckpointFile(filename, func(w io.Writer) error {
+		return nt.WriteWeightsBinary(w, false)
+	})
+}
+
+// OpenWeights opens network weights from given file name, automatically
+// detecting whether it is in the binary or JSON format from its contents,
+// and whether it is gzip compressed.
+func (nt *Network) OpenWeights(filename core.Filename) error {
+	fp, err := os.Open(string(filename))
+	if err != nil {
+		return errors.Log(err)
+	}
+	defer fp.Close()
+	r, err := weightsFileReader(fp)
+	if err != nil {
+		return errors.Log(err)
+	}
+	if isWeightsBinary(r) {
+		return errors.Log(nt.ReadWeightsBinary(r))
+	}
+	return nt.ReadWeightsJSON(r)
+}
+
+// IsWeightsBinaryFile returns true if given file name has the
+// [WeightsBinaryExt] extension, optionally followed by .gz.
+func IsWeightsBinaryFile(filename string) bool {
+	return filepath.Ext(strings.TrimSuffix(filename, ".gz")) == WeightsBinaryExt
+}
+
+// ConvertWeightsFile converts a weights file between the JSON and binary
+// formats, without requiring a network. The input format is detected
+// from the file contents, and the output format from the file extension,
+// as in [Network.SaveWeights]. If half is true, binary output uses float16
+// synaptic values. Either file can be gzip compressed with a .gz extension.
+func ConvertWeightsFile(from, to core.Filename, half bool) error {
+	fp, err := os.Open(string(from))
+	if err != nil {
+		return errors.Log(err)
+	}
+	defer fp.Close()
+	r, err := weightsFileReader(fp)
+	if err != nil {
+		return errors.Log(err)
+	}
+	var nw *weights.Network
+	if isWeightsBinary(r) {
+		nw, err = ReadWeightsBinaryNetwork(r)
+	} else {
+		nw, err = weights.NetReadJSON(r)
+	}
+	if err != nil {
+		return errors.Log(err)
+	}
+	if IsWeightsBinaryFile(string(to)) {
+		return writeCheckpointFile(to, func(w io.Writer) error {
+			return WriteWeightsBinaryNetwork(w, nw, nil, half)
+		})
+	}
+	return writeCheckpointFile(to, func(w io.Writer) error {
+		return WriteWeightsJSONNetwork(w, nw)
+	})
+}
+
+// WriteWeightsBinaryNetwork writes given decoded weights in the binary format.
+// The layer shapes are taken from the shapes map by layer name if present,
+// and otherwise written as a 1D shape with the number of units, which is
+// sufficient for checking that the number of neurons matches when loading.
+func WriteWeightsBinaryNetwork(w io.Writer, nw *weights.Network, shapes map[string][]int, half bool) error {
+	ww := newWeightsWriter(w)
+	ww.string(weightsBinaryMagic)
+	ww.uint32s(WeightsBinaryVersion)
+	ww.string(nw.Network)
+	ww.metaData(nw.MetaData)
+	ww.uint32s(uint32(len(nw.Layers)))
+	for li := range nw.Layers {
+		lw := &nw.Layers[li]
+		shape, ok := shapes[lw.Layer]
+		if !ok {
+			shape = []int{weightsLayerUnits(lw)}
+		}
+		ww.layer(lw, shape, half)
+		if ww.err != nil {
+			return ww.err
+		}
+	}
+	return ww.finish()
+}
+
+// ReadWeightsBinaryNetwork reads the full binary weights into a
+// [weights.Network] structure, e.g., for conversion to JSON.
+func ReadWeightsBinaryNetwork(r io.Reader) (*weights.Network, error) {
+	wr := newWeightsReader(r)
+	nw, nl := wr.header()
+	for range nl {
+		if wr.err != nil {
+			break
+		}
+		lw, _ := wr.layer()
+		if lw != nil {
+			nw.Layers = append(nw.Layers, *lw)
+		}
+	}
+	if wr.err != nil {
+		return nil, wr.err
+	}
+	return nw, wr.finish()
+}
+
+// WriteWeightsJSONNetwork writes given decoded weights in the JSON weights
+// format, which can be read by [Network.ReadWeightsJSON].
+func WriteWeightsJSONNetwork(w io.Writer, nw *weights.Network) error {
+	b, err := json.MarshalIndent(nw, "", "\t")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+// WeightsLayer returns the weights for this layer and its receiving paths
+// as a [weights.Layer] structure, with the same content as WriteWeightsJSON.
+func (ly *Layer) WeightsLayer() *weights.Layer {
+	uvars := ly.weightsMetaData()
+	lw := &weights.Layer{Layer: ly.Name, MetaData: maps.Clone(ly.MetaData)}
+	if len(uvars) > 0 {
+		lw.Units = make(map[string][]float32)
+		for _, vnm := range uvars {
+			vidx, err := ly.UnitVarIndex(vnm)
+			if errors.Log(err) != nil {
+				continue
+			}
+			vals := make([]float32, ly.NNeurons)
+			for lni := range vals {
+				vals[lni] = ly.UnitValue1D(vidx, lni, 0)
+			}
+			lw.Units[vnm] = vals
+		}
+	}
+	for _, pt := range ly.RecvPaths {
+		if !pt.Off {
+			lw.Paths = append(lw.Paths, *pt.WeightsPath())
+		}
+	}
+	return lw
+}
+
+// WeightsPath returns the weights for this path as a [weights.Path]
+// structure, with the same content as WriteWeightsJSON.
+func (pt *Path) WeightsPath() *weights.Path {
+	nr := int(pt.Recv.NNeurons)
+	pw := &weights.Path{From: pt.Send.Name, Rs: make([]weights.Recv, nr)}
+	for ri := range nr {
+		pt.weightsRecv(ri, &pw.Rs[ri])
+	}
+	return pw
+}
+
+// weightsLayerUnits returns the number of units in given layer weights,
+// based on unit variables or the number of receiving neurons in paths.
+func weightsLayerUnits(lw *weights.Layer) int {
+	for _, vals := range lw.Units {
+		return len(vals)
+	}
+	for pi := range lw.Paths {
+		return len(lw.Paths[pi].Rs)
+	}
+	return 0
+}
+
+// weightsShapeLen returns the total number of units in given layer shape.
+func weightsShapeLen(shape []int) int {
+	if len(shape) == 0 {
+		return 0
+	}
+	n := 1
+	for _, sz := range shape {
+		n *= sz
+	}
+	return n
+}
+
+// isWeightsBinary returns true if the reader starts with the binary weights tag.
+func isWeightsBinary(r *bufio.Reader) bool {
+	tag, _ := r.Peek(4 + len(weightsBinaryMagic))
+	return len(tag) == 4+len(weightsBinaryMagic) && string(tag[4:]) == weightsBinaryMagic
+}
+
+// weightsFileReader returns a buffered reader for given file,
+// which is gzip uncompressed if it starts with the gzip header.
+func weightsFileReader(fp io.Reader) (*bufio.Reader, error) {
+	br := bufio.NewReader(fp)
+	hdr, _ := br.Peek(2)
+	if len(hdr) == 2 && hdr[0] == 0x1f && hdr[1] == 0x8b {
+		gzr, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, err
+		}
+		return bufio.NewReader(gzr), nil
+	}
+	return br, nil
+}
+
+//////// writer
+
+// weightsWriter writes binary weights, accumulating the checksum
+// and retaining the first error, so that errors need only be checked
+// at the end of each layer.
+type weightsWriter struct {
+	w   *bufio.Writer
+	crc hash.Hash32
+	mw  io.Writer
+	err error
+}
+
+func newWeightsWriter(w io.Writer) *weightsWriter {
+	ww := &weightsWriter{w: bufio.NewWriter(w), crc: crc32.NewIEEE()}
+	ww.mw = io.MultiWriter(ww.w, ww.crc)
+	return ww
+}
+
+func (ww *weightsWriter) write(v any) {
+	if ww.err == nil {
+		ww.err = binary.Write(ww.mw, binary.LittleEndian, v)
+	}
+}
+
+func (ww *weightsWriter) uint32s(v ...uint32) {
+	ww.write(v)
+}
+
+func (ww *weightsWriter) string(s string) {
+	ww.uint32s(uint32(len(s)))
+	if ww.err == nil {
+		_, ww.err = io.WriteString(ww.mw, s)
+	}
+}
+
+func (ww *weightsWriter) metaData(md map[string]string) {
+	kys := slices.Sorted(maps.Keys(md))
+	ww.uint32s(uint32(len(kys)))
+	for _, k := range kys {
+		ww.string(k)
+		ww.string(md[k])
+	}
+}
+
+func (ww *weightsWriter) values(md map[string][]float32) {
+	kys := slices.Sorted(maps.Keys(md))
+	ww.uint32s(uint32(len(kys)))
+	for _, k := range kys {
+		ww.string(k)
+		ww.uint32s(uint32(len(md[k])))
+		ww.write(md[k])
+	}
+}
+
+func (ww *weightsWriter) layer(lw *weights.Layer, shape []int, half bool) {
+	ww.string(lw.Layer)
+	ww.uint32s(uint32(len(shape)))
+	for _, sz := range shape {
+		ww.uint32s(uint32(sz))
+	}
+	ww.metaData(lw.MetaData)
+	ww.values(lw.Units)
+	ww.uint32s(uint32(len(lw.Paths)))
+	for pi := range lw.Paths {
+		ww.path(&lw.Paths[pi], half)
+	}
+}
+
+func (ww *weightsWriter) path(pw *weights.Path, half bool) {
+	ww.string(pw.From)
+	ww.metaData(pw.MetaData)
+	ww.values(pw.MetaValues)
+	nsyn := 0
+	hasWt2 := false
+	for ri := range pw.Rs {
+		nsyn += len(pw.Rs[ri].Si)
+		hasWt2 = hasWt2 || len(pw.Rs[ri].Wt2) > 0
+	}
+	ww.uint32s(uint32(len(pw.Rs)), uint32(nsyn))
+	idxs := make([]uint32, 0, max(nsyn, 2*len(pw.Rs)))
+	for ri := range pw.Rs {
+		idxs = append(idxs, uint32(pw.Rs[ri].Ri), uint32(len(pw.Rs[ri].Si)))
+	}
+	ww.write(idxs)
+	idxs = idxs[:0]
+	for ri := range pw.Rs {
+		for _, si := range pw.Rs[ri].Si {
+			idxs = append(idxs, uint32(si))
+		}
+	}
+	ww.write(idxs)
+	nvars := uint32(2)
+	if hasWt2 {
+		nvars = 3
+	}
+	ww.uint32s(nvars)
+	ww.synValues(pw, "Wt", nsyn, half, func(pr *weights.Recv) []float32 { return pr.Wt })
+	ww.synValues(pw, "Wt1", nsyn, half, func(pr *weights.Recv) []float32 { return pr.Wt1 })
+	if hasWt2 {
+		ww.synValues(pw, "Wt2", nsyn, half, func(pr *weights.Recv) []float32 { return pr.Wt2 })
+	}
+}
+
+// synValues writes one synaptic variable for all receiving neurons,
+// with missing values written as 0.
+func (ww *weightsWriter) synValues(pw *weights.Path, name string, nsyn int, half bool, vals func(pr *weights.Recv) []float32) {
+	ww.string(name)
+	prec := weightsFloat32
+	if half {
+		prec = weightsFloat16
+	}
+	ww.write(prec)
+	if half {
+		hv := make([]uint16, 0, nsyn)
+		for ri := range pw.Rs {
+			pr := &pw.Rs[ri]
+			vs := vals(pr)
+			for ci := range pr.Si {
+				hv = append(hv, float32ToFloat16(weightsValue(vs, ci)))
+			}
+		}
+		ww.write(hv)
+		return
+	}
+	fv := make([]float32, 0, nsyn)
+	for ri := range pw.Rs {
+		pr := &pw.Rs[ri]
+		vs := vals(pr)
+		for ci := range pr.Si {
+			fv = append(fv, weightsValue(vs, ci))
+		}
+	}
+	ww.write(fv)
+}
+
+// finish writes the checksum and flushes the output.
+func (ww *weightsWriter) finish() error {
+	if ww.err != nil {
+		return ww.err
+	}
+	if err := binary.Write(ww.w, binary.LittleEndian, ww.crc.Sum32()); err != nil {
+		return err
+	}
+	return ww.w.Flush()
+}
+
+func weightsValue(vs []float32, i int) float32 {
+	if i < len(vs) {
+		return vs[i]
+	}
+	return 0
+}
+
+//////// reader
+
+// weightsMaxString is the maximum length of strings in binary weights files.
+const weightsMaxString = 1 << 20
+
+// weightsReadChunk is the maximum number of values allocated at a time
+// when reading, so that memory only grows with the data actually read,
+// not the lengths given in the file.
+const weightsReadChunk = 1 << 16
+
+// weightsReader reads binary weights, accumulating the checksum
+// and retaining the first error.
+type weightsReader struct {
+	r   *bufio.Reader
+	crc hash.Hash32
+	tr  io.Reader
+	err error
+
+	// maxLen is the maximum number of values of any kind,
+	// based on the network sizes. 0 = no limit.
+	maxLen int
+}
+
+func newWeightsReader(r io.Reader) *weightsReader {
+	br, ok := r.(*bufio.Reader)
+	if !ok {
+		br = bufio.NewReader(r)
+	}
+	wr := &weightsReader{r: br, crc: crc32.NewIEEE()}
+	wr.tr = io.TeeReader(wr.r, wr.crc)
+	return wr
+}
+
+func (wr *weightsReader) read(v any) {
+	if wr.err == nil {
+		wr.err = binary.Read(wr.tr, binary.LittleEndian, v)
+	}
+}
+
+func (wr *weightsReader) uint32() uint32 {
+	var v uint32
+	wr.read(&v)
+	return v
+}
+
+// length reads a number of values, returning an error
+// if it is greater than given maximum (if > 0).
+func (wr *weightsReader) length(mx int) int {
+	n := int(wr.uint32())
+	if wr.err == nil && mx > 0 && n > mx {
+		wr.err = fmt.Errorf("ReadWeightsBinary: length %d exceeds maximum: %d", n, mx)
+	}
+	if wr.err != nil {
+		return 0
+	}
+	return n
+}
+
+// readWeightsValues reads n values, allocating as they are read.
+func readWeightsValues[T uint8 | uint16 | uint32 | float32](wr *weightsReader, n int) []T {
+	var vals []T
+	for len(vals) < n && wr.err == nil {
+		st := len(vals)
+		m := min(n-st, weightsReadChunk)
+		vals = slices.Grow(vals, m)[:st+m]
+		wr.read(vals[st:])
+	}
+	return vals
+}
+
+func (wr *weightsReader) string() string {
+	return string(readWeightsValues[uint8](wr, wr.length(weightsMaxString)))
+}
+
+func (wr *weightsReader) metaData() map[string]string {
+	n := wr.length(wr.maxLen)
+	if n == 0 {
+		return nil
+	}
+	md := make(map[string]string)
+	for range n {
+		k := wr.string()
+		md[k] = wr.string()
+		if wr.err != nil {
+			break
+		}
+	}
+	return md
+}
+
+func (wr *weightsReader) values() map[string][]float32 {
+	n := wr.length(wr.maxLen)
+	if n == 0 {
+		return nil
+	}
+	md := make(map[string][]float32)
+	for range n {
+		k := wr.string()
+		md[k] = readWeightsValues[float32](wr, wr.length(wr.maxLen))
+		if wr.err != nil {
+			break
+		}
+	}
+	return md
+}
+
+// header reads the file header, returning the network
+// with its name and MetaData, and the number of layers.
+func (wr *weightsReader) header() (*weights.Network, int) {
+	if tag := wr.string(); wr.err == nil && tag != weightsBinaryMagic {
+		wr.err = errors.New("ReadWeightsBinary: not an axon binary weights file")
+	}
+	if vers := wr.uint32(); wr.err == nil && vers != WeightsBinaryVersion {
+		wr.err = fmt.Errorf("ReadWeightsBinary: weights version %d != current version %d", vers, WeightsBinaryVersion)
+	}
+	nw := &weights.Network{}
+	nw.Network = wr.string()
+	nw.MetaData = wr.metaData()
+	return nw, wr.length(wr.maxLen)
+}
+
+// layer reads the next layer, returning it and its shape.
+func (wr *weightsReader) layer() (*weights.Layer, []int) {
+	lw := &weights.Layer{Layer: wr.string()}
+	shp := readWeightsValues[uint32](wr, wr.length(wr.maxLen))
+	shape := make([]int, len(shp))
+	for i, sz := range shp {
+		shape[i] = int(sz)
+	}
+	lw.MetaData = wr.metaData()
+	lw.Units = wr.values()
+	np := wr.length(wr.maxLen)
+	for range np {
+		if wr.err != nil {
+			break
+		}
+		lw.Paths = append(lw.Paths, weights.Path{})
+		wr.path(&lw.Paths[len(lw.Paths)-1])
+	}
+	if wr.err != nil {
+		return nil, nil
+	}
+	return lw, shape
+}
+
+func (wr *weightsReader) path(pw *weights.Path) {
+	pw.From = wr.string()
+	pw.MetaData = wr.metaData()
+	pw.MetaValues = wr.values()
+	nr := wr.length(wr.maxLen)
+	nsyn := wr.length(wr.maxLen)
+	idxs := readWeightsValues[uint32](wr, 2*nr)
+	sis := readWeightsValues[uint32](wr, nsyn)
+	if wr.err != nil {
+		return
+	}
+	pw.Rs = make([]weights.Recv, nr)
+	st := 0
+	for ri := range pw.Rs {
+		pr := &pw.Rs[ri]
+		pr.Ri = int(idxs[2*ri])
+		pr.N = int(idxs[2*ri+1])
+		if st+pr.N > nsyn {
+			wr.err = fmt.Errorf("ReadWeightsBinary: path from %s: synapse counts exceed total: %d", pw.From, nsyn)
+			return
+		}
+		pr.Si = make([]int, pr.N)
+		for ci := range pr.N {
+			pr.Si[ci] = int(sis[st+ci])
+		}
+		st += pr.N
+	}
+	nvars := wr.length(3)
+	for range nvars {
+		name := wr.string()
+		vals := wr.synValues(nsyn)
+		if wr.err != nil {
+			return
+		}
+		st := 0
+		for ri := range pw.Rs {
+			pr := &pw.Rs[ri]
+			vs := vals[st : st+pr.N]
+			switch name {
+			case "Wt":
+				pr.Wt = vs
+			case "Wt1":
+				pr.Wt1 = vs
+			case "Wt2":
+				pr.Wt2 = vs
+			}
+			st += pr.N
+		}
+	}
+}
+
+// synValues reads one synaptic variable, converting from float16 as needed.
+func (wr *weightsReader) synValues(nsyn int) []float32 {
+	var prec uint8
+	wr.read(&prec)
+	if wr.err != nil {
+		return nil
+	}
+	switch prec {
+	case weightsFloat32:
+		return readWeightsValues[float32](wr, nsyn)
+	case weightsFloat16:
+		hv := readWeightsValues[uint16](wr, nsyn)
+		vals := make([]float32, len(hv))
+		for i, h := range hv {
+			vals[i] = float16ToFloat32(h)
+		}
+		return vals
+	}
+	wr.err = fmt.Errorf("ReadWeightsBinary: invalid precision code: %d", prec)
+	return nil
+}
+
+// finish verifies the checksum at the end of the data.
+func (wr *weightsReader) finish() error {
+	if wr.err != nil {
+		return wr.err
+	}
+	sum := wr.crc.Sum32()
+	var fsum uint32
+	if err := binary.Read(wr.r, binary.LittleEndian, &fsum); err != nil {
+		return err
+	}
+	if fsum != sum {
+		return fmt.Errorf("ReadWeightsBinary: checksum mismatch: file: %08x != computed: %08x", fsum, sum)
+	}
+	return nil
+}
+
+//////// float16
+
+// float32ToFloat16 converts a float32 to IEEE 754 half precision bits,
+// with round-to-nearest-even, saturating to infinity.
+func float32ToFloat16(f float32) uint16 {
+	b := math.Float32bits(f)
+	sign := uint16(b>>16) & 0x8000
+	exp := int32(b>>23) & 0xff
+	mant := b & 0x7fffff
+	switch {
+	case exp == 0xff: // inf, nan
+		if mant != 0 {
+			return sign | 0x7e00
+		}
+		return sign | 0x7c00
+	case exp-127 > 15: // overflow
+		return sign | 0x7c00
+	case exp-127 >= -14: // normal
+		h := uint32(exp-127+15)<<10 | mant>>13
+		rem := mant & 0x1fff
+		if rem > 0x1000 || (rem == 0x1000 && h&1 == 1) {
+			h++ // may carry into exponent, which is correct
+		}
+		return sign | uint16(h)
+	case exp-127 >= -25: // subnormal
+		mant |= 0x800000
+		shift := uint32(-14-(exp-127)) + 13
+		h := mant >> shift
+		rem := mant & (1<<shift - 1)
+		half := uint32(1) << (shift - 1)
+		if rem > half || (rem == half && h&1 == 1) {
+			h++
+		}
+		return sign | uint16(h)
+	}
+	return sign
+}
+
+// float16ToFloat32 converts IEEE 754 half precision bits to a float32.
+func float16ToFloat32(h uint16) float32 {
+	sign := uint32(h&0x8000) << 16
+	exp := uint32(h>>10) & 0x1f
+	mant := uint32(h & 0x3ff)
+	switch {
+	case exp == 0x1f:
+		return math.Float32frombits(sign | 0x7f800000 | mant<<13)
+	case exp == 0:
+		if mant == 0 {
+			return math.Float32frombits(sign)
+		}
+		exp = 127 - 14
+		for mant&0x400 == 0 {
+			mant <<= 1
+			exp--
+		}
+		mant &= 0x3ff
+		return math.Float32frombits(sign | exp<<23 | mant<<13)
+	}
+	return math.Float32frombits(sign | (exp+127-15)<<23 | mant<<13)
+}
@@ -0,0 +1,607 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package axon
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// CPUPool is the worker pool used to run all of the compute kernels
+// on the CPU, with the number of workers set by [Network.SetNThreads].
+var CPUPool = NewWorkPool(1)
+
+// VectorizeFunc runs given kernel function for n items on the CPU,
+// using [CPUPool]. The name of the kernel is used for recording
+// the load imbalance stats.
+func VectorizeFunc(name string, n int, fun func(idx uint32)) {
+	CPUPool.Run(name, n, fun)
+}
+
+// RunKernel runs the given kernel for n items, on the GPU using
+// gpuRun if UseGPU, and otherwise on the CPU using [CPUPool].
+// This is used instead of the gosl-generated Run* functions,
+// whose CPU version uses gpu.VectorizeFunc, which starts new
+// goroutines for each call, with a static partition of the items.
+func RunKernel(name string, n int, gpuRun func(n int), fun func(idx uint32)) {
+	if UseGPU {
+		gpuRun(n)
+		return
+	}
+	CPUPool.Run(name, n, fun)
+}
+
+// WorkPool is a persistent pool of worker goroutines that runs the
+// CPU versions of the compute kernels, shared by all kernels.
+// Each kernel invocation divides its n items into Grain chunks per worker,
+// which are assigned to the workers in contiguous blocks. Each worker
+// processes its own chunks in order, and then steals chunks from the
+// end of the other workers' blocks, so that workers with cheaper items
+// take over work from those with more expensive ones (e.g., neurons in
+// layers with heavy channel dynamics). With NUMA on, the workers are
+// grouped by NUMA node, so that each node processes a contiguous range of
+// items in proportion to its number of workers, and workers steal from
+// others on the same node first. With Pin on (linux only), each worker
+// goroutine is locked to its own OS thread, which is restricted to the
+// CPUs of the worker's node, so that the items stay on that node.
+// The calling goroutine runs as worker 0, and is not pinned,
+// while the others wait for the next invocation, so no goroutines are
+// created per kernel.
+type WorkPool struct {
+
+	// NWorkers is the number of workers, including the calling goroutine.
+	NWorkers int `edit:"-"`
+
+	// Grain is the number of chunks per worker that the items are divided
+	// into: more chunks give finer-grained balancing, with more overhead.
+	Grain int `default:"4"`
+
+	// MinChunk is the minimum number of items per chunk. Kernels with
+	// fewer than two chunks of items are run directly on the calling
+	// goroutine. Values less than 1 are treated as 1.
+	MinChunk int `default:"16"`
+
+	// Static turns off work stealing, so that each worker only processes
+	// its own chunks, as in a static partition of the items.
+	// This is useful for measuring the load imbalance across workers.
+	Static bool
+
+	// PerCall runs each invocation on NWorkers new goroutines, one per
+	// contiguous block of items, without stealing, as in the
+	// gpu.VectorizeFunc scheduling used before the pool.
+	// This is only useful for comparing against the pool.
+	PerCall bool
+
+	// NUMA groups the workers by NUMA node, as determined by NodeWorkers.
+	NUMA bool `default:"true"`
+
+	// Pin pins the worker goroutines to the CPUs of their NUMA node,
+	// when there is more than one node (linux only). It takes effect
+	// when the workers are started by SetNWorkers.
+	Pin bool `default:"true"`
+
+	// NodeWorkers is the number of workers on each NUMA node, in order,
+	// which is set from the CPUs per node on linux, and is otherwise one node.
+	NodeWorkers []int `edit:"-"`
+
+	// RecStats records the per-kernel load imbalance stats in Stats.
+	RecStats bool
+
+	// RecFunTimes, if set, is the [Network.RecFunTimes] flag, which also
+	// turns on the recording of Stats while it is on. It is read at the
+	// start of each invocation, so it can be changed at any time.
+	RecFunTimes *bool `display:"-"`
+
+	// Stats are the per-kernel load imbalance stats, when RecStats
+	// or RecFunTimes is on.
+	Stats map[string]*KernelStats `edit:"-"`
+
+	// Trace records the kernel invocations and the work of each worker
+	// on each layer, when set and On (see [Network.StartTrace]).
+	Trace *Tracer `display:"-"`
+
+	// workers are the per-worker state.
+	workers []poolWorker
+
+	// steal is the order of other workers to steal from, for each worker.
+	steal [][]int
+
+	// fun is the kernel function being run.
+	fun func(idx uint32)
+
+	// n is the number of items in the current invocation.
+	n int
+
+	// chunk is the number of items per chunk in the current invocation.
+	chunk int
+
+	// trace is the Trace if recording the current invocation.
+	trace *Tracer
+
+	// rec is true if recording the Stats for the current invocation.
+	rec bool
+
+	// running is true during an invocation, so that any nested invocations
+	// are run directly on the calling goroutine.
+	running atomic.Bool
+
+	// wait is used to wait for the other workers to finish.
+	wait sync.WaitGroup
+}
+
+// poolWorker is the state for one worker in a [WorkPool].
+type poolWorker struct {
+
+	// chunks is the range of chunk indexes remaining for this worker,
+	// with the start in the upper 32 bits and the end in the lower 32 bits.
+	// The worker takes chunks from the start, and others steal from the end.
+	chunks atomic.Uint64
+
+	// start is used to start the worker for each invocation,
+	// and is closed to stop it.
+	start chan struct{}
+
+	// busy is the time spent processing items in the current invocation.
+	busy time.Duration
+
+	// steals is the number of chunks stolen in the current invocation.
+	steals int
+
+	// cpus are the CPUs of the worker's NUMA node, to pin the worker to.
+	cpus []int
+
+	// pad avoids false sharing of the chunks between workers.
+	pad [64]byte
+}
+
+// KernelStats are the load imbalance stats for one kernel in a [WorkPool].
+// The imbalance for each invocation is the maximum over workers of the
+// time spent processing items, divided by the mean, so that 1 is a
+// perfect balance, and NWorkers means that one worker did all the work.
+type KernelStats struct {
+
+	// N is the number of invocations.
+	N int
+
+	// Time is the total time across invocations.
+	Time time.Duration
+
+	// Imbal is the sum of the imbalance across invocations.
+	Imbal float64
+
+	// MaxImbal is the maximum imbalance across invocations.
+	MaxImbal float64
+
+	// Chunks is the total number of chunks across invocations.
+	Chunks int
+
+	// Steals is the total number of stolen chunks across invocations.
+	Steals int
+}
+
+// MeanImbal returns the mean imbalance per invocation.
+func (ks *KernelStats) MeanImbal() float64 {
+	if ks.N == 0 {
+		return 0
+	}
+	return ks.Imbal / float64(ks.N)
+}
+
+// StealPct returns the percent of chunks that were stolen.
+func (ks *KernelStats) StealPct() float64 {
+	if ks.Chunks == 0 {
+		return 0
+	}
+	return 100 * float64(ks.Steals) / float64(ks.Chunks)
+}
+
+// NewWorkPool returns a new [WorkPool] with given number of workers.
+func NewWorkPool(nworkers int) *WorkPool {
+	wp := &WorkPool{}
+	wp.Defaults()
+	wp.SetNWorkers(nworkers)
+	return wp
+}
+
+func (wp *WorkPool) Defaults() {
+	wp.Grain = 4
+	wp.MinChunk = 16
+	wp.NUMA = true
+	wp.Pin = true
+}
+
+// SetNWorkers sets the number of workers, starting new worker
+// goroutines if the number has changed.
+func (wp *WorkPool) SetNWorkers(nworkers int) {
+	nworkers = max(nworkers, 1)
+	if nworkers == wp.NWorkers && len(wp.workers) == nworkers {
+		return
+	}
+	wp.startWorkers(nworkers, numaNodeCPUs())
+}
+
+// startWorkers starts the given number of workers, distributed across
+// NUMA nodes with the given list of CPUs on each node (see SetNodes).
+func (wp *WorkPool) startWorkers(nworkers int, nodeCPUs [][]int) {
+	wp.Stop()
+	wp.NWorkers = nworkers
+	wp.workers = make([]poolWorker, nworkers)
+	wp.SetNodes(nodeCPUs)
+	for w := 1; w < nworkers; w++ {
+		wk := &wp.workers[w]
+		wk.start = make(chan struct{}, 1)
+		var cpus []int
+		if wp.Pin {
+			cpus = wk.cpus
+		}
+		go wp.worker(w, wk.start, cpus)
+	}
+}
+
+// Stop stops the worker goroutines.
+func (wp *WorkPool) Stop() {
+	for w := 1; w < len(wp.workers); w++ {
+		close(wp.workers[w].start)
+	}
+	wp.workers = nil
+	wp.NWorkers = 0
+}
+
+// SetNodes sets the NodeWorkers and the stealing order, distributing
+// the workers across NUMA nodes in proportion to the number of
+// CPUs per node, given the list of CPUs on each node.
+// If nodeCPUs is empty or NUMA is off, all workers are on one node.
+func (wp *WorkPool) SetNodes(nodeCPUs [][]int) {
+	nw := wp.NWorkers
+	ncpu := 0
+	for _, cpus := range nodeCPUs {
+		ncpu += len(cpus)
+	}
+	multi := wp.NUMA && len(nodeCPUs) > 1 && ncpu > 0
+	if !multi {
+		wp.NodeWorkers = []int{nw}
+	} else {
+		wp.NodeWorkers = make([]int, len(nodeCPUs))
+		cum := 0
+		for i, cpus := range nodeCPUs {
+			st := (cum * nw) / ncpu
+			cum += len(cpus)
+			wp.NodeWorkers[i] = (cum*nw)/ncpu - st
+		}
+	}
+	node := make([]int, nw)
+	w := 0
+	for ni, nnw := range wp.NodeWorkers {
+		for range nnw {
+			node[w] = ni
+			if multi {
+				wp.workers[w].cpus = nodeCPUs[ni]
+			} else {
+				wp.workers[w].cpus = nil
+			}
+			w++
+		}
+	}
+	wp.steal = make([][]int, nw)
+	for w := range nw {
+		order := make([]int, 0, nw-1)
+		for _, same := range []bool{true, false} {
+			for i := 1; i < nw; i++ {
+				v := (w + i) % nw
+				if (node[v] == node[w]) == same {
+					order = append(order, v)
+				}
+			}
+		}
+		wp.steal[w] = order
+	}
+}
+
+// Run runs given kernel function for n items, using the workers.
+// The name of the kernel is used for recording the Stats and Trace.
+func (wp *WorkPool) Run(name string, n int, fun func(idx uint32)) {
+	if !wp.running.CompareAndSwap(false, true) { // nested
+		for idx := range n {
+			fun(uint32(idx))
+		}
+		return
+	}
+	defer wp.running.Store(false)
+	nw := wp.NWorkers
+	wp.rec = wp.RecStats || (wp.RecFunTimes != nil && *wp.RecFunTimes)
+	wp.trace = nil
+	if tr := wp.Trace; tr != nil && tr.startKernel(name, n, nw) {
+		wp.trace = tr
+		defer tr.endKernel()
+	}
+	if wp.PerCall && nw > 1 {
+		wp.runPerCall(name, n, fun)
+		return
+	}
+	mc := max(wp.MinChunk, 1)
+	if nw <= 1 || n < 2*mc {
+		if wp.trace != nil {
+			wp.trace.runItems(0, 0, n, fun)
+			return
+		}
+		for idx := range n {
+			fun(uint32(idx))
+		}
+		return
+	}
+	var st time.Time
+	if wp.rec {
+		st = time.Now()
+	}
+	nchunks := min(nw*max(wp.Grain, 1), n/mc)
+	chunk := (n + nchunks - 1) / nchunks
+	if chunk > mc { // align chunks to MinChunk
+		chunk = ((chunk + mc - 1) / mc) * mc
+	}
+	nchunks = (n + chunk - 1) / chunk
+	wp.fun = fun
+	wp.n = n
+	wp.chunk = chunk
+	for w := range nw {
+		lo := uint64((w * nchunks) / nw)
+		hi := uint64(((w + 1) * nchunks) / nw)
+		wk := &wp.workers[w]
+		wk.chunks.Store(lo<<32 | hi)
+		wk.busy = 0
+		wk.steals = 0
+	}
+	wp.wait.Add(nw - 1)
+	for w := 1; w < nw; w++ {
+		wp.workers[w].start <- struct{}{}
+	}
+	wp.work(0)
+	wp.wait.Wait()
+	wp.fun = nil
+	if wp.rec {
+		wp.recordStats(name, time.Since(st), nchunks)
+	}
+}
+
+// runPerCall runs given kernel function for n items on NWorkers
+// new goroutines, each processing one contiguous block of items
+// (see PerCall).
+func (wp *WorkPool) runPerCall(name string, n int, fun func(idx uint32)) {
+	var st time.Time
+	if wp.rec {
+		st = time.Now()
+	}
+	nw := wp.NWorkers
+	nper := (n + nw - 1) / nw
+	nblocks := 0
+	var wait sync.WaitGroup
+	for w := range nw {
+		wk := &wp.workers[w]
+		wk.busy = 0
+		wk.steals = 0
+		lo := w * nper
+		hi := min(lo+nper, n)
+		if lo >= hi {
+			continue
+		}
+		nblocks++
+		wait.Add(1)
+		go func() {
+			var bst time.Time
+			if wp.rec {
+				bst = time.Now()
+			}
+			if wp.trace != nil {
+				wp.trace.runItems(w, lo, hi, fun)
+			} else {
+				for idx := lo; idx < hi; idx++ {
+					fun(uint32(idx))
+				}
+			}
+			if wp.rec {
+				wk.busy = time.Since(bst)
+			}
+			wait.Done()
+		}()
+	}
+	wait.Wait()
+	if wp.rec {
+		wp.recordStats(name, time.Since(st), nblocks)
+	}
+}
+
+// worker is the goroutine for worker w, which runs until start is closed.
+// If cpus is non-empty, the goroutine is locked to its OS thread, which
+// is pinned to the given CPUs.
+func (wp *WorkPool) worker(w int, start chan struct{}, cpus []int) {
+	if len(cpus) > 0 {
+		runtime.LockOSThread()
+		defer runtime.UnlockOSThread()
+		pinThread(cpus)
+	}
+	for range start {
+		wp.work(w)
+		wp.wait.Done()
+	}
+}
+
+// work processes the chunks for worker w, and then steals chunks
+// from the other workers, unless Static.
+func (wp *WorkPool) work(w int) {
+	wk := &wp.workers[w]
+	var st time.Time
+	if wp.rec {
+		st = time.Now()
+	}
+	for {
+		c, ok := wk.next()
+		if !ok {
+			break
+		}
+		wp.runChunk(w, c)
+	}
+	if !wp.Static {
+		for _, v := range wp.steal[w] {
+			vk := &wp.workers[v]
+			for {
+				c, ok := vk.stealChunk()
+				if !ok {
+					break
+				}
+				wk.steals++
+				wp.runChunk(w, c)
+			}
+		}
+	}
+	if wp.rec {
+		wk.busy = time.Since(st)
+	}
+}
+
+// runChunk runs the kernel function for the items in chunk c,
+// on worker w.
+func (wp *WorkPool) runChunk(w, c int) {
+	st := c * wp.chunk
+	ed := min(st+wp.chunk, wp.n)
+	if wp.trace != nil {
+		wp.trace.runItems(w, st, ed, wp.fun)
+		return
+	}
+	for idx := st; idx < ed; idx++ {
+		wp.fun(uint32(idx))
+	}
+}
+
+// next returns the next chunk from the start of the worker's chunks.
+func (wk *poolWorker) next() (int, bool) {
+	for {
+		cs := wk.chunks.Load()
+		lo, hi := cs>>32, cs&0xFFFFFFFF
+		if lo >= hi {
+			return 0, false
+		}
+		if wk.chunks.CompareAndSwap(cs, (lo+1)<<32|hi) {
+			return int(lo), true
+		}
+	}
+}
+
+// stealChunk returns the last chunk from the end of the worker's chunks.
+func (wk *poolWorker) stealChunk() (int, bool) {
+	for {
+		cs := wk.chunks.Load()
+		lo, hi := cs>>32, cs&0xFFFFFFFF
+		if lo >= hi {
+			return 0, false
+		}
+		if wk.chunks.CompareAndSwap(cs, lo<<32|(hi-1)) {
+			return int(hi - 1), true
+		}
+	}
+}
+
+// recordStats records the stats for the invocation of kernel name.
+func (wp *WorkPool) recordStats(name string, dur time.Duration, nchunks int) {
+	if wp.Stats == nil {
+		wp.Stats = make(map[string]*KernelStats)
+	}
+	ks, ok := wp.Stats[name]
+	if !ok {
+		ks = &KernelStats{}
+		wp.Stats[name] = ks
+	}
+	var sum, mx time.Duration
+	for w := range wp.NWorkers {
+		wk := &wp.workers[w]
+		sum += wk.busy
+		mx = max(mx, wk.busy)
+		ks.Steals += wk.steals
+	}
+	imbal := 1.0
+	if sum > 0 {
+		imbal = float64(mx) / (float64(sum) / float64(wp.NWorkers))
+	}
+	ks.N++
+	ks.Time += dur
+	ks.Imbal += imbal
+	ks.MaxImbal = max(ks.MaxImbal, imbal)
+	ks.Chunks += nchunks
+}
+
+// ResetStats resets the Stats.
+func (wp *WorkPool) ResetStats() {
+	wp.Stats = nil
+}
+
+// StatsReport returns a report of the Stats for each kernel,
+// sorted by name.
+func (wp *WorkPool) StatsReport() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "WorkPool: %d workers, NUMA nodes: %v, Static: %v, PerCall: %v\n", wp.NWorkers, wp.NodeWorkers, wp.Static, wp.PerCall)
+	fmt.Fprintf(&b, "\t%22s \t%7s\t%7s\t%7s\t%7s\t%7s\n", "Kernel", "N", "Secs", "Imbal", "MaxImb", "Steal%")
+	names := make([]string, 0, len(wp.Stats))
+	for nm := range wp.Stats {
+		names = append(names, nm)
+	}
+	sort.Strings(names)
+	for _, nm := range names {
+		ks := wp.Stats[nm]
+		fmt.Fprintf(&b, "\t%22s \t%7d\t%7.3f\t%7.2f\t%7.2f\t%7.1f\n", nm, ks.N, ks.Time.Seconds(), ks.MeanImbal(), ks.MaxImbal, ks.StealPct())
+	}
+	return b.String()
+}
+
+// numaNodeCPUs returns the list of CPUs on each NUMA node,
+// from /sys/devices/system/node on linux, or nil if not available.
+func numaNodeCPUs() [][]int {
+	dirs, err := filepath.Glob("/sys/devices/system/node/node[0-9]*")
+	if err != nil || len(dirs) <= 1 {
+		return nil
+	}
+	nodeNum := func(d string) int {
+		n, _ := strconv.Atoi(strings.TrimPrefix(filepath.Base(d), "node"))
+		return n
+	}
+	sort.Slice(dirs, func(i, j int) bool { return nodeNum(dirs[i]) < nodeNum(dirs[j]) })
+	cpus := make([][]int, len(dirs))
+	for i, d := range dirs {
+		b, err := os.ReadFile(filepath.Join(d, "cpulist"))
+		if err != nil {
+			return nil
+		}
+		cpus[i] = cpuList(strings.TrimSpace(string(b)))
+	}
+	return cpus
+}
+
+// cpuList returns the CPUs in a linux cpulist string, e.g., "0-3,8-11".
+func cpuList(list string) []int {
+	var cpus []int
+	for _, rg := range strings.Split(list, ",") {
+		if rg == "" {
+			continue
+		}
+		st, ed, isrg := strings.Cut(rg, "-")
+		if !isrg {
+			ed = st
+		}
+		s, err1 := strconv.Atoi(st)
+		e, err2 := strconv.Atoi(ed)
+		if err1 != nil || err2 != nil {
+			continue
+		}
+		for c := s; c <= e; c++ {
+			cpus = append(cpus, c)
+		}
+	}
+	return cpus
+}
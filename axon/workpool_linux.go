@@ -0,0 +1,19 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package axon
+
+import "golang.org/x/sys/unix"
+
+// pinThread restricts the current OS thread to run on the given CPUs.
+// Errors are ignored, as pinning is only an optimization.
+func pinThread(cpus []int) {
+	var set unix.CPUSet
+	for _, c := range cpus {
+		set.Set(c)
+	}
+	unix.SchedSetaffinity(0, &set)
+}
@@ -0,0 +1,10 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !linux
+
+package axon
+
+// pinThread does nothing on this platform.
+func pinThread(cpus []int) {}
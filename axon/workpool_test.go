@@ -0,0 +1,155 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package axon
+
+import (
+	"fmt"
+	"runtime"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkPoolRun(t *testing.T) {
+	for _, nw := range []int{1, 2, 3, 8} {
+		wp := NewWorkPool(nw)
+		for _, static := range []bool{false, true} {
+			wp.Static = static
+			for _, perCall := range []bool{false, true} {
+				wp.PerCall = perCall
+				for _, n := range []int{0, 1, 31, 32, 100, 1000, 12345} {
+					counts := make([]int32, n)
+					wp.Run("Test", n, func(idx uint32) {
+						atomic.AddInt32(&counts[idx], 1)
+					})
+					for i, c := range counts {
+						if c != 1 {
+							t.Fatalf("workers: %d n: %d static: %v per-call: %v item: %d count: %d", nw, n, static, perCall, i, c)
+						}
+					}
+				}
+			}
+		}
+		wp.Stop()
+	}
+}
+
+func TestWorkPoolNested(t *testing.T) {
+	wp := NewWorkPool(4)
+	defer wp.Stop()
+	var count atomic.Int32
+	wp.Run("Outer", 64, func(idx uint32) {
+		wp.Run("Inner", 64, func(idx uint32) {
+			count.Add(1)
+		})
+	})
+	assert.Equal(t, int32(64*64), count.Load())
+}
+
+func TestWorkPoolStats(t *testing.T) {
+	wp := NewWorkPool(4)
+	defer wp.Stop()
+	wp.RecStats = true
+	// all of the cost is in the first quarter of the items
+	slow := func(idx uint32) {
+		if idx < 64 {
+			time.Sleep(100 * time.Microsecond)
+		}
+	}
+	for _, static := range []bool{true, false} {
+		wp.Static = static
+		wp.ResetStats()
+		for range 4 {
+			wp.Run("Slow", 256, slow)
+		}
+		ks := wp.Stats["Slow"]
+		assert.Equal(t, 4, ks.N)
+		assert.Greater(t, ks.Time, time.Duration(0))
+		if static {
+			assert.Equal(t, 0, ks.Steals)
+			assert.Greater(t, ks.MeanImbal(), 3.0)
+		} else {
+			assert.Greater(t, ks.Steals, 0)
+			assert.Less(t, ks.MeanImbal(), 2.5)
+		}
+		assert.GreaterOrEqual(t, ks.MaxImbal, ks.MeanImbal())
+	}
+	// per-call blocks have the same imbalance as static chunks
+	wp.Static = false
+	wp.PerCall = true
+	wp.ResetStats()
+	for range 4 {
+		wp.Run("Slow", 256, slow)
+	}
+	ks := wp.Stats["Slow"]
+	assert.Equal(t, 4, ks.N)
+	assert.Equal(t, 4*4, ks.Chunks)
+	assert.Equal(t, 0, ks.Steals)
+	assert.Greater(t, ks.MeanImbal(), 3.0)
+	assert.Contains(t, wp.StatsReport(), "Slow")
+}
+
+func TestWorkPoolNodes(t *testing.T) {
+	wp := NewWorkPool(6)
+	defer wp.Stop()
+	node0 := []int{0, 1, 2, 3}
+	node1 := []int{4, 5, 6, 7}
+	wp.SetNodes([][]int{node0, node1})
+	assert.Equal(t, []int{3, 3}, wp.NodeWorkers)
+	// same node first
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, wp.steal[0])
+	assert.Equal(t, []int{5, 3, 0, 1, 2}, wp.steal[4])
+	assert.Equal(t, node0, wp.workers[2].cpus)
+	assert.Equal(t, node1, wp.workers[3].cpus)
+
+	wp.SetNodes([][]int{{0, 1}, {2, 3, 4, 5, 6, 7}})
+	assert.Equal(t, []int{1, 5}, wp.NodeWorkers)
+
+	wp.NUMA = false
+	wp.SetNodes([][]int{node0, node1})
+	assert.Equal(t, []int{6}, wp.NodeWorkers)
+	assert.Nil(t, wp.workers[3].cpus)
+
+	assert.Equal(t, []int{0, 1, 2, 3, 8, 9, 10, 11}, cpuList("0-3,8-11"))
+	assert.Equal(t, []int{0, 2, 4}, cpuList("0,2,4"))
+	assert.Nil(t, cpuList(""))
+}
+
+func TestWorkPoolPin(t *testing.T) {
+	wp := &WorkPool{}
+	wp.Defaults()
+	wp.MinChunk = 0 // treated as 1
+	ncpu := runtime.NumCPU()
+	wp.startWorkers(4, [][]int{cpuList(fmt.Sprintf("0-%d", ncpu/2)), cpuList(fmt.Sprintf("%d-%d", ncpu/2, ncpu-1))})
+	defer wp.Stop()
+	assert.NotNil(t, wp.workers[3].cpus)
+	var count atomic.Int32
+	wp.Run("Pin", 100, func(idx uint32) {
+		count.Add(1)
+	})
+	assert.Equal(t, int32(100), count.Load())
+}
+
+// runWorkPoolNet runs the test network with given number of workers,
+// returning the resulting neuron state and weights.
+func runWorkPoolNet(nthr int) (nrns, wts []float32) {
+	net := newTestNetFull(2)
+	net.SetNThreads(nthr)
+	CPUPool.MinChunk = 1
+	defer CPUPool.Defaults()
+	net.InitWeights()
+	runTestTrials(net, 0, 4)
+	return slices.Clone(Neurons.Values), slices.Clone(Synapses.Values)
+}
+
+func TestWorkPoolNet(t *testing.T) {
+	nrns1, wts1 := runWorkPoolNet(1)
+	nrns4, wts4 := runWorkPoolNet(4)
+	assert.Equal(t, nrns1, nrns4)
+	assert.Equal(t, wts1, wts4)
+}
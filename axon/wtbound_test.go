@@ -0,0 +1,190 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package axon
+
+import (
+	"math/rand"
+	"testing"
+
+	"cogentcore.org/core/math32"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWtBoundDWt(t *testing.T) {
+	wb := &WtBoundParams{}
+	wb.Defaults()
+	for _, lwt := range []float32{0, 0.2, 0.5, 0.9, 1} {
+		// default is the standard soft bounding
+		assert.InDelta(t, 0.1*(1-lwt), wb.DWt(0.1, lwt, false), 1.0e-6)
+		assert.InDelta(t, -0.1*lwt, wb.DWt(-0.1, lwt, false), 1.0e-6)
+	}
+
+	wb.Mode = MultBound
+	assert.InDelta(t, 0.1*0.8, wb.DWt(0.1, 0.2, false), 1.0e-6)
+	wb.MuPlus = 0
+	wb.MuMinus = 0
+	assert.Equal(t, float32(0.1), wb.DWt(0.1, 0.2, false))
+	assert.Equal(t, float32(-0.1), wb.DWt(-0.1, 0.2, false))
+	wb.MuPlus = 0.5
+	assert.InDelta(t, 0.1*math32.Sqrt(0.75), wb.DWt(0.1, 0.25, false), 1.0e-6)
+
+	wb.Mode = HardBound
+	assert.Equal(t, float32(0.1), wb.DWt(0.1, 0.9, false))
+	assert.Equal(t, float32(0.1), wb.DWt(0.1, 0.9, true))
+
+	wb.Mode = LogNormBound
+	wb.Ref = 0.3
+	assert.InDelta(t, 0.03, wb.DWt(0.1, 0.9, false), 1.0e-6)
+	assert.InDelta(t, -0.09, wb.DWt(-0.1, 0.9, false), 1.0e-6)
+
+	wb.Mode = DaleBound
+	assert.Equal(t, float32(0.1), wb.DWt(0.1, 0.5, false))
+	assert.Equal(t, float32(-0.1), wb.DWt(0.1, 0.5, true))
+
+	// bounds are normalized for weight dependence
+	wb.Mode = SoftBound
+	wb.Min = 0.2
+	wb.Max = 0.6
+	assert.InDelta(t, 0.1*0.75, wb.DWt(0.1, 0.3, false), 1.0e-6)
+	assert.Equal(t, float32(0.2), wb.ClipLWt(0.1))
+	assert.Equal(t, float32(0.6), wb.ClipLWt(0.7))
+	assert.Equal(t, float32(0.4), wb.ClipLWt(0.4))
+}
+
+// wtBoundDist runs a random walk of symmetric weight changes on
+// n weights through the given bound mode, returning the mean,
+// standard deviation and fraction at the bounds, after each of
+// two successive blocks of nSteps.
+func wtBoundDist(sp *SWtParams, n, nSteps int) (mean, std, atBound [2]float32) {
+	rnd := rand.New(rand.NewSource(42))
+	lwts := make([]float32, n)
+	wts := make([]float32, n)
+	for i := range lwts {
+		lwts[i] = 0.5
+	}
+	for blk := range 2 {
+		for range nSteps {
+			for i := range lwts {
+				dwt := 0.05 * (2*rnd.Float32() - 1)
+				dwt = sp.Bound.DWt(dwt, lwts[i], false)
+				sp.WtFromDWt(&wts[i], &lwts[i], dwt, 0.5)
+			}
+		}
+		for _, lw := range lwts {
+			mean[blk] += lw
+			if lw <= sp.Bound.Min || lw >= sp.Bound.Max {
+				atBound[blk]++
+			}
+		}
+		mean[blk] /= float32(n)
+		for _, lw := range lwts {
+			std[blk] += (lw - mean[blk]) * (lw - mean[blk])
+		}
+		std[blk] = math32.Sqrt(std[blk] / float32(n))
+		atBound[blk] /= float32(n)
+	}
+	return
+}
+
+func TestWtBoundStationarity(t *testing.T) {
+	sp := &SWtParams{}
+	sp.Defaults()
+	for _, mode := range []WtBoundModes{SoftBound, HardBound, MultBound, LogNormBound} {
+		sp.Bound.Defaults()
+		sp.Bound.Mode = mode
+		sp.Bound.MuPlus = 0.5
+		sp.Bound.MuMinus = 0.5
+		sp.Bound.Ref = 0.3
+		mean, std, atBound := wtBoundDist(sp, 1000, 4000)
+		// distribution is stationary after the first block
+		assert.InDelta(t, mean[0], mean[1], 0.03, mode.String())
+		assert.InDelta(t, std[0], std[1], 0.03, mode.String())
+		switch mode {
+		case SoftBound:
+			assert.InDelta(t, 0.5, mean[1], 0.03)
+			assert.Equal(t, float32(0), atBound[1])
+		case MultBound:
+			assert.InDelta(t, 0.5, mean[1], 0.03)
+		case HardBound:
+			// diffuses across the full range, accumulating at the bounds
+			assert.Greater(t, std[1], float32(0.2))
+			assert.Greater(t, atBound[1], float32(0))
+		case LogNormBound:
+			// unimodal around Ref
+			assert.InDelta(t, sp.Bound.Ref, mean[1], 0.03)
+			assert.Less(t, std[1], float32(0.1))
+			assert.Equal(t, float32(0), atBound[1])
+		}
+	}
+
+	// hard bounds are respected
+	sp.Bound.Defaults()
+	sp.Bound.Mode = HardBound
+	sp.Bound.Min = 0.3
+	sp.Bound.Max = 0.7
+	_, std, atBound := wtBoundDist(sp, 1000, 2000)
+	assert.Less(t, std[1], float32(0.15))
+	assert.Greater(t, atBound[1], float32(0))
+}
+
+// assertLWtBounds asserts that all LWt values in the network are
+// within given bounds.
+func assertLWtBounds(t *testing.T, net *Network, mn, mx float32) {
+	var lwts []float32
+	for _, ly := range net.Layers {
+		for _, pt := range ly.RecvPaths {
+			pt.SynValues(&lwts, "LWt")
+			for _, lw := range lwts {
+				assert.GreaterOrEqual(t, lw, mn, pt.Name)
+				assert.LessOrEqual(t, lw, mx, pt.Name)
+			}
+		}
+	}
+}
+
+func TestWtBoundNet(t *testing.T) {
+	net := newTestNetFull(1)
+	for _, ly := range net.Layers {
+		for _, pt := range ly.RecvPaths {
+			pt.Params.SWts.Bound.Mode = HardBound
+			pt.Params.SWts.Bound.Min = 0.48
+			pt.Params.SWts.Bound.Max = 0.52
+			pt.Params.SWts.Init.Var = 0.25 // initial LWt outside of bounds
+			pt.Params.SWts.Init.SPct = 0
+		}
+	}
+	net.UpdateParams()
+	net.InitWeights()
+	assertLWtBounds(t, net, 0.48, 0.52)
+	hid := net.LayerByName("Hidden").RecvPaths[0]
+	var lwt0, lwts []float32
+	hid.SynValues(&lwt0, "LWt")
+	runTestTrials(net, 0, 8)
+	hid.SynValues(&lwts, "LWt")
+	nchg := 0
+	for i, lw := range lwts {
+		if lw != lwt0[i] {
+			nchg++
+		}
+	}
+	assert.Greater(t, nchg, 0)
+	assertLWtBounds(t, net, 0.48, 0.52)
+}
+
+func TestWtBoundUpdate(t *testing.T) {
+	wb := &WtBoundParams{}
+	wb.Defaults()
+	wb.Min = 0.3
+	wb.Max = 0.7
+	wb.Update()
+	assert.Equal(t, []float32{0.3, 0.7}, []float32{wb.Min, wb.Max})
+	for _, mx := range []float32{0.5, 0.4} {
+		wb.Min = 0.5
+		wb.Max = mx
+		wb.Update()
+		assert.Equal(t, []float32{0, 1}, []float32{wb.Min, wb.Max})
+		assert.False(t, math32.IsNaN(wb.DWt(0.1, 0.5, false)))
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package chans
+
+import (
+	"cogentcore.org/core/math32"
+)
+
+//gosl:start
+
+// CaTParams control the low-threshold T-type Ca channel, which is
+// de-inactivated by hyperpolarization and then opens transiently on
+// subsequent depolarization, producing a low-threshold calcium spike
+// that drives a burst of action potentials. This is critical for burst
+// firing in thalamic relay and reticular (TRN) neurons, and in the
+// subthalamic nucleus (STN). Equations are from Huguenard & McCormick (1992)
+// as used in Destexhe et al (1996), with m^2 h gating, and temperature
+// scaling factors for 36 deg C.
+type CaTParams struct {
+
+	// Ge is the strength of the CaT contribution to Ge(t) excitatory
+	// conductance. Ge(t) is later multiplied by Gbar.E for pA unit scaling.
+	Ge float32 `default:"0,0.1"`
+
+	// Ca is the calcium from conductance factor, which determines the
+	// contribution of CaT to the [LearnCa] calcium for learning.
+	Ca float32 `default:"0.25"`
+
+	// Vshift is a voltage shift added to the membrane potential for all
+	// gating functions, e.g., to shift the activation and inactivation
+	// curves to more depolarized values as found in TRN neurons (~ +2 mV).
+	Vshift float32 `default:"0"`
+
+	// PhiM is the temperature scaling factor for the M time constant,
+	// 5^((36-24)/10) for Q10 = 5.
+	PhiM float32 `default:"6.9"`
+
+	// PhiH is the temperature scaling factor for the H time constant,
+	// 3^((36-24)/10) for Q10 = 3.
+	PhiH float32 `default:"3.74"`
+
+	// Mrest is Minf at resting membrane potential of -70, computed from other params.
+	Mrest float32 `edit:"-"`
+
+	// Hrest is Hinf at resting membrane potential of -70, computed from other params.
+	Hrest float32 `edit:"-"`
+
+	pad float32
+}
+
+func (cp *CaTParams) Defaults() {
+	cp.Ge = 0.1
+	cp.Ca = 0.25
+	cp.Vshift = 0
+	cp.PhiM = 6.9
+	cp.PhiH = 3.74
+	cp.Update()
+}
+
+func (cp *CaTParams) Update() {
+	cp.Mrest = cp.Minf(-70)
+	cp.Hrest = cp.Hinf(-70)
+}
+
+func (cp *CaTParams) ShouldDisplay(field string) bool {
+	switch field {
+	case "Ge":
+		return true
+	default:
+		return cp.Ge > 0
+	}
+}
+
+// Minf returns the asymptotic M activation gate value as a function of voltage.
+func (cp *CaTParams) Minf(v float32) float32 {
+	return 1.0 / (1.0 + math32.FastExp(-(v+cp.Vshift+57)/6.2))
+}
+
+// Hinf returns the asymptotic H inactivation gate value as a function of voltage.
+func (cp *CaTParams) Hinf(v float32) float32 {
+	return 1.0 / (1.0 + math32.FastExp((v+cp.Vshift+81)/4))
+}
+
+// MTau returns the M time constant in msec as a function of voltage.
+func (cp *CaTParams) MTau(v float32) float32 {
+	vs := v + cp.Vshift
+	return (0.612 + 1.0/(math32.FastExp(-(vs+132)/16.7)+math32.FastExp((vs+16.8)/18.2))) / cp.PhiM
+}
+
+// HTau returns the H time constant in msec as a function of voltage.
+func (cp *CaTParams) HTau(v float32) float32 {
+	vs := v + cp.Vshift
+	if vs < -80 {
+		return math32.FastExp((vs+467)/66.6) / cp.PhiH
+	}
+	return (28 + math32.FastExp(-(vs+22)/10.5)) / cp.PhiH
+}
+
+// DM returns the change in M gating value at msec update scale,
+// as a function of voltage and current M value.
+// Time constants below 1 msec are treated as instantaneous.
+func (cp *CaTParams) DM(v, m float32) float32 {
+	return (cp.Minf(v) - m) * min(1.0/cp.MTau(v), 1.0)
+}
+
+// DH returns the change in H gating value at msec update scale,
+// as a function of voltage and current H value.
+func (cp *CaTParams) DH(v, h float32) float32 {
+	return (cp.Hinf(v) - h) * min(1.0/cp.HTau(v), 1.0)
+}
+
+// Gcat returns the CaT net conductance from m, h gating values.
+func (cp *CaTParams) Gcat(m, h float32) float32 {
+	return cp.Ge * m * m * h
+}
+
+// CaFromG returns the Ca flux from Gcat conductance and v.
+func (cp *CaTParams) CaFromG(v, g float32) float32 {
+	return -v * cp.Ca * g
+}
+
+//gosl:end
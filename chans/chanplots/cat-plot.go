@@ -0,0 +1,167 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package chanplots
+
+import (
+	"cogentcore.org/core/core"
+	"cogentcore.org/core/icons"
+	"cogentcore.org/core/tree"
+	"cogentcore.org/lab/lab"
+	"cogentcore.org/lab/plot"
+	"cogentcore.org/lab/tensorfs"
+	"github.com/emer/axon/v2/chans"
+)
+
+// CaTPlot plots the low-threshold T-type Ca channel.
+type CaTPlot struct {
+
+	// CaT function
+	CaT chans.CaTParams `display:"add-fields"`
+
+	// Vstart is starting voltage
+	Vstart float32 `default:"-120"`
+
+	// Vend is ending voltage
+	Vend float32 `default:"0"`
+
+	// Vstep is voltage increment
+	Vstep float32 `default:"1"`
+
+	// TimeSteps is number of time steps
+	TimeSteps int
+
+	// TimeHyper is the number of time steps from the start
+	// of TimeRun to hold at the TimeVhyper hyperpolarized potential,
+	// after which it is held at TimeVdepol, to show the rebound response.
+	TimeHyper int
+
+	// TimeVhyper is the time-run hyperpolarized membrane potential.
+	TimeVhyper float32
+
+	// TimeVdepol is the time-run depolarized membrane potential.
+	TimeVdepol float32
+
+	Dir  *tensorfs.Node `display:"-"`
+	Tabs lab.Tabber     `display:"-"`
+}
+
+// Config configures all the elements using the standard functions
+func (pl *CaTPlot) Config(parent *tensorfs.Node, tabs lab.Tabber) {
+	pl.Dir = parent.Dir("CaT")
+	pl.Tabs = tabs
+
+	pl.CaT.Defaults()
+	pl.CaT.Ge = 1
+	pl.Vstart = -120
+	pl.Vend = 0
+	pl.Vstep = 1
+	pl.TimeSteps = 500
+	pl.TimeHyper = 200
+	pl.TimeVhyper = -90
+	pl.TimeVdepol = -55
+	pl.Update()
+}
+
+// Update updates computed values
+func (pl *CaTPlot) Update() {
+}
+
+// GVRun plots the asymptotic gating values, the window conductance G
+// and current I, and the time constants, as a function of V.
+// Current is relative to Erev.E = 0, as CaT contributes to Ge.
+func (pl *CaTPlot) GVRun() { //types:add
+	pl.Update()
+	dir := pl.Dir.Dir("G_V")
+
+	mp := &pl.CaT
+	nv := int((pl.Vend - pl.Vstart) / pl.Vstep)
+	for vi := 0; vi < nv; vi++ {
+		v := pl.Vstart + float32(vi)*pl.Vstep
+		minf := mp.Minf(v)
+		hinf := mp.Hinf(v)
+		g := mp.Gcat(minf, hinf)
+
+		dir.Float64("V", nv).SetFloat1D(float64(v), vi)
+		dir.Float64("Gcat", nv).SetFloat1D(float64(g), vi)
+		dir.Float64("Icat", nv).SetFloat1D(float64(-g*v), vi)
+		dir.Float64("Minf", nv).SetFloat1D(float64(minf), vi)
+		dir.Float64("Hinf", nv).SetFloat1D(float64(hinf), vi)
+		dir.Float64("Mtau", nv).SetFloat1D(float64(mp.MTau(v)), vi)
+		dir.Float64("Htau", nv).SetFloat1D(float64(mp.HTau(v)), vi)
+	}
+	plot.SetFirstStyler(dir.Float64("V"), func(s *plot.Style) {
+		s.Role = plot.X
+	})
+	ons := []string{"Minf", "Hinf"}
+	for _, on := range ons {
+		plot.SetFirstStyler(dir.Float64(on), func(s *plot.Style) {
+			s.On = true
+			s.Plot.Title = "CaT G(V)"
+		})
+	}
+	if pl.Tabs != nil {
+		pl.Tabs.AsLab().PlotTensorFS(dir)
+	}
+}
+
+// TimeRun runs the equations over time, showing the rebound
+// response after hyperpolarization.
+func (pl *CaTPlot) TimeRun() { //types:add
+	pl.Update()
+	dir := pl.Dir.Dir("G_Time")
+	nv := pl.TimeSteps
+
+	mp := &pl.CaT
+
+	m := mp.Minf(pl.TimeVdepol)
+	h := mp.Hinf(pl.TimeVdepol)
+	msdt := float32(0.001)
+
+	for ti := range nv {
+		t := float32(ti+1) * msdt
+		v := pl.TimeVdepol
+		if ti < pl.TimeHyper {
+			v = pl.TimeVhyper
+		}
+
+		g := mp.Gcat(m, h)
+		ca := mp.CaFromG(v, g)
+		m += mp.DM(v, m)
+		h += mp.DH(v, h)
+
+		dir.Float64("Time", nv).SetFloat1D(float64(t), ti)
+		dir.Float64("V", nv).SetFloat1D(float64(v), ti)
+		dir.Float64("Gcat", nv).SetFloat1D(float64(g), ti)
+		dir.Float64("Ca", nv).SetFloat1D(float64(ca), ti)
+		dir.Float64("M", nv).SetFloat1D(float64(m), ti)
+		dir.Float64("H", nv).SetFloat1D(float64(h), ti)
+	}
+	plot.SetFirstStyler(dir.Float64("Time"), func(s *plot.Style) {
+		s.Role = plot.X
+	})
+	plot.SetFirstStyler(dir.Float64("V"), func(s *plot.Style) {
+		s.On = true
+		s.Plot.Title = "CaT G(t)"
+		s.RightY = true
+	})
+	ons := []string{"Gcat", "M", "H"}
+	for _, on := range ons {
+		plot.SetFirstStyler(dir.Float64(on), func(s *plot.Style) {
+			s.On = true
+		})
+	}
+	if pl.Tabs != nil {
+		pl.Tabs.AsLab().PlotTensorFS(dir)
+	}
+}
+
+func (pl *CaTPlot) MakeToolbar(p *tree.Plan) {
+	tree.Add(p, func(w *core.FuncButton) {
+		w.SetFunc(pl.GVRun).SetIcon(icons.PlayArrow)
+	})
+	tree.Add(p, func(w *core.FuncButton) {
+		w.SetFunc(pl.TimeRun).SetIcon(icons.PlayArrow)
+	})
+}
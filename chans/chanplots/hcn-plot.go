@@ -0,0 +1,162 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package chanplots
+
+import (
+	"cogentcore.org/core/core"
+	"cogentcore.org/core/icons"
+	"cogentcore.org/core/tree"
+	"cogentcore.org/lab/lab"
+	"cogentcore.org/lab/plot"
+	"cogentcore.org/lab/tensorfs"
+	"github.com/emer/axon/v2/chans"
+)
+
+// HCNPlot plots the HCN hyperpolarization-activated cation channel (Ih).
+type HCNPlot struct {
+
+	// HCN function
+	HCN chans.HCNParams `display:"add-fields"`
+
+	// Vstart is starting voltage
+	Vstart float32 `default:"-120"`
+
+	// Vend is ending voltage
+	Vend float32 `default:"-20"`
+
+	// Vstep is voltage increment
+	Vstep float32 `default:"1"`
+
+	// TimeSteps is number of time steps
+	TimeSteps int
+
+	// TimeHyper is the number of time steps from the start
+	// of TimeRun to hold at the TimeVhyper hyperpolarized potential,
+	// after which it is held at TimeVstart, to show the rebound current.
+	TimeHyper int
+
+	// TimeVstart is the time-run starting and ending membrane potential.
+	TimeVstart float32
+
+	// TimeVhyper is the time-run hyperpolarized membrane potential.
+	TimeVhyper float32
+
+	Dir  *tensorfs.Node `display:"-"`
+	Tabs lab.Tabber     `display:"-"`
+}
+
+// Config configures all the elements using the standard functions
+func (pl *HCNPlot) Config(parent *tensorfs.Node, tabs lab.Tabber) {
+	pl.Dir = parent.Dir("HCN")
+	pl.Tabs = tabs
+
+	pl.HCN.Defaults()
+	pl.HCN.Gbar = 1
+	pl.Vstart = -120
+	pl.Vend = -20
+	pl.Vstep = 1
+	pl.TimeSteps = 3000
+	pl.TimeHyper = 1000
+	pl.TimeVstart = -60
+	pl.TimeVhyper = -90
+	pl.Update()
+}
+
+// Update updates computed values
+func (pl *HCNPlot) Update() {
+}
+
+// GVRun plots the asymptotic conductance G, and the I/V
+// relationship for the Ih current, as a function of V.
+func (pl *HCNPlot) GVRun() { //types:add
+	pl.Update()
+	dir := pl.Dir.Dir("G_V")
+
+	mp := &pl.HCN
+	nv := int((pl.Vend - pl.Vstart) / pl.Vstep)
+	for vi := 0; vi < nv; vi++ {
+		v := pl.Vstart + float32(vi)*pl.Vstep
+		minf := mp.Minf(v)
+		mtau := mp.MTau(v)
+		g := mp.Gh(minf)
+		ih := mp.Ih(v, g)
+
+		dir.Float64("V", nv).SetFloat1D(float64(v), vi)
+		dir.Float64("Gh", nv).SetFloat1D(float64(g), vi)
+		dir.Float64("Ih", nv).SetFloat1D(float64(ih), vi)
+		dir.Float64("Minf", nv).SetFloat1D(float64(minf), vi)
+		dir.Float64("Mtau", nv).SetFloat1D(float64(mtau), vi)
+	}
+	plot.SetFirstStyler(dir.Float64("V"), func(s *plot.Style) {
+		s.Role = plot.X
+	})
+	ons := []string{"Gh", "Ih"}
+	for _, on := range ons {
+		plot.SetFirstStyler(dir.Float64(on), func(s *plot.Style) {
+			s.On = true
+			s.Plot.Title = "HCN G(V), I(V)"
+		})
+	}
+	if pl.Tabs != nil {
+		pl.Tabs.AsLab().PlotTensorFS(dir)
+	}
+}
+
+// TimeRun runs the equation over time.
+func (pl *HCNPlot) TimeRun() { //types:add
+	pl.Update()
+	dir := pl.Dir.Dir("G_Time")
+	nv := pl.TimeSteps
+
+	mp := &pl.HCN
+
+	m := mp.Minf(pl.TimeVstart)
+	msdt := float32(0.001)
+
+	for ti := range nv {
+		t := float32(ti+1) * msdt
+		v := pl.TimeVstart
+		if ti < pl.TimeHyper {
+			v = pl.TimeVhyper
+		}
+
+		g := mp.Gh(m)
+		ih := mp.Ih(v, g)
+		dm := mp.DM(v, m)
+		m += dm
+
+		dir.Float64("Time", nv).SetFloat1D(float64(t), ti)
+		dir.Float64("V", nv).SetFloat1D(float64(v), ti)
+		dir.Float64("Gh", nv).SetFloat1D(float64(g), ti)
+		dir.Float64("Ih", nv).SetFloat1D(float64(ih), ti)
+		dir.Float64("M", nv).SetFloat1D(float64(m), ti)
+	}
+	plot.SetFirstStyler(dir.Float64("Time"), func(s *plot.Style) {
+		s.Role = plot.X
+	})
+	plot.SetFirstStyler(dir.Float64("V"), func(s *plot.Style) {
+		s.On = true
+		s.Plot.Title = "HCN G(t)"
+		s.RightY = true
+	})
+	ons := []string{"Gh", "Ih"}
+	for _, on := range ons {
+		plot.SetFirstStyler(dir.Float64(on), func(s *plot.Style) {
+			s.On = true
+		})
+	}
+	if pl.Tabs != nil {
+		pl.Tabs.AsLab().PlotTensorFS(dir)
+	}
+}
+
+func (pl *HCNPlot) MakeToolbar(p *tree.Plan) {
+	tree.Add(p, func(w *core.FuncButton) {
+		w.SetFunc(pl.GVRun).SetIcon(icons.PlayArrow)
+	})
+	tree.Add(p, func(w *core.FuncButton) {
+		w.SetFunc(pl.TimeRun).SetIcon(icons.PlayArrow)
+	})
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package chanplots
+
+import (
+	"cogentcore.org/core/core"
+	"cogentcore.org/core/icons"
+	"cogentcore.org/core/tree"
+	"cogentcore.org/lab/lab"
+	"cogentcore.org/lab/plot"
+	"cogentcore.org/lab/tensorfs"
+	"github.com/emer/axon/v2/chans"
+)
+
+// NaPPlot plots the persistent sodium channel.
+type NaPPlot struct {
+
+	// NaP function
+	NaP chans.NaPParams `display:"add-fields"`
+
+	// Vstart is starting voltage
+	Vstart float32 `default:"-100"`
+
+	// Vend is ending voltage
+	Vend float32 `default:"0"`
+
+	// Vstep is voltage increment
+	Vstep float32 `default:"1"`
+
+	// TimeSteps is number of time steps
+	TimeSteps int
+
+	// TimeVstart is the time-run starting membrane potential,
+	// which the gating values start at steady state for.
+	TimeVstart float32
+
+	// TimeV is the time-run membrane potential that is stepped to.
+	TimeV float32
+
+	Dir  *tensorfs.Node `display:"-"`
+	Tabs lab.Tabber     `display:"-"`
+}
+
+// Config configures all the elements using the standard functions
+func (pl *NaPPlot) Config(parent *tensorfs.Node, tabs lab.Tabber) {
+	pl.Dir = parent.Dir("NaP")
+	pl.Tabs = tabs
+
+	pl.NaP.Defaults()
+	pl.NaP.Ge = 1
+	pl.Vstart = -100
+	pl.Vend = 0
+	pl.Vstep = 1
+	pl.TimeSteps = 20000
+	pl.TimeVstart = -70
+	pl.TimeV = -45
+	pl.Update()
+}
+
+// Update updates computed values
+func (pl *NaPPlot) Update() {
+}
+
+// GVRun plots the asymptotic gating values, the window conductance G
+// and current I, and the H time constant, as a function of V.
+// Current is relative to Erev.E = 0, as NaP contributes to Ge.
+func (pl *NaPPlot) GVRun() { //types:add
+	pl.Update()
+	dir := pl.Dir.Dir("G_V")
+
+	mp := &pl.NaP
+	nv := int((pl.Vend - pl.Vstart) / pl.Vstep)
+	for vi := 0; vi < nv; vi++ {
+		v := pl.Vstart + float32(vi)*pl.Vstep
+		minf := mp.Minf(v)
+		hinf := mp.Hinf(v)
+		g := mp.Gnap(minf, hinf)
+
+		dir.Float64("V", nv).SetFloat1D(float64(v), vi)
+		dir.Float64("Gnap", nv).SetFloat1D(float64(g), vi)
+		dir.Float64("Inap", nv).SetFloat1D(float64(-g*v), vi)
+		dir.Float64("Minf", nv).SetFloat1D(float64(minf), vi)
+		dir.Float64("Hinf", nv).SetFloat1D(float64(hinf), vi)
+		dir.Float64("Htau", nv).SetFloat1D(float64(mp.HTauFromV(v)), vi)
+	}
+	plot.SetFirstStyler(dir.Float64("V"), func(s *plot.Style) {
+		s.Role = plot.X
+	})
+	ons := []string{"Minf", "Hinf"}
+	for _, on := range ons {
+		plot.SetFirstStyler(dir.Float64(on), func(s *plot.Style) {
+			s.On = true
+			s.Plot.Title = "NaP G(V)"
+		})
+	}
+	if pl.Tabs != nil {
+		pl.Tabs.AsLab().PlotTensorFS(dir)
+	}
+}
+
+// TimeRun runs the equations over time, stepping from TimeVstart to TimeV.
+func (pl *NaPPlot) TimeRun() { //types:add
+	pl.Update()
+	dir := pl.Dir.Dir("G_Time")
+	nv := pl.TimeSteps
+
+	mp := &pl.NaP
+
+	m := mp.Minf(pl.TimeVstart)
+	h := mp.Hinf(pl.TimeVstart)
+	msdt := float32(0.001)
+	v := pl.TimeV
+
+	for ti := range nv {
+		t := float32(ti+1) * msdt
+
+		g := mp.Gnap(m, h)
+		m += mp.DM(v, m)
+		h += mp.DH(v, h)
+
+		dir.Float64("Time", nv).SetFloat1D(float64(t), ti)
+		dir.Float64("Gnap", nv).SetFloat1D(float64(g), ti)
+		dir.Float64("M", nv).SetFloat1D(float64(m), ti)
+		dir.Float64("H", nv).SetFloat1D(float64(h), ti)
+	}
+	plot.SetFirstStyler(dir.Float64("Time"), func(s *plot.Style) {
+		s.Role = plot.X
+	})
+	ons := []string{"Gnap", "M", "H"}
+	for _, on := range ons {
+		plot.SetFirstStyler(dir.Float64(on), func(s *plot.Style) {
+			s.On = true
+			s.Plot.Title = "NaP G(t)"
+		})
+	}
+	if pl.Tabs != nil {
+		pl.Tabs.AsLab().PlotTensorFS(dir)
+	}
+}
+
+func (pl *NaPPlot) MakeToolbar(p *tree.Plan) {
+	tree.Add(p, func(w *core.FuncButton) {
+		w.SetFunc(pl.GVRun).SetIcon(icons.PlayArrow)
+	})
+	tree.Add(p, func(w *core.FuncButton) {
+		w.SetFunc(pl.TimeRun).SetIcon(icons.PlayArrow)
+	})
+}
@@ -10,12 +10,18 @@ var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/chans/chanplots
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/chans/chanplots.AKParams", IDName: "ak-params", Doc: "AKParams control an A-type K+ channel, which is voltage gated with maximal\nactivation around -37 mV.  It has two state variables, M (v-gated opening)\nand H (v-gated closing), which integrate with fast and slow time constants,\nrespectively.  H relatively quickly hits an asymptotic level of inactivation\nfor sustained activity patterns.\nIt is particularly important for counteracting the excitatory effects of\nvoltage gated calcium channels which can otherwise drive runaway excitatory currents.\nSee AKsParams for a much simpler version that works fine when full AP-like spikes are\nnot simulated, as in our standard axon models.", Fields: []types.Field{{Name: "Gk", Doc: "Gk is the strength of the AK conductance contribution to Gk(t) factor\n(which is then multiplied by Gbar.K that provides pA unit scaling)."}, {Name: "Beta", Doc: "Beta multiplier for the beta term; 0.01446 for distal, 0.02039\nfor proximal dendrites."}, {Name: "Dm", Doc: "Dm factor: 0.5 for distal, 0.25 for proximal"}, {Name: "Koff", Doc: "K is the offset for K, 1.8 for distal, 1.5 for proximal."}, {Name: "Voff", Doc: "Voff is the voltage offset for alpha and beta functions: 1 for distal,\n11 for proximal."}, {Name: "Hf", Doc: "Hf is the h multiplier factor, 0.1133 for distal, 0.1112 for proximal."}, {Name: "pad"}, {Name: "pad1"}}})
 
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/chans/chanplots.CaTPlot", IDName: "ca-t-plot", Doc: "CaTPlot plots the low-threshold T-type Ca channel.", Fields: []types.Field{{Name: "CaT", Doc: "CaT function"}, {Name: "Vstart", Doc: "Vstart is starting voltage"}, {Name: "Vend", Doc: "Vend is ending voltage"}, {Name: "Vstep", Doc: "Vstep is voltage increment"}, {Name: "TimeSteps", Doc: "TimeSteps is number of time steps"}, {Name: "TimeHyper", Doc: "TimeHyper is the number of time steps from the start\nof TimeRun to hold at the TimeVhyper hyperpolarized potential,\nafter which it is held at TimeVdepol, to show the rebound response."}, {Name: "TimeVhyper", Doc: "TimeVhyper is the time-run hyperpolarized membrane potential."}, {Name: "TimeVdepol", Doc: "TimeVdepol is the time-run depolarized membrane potential."}, {Name: "Dir"}, {Name: "Tabs"}}})
+
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/chans/chanplots.GABABPlot", IDName: "gabab-plot", Methods: []types.Method{{Name: "GVRun", Doc: "GVRun plots the conductance G (and other variables) as a function of V.", Directives: []types.Directive{{Tool: "types", Directive: "add"}}}, {Name: "GSRun", Doc: "GSRun plots conductance as function of spiking rate.", Directives: []types.Directive{{Tool: "types", Directive: "add"}}}, {Name: "TimeRun", Doc: "TimeRun runs the equations over time.", Directives: []types.Directive{{Tool: "types", Directive: "add"}}}}, Fields: []types.Field{{Name: "GABAB", Doc: "standard chans version of GABAB"}, {Name: "Vgain", Doc: "multiplier on GABA-B as function of voltage"}, {Name: "Voff", Doc: "voltage offset for GABA-B exponential function"}, {Name: "Erev", Doc: "GABAb reversal / driving potential"}, {Name: "Vstart", Doc: "starting voltage"}, {Name: "Vend", Doc: "ending voltage"}, {Name: "Vstep", Doc: "voltage increment"}, {Name: "Smax", Doc: "max number of spikes"}, {Name: "TimeSteps", Doc: "total number of time steps to take"}, {Name: "TimeInc", Doc: "time increment per step"}, {Name: "TimeIn", Doc: "time in msec for inputs to remain on in TimeRun"}, {Name: "TimeHz", Doc: "frequency of spiking inputs at start of TimeRun"}, {Name: "Dir"}, {Name: "Tabs"}}})
 
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/chans/chanplots.HCNPlot", IDName: "hcn-plot", Doc: "HCNPlot plots the HCN hyperpolarization-activated cation channel (Ih).", Fields: []types.Field{{Name: "HCN", Doc: "HCN function"}, {Name: "Vstart", Doc: "Vstart is starting voltage"}, {Name: "Vend", Doc: "Vend is ending voltage"}, {Name: "Vstep", Doc: "Vstep is voltage increment"}, {Name: "TimeSteps", Doc: "TimeSteps is number of time steps"}, {Name: "TimeHyper", Doc: "TimeHyper is the number of time steps from the start\nof TimeRun to hold at the TimeVhyper hyperpolarized potential,\nafter which it is held at TimeVstart, to show the rebound current."}, {Name: "TimeVstart", Doc: "TimeVstart is the time-run starting and ending membrane potential."}, {Name: "TimeVhyper", Doc: "TimeVhyper is the time-run hyperpolarized membrane potential."}, {Name: "Dir"}, {Name: "Tabs"}}})
+
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/chans/chanplots.KirPlot", IDName: "kir-plot", Methods: []types.Method{{Name: "GVRun", Doc: "VmRun plots the equation as a function of V", Directives: []types.Directive{{Tool: "types", Directive: "add"}}}, {Name: "TimeRun", Doc: "TimeRun runs the equation over time.", Directives: []types.Directive{{Tool: "types", Directive: "add"}}}}, Fields: []types.Field{{Name: "Kir", Doc: "Kir function"}, {Name: "Vstart", Doc: "Vstart is starting voltage"}, {Name: "Vend", Doc: "Vend is ending voltage"}, {Name: "Vstep", Doc: "Vstep is voltage increment"}, {Name: "TimeSteps", Doc: "TimeSteps is number of time steps"}, {Name: "TimeSpike", Doc: "do spiking instead of voltage ramp"}, {Name: "SpikeFreq", Doc: "spiking frequency"}, {Name: "TimeVstart", Doc: "time-run starting membrane potential"}, {Name: "TimeVend", Doc: "time-run ending membrane potential"}, {Name: "Dir"}, {Name: "Tabs"}}})
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/chans/chanplots.MahpPlot", IDName: "mahp-plot", Methods: []types.Method{{Name: "GVRun", Doc: "GVRun plots the conductance G (and other variables) as a function of V.", Directives: []types.Directive{{Tool: "types", Directive: "add"}}}, {Name: "TimeRun", Doc: "TimeRun runs the equation over time.", Directives: []types.Directive{{Tool: "types", Directive: "add"}}}}, Fields: []types.Field{{Name: "Mahp", Doc: "mAHP function"}, {Name: "Vstart", Doc: "Vstart is starting voltage"}, {Name: "Vend", Doc: "Vend is ending voltage"}, {Name: "Vstep", Doc: "Vstep is voltage increment"}, {Name: "TimeSteps", Doc: "TimeSteps is number of time steps"}, {Name: "TimeSpike", Doc: "do spiking instead of voltage ramp"}, {Name: "SpikeFreq", Doc: "spiking frequency"}, {Name: "TimeVstart", Doc: "time-run starting membrane potential"}, {Name: "TimeVend", Doc: "time-run ending membrane potential"}, {Name: "Dir"}, {Name: "Tabs"}}})
 
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/chans/chanplots.NaPPlot", IDName: "na-p-plot", Doc: "NaPPlot plots the persistent sodium channel.", Fields: []types.Field{{Name: "NaP", Doc: "NaP function"}, {Name: "Vstart", Doc: "Vstart is starting voltage"}, {Name: "Vend", Doc: "Vend is ending voltage"}, {Name: "Vstep", Doc: "Vstep is voltage increment"}, {Name: "TimeSteps", Doc: "TimeSteps is number of time steps"}, {Name: "TimeVstart", Doc: "TimeVstart is the time-run starting membrane potential,\nwhich the gating values start at steady state for."}, {Name: "TimeV", Doc: "TimeV is the time-run membrane potential that is stepped to."}, {Name: "Dir"}, {Name: "Tabs"}}})
+
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/chans/chanplots.NMDAPlot", IDName: "nmda-plot", Methods: []types.Method{{Name: "GVRun", Doc: "GVRun plots the conductance G (and other variables) as a function of V.", Directives: []types.Directive{{Tool: "types", Directive: "add"}}}, {Name: "TimeRun", Doc: "TimeRun runs the equation over time.", Directives: []types.Directive{{Tool: "types", Directive: "add"}}}}, Fields: []types.Field{{Name: "NMDA", Doc: "standard NMDA implementation in chans"}, {Name: "Vgain", Doc: "multiplier on NMDA as function of voltage"}, {Name: "Norm", Doc: "denominator of NMDA function"}, {Name: "Erev", Doc: "reversal / driving potential"}, {Name: "Vstart", Doc: "starting voltage"}, {Name: "Vend", Doc: "ending voltage"}, {Name: "Vstep", Doc: "voltage increment"}, {Name: "TimeSteps", Doc: "number of 1msec time steps for time run"}, {Name: "TimeV", Doc: "clamped voltage for TimeRun"}, {Name: "TimeIn", Doc: "time in msec for inputs to remain on in TimeRun"}, {Name: "TimeHz", Doc: "frequency of spiking inputs at start of TimeRun"}, {Name: "TimeGin", Doc: "proportion activation of NMDA channels per spike"}, {Name: "Dir"}, {Name: "Tabs"}}})
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/chans/chanplots.SahpPlot", IDName: "sahp-plot", Methods: []types.Method{{Name: "GCaRun", Doc: "GCaRun plots the conductance G (and other variables) as a function of Ca.", Directives: []types.Directive{{Tool: "types", Directive: "add"}}}, {Name: "TimeRun", Doc: "TimeRun runs the equation over time.", Directives: []types.Directive{{Tool: "types", Directive: "add"}}}}, Fields: []types.Field{{Name: "Sahp", Doc: "sAHP function"}, {Name: "CaStart", Doc: "starting calcium"}, {Name: "CaEnd", Doc: "ending calcium"}, {Name: "CaStep", Doc: "calcium increment"}, {Name: "TimeSteps", Doc: "number of time steps"}, {Name: "TimeCaStart", Doc: "time-run starting calcium"}, {Name: "TimeCaD", Doc: "time-run CaD value at end of each theta cycle"}, {Name: "Dir"}, {Name: "Tabs"}}})
@@ -111,3 +111,177 @@ func TestVGGC_HFromV(t *testing.T) {
 		prevVBio = vBio
 	}
 }
+
+func TestHCN_Minf(t *testing.T) {
+	const step = .01
+	const maxRelativeDiff = .1
+	prevM := float32(math.NaN())
+	prevVBio := float32(math.NaN())
+	var params HCNParams
+	params.Defaults()
+	nv := int(100.0 / step)
+	for vi := range nv {
+		vBio := float32(-120.0) + float32(vi)*step
+		m := params.Minf(vBio)
+		assert.GreaterOrEqual(t, m, float32(0), "for input %v", vBio)
+		assert.LessOrEqual(t, m, float32(1), "for input %v", vBio)
+		if !math.IsNaN(float64(prevM)) {
+			// check for discontinuities, where m is not too small
+			if prevM > 0.001 {
+				assert.InEpsilon(t, prevM, m, maxRelativeDiff, "for inputs %v and %v", prevVBio, vBio)
+			}
+			// check for monotonicity: opens with hyperpolarization
+			assert.LessOrEqual(t, m, prevM, "for inputs %v and %v", prevVBio, vBio)
+		}
+		prevM = m
+		prevVBio = vBio
+	}
+	assert.InDelta(t, 0.5, params.Minf(params.Voff), 0.01)
+	assert.Equal(t, params.Minf(-70), params.Mrest)
+}
+
+func TestHCN_MTau(t *testing.T) {
+	const step = .01
+	const maxRelativeDiff = .1
+	prevTau := float32(math.NaN())
+	prevVBio := float32(math.NaN())
+	var params HCNParams
+	params.Defaults()
+	nv := int(100.0 / step)
+	for vi := range nv {
+		vBio := float32(-120.0) + float32(vi)*step
+		tau := params.MTau(vBio)
+		assert.Greater(t, tau, float32(20), "for input %v", vBio)
+		assert.LessOrEqual(t, tau, float32(1200), "for input %v", vBio)
+		if !math.IsNaN(float64(prevTau)) {
+			// check for discontinuities
+			assert.InEpsilon(t, prevTau, tau, maxRelativeDiff, "for inputs %v and %v", prevVBio, vBio)
+		}
+		prevTau = tau
+		prevVBio = vBio
+	}
+}
+
+func TestHCN_DM(t *testing.T) {
+	var params HCNParams
+	params.Defaults()
+	// hyperpolarization slowly opens the channel, driving a depolarizing current
+	m := params.Mrest
+	v := float32(-90)
+	minf := params.Minf(v)
+	for range 5000 {
+		m += params.DM(v, m)
+	}
+	assert.InDelta(t, minf, m, 0.001)
+	assert.Greater(t, params.Ih(v, params.Gh(m)), float32(0))
+	assert.Less(t, params.Ih(-20, params.Gh(m)), float32(0))
+}
+
+func TestCaT_MHinf(t *testing.T) {
+	const step = .01
+	prevM := float32(math.NaN())
+	prevH := float32(math.NaN())
+	var params CaTParams
+	params.Defaults()
+	nv := int(100.0 / step)
+	for vi := range nv {
+		vBio := float32(-120.0) + float32(vi)*step
+		m := params.Minf(vBio)
+		h := params.Hinf(vBio)
+		assert.GreaterOrEqual(t, m, float32(0), "for input %v", vBio)
+		assert.LessOrEqual(t, m, float32(1), "for input %v", vBio)
+		assert.GreaterOrEqual(t, h, float32(0), "for input %v", vBio)
+		assert.LessOrEqual(t, h, float32(1), "for input %v", vBio)
+		if !math.IsNaN(float64(prevM)) {
+			// check for monotonicity
+			assert.GreaterOrEqual(t, m, prevM, "for input %v", vBio)
+			assert.LessOrEqual(t, h, prevH, "for input %v", vBio)
+		}
+		assert.Greater(t, params.MTau(vBio), float32(0), "for input %v", vBio)
+		assert.Greater(t, params.HTau(vBio), float32(0), "for input %v", vBio)
+		prevM = m
+		prevH = h
+	}
+}
+
+// catPeak returns the peak CaT conductance after stepping to vstep,
+// starting from steady state at vhold.
+func catPeak(params *CaTParams, vhold, vstep float32) float32 {
+	m := params.Minf(vhold)
+	h := params.Hinf(vhold)
+	mx := float32(0)
+	for range 200 {
+		m += params.DM(vstep, m)
+		h += params.DH(vstep, h)
+		mx = max(mx, params.Gcat(m, h))
+	}
+	return mx
+}
+
+func TestCaT_Rebound(t *testing.T) {
+	var params CaTParams
+	params.Defaults()
+	// de-inactivation by hyperpolarization is required for a strong response
+	hyper := catPeak(&params, -90, -50)
+	rest := catPeak(&params, -60, -50)
+	assert.Greater(t, hyper, 5*rest)
+	// and the response is transient, due to inactivation
+	m := params.Minf(-90)
+	h := params.Hinf(-90)
+	for range 500 {
+		m += params.DM(-50, m)
+		h += params.DH(-50, h)
+	}
+	assert.Less(t, params.Gcat(m, h), 0.1*hyper)
+	assert.Greater(t, params.CaFromG(-50, hyper), float32(0))
+}
+
+func TestNaP_MHinf(t *testing.T) {
+	const step = .01
+	prevM := float32(math.NaN())
+	prevH := float32(math.NaN())
+	var params NaPParams
+	params.Defaults()
+	nv := int(100.0 / step)
+	for vi := range nv {
+		vBio := float32(-100.0) + float32(vi)*step
+		m := params.Minf(vBio)
+		h := params.Hinf(vBio)
+		assert.GreaterOrEqual(t, m, float32(0), "for input %v", vBio)
+		assert.LessOrEqual(t, m, float32(1), "for input %v", vBio)
+		assert.GreaterOrEqual(t, h, float32(0), "for input %v", vBio)
+		assert.LessOrEqual(t, h, float32(1), "for input %v", vBio)
+		if !math.IsNaN(float64(prevM)) {
+			// check for monotonicity
+			assert.GreaterOrEqual(t, m, prevM, "for input %v", vBio)
+			assert.LessOrEqual(t, h, prevH, "for input %v", vBio)
+		}
+		tau := params.HTauFromV(vBio)
+		assert.Greater(t, tau, float32(0), "for input %v", vBio)
+		assert.LessOrEqual(t, tau, params.HTau*1.001, "for input %v", vBio)
+		prevM = m
+		prevH = h
+	}
+	assert.InDelta(t, params.HTau, params.HTauFromV(params.HVoff), 1)
+}
+
+func TestNaP_Time(t *testing.T) {
+	var params NaPParams
+	params.Defaults()
+	// persistent current activates quickly and inactivates slowly
+	m := params.Mrest
+	h := params.Hrest
+	v := float32(-45)
+	for range 10 {
+		m += params.DM(v, m)
+		h += params.DH(v, h)
+	}
+	g0 := params.Gnap(m, h)
+	assert.Greater(t, g0, 0.5*params.Ge*params.Minf(v)*params.Hrest)
+	for range 60000 {
+		m += params.DM(v, m)
+		h += params.DH(v, h)
+	}
+	assert.Less(t, params.Gnap(m, h), g0)
+	assert.InDelta(t, params.Gnap(params.Minf(v), params.Hinf(v)), params.Gnap(m, h), 1.0e-4)
+}
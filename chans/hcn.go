@@ -0,0 +1,107 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package chans
+
+import (
+	"cogentcore.org/core/math32"
+)
+
+//gosl:start
+
+// HCNParams control the HCN hyperpolarization-activated cation channel,
+// which produces the Ih current (also known as the "funny" or "queer" current),
+// based on the equations from Huguenard & McCormick (1992) for thalamic
+// relay neurons. The conductance opens slowly with hyperpolarization, and
+// has a mixed Na+ / K+ reversal potential around -30 mV, so it depolarizes
+// the neuron back toward firing after a period of inhibition, producing
+// rebound bursting in thalamus, resonance in entorhinal and hippocampal
+// neurons, and normalization of dendritic integration.
+// Unlike the other channels, Ih has its own Gbar and Erev values,
+// instead of adding to Ge or Gk.
+type HCNParams struct {
+
+	// Gbar is the maximal conductance of the HCN channel in nS (nanosiemens),
+	// which multiplies the M gating factor. This is directly comparable to
+	// the Gbar values in ActParams (e.g., Gbar.L = 20 for leak).
+	Gbar float32 `default:"0,2"`
+
+	// Erev is the reversal / driving potential for the HCN channel, in mV.
+	// It is a mixed cation channel with a reversal potential between Na+ and K+.
+	Erev float32 `default:"-30"`
+
+	// Voff is the voltage offset for the Minf logistic function:
+	// the channel is half open at this membrane potential.
+	Voff float32 `default:"-75"`
+
+	// Vslope is the slope of the Minf logistic function.
+	Vslope float32 `default:"5.5"`
+
+	// TauFact is a multiplier on the voltage-dependent time constant for M,
+	// which is around 1 second at Voff with the default of 1.
+	// Smaller values are faster.
+	TauFact float32 `default:"1"`
+
+	// Mrest is Minf at resting membrane potential of -70, computed from other params.
+	Mrest float32 `edit:"-"`
+
+	pad, pad1 float32
+}
+
+func (hp *HCNParams) Defaults() {
+	hp.Gbar = 2
+	hp.Erev = -30
+	hp.Voff = -75
+	hp.Vslope = 5.5
+	hp.TauFact = 1
+	hp.Update()
+}
+
+func (hp *HCNParams) Update() {
+	hp.Mrest = hp.MinfRest()
+}
+
+func (hp *HCNParams) ShouldDisplay(field string) bool {
+	switch field {
+	case "Gbar":
+		return true
+	default:
+		return hp.Gbar > 0
+	}
+}
+
+// Minf returns Minf as a function of voltage potential,
+// which is higher for more hyperpolarized potentials.
+func (hp *HCNParams) Minf(v float32) float32 {
+	return 1.0 / (1.0 + math32.FastExp((v-hp.Voff)/hp.Vslope))
+}
+
+// MinfRest returns Minf at nominal resting membrane potential of -70mV
+// which serves as the initial value.
+func (hp *HCNParams) MinfRest() float32 {
+	return hp.Minf(-70.0)
+}
+
+// MTau returns the M time constant in msec as a function of voltage,
+// from Huguenard & McCormick (1992).
+func (hp *HCNParams) MTau(v float32) float32 {
+	return hp.TauFact / (math32.FastExp(-14.59-0.086*v) + math32.FastExp(-1.87+0.0701*v))
+}
+
+// DM computes the change in M gating parameter.
+func (hp *HCNParams) DM(v, m float32) float32 {
+	return (hp.Minf(v) - m) / hp.MTau(v)
+}
+
+// Gh returns the overall net HCN conductance, including Gbar.
+func (hp *HCNParams) Gh(m float32) float32 {
+	return hp.Gbar * m
+}
+
+// Ih returns the HCN current from the conductance and membrane potential.
+func (hp *HCNParams) Ih(v, gh float32) float32 {
+	return gh * (hp.Erev - v)
+}
+
+//gosl:end
@@ -0,0 +1,115 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package chans
+
+import (
+	"cogentcore.org/core/math32"
+)
+
+//gosl:start
+
+// NaPParams control the persistent sodium (NaP) channel, which activates
+// rapidly at subthreshold membrane potentials and inactivates very slowly,
+// providing a sustained depolarizing drive that supports burst firing and
+// plateau potentials, e.g., in the subthalamic nucleus (STN).
+// Equations are from Butera et al (1999), with m h gating, where m is
+// typically effectively instantaneous, and h has a bell-shaped time constant.
+type NaPParams struct {
+
+	// Ge is the strength of the NaP contribution to Ge(t) excitatory
+	// conductance. Ge(t) is later multiplied by Gbar.E for pA unit scaling.
+	Ge float32 `default:"0,0.02"`
+
+	// MVoff is the voltage offset for the M activation function,
+	// where it is half activated.
+	MVoff float32 `default:"-40"`
+
+	// MVslope is the slope of the M activation function.
+	MVslope float32 `default:"6"`
+
+	// HVoff is the voltage offset for the H inactivation function,
+	// where it is half inactivated, and for the peak of the H time constant.
+	HVoff float32 `default:"-48"`
+
+	// HVslope is the slope of the H inactivation function.
+	HVslope float32 `default:"6"`
+
+	// MTau is the time constant for the M activation gate in msec,
+	// where 1 or less is effectively instantaneous.
+	MTau float32 `default:"1"`
+
+	// HTau is the maximum time constant for the H inactivation gate in msec,
+	// at HVoff.
+	HTau float32 `default:"10000"`
+
+	// Mrest is Minf at resting membrane potential of -70, computed from other params.
+	Mrest float32 `edit:"-"`
+
+	// Hrest is Hinf at resting membrane potential of -70, computed from other params.
+	Hrest float32 `edit:"-"`
+
+	pad, pad1, pad2 float32
+}
+
+func (np *NaPParams) Defaults() {
+	np.Ge = 0.02
+	np.MVoff = -40
+	np.MVslope = 6
+	np.HVoff = -48
+	np.HVslope = 6
+	np.MTau = 1
+	np.HTau = 10000
+	np.Update()
+}
+
+func (np *NaPParams) Update() {
+	np.Mrest = np.Minf(-70)
+	np.Hrest = np.Hinf(-70)
+}
+
+func (np *NaPParams) ShouldDisplay(field string) bool {
+	switch field {
+	case "Ge":
+		return true
+	default:
+		return np.Ge > 0
+	}
+}
+
+// Minf returns the asymptotic M activation gate value as a function of voltage.
+func (np *NaPParams) Minf(v float32) float32 {
+	return 1.0 / (1.0 + math32.FastExp(-(v-np.MVoff)/np.MVslope))
+}
+
+// Hinf returns the asymptotic H inactivation gate value as a function of voltage.
+func (np *NaPParams) Hinf(v float32) float32 {
+	return 1.0 / (1.0 + math32.FastExp((v-np.HVoff)/np.HVslope))
+}
+
+// HTauFromV returns the H time constant in msec as a function of voltage,
+// which is HTau / cosh((v - HVoff) / (2 HVslope)).
+func (np *NaPParams) HTauFromV(v float32) float32 {
+	x := (v - np.HVoff) / (2 * np.HVslope)
+	return 2 * np.HTau / (math32.FastExp(x) + math32.FastExp(-x))
+}
+
+// DM returns the change in M gating value at msec update scale,
+// as a function of voltage and current M value.
+func (np *NaPParams) DM(v, m float32) float32 {
+	return (np.Minf(v) - m) * min(1.0/np.MTau, 1.0)
+}
+
+// DH returns the change in H gating value at msec update scale,
+// as a function of voltage and current H value.
+func (np *NaPParams) DH(v, h float32) float32 {
+	return (np.Hinf(v) - h) * min(1.0/np.HTauFromV(v), 1.0)
+}
+
+// Gnap returns the NaP net conductance from m, h gating values.
+func (np *NaPParams) Gnap(m, h float32) float32 {
+	return np.Ge * m * h
+}
+
+//gosl:end
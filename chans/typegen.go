@@ -8,10 +8,14 @@ import (
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/chans.AKsParams", IDName: "a-ks-params", Doc: "AKsParams provides a highly simplified stateless A-type K+ channel\nthat only has the voltage-gated activation (M) dynamic with a cutoff\nthat ends up capturing a close approximation to the much more complex AK function.\nThis is voltage gated with maximal activation around -37 mV.\nIt is particularly important for counteracting the excitatory effects of\nvoltage gated calcium channels which can otherwise drive runaway excitatory currents.", Directives: []types.Directive{{Tool: "gosl", Directive: "start"}}, Fields: []types.Field{{Name: "Gk", Doc: "strength of AK conductance as contribution to g_k(t) factor\n(which is then multiplied by gbar_k that provides pA unit scaling)."}, {Name: "Hf", Doc: "Hf is the multiplier factor as a constant multiplier\non overall M factor result. Rescales M to level consistent\nwith H being present at full strength."}, {Name: "Mf", Doc: "Mf is the multiplier factor for M, determines slope of function."}, {Name: "Voff", Doc: "Voff is the voltage offset for M function."}, {Name: "Vmax", Doc: "Vmax is the voltage level of maximum channel opening: stays flat above that."}, {Name: "pad"}, {Name: "pad1"}, {Name: "pad2"}}})
 
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/chans.CaTParams", IDName: "ca-t-params", Doc: "CaTParams control the low-threshold T-type Ca channel, which is\nde-inactivated by hyperpolarization and then opens transiently on\nsubsequent depolarization, producing a low-threshold calcium spike\nthat drives a burst of action potentials. This is critical for burst\nfiring in thalamic relay and reticular (TRN) neurons, and in the\nsubthalamic nucleus (STN). Equations are from Huguenard & McCormick (1992)\nas used in Destexhe et al (1996), with m^2 h gating, and temperature\nscaling factors for 36 deg C.", Directives: []types.Directive{{Tool: "gosl", Directive: "start"}}, Fields: []types.Field{{Name: "Ge", Doc: "Ge is the strength of the CaT contribution to Ge(t) excitatory\nconductance. Ge(t) is later multiplied by Gbar.E for pA unit scaling."}, {Name: "Ca", Doc: "Ca is the calcium from conductance factor, which determines the\ncontribution of CaT to the [LearnCa] calcium for learning."}, {Name: "Vshift", Doc: "Vshift is a voltage shift added to the membrane potential for all\ngating functions, e.g., to shift the activation and inactivation\ncurves to more depolarized values as found in TRN neurons (~ +2 mV)."}, {Name: "PhiM", Doc: "PhiM is the temperature scaling factor for the M time constant,\n5^((36-24)/10) for Q10 = 5."}, {Name: "PhiH", Doc: "PhiH is the temperature scaling factor for the H time constant,\n3^((36-24)/10) for Q10 = 3."}, {Name: "Mrest", Doc: "Mrest is Minf at resting membrane potential of -70, computed from other params."}, {Name: "Hrest", Doc: "Hrest is Hinf at resting membrane potential of -70, computed from other params."}, {Name: "pad"}}})
+
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/chans.Chans", IDName: "chans", Doc: "Chans are ion channels used in computing point-neuron activation function.", Directives: []types.Directive{{Tool: "go", Directive: "generate", Args: []string{"core", "generate", "-add-types", "-gosl"}}, {Tool: "gosl", Directive: "start"}}, Fields: []types.Field{{Name: "E", Doc: "excitatory sodium (Na) AMPA channels activated by synaptic glutamate."}, {Name: "L", Doc: "constant leak (potassium, K+) channels. determines resting potential\n(typically higher than resting potential of K)."}, {Name: "I", Doc: "inhibitory chloride (Cl-) channels activated by synaptic GABA."}, {Name: "K", Doc: "gated / active potassium channels. Typically hyperpolarizing\nrelative to leak / rest."}}})
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/chans.GABABParams", IDName: "gabab-params", Doc: "GABA-B is an inhibitory channel activated by the usual GABA inhibitory\nneurotransmitter, which is coupled to the GIRK G-protein coupled inwardly\nrectifying potassium (K) channel. It is ubiquitous in the brain, and critical\nfor stability of spiking patterns over time in axon. The inward rectification\nis caused by a Mg+ ion block *from the inside* of the neuron,\nwhich means that these channels are most open when the neuron is hyperpolarized\n(inactive), and thus it serves to keep inactive neurons inactive.\nBased on Thomson & Destexhe (1999).", Directives: []types.Directive{{Tool: "gosl", Directive: "start"}}, Fields: []types.Field{{Name: "Gk", Doc: "Gk is the strength of GABA-B conductance as contribution to Gk(t) factor\n(which is then multiplied by Gbar.K that provides pA unit scaling).\nThe 0.015 default is a high value that works well in smaller networks.\nLarger networks may benefit from lower levels (e.g., 0.012).\nGababM activation factor can become large, so that overall GgabaB = ~50 nS."}, {Name: "Rise", Doc: "Rise is the rise time for bi-exponential time dynamics of GABA-B, in ms."}, {Name: "Decay", Doc: "Decay is the decay time for bi-exponential time dynamics of GABA-B, in ms."}, {Name: "Gbase", Doc: "Gbase is the baseline level of GABA-B channels open independent of\ninhibitory input (is added to spiking-produced conductance)."}, {Name: "GiSpike", Doc: "GiSpike is the multiplier for converting Gi to equivalent GABA spikes."}, {Name: "MaxTime", Doc: "MaxTime is the time offset when peak conductance occurs, in msec, computed\nfrom Rise and Decay."}, {Name: "TauFact", Doc: "TauFact is the time constant factor used in integration:\n(Decay / Rise) ^ (Rise / (Decay - Rise))"}, {Name: "RiseDt", Doc: "RiseDt = 1/Tau"}, {Name: "DecayDt", Doc: "DecayDt = 1/Tau"}, {Name: "pad"}, {Name: "pad1"}, {Name: "pad2"}}})
 
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/chans.HCNParams", IDName: "hcn-params", Doc: "HCNParams control the HCN hyperpolarization-activated cation channel,\nwhich produces the Ih current (also known as the \"funny\" or \"queer\" current),\nbased on the equations from Huguenard & McCormick (1992) for thalamic\nrelay neurons. The conductance opens slowly with hyperpolarization, and\nhas a mixed Na+ / K+ reversal potential around -30 mV, so it depolarizes\nthe neuron back toward firing after a period of inhibition, producing\nrebound bursting in thalamus, resonance in entorhinal and hippocampal\nneurons, and normalization of dendritic integration.\nUnlike the other channels, Ih has its own Gbar and Erev values,\ninstead of adding to Ge or Gk.", Directives: []types.Directive{{Tool: "gosl", Directive: "start"}}, Fields: []types.Field{{Name: "Gbar", Doc: "Gbar is the maximal conductance of the HCN channel in nS (nanosiemens),\nwhich multiplies the M gating factor. This is directly comparable to\nthe Gbar values in ActParams (e.g., Gbar.L = 20 for leak)."}, {Name: "Erev", Doc: "Erev is the reversal / driving potential for the HCN channel, in mV.\nIt is a mixed cation channel with a reversal potential between Na+ and K+."}, {Name: "Voff", Doc: "Voff is the voltage offset for the Minf logistic function:\nthe channel is half open at this membrane potential."}, {Name: "Vslope", Doc: "Vslope is the slope of the Minf logistic function."}, {Name: "TauFact", Doc: "TauFact is a multiplier on the voltage-dependent time constant for M,\nwhich is around 1 second at Voff with the default of 1.\nSmaller values are faster."}, {Name: "Mrest", Doc: "Mrest is Minf at resting membrane potential of -70, computed from other params."}, {Name: "pad"}, {Name: "pad1"}}})
+
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/chans.KirParams", IDName: "kir-params", Doc: "KirParams control the kIR K+ inwardly rectifying current,\nbased on the equations from Lindroos et al (2018).\nThe conductance is highest at low membrane potentials.", Directives: []types.Directive{{Tool: "gosl", Directive: "start"}}, Fields: []types.Field{{Name: "Gk", Doc: "Gk is the strength of Kir conductance as contribution to Gk(t) factor\n(which is then multiplied by Gbar.K that provides pA unit scaling)."}, {Name: "MinfOff", Doc: "MinfOff is the asymptotic gating factor M, offset."}, {Name: "MinfTau", Doc: "MinfTau is the asymptotic gating factor M, time constant."}, {Name: "RiseOff", Doc: "RiseOff is the rise time constant as a function of voltage, offset."}, {Name: "RiseTau", Doc: "RiseTau is the rise time constant as a function of voltage, time constant factor."}, {Name: "DecayOff", Doc: "DecayOff is the decay time constant as a function of voltage, offset."}, {Name: "DecayTau", Doc: "DecayTau is the decay time constant as a function of voltage, time constant factor."}, {Name: "Mrest", Doc: "Mrest is Minf at resting membrane potential of -70, computed from other params."}}})
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/chans.KNaParams", IDName: "k-na-params", Doc: "KNaParams implements sodium (Na) gated potassium (K) currents\nthat drive adaptation (accommodation) in neural firing.\nAs neurons spike, driving an influx of Na, this activates\nthe K channels, which, like leak channels, pull the membrane\npotential back down toward rest (or even below).", Directives: []types.Directive{{Tool: "gosl", Directive: "start"}}, Fields: []types.Field{{Name: "On", Doc: "On enables this component of KNa adaptation."}, {Name: "Rise", Doc: "Rise is the time constant in ms for increase in conductance based on Na\nconcentration due to spiking."}, {Name: "Decay", Doc: "Decay is the time constant in ms for decay of conductance."}, {Name: "Max", Doc: "Max is the maximum potential conductance contribution to Gk(t)\n(which is then multiplied by Gbar.K that provides pA unit scaling)."}, {Name: "DtRise", Doc: "Dt = 1/Tau rate constant."}, {Name: "DtDecay", Doc: "Dt = 1/Tau rate constant."}, {Name: "pad"}, {Name: "pad1"}}})
@@ -20,6 +24,8 @@ var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/chans.KNaMedSlo
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/chans.MahpParams", IDName: "mahp-params", Doc: "MahpParams implements an M-type medium afterhyperpolarizing (mAHP) K+ channel,\nwhere m also stands for muscarinic due to the ACh inactivation of this channel.\nIt has a slow activation and deactivation time constant, and opens at a lowish\nmembrane potential.\nThere is one gating variable N updated over time with a tau that is also\nvoltage dependent.\nThe infinite-time value of N is voltage dependent according to a logistic function\nof the membrane potential, centered at Voff with slope Vslope.", Directives: []types.Directive{{Tool: "gosl", Directive: "start"}}, Fields: []types.Field{{Name: "Gk", Doc: "Gk is the strength of mAHP conductance as contribution to Gk(t) factor\n(which is then multiplied by Gbar.K that provides pA unit scaling)."}, {Name: "Off", Doc: "Off is the voltage offset (threshold) in biological units for infinite time\nN gating function: where the gate is at 50% strength."}, {Name: "Slope", Doc: "Slope is the slope of the arget (infinite time) gating function."}, {Name: "TauMax", Doc: "TauMax is the maximum slow rate time constant in msec for activation\n/ deactivation. The effective Tau is much slower: 1/20th in original temp,\nand 1/60th in standard 37 C temp."}, {Name: "Tadj", Doc: "Tadj is a temperature adjustment factor: assume temp = 37 C,\nwhereas original units were at 23 C."}, {Name: "DtMax", Doc: "DtMax = 1/Tau"}, {Name: "pad"}, {Name: "pad2"}}})
 
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/chans.NaPParams", IDName: "na-p-params", Doc: "NaPParams control the persistent sodium (NaP) channel, which activates\nrapidly at subthreshold membrane potentials and inactivates very slowly,\nproviding a sustained depolarizing drive that supports burst firing and\nplateau potentials, e.g., in the subthalamic nucleus (STN).\nEquations are from Butera et al (1999), with m h gating, where m is\ntypically effectively instantaneous, and h has a bell-shaped time constant.", Directives: []types.Directive{{Tool: "gosl", Directive: "start"}}, Fields: []types.Field{{Name: "Ge", Doc: "Ge is the strength of the NaP contribution to Ge(t) excitatory\nconductance. Ge(t) is later multiplied by Gbar.E for pA unit scaling."}, {Name: "MVoff", Doc: "MVoff is the voltage offset for the M activation function,\nwhere it is half activated."}, {Name: "MVslope", Doc: "MVslope is the slope of the M activation function."}, {Name: "HVoff", Doc: "HVoff is the voltage offset for the H inactivation function,\nwhere it is half inactivated, and for the peak of the H time constant."}, {Name: "HVslope", Doc: "HVslope is the slope of the H inactivation function."}, {Name: "MTau", Doc: "MTau is the time constant for the M activation gate in msec,\nwhere 1 or less is effectively instantaneous."}, {Name: "HTau", Doc: "HTau is the maximum time constant for the H inactivation gate in msec,\nat HVoff."}, {Name: "Mrest", Doc: "Mrest is Minf at resting membrane potential of -70, computed from other params."}, {Name: "Hrest", Doc: "Hrest is Hinf at resting membrane potential of -70, computed from other params."}, {Name: "pad"}, {Name: "pad1"}, {Name: "pad2"}}})
+
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/chans.NMDAParams", IDName: "nmda-params", Doc: "NMDAParams control the NMDA dynamics, based on Jahr & Stevens (1990) equations\nwhich are widely used in models, from Brunel & Wang (2001) to Sanders et al. (2013).\nThe overall conductance is a function of a voltage-dependent postsynaptic factor based\non Mg ion blockage, and presynaptic Glu-based opening, which in a simple model just\nincrements", Directives: []types.Directive{{Tool: "gosl", Directive: "start"}}, Fields: []types.Field{{Name: "Ge", Doc: "Ge is the multiplier for the NMDA contribution to Ge(t) excitatory conductance.\nMultiplies GnmdaSyn to get net conductance including presynaptic.\nGnmdaSyn can be relatively large, such that overall Gnmda conductance = ~50 nS max.\nGe(t) is later multiplied by Gbar.E for pA unit scaling."}, {Name: "Tau", Doc: "Tau is the decay time constant for NMDA channel activation.\nRise time is 2 msec and not worth extra cost for biexponential.\n30 fits the Urakubo et al (2008) model with ITau = 100, but 100\nworks better in practice."}, {Name: "ITau", Doc: "ITau is the decay time constant for NMDA channel inhibition, which captures the\nUrakubo et al (2008) allosteric dynamics (100 fits their model well).\nSet to 1 to eliminate that mechanism."}, {Name: "MgC", Doc: "MgC is the magnesium ion concentration: Brunel & Wang (2001) and\n Sanders et al (2013) use 1 mM, based on Jahr & Stevens (1990).\nUrakubo et al (2008) use 1.5 mM. 1.4 with Voff = 5 works best so far\nin large models, 1.2, Voff = 0 best in smaller nets."}, {Name: "Voff", Doc: "Voff is the offset in membrane potential in biological units for\nvoltage-dependent functions. 5 corresponds to the -65 mV rest,\n-45 threshold of the Urakubo et al (2008) model.\n0 is used in Brunel & Wang, 2001."}, {Name: "Dt", Doc: "Dt = 1 / tau"}, {Name: "IDt", Doc: "IDt = 1 / tau"}, {Name: "MgFact", Doc: "MgFact = MgC / 3.57"}}})
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/chans.SahpParams", IDName: "sahp-params", Doc: "SahpParams implements a slow afterhyperpolarizing (sAHP) K+ channel,\nIt has a slowly accumulating calcium value, aggregated at the\ntheta cycle level, that then drives the logistic gating function,\nso that it only activates after a significant accumulation.\nAfter which point it decays.\nFor the theta-cycle updating, the normal m-type tau is all within\nthe scope of a single theta cycle, so we just omit the time integration\nof the n gating value, but tau is computed in any case.", Directives: []types.Directive{{Tool: "gosl", Directive: "start"}}, Fields: []types.Field{{Name: "Gk", Doc: "Gk is the strength of sAHP conductance as contribution to Gk(t) factor\n(which is then multiplied by Gbar.K that provides pA unit scaling)."}, {Name: "CaTau", Doc: "CaTau is the time constant for integrating Ca across theta cycles."}, {Name: "Off", Doc: "Off is the integrated Ca offset (threshold) for infinite time N\ngating function, where the gate is at 50% strength."}, {Name: "Slope", Doc: "Slope of the infinite time logistic gating function."}, {Name: "TauMax", Doc: "TauMax is the maximum slow rate time constant in msec for activation\n/ deactivation. The effective Tau is much slower: 1/20th in original temp,\nand 1/60th in standard 37 C temp."}, {Name: "CaDt", Doc: "1/Tau"}, {Name: "DtMax", Doc: "1/Tau"}, {Name: "pad"}}})
@@ -0,0 +1,120 @@
+// Copyright (c) 2026, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package kinase
+
+import (
+	"cogentcore.org/core/math32"
+)
+
+//gosl:start
+
+// CaThrParams are parameters for the calcium-threshold synaptic plasticity
+// rule of Graupner & Brunel (2012), as a biophysically motivated alternative
+// to the CaP - CaD kinase rule. Pre and post spikes drive a single
+// synaptic calcium variable Ca, and a bistable synaptic efficacy
+// Rho (0-1) is potentiated when Ca is above the ThrP threshold, and
+// depressed when Ca is above the ThrD threshold, while it otherwise
+// slowly relaxes toward either 0 (DOWN) or 1 (UP) on either side of
+// the unstable fixed point RhoStar. Defaults are the fits to the
+// cortical slice data of Sjöström et al (2001), with cycles = msec.
+type CaThrParams struct { //types:add
+
+	// Tau is the time constant in cycles (msec) of changes in synaptic efficacy
+	// Rho, which is very slow (150 sec) relative to the Ca dynamics.
+	Tau float32 `default:"150000" min:"1"`
+
+	// CaTau is the time constant in cycles (msec) of the synaptic Ca decay.
+	CaTau float32 `default:"20" min:"1"`
+
+	// CaPre is the amount of Ca added for each presynaptic spike,
+	// after PreDelay cycles.
+	CaPre float32 `default:"1"`
+
+	// CaPost is the amount of Ca added for each postsynaptic spike.
+	CaPost float32 `default:"2"`
+
+	// PreDelay is the delay in cycles (msec) from the presynaptic spike
+	// to the Ca increase, reflecting the slower NMDA receptor dynamics.
+	// This delay must be applied by the caller to the presynaptic spike
+	// input to [CaThrParams.CaFromSpikes].
+	PreDelay int32 `default:"14"`
+
+	// ThrD is the Ca threshold above which depression occurs.
+	ThrD float32 `default:"1"`
+
+	// ThrP is the Ca threshold above which potentiation occurs.
+	ThrP float32 `default:"1.3"`
+
+	// GainD is the rate of depression when Ca is above ThrD.
+	GainD float32 `default:"200"`
+
+	// GainP is the rate of potentiation when Ca is above ThrP.
+	GainP float32 `default:"321.808"`
+
+	// RhoStar is the unstable fixed point of the bistable efficacy dynamics,
+	// separating the DOWN (0) and UP (1) states in the absence of Ca.
+	RhoStar float32 `default:"0.5"`
+
+	// Noise is the standard deviation of the efficacy noise, as a multiplier
+	// on the noise sample passed to [CaThrParams.RhoFromCa].
+	// 2.8284 was used in the original fits.
+	Noise float32 `default:"0"`
+
+	// CaDt = 1 / CaTau
+	CaDt float32 `display:"-" json:"-" xml:"-" edit:"-"`
+
+	// Dt = 1 / Tau
+	Dt float32 `display:"-" json:"-" xml:"-" edit:"-"`
+
+	// NoiseDt = Noise * sqrt(Dt)
+	NoiseDt float32 `display:"-" json:"-" xml:"-" edit:"-"`
+
+	pad, pad1 float32
+}
+
+func (cp *CaThrParams) Defaults() {
+	cp.Tau = 150000
+	cp.CaTau = 20
+	cp.CaPre = 1
+	cp.CaPost = 2
+	cp.PreDelay = 14
+	cp.ThrD = 1
+	cp.ThrP = 1.3
+	cp.GainD = 200
+	cp.GainP = 321.808
+	cp.RhoStar = 0.5
+	cp.Noise = 0
+	cp.Update()
+}
+
+func (cp *CaThrParams) Update() {
+	cp.CaDt = 1 / cp.CaTau
+	cp.Dt = 1 / cp.Tau
+	cp.NoiseDt = cp.Noise * math32.Sqrt(cp.Dt)
+}
+
+// CaFromSpikes updates the synaptic Ca from pre and post spikes,
+// which are either 0 or 1. The pre spike must already be delayed
+// by PreDelay cycles.
+func (cp *CaThrParams) CaFromSpikes(preSpike, postSpike float32, ca *float32) {
+	*ca += cp.CaPre*preSpike + cp.CaPost*postSpike - cp.CaDt*(*ca)
+}
+
+// RhoFromCa updates the synaptic efficacy Rho from the current synaptic Ca,
+// with given normally distributed noise sample (ignored if Noise = 0).
+func (cp *CaThrParams) RhoFromCa(ca, noise float32, rho *float32) {
+	r := *rho
+	dr := -r * (1 - r) * (cp.RhoStar - r)
+	if ca >= cp.ThrP {
+		dr += cp.GainP * (1 - r)
+	}
+	if ca >= cp.ThrD {
+		dr -= cp.GainD * r
+	}
+	r += cp.Dt*dr + cp.NoiseDt*noise
+	*rho = math32.Clamp(r, 0, 1)
+}
+
+//gosl:end
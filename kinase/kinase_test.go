@@ -7,6 +7,8 @@ package kinase
 import (
 	"fmt"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestSynCaWts(t *testing.T) {
@@ -26,3 +28,61 @@ func TestSynCaWts(t *testing.T) {
 	}
 	fmt.Println("CaP Sum:", cpsum, "CaD Sum:", cdsum)
 }
+
+// caThrPairing returns the change in Rho from 0.5 for n pre-post pairings
+// at given interval, with post spikes dt cycles after pre spikes.
+func caThrPairing(cp *CaThrParams, n, interval, dt int) float32 {
+	start := 100
+	ncyc := start + n*interval + 200
+	pre := make([]float32, ncyc)
+	post := make([]float32, ncyc)
+	for i := range n {
+		t := start + i*interval
+		pre[t] = 1
+		post[t+dt] = 1
+	}
+	ca := float32(0)
+	rho := float32(0.5)
+	for t := range ncyc {
+		preSpike := float32(0)
+		if t >= int(cp.PreDelay) {
+			preSpike = pre[t-int(cp.PreDelay)]
+		}
+		cp.CaFromSpikes(preSpike, post[t], &ca)
+		cp.RhoFromCa(ca, 0, &rho)
+	}
+	return rho - 0.5
+}
+
+func TestCaThr(t *testing.T) {
+	cp := &CaThrParams{}
+	cp.Defaults()
+
+	// classic STDP protocol: 60 pairings at 1 Hz
+	ltp := caThrPairing(cp, 60, 1000, 10)
+	ltd := caThrPairing(cp, 60, 1000, -20)
+	assert.Greater(t, ltp, float32(0.03))
+	assert.Less(t, ltd, float32(-0.03))
+	assert.Less(t, caThrPairing(cp, 60, 1000, 30), ltp)
+
+	// no Ca: Rho relaxes toward the nearest stable state
+	cp.Tau = 1000
+	cp.Update()
+	rho := float32(0.6)
+	for range 1000 {
+		cp.RhoFromCa(0, 0, &rho)
+	}
+	assert.Greater(t, rho, float32(0.6))
+	rho = 0.4
+	for range 1000 {
+		cp.RhoFromCa(0, 0, &rho)
+	}
+	assert.Less(t, rho, float32(0.4))
+
+	// sustained Ca above both thresholds converges on the balance point
+	rho = 0
+	for range 10000 {
+		cp.RhoFromCa(2, 0, &rho)
+	}
+	assert.InDelta(t, cp.GainP/(cp.GainP+cp.GainD), rho, 0.01)
+}
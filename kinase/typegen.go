@@ -6,6 +6,8 @@ import (
 	"cogentcore.org/core/types"
 )
 
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/kinase.CaThrParams", IDName: "ca-thr-params", Doc: "CaThrParams are parameters for the calcium-threshold synaptic plasticity\nrule of Graupner & Brunel (2012), as a biophysically motivated alternative\nto the CaP - CaD kinase rule. Pre and post spikes drive a single\nsynaptic calcium variable Ca, and a bistable synaptic efficacy\nRho (0-1) is potentiated when Ca is above the ThrP threshold, and\ndepressed when Ca is above the ThrD threshold, while it otherwise\nslowly relaxes toward either 0 (DOWN) or 1 (UP) on either side of\nthe unstable fixed point RhoStar. Defaults are the fits to the\ncortical slice data of Sjöström et al (2001), with cycles = msec.", Directives: []types.Directive{{Tool: "gosl", Directive: "start"}, {Tool: "types", Directive: "add"}}, Fields: []types.Field{{Name: "Tau", Doc: "Tau is the time constant in cycles (msec) of changes in synaptic efficacy\nRho, which is very slow (150 sec) relative to the Ca dynamics."}, {Name: "CaTau", Doc: "CaTau is the time constant in cycles (msec) of the synaptic Ca decay."}, {Name: "CaPre", Doc: "CaPre is the amount of Ca added for each presynaptic spike,\nafter PreDelay cycles."}, {Name: "CaPost", Doc: "CaPost is the amount of Ca added for each postsynaptic spike."}, {Name: "PreDelay", Doc: "PreDelay is the delay in cycles (msec) from the presynaptic spike\nto the Ca increase, reflecting the slower NMDA receptor dynamics.\nThis delay must be applied by the caller to the presynaptic spike\ninput to [CaThrParams.CaFromSpikes]."}, {Name: "ThrD", Doc: "ThrD is the Ca threshold above which depression occurs."}, {Name: "ThrP", Doc: "ThrP is the Ca threshold above which potentiation occurs."}, {Name: "GainD", Doc: "GainD is the rate of depression when Ca is above ThrD."}, {Name: "GainP", Doc: "GainP is the rate of potentiation when Ca is above ThrP."}, {Name: "RhoStar", Doc: "RhoStar is the unstable fixed point of the bistable efficacy dynamics,\nseparating the DOWN (0) and UP (1) states in the absence of Ca."}, {Name: "Noise", Doc: "Noise is the standard deviation of the efficacy noise, as a multiplier\non the noise sample passed to [CaThrParams.RhoFromCa].\n2.8284 was used in the original fits."}, {Name: "CaDt", Doc: "CaDt = 1 / CaTau"}, {Name: "Dt", Doc: "Dt = 1 / Tau"}, {Name: "NoiseDt", Doc: "NoiseDt = Noise * sqrt(Dt)"}, {Name: "pad"}, {Name: "pad1"}}})
+
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/kinase.CaDtParams", IDName: "ca-dt-params", Doc: "CaDtParams has rate constants for integrating Ca calcium\nat different time scales, including final CaP = CaMKII and CaD = DAPK1\ntimescales for LTP potentiation vs. LTD depression factors.", Directives: []types.Directive{{Tool: "go", Directive: "generate", Args: []string{"core", "generate", "-add-types", "-gosl"}}, {Tool: "gosl", Directive: "start"}, {Tool: "types", Directive: "add"}}, Fields: []types.Field{{Name: "MTau", Doc: "CaM (calmodulin) time constant in cycles (msec),\nwhich is the first level integration.\nFor CaLearn, 2 is best; for CaSpk, 5 is best.\nFor synaptic-level integration this integrates on top of Ca\nsignal from send->CaSyn * recv->CaSyn, each of which are\ntypically integrated with a 30 msec Tau."}, {Name: "PTau", Doc: "LTP spike-driven potentiation Ca factor (CaP) time constant\nin cycles (msec), simulating CaMKII in the Kinase framework,\ncascading on top of MTau.\nComputationally, CaP represents the plus phase learning signal that\nreflects the most recent past information.\nValue tracks linearly with number of cycles per learning trial:\n200 = 40, 300 = 60, 400 = 80"}, {Name: "DTau", Doc: "LTD spike-driven depression Ca factor (CaD) time constant\nin cycles (msec), simulating DAPK1 in Kinase framework,\ncascading on top of PTau.\nComputationally, CaD represents the minus phase learning signal that\nreflects the expectation representation prior to experiencing the\noutcome (in addition to the outcome).\nValue tracks linearly with number of cycles per learning trial:\n200 = 40, 300 = 60, 400 = 80"}, {Name: "MDt", Doc: "rate = 1 / tau"}, {Name: "PDt", Doc: "rate = 1 / tau"}, {Name: "DDt", Doc: "rate = 1 / tau"}, {Name: "pad"}, {Name: "pad1"}}})
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/kinase.CaSpikeParams", IDName: "ca-spike-params", Doc: "CaSpikeParams parameterizes the neuron-level spike-driven calcium\nsignals, including CaM, CaP, CaD for basic activity stats and RLRate, and\nCaSyn which is integrated at the neuron level and drives synapse-level,\npre * post Ca integration, providing the Tr credit assignment trace factor\nfor kinase error-driven cortical learning.", Fields: []types.Field{{Name: "SpikeCaM", Doc: "SpikeCaM is the drive factor for updating the neuron-level CaM (calmodulin)\nbased on a spike impulse, which is then cascaded into updating the\nCaP and CaD values. These values are used for stats and RLRate computation,\nbut do not drive learning directly. Larger values (e.g., 12) may be useful\nin some models."}, {Name: "SpikeCaSyn", Doc: "SpikeCaSyn is the drive factor for updating the neuron-level CaSyn\nsynaptic calcium trace value based on a spike impulse. CaSyn is integrated\ninto CaBins which are then used to compute synapse-level pre * post\nCa values over the theta cycle, which then drive the Tr credit assignment\ntrace factor for kinase error-driven cortical learning. Changes in this\nvalue will affect the net learning rate."}, {Name: "CaSynTau", Doc: "CaSynTau is the time constant for integrating the spike-driven calcium\ntrace CaSyn at sender and recv neurons. See SpikeCaSyn for more info.\nIf this param is changed, then there will be a change in effective\nlearning rate that can be compensated for by multiplying\nCaScale by sqrt(30 / sqrt(SynTau)"}, {Name: "CaSynDt", Doc: "CaSynDt rate = 1 / tau"}, {Name: "Dt", Doc: "Dt are time constants for integrating Spike-driven Ca across CaM, CaP and CaD\ncascading levels. Typically the same as in LearnCa parameters."}}})
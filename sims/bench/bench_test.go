@@ -9,8 +9,10 @@ import (
 	"testing"
 
 	"cogentcore.org/core/core"
+	"cogentcore.org/lab/patterns"
 	"cogentcore.org/lab/table"
 	"github.com/emer/axon/v2/axon"
+	"github.com/emer/emergent/v2/etime"
 )
 
 func init() {
@@ -31,6 +33,7 @@ var numPats = flag.Int("pats", 10, "number of patterns per epoch")
 var numUnits = flag.Int("units", 100, "number of units per layer -- uses NxN where N = sqrt(units)")
 var verbose = flag.Bool("verbose", true, "if false, only report the final time")
 var writeStats = flag.Bool("writestats", false, "whether to write network stats to a CSV file")
+var spikeSend = flag.String("spikesend", "SpikeSendAuto", "CPU spike sending mode: SpikeSendAuto, SpikeSendSparse or SpikeSendDense")
 
 func BenchmarkBenchNetFull(b *testing.B) {
 	if *maxProcs > 0 {
@@ -43,6 +46,9 @@ func BenchmarkBenchNetFull(b *testing.B) {
 
 	net := axon.NewNetwork("BenchNet")
 	ConfigNet(net, *threads, *numUnits, *verbose)
+	if err := net.SpikeSend.Mode.SetString(*spikeSend); err != nil {
+		b.Fatal(err)
+	}
 	if *verbose {
 		slog.Info(net.SizeReport(false))
 	}
@@ -121,6 +127,131 @@ func benchmarkNeuronFunMultiThread(numThread, numUnits int, b *testing.B) {
 	}
 }
 
+// spikeSendLevels are the activity levels for the spike sending benchmarks,
+// set by the fraction of Input units that are on, and a multiplier on the
+// inhibition in the other layers, spanning the [axon.SpikeSend] Thr
+// where the Auto mode switches between sparse and dense sending.
+var spikeSendLevels = []struct {
+	Name   string
+	InAct  float32
+	GiMult float32
+}{
+	{"Act02", 0.02, 1},
+	{"Act12", 0.125, 1},
+	{"Act25", 0.25, 0.8},
+	{"Act50", 0.5, 0.6},
+	{"Act75", 0.75, 0.4},
+}
+
+// Run just the spike sending benchmarks with `go test -bench=".*SendSpike.*" .`
+// and use -units to set the network size. Each mode is run at each of the
+// spikeSendLevels.
+func benchmarkSendSpike(mode axon.SpikeSendModes, b *testing.B) {
+	for _, lv := range spikeSendLevels {
+		b.Run(lv.Name, func(b *testing.B) {
+			benchmarkSendSpikeLevel(mode, lv.InAct, lv.GiMult, b)
+		})
+	}
+}
+
+func benchmarkSendSpikeLevel(mode axon.SpikeSendModes, inAct, giMult float32, b *testing.B) {
+	net := axon.NewNetwork("BenchNet")
+	ConfigNet(net, *threads, *numUnits, false)
+	net.SpikeSend.Mode = mode
+	for _, ly := range net.Layers {
+		if ly.Name != "Input" {
+			ly.Params.Inhib.Layer.Gi *= giMult
+		}
+	}
+	net.UpdateParams()
+
+	pats := table.New()
+	ConfigPats(pats, 1, *numUnits)
+	nOn := max(int(inAct*float32(*numUnits)), 1)
+	patterns.PermutedBinaryMinDiff(pats.Columns.Values[1], nOn, 1, 0, nOn/2)
+	net.ThetaCycleStart(etime.Train, false)
+	net.MinusPhaseStart()
+	net.LayerByName("Input").ApplyExt(0, pats.Column("Input").SubSpace(0))
+	net.ApplyExts()
+	for range 50 { // get activity going
+		net.Cycle(false)
+	}
+	net.SpikeSend.ResetStats()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		net.Cycle(false)
+	}
+	b.StopTimer()
+	ss := &net.SpikeSend
+	if ss.NSparse > 0 {
+		b.ReportMetric(float64(ss.Frac), "spike-frac")
+	}
+	b.ReportMetric(float64(ss.NSparse)/float64(ss.NSparse+ss.NDense), "sparse-cycles")
+}
+
+func BenchmarkSendSpikeDense(b *testing.B) {
+	benchmarkSendSpike(axon.SpikeSendDense, b)
+}
+
+func BenchmarkSendSpikeSparse(b *testing.B) {
+	benchmarkSendSpike(axon.SpikeSendSparse, b)
+}
+
+func BenchmarkSendSpikeAuto(b *testing.B) {
+	benchmarkSendSpike(axon.SpikeSendAuto, b)
+}
+
+// Run just the worker pool benchmarks with `go test -bench=".*WorkPool.*" .`
+// and use -units and -threads to set the network size and number of workers.
+// The PerCall version uses new goroutines for each kernel invocation,
+// as in the gpu.VectorizeFunc scheduling used before the pool.
+func benchmarkWorkPool(static, perCall bool, b *testing.B) {
+	net := axon.NewNetwork("BenchNet")
+	ConfigNet(net, *threads, *numUnits, false)
+	axon.CPUPool.Static = static
+	axon.CPUPool.PerCall = perCall
+	defer func() {
+		axon.CPUPool.Static = false
+		axon.CPUPool.PerCall = false
+	}()
+
+	pats := table.New()
+	ConfigPats(pats, 1, *numUnits)
+	net.ThetaCycleStart(etime.Train, false)
+	net.MinusPhaseStart()
+	net.LayerByName("Input").ApplyExt(0, pats.Column("Input").SubSpace(0))
+	net.ApplyExts()
+	for range 50 { // get activity going
+		net.Cycle(false)
+	}
+	axon.CPUPool.RecStats = true
+	axon.CPUPool.ResetStats()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		net.Cycle(false)
+	}
+	b.StopTimer()
+	axon.CPUPool.RecStats = false
+	if ks, ok := axon.CPUPool.Stats["CycleNeuron"]; ok {
+		b.ReportMetric(ks.MeanImbal(), "imbalance")
+		b.ReportMetric(ks.StealPct(), "steal-pct")
+	}
+}
+
+func BenchmarkWorkPoolSteal(b *testing.B) {
+	benchmarkWorkPool(false, false, b)
+}
+
+func BenchmarkWorkPoolStatic(b *testing.B) {
+	benchmarkWorkPool(true, false, b)
+}
+
+func BenchmarkWorkPoolPerCall(b *testing.B) {
+	benchmarkWorkPool(false, true, b)
+}
+
 const (
 	smallNumUnits = 2048       // 5 * 2048 * 80 * 4B = 3MB (should fit in the cache)
 	hugeNumUnits  = 256 * 2048 // 5 * 256 * 2048 * 80 * 4B = 786MB (should not fit in the cache)
@@ -117,6 +117,7 @@ var LayerParams = axon.LayerSheets{
 				ly.Acts.Noise.On.SetBool(false)
 			}},
 	},
+	"ThreeFactor": {},
 }
 
 // PathParams sets the minimal non-default params.
@@ -290,6 +291,16 @@ var PathParams = axon.PathSheets{
 			// 	}},
 		*/
 	},
+	"ThreeFactor": {
+		{Sel: ".ToM1", Doc: "learn cortical paths to M1 purely from the sequence reward, instead of error-driven",
+			Set: func(pt *axon.PathParams) {
+				pt.Learn.ThreeFactor.Rule = axon.PerturbThreeFactor
+				pt.Learn.ThreeFactor.Mod = axon.ThreeFactorGlobal
+				pt.Learn.ThreeFactor.Global = axon.GvDA
+				pt.Learn.ThreeFactor.TraceDecay = 0.5
+				pt.Learn.ThreeFactor.Delayed.SetBool(true) // reward only at end of sequence
+			}},
+	},
 }
 
 /////////
@@ -358,7 +358,7 @@ func (ss *Sim) NewRun() {
 	ctx.Reset()
 	ss.Net.InitWeights()
 	if ss.Config.Run.StartWeights != "" {
-		ss.Net.OpenWeightsJSON(core.Filename(ss.Config.Run.StartWeights))
+		ss.Net.OpenWeights(core.Filename(ss.Config.Run.StartWeights))
 		mpi.Printf("Starting with initial weights from: %s\n", ss.Config.Run.StartWeights)
 	}
 }
@@ -633,7 +633,7 @@ func (ss *Sim) NewRun() {
 	ctx.Reset()
 	ss.Net.InitWeights()
 	if ss.Config.Run.StartWeights != "" { // this is just for testing -- not usually needed
-		ss.Net.OpenWeightsJSON(core.Filename(ss.Config.Run.StartWeights))
+		ss.Net.OpenWeights(core.Filename(ss.Config.Run.StartWeights))
 		mpi.Printf("Starting with initial weights from: %s\n", ss.Config.Run.StartWeights)
 	}
 }
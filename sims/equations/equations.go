@@ -39,6 +39,12 @@ type Plots struct {
 	// not simulated, as in our standard axon models.
 	AK chanplots.AKPlot `new-window:"+" display:"no-inline"`
 
+	// CaT is the low-threshold T-type Ca channel, which is de-inactivated
+	// by hyperpolarization and then drives a transient low-threshold calcium spike
+	// on subsequent depolarization, producing burst firing in thalamic and STN neurons.
+	// Based on Huguenard & McCormick (1992) and Destexhe et al (1996).
+	CaT chanplots.CaTPlot `new-window:"+" display:"no-inline"`
+
 	// GABA-B is an inhibitory channel activated by the usual GABA inhibitory neurotransmitter,
 	// which is coupled to the GIRK G-protein coupled inwardly rectifying potassium (K) channel.
 	// It is ubiquitous in the brain, and critical for stability of spiking patterns over time in axon.
@@ -47,6 +53,12 @@ type Plots struct {
 	// and thus it serves to keep inactive neurons inactive. Based on Thomson & Destexhe (1999).
 	GABAB chanplots.GABABPlot `new-window:"+" display:"no-inline"`
 
+	// HCN is the hyperpolarization-activated cation channel that produces
+	// the Ih current, based on Huguenard & McCormick (1992).
+	// It slowly opens with hyperpolarization, driving a depolarizing
+	// rebound current with a reversal potential around -30 mV.
+	HCN chanplots.HCNPlot `new-window:"+" display:"no-inline"`
+
 	// Kir is the kIR potassium inwardly rectifying current,
 	// based on the equations from Lindroos et al (2018).
 	// The conductance is highest at low membrane potentials.
@@ -61,6 +73,11 @@ type Plots struct {
 	// of the membrane potential, centered at Voff with slope Vslope.
 	Mahp chanplots.MahpPlot `new-window:"+" display:"no-inline"`
 
+	// NaP is the persistent sodium channel, which activates rapidly at
+	// subthreshold potentials and inactivates very slowly, supporting
+	// burst firing and plateau potentials. Based on Butera et al (1999).
+	NaP chanplots.NaPPlot `new-window:"+" display:"no-inline"`
+
 	// NMDA implements NMDA dynamics, based on Jahr & Stevens (1990) equations
 	// which are widely used in models, from Brunel & Wang (2001) to Sanders et al. (2013).
 	// The overall conductance is a function of a voltage-dependent postsynaptic factor based
@@ -101,9 +118,12 @@ type Plots struct {
 
 func (pl *Plots) Config(root *tensorfs.Node) {
 	pl.AK.Config(root, pl.GUI.Tabs)
+	pl.CaT.Config(root, pl.GUI.Tabs)
 	pl.GABAB.Config(root, pl.GUI.Tabs)
+	pl.HCN.Config(root, pl.GUI.Tabs)
 	pl.Kir.Config(root, pl.GUI.Tabs)
 	pl.Mahp.Config(root, pl.GUI.Tabs)
+	pl.NaP.Config(root, pl.GUI.Tabs)
 	pl.NMDA.Config(root, pl.GUI.Tabs)
 	pl.Sahp.Config(root, pl.GUI.Tabs)
 	pl.SKCa.Config(root, pl.GUI.Tabs)
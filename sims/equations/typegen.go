@@ -6,4 +6,4 @@ import (
 	"cogentcore.org/core/types"
 )
 
-var _ = types.AddType(&types.Type{Name: "main.Plots", IDName: "plots", Fields: []types.Field{{Name: "GUI"}, {Name: "AK", Doc: "AK is an A-type K channel, which is voltage gated with maximal\nactivation around -37 mV.  It has two state variables, M (v-gated opening)\nand H (v-gated closing), which integrate with fast and slow time constants,\nrespectively.  H relatively quickly hits an asymptotic level of inactivation\nfor sustained activity patterns.\nIt is particularly important for counteracting the excitatory effects of\nvoltage gated calcium channels which can otherwise drive runaway excitatory currents.\nSee AKsParams for a much simpler version that works fine when full AP-like spikes are\nnot simulated, as in our standard axon models."}, {Name: "GABAB", Doc: "GABA-B is an inhibitory channel activated by the usual GABA inhibitory neurotransmitter,\nwhich is coupled to the GIRK G-protein coupled inwardly rectifying potassium (K) channel.\nIt is ubiquitous in the brain, and critical for stability of spiking patterns over time in axon.\nThe inward rectification is caused by a Mg+ ion block *from the inside* of the neuron,\nwhich means that these channels are most open when the neuron is hyperpolarized (inactive),\nand thus it serves to keep inactive neurons inactive. Based on Thomson & Destexhe (1999)."}, {Name: "Kir", Doc: "Kir is the kIR potassium inwardly rectifying current,\nbased on the equations from Lindroos et al (2018).\nThe conductance is highest at low membrane potentials."}, {Name: "Mahp", Doc: "Mahp implements an M-type medium afterhyperpolarizing (mAHP) channel,\nwhere m also stands for muscarinic due to the ACh inactivation of this channel.\nIt has a slow activation and deactivation time constant, and opens at a lowish\nmembrane potential.\nThere is one gating variable n updated over time with a tau that is also voltage dependent.\nThe infinite-time value of n is voltage dependent according to a logistic function\nof the membrane potential, centered at Voff with slope Vslope."}, {Name: "NMDA", Doc: "NMDA implements NMDA dynamics, based on Jahr & Stevens (1990) equations\nwhich are widely used in models, from Brunel & Wang (2001) to Sanders et al. (2013).\nThe overall conductance is a function of a voltage-dependent postsynaptic factor based\non Mg ion blockage, and presynaptic Glu-based opening, which in a simple model just\nincrements"}, {Name: "Sahp", Doc: "Sahp implements a slow afterhyperpolarizing (sAHP) channel,\nIt has a slowly accumulating calcium value, aggregated at the\ntheta cycle level, that then drives the logistic gating function,\nso that it only activates after a significant accumulation.\nAfter which point it decays.\nFor the theta-cycle updating, the normal m-type tau is all within\nthe scope of a single theta cycle, so we just omit the time integration\nof the n gating value, but tau is computed in any case."}, {Name: "SKCa", Doc: "SKCa describes the small-conductance calcium-activated potassium channel,\nactivated by intracellular stores in a way that drives pauses in firing,\nand can require inactivity to recharge the Ca available for release.\nThese intracellular stores can release quickly, have a slow decay once released,\nand the stores can take a while to rebuild, leading to rapidly triggered,\nlong-lasting pauses that don't recur until stores have rebuilt, which is the\nobserved pattern of firing of STNp pausing neurons.\nCaIn = intracellular stores available for release; CaR = released amount from stores\nCaM = K channel conductance gating factor driven by CaR binding,\ncomputed using the Hill equations described in Fujita et al (2012), Gunay et al (2008)\n(also Muddapu & Chakravarthy, 2021): X^h / (X^h + C50^h) where h ~= 4 (hard coded)"}, {Name: "VGCC", Doc: "VGCC plots the standard L-type voltage gated Ca channel.\nAll functions based on Urakubo et al (2008)."}, {Name: "SynCa", Doc: "SynCa plots synaptic calcium according to the kinase calcium dynamics."}}})
+var _ = types.AddType(&types.Type{Name: "main.Plots", IDName: "plots", Fields: []types.Field{{Name: "GUI"}, {Name: "AK", Doc: "AK is an A-type K channel, which is voltage gated with maximal\nactivation around -37 mV.  It has two state variables, M (v-gated opening)\nand H (v-gated closing), which integrate with fast and slow time constants,\nrespectively.  H relatively quickly hits an asymptotic level of inactivation\nfor sustained activity patterns.\nIt is particularly important for counteracting the excitatory effects of\nvoltage gated calcium channels which can otherwise drive runaway excitatory currents.\nSee AKsParams for a much simpler version that works fine when full AP-like spikes are\nnot simulated, as in our standard axon models."}, {Name: "CaT", Doc: "CaT is the low-threshold T-type Ca channel, which is de-inactivated\nby hyperpolarization and then drives a transient low-threshold calcium spike\non subsequent depolarization, producing burst firing in thalamic and STN neurons.\nBased on Huguenard & McCormick (1992) and Destexhe et al (1996)."}, {Name: "GABAB", Doc: "GABA-B is an inhibitory channel activated by the usual GABA inhibitory neurotransmitter,\nwhich is coupled to the GIRK G-protein coupled inwardly rectifying potassium (K) channel.\nIt is ubiquitous in the brain, and critical for stability of spiking patterns over time in axon.\nThe inward rectification is caused by a Mg+ ion block *from the inside* of the neuron,\nwhich means that these channels are most open when the neuron is hyperpolarized (inactive),\nand thus it serves to keep inactive neurons inactive. Based on Thomson & Destexhe (1999)."}, {Name: "HCN", Doc: "HCN is the hyperpolarization-activated cation channel that produces\nthe Ih current, based on Huguenard & McCormick (1992).\nIt slowly opens with hyperpolarization, driving a depolarizing\nrebound current with a reversal potential around -30 mV."}, {Name: "Kir", Doc: "Kir is the kIR potassium inwardly rectifying current,\nbased on the equations from Lindroos et al (2018).\nThe conductance is highest at low membrane potentials."}, {Name: "Mahp", Doc: "Mahp implements an M-type medium afterhyperpolarizing (mAHP) channel,\nwhere m also stands for muscarinic due to the ACh inactivation of this channel.\nIt has a slow activation and deactivation time constant, and opens at a lowish\nmembrane potential.\nThere is one gating variable n updated over time with a tau that is also voltage dependent.\nThe infinite-time value of n is voltage dependent according to a logistic function\nof the membrane potential, centered at Voff with slope Vslope."}, {Name: "NaP", Doc: "NaP is the persistent sodium channel, which activates rapidly at\nsubthreshold potentials and inactivates very slowly, supporting\nburst firing and plateau potentials. Based on Butera et al (1999)."}, {Name: "NMDA", Doc: "NMDA implements NMDA dynamics, based on Jahr & Stevens (1990) equations\nwhich are widely used in models, from Brunel & Wang (2001) to Sanders et al. (2013).\nThe overall conductance is a function of a voltage-dependent postsynaptic factor based\non Mg ion blockage, and presynaptic Glu-based opening, which in a simple model just\nincrements"}, {Name: "Sahp", Doc: "Sahp implements a slow afterhyperpolarizing (sAHP) channel,\nIt has a slowly accumulating calcium value, aggregated at the\ntheta cycle level, that then drives the logistic gating function,\nso that it only activates after a significant accumulation.\nAfter which point it decays.\nFor the theta-cycle updating, the normal m-type tau is all within\nthe scope of a single theta cycle, so we just omit the time integration\nof the n gating value, but tau is computed in any case."}, {Name: "SKCa", Doc: "SKCa describes the small-conductance calcium-activated potassium channel,\nactivated by intracellular stores in a way that drives pauses in firing,\nand can require inactivity to recharge the Ca available for release.\nThese intracellular stores can release quickly, have a slow decay once released,\nand the stores can take a while to rebuild, leading to rapidly triggered,\nlong-lasting pauses that don't recur until stores have rebuilt, which is the\nobserved pattern of firing of STNp pausing neurons.\nCaIn = intracellular stores available for release; CaR = released amount from stores\nCaM = K channel conductance gating factor driven by CaR binding,\ncomputed using the Hill equations described in Fujita et al (2012), Gunay et al (2008)\n(also Muddapu & Chakravarthy, 2021): X^h / (X^h + C50^h) where h ~= 4 (hard coded)"}, {Name: "VGCC", Doc: "VGCC plots the standard L-type voltage gated Ca channel.\nAll functions based on Urakubo et al (2008)."}, {Name: "SynCa", Doc: "SynCa plots synaptic calcium according to the kinase calcium dynamics."}}})
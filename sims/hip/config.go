@@ -94,6 +94,10 @@ type RunConfig struct {
 
 	// StopMem is memory pct correct level (proportion) above which training
 	// on current list stops (switch from AB to AC or stop on AC).
+	// Memory is 1 - Err over the test items for the current list,
+	// and is checked after each test. The AB weights are snapshot
+	// for weight consolidation (e.g., EWC or SI params sheets)
+	// when switching to AC.
 	StopMem float32 `default:"0.9"`
 
 	// Run is the _starting_ run number, which determines the random seed.
@@ -122,6 +126,30 @@ type RunConfig struct {
 	TestInterval int `default:"5"`
 }
 
+// SleepConfig has config parameters related to the offline sleep phase,
+// where the network runs without external inputs to consolidate learning.
+type SleepConfig struct {
+
+	// Interval is how often (in epochs) to run an offline sleep phase
+	// at the end of a training epoch, with testing just before and after
+	// to measure the effects of sleep. Can use 0 or -1 for no sleep.
+	Interval int `default:"0"`
+
+	// Trials is the number of trials in each sleep phase.
+	// Should be an even multiple of NData.
+	Trials int `default:"20"`
+
+	// Replay replays recorded training patterns as partial cues to the
+	// Input layer during sleep, which the hippocampus completes and
+	// reinstates in EC, instead of running spontaneous activity driven
+	// only by noise.
+	Replay bool `default:"true"`
+
+	// ReplayN is the maximum number of training trial patterns to record
+	// for replay, with the most recent ones replacing older ones.
+	ReplayN int `default:"100"`
+}
+
 // LogConfig has config parameters related to logging data.
 type LogConfig struct {
 
@@ -133,6 +161,9 @@ type LogConfig struct {
 
 	// Test has the list of Test mode levels to save log files for.
 	Test []string `nest:"+"`
+
+	// Sleep has the list of Sleep mode levels to save log files for.
+	Sleep []string `default:"['Epoch']" nest:"+"`
 }
 
 // Config has the overall Sim configuration options.
@@ -151,6 +182,9 @@ type Config struct {
 	// Run has sim running related configuration options.
 	Run RunConfig `display:"add-fields"`
 
+	// Sleep has offline sleep phase configuration options.
+	Sleep SleepConfig `display:"add-fields"`
+
 	// Log has data logging related configuration options.
 	Log LogConfig `display:"add-fields"`
 }
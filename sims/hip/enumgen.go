@@ -6,20 +6,20 @@ import (
 	"cogentcore.org/core/enums"
 )
 
-var _ModesValues = []Modes{0, 1}
+var _ModesValues = []Modes{0, 1, 2}
 
 // ModesN is the highest valid value for type Modes, plus one.
 //
 //gosl:start
-const ModesN Modes = 2
+const ModesN Modes = 3
 
 //gosl:end
 
-var _ModesValueMap = map[string]Modes{`Train`: 0, `Test`: 1}
+var _ModesValueMap = map[string]Modes{`Train`: 0, `Test`: 1, `Sleep`: 2}
 
-var _ModesDescMap = map[Modes]string{0: ``, 1: ``}
+var _ModesDescMap = map[Modes]string{0: ``, 1: ``, 2: ``}
 
-var _ModesMap = map[Modes]string{0: `Train`, 1: `Test`}
+var _ModesMap = map[Modes]string{0: `Train`, 1: `Test`, 2: `Sleep`}
 
 // String returns the string representation of this Modes value.
 func (i Modes) String() string { return enums.String(i, _ModesMap) }
@@ -38,6 +38,7 @@ type Modes int32 //enums:enum
 const (
 	Train Modes = iota
 	Test
+	Sleep
 )
 
 // Levels are the looping levels for running and statistics.
@@ -71,6 +72,9 @@ type Sim struct {
 	// across stacks of Levels.
 	Loops *looper.Stacks `new-window:"+" display:"no-inline"`
 
+	// Sleep has the parameters and state for the offline sleep phase.
+	Sleep axon.Sleep `display:"inline"`
+
 	// Envs provides mode-string based storage of environments.
 	Envs env.Envs `new-window:"+" display:"no-inline"`
 
@@ -111,6 +115,7 @@ func (ss *Sim) ConfigSim() {
 	ss.Params.Config(LayerParams, PathParams, ss.Config.Params.Sheet, ss.Config.Params.Tag, reflect.ValueOf(ss))
 	ss.RandSeeds.Init(100) // max 100 runs
 	ss.InitRandSeed(0)
+	ss.Sleep.Defaults()
 	if ss.Config.GPU {
 		gpu.SelectAdapter = ss.Config.Run.GPUDevice
 		axon.GPUInit()
@@ -184,6 +189,11 @@ func (ss *Sim) ConfigNet(net *axon.Network) {
 	net.SetNThreads(ss.Config.Run.NThreads)
 	ss.ApplyParams()
 	// net.InitWeights()
+
+	if ss.Config.Sleep.Replay {
+		ss.Sleep.Mode = axon.SleepReplay
+		ss.Sleep.Replay.Config(ss.Config.Sleep.ReplayN, "Input")
+	}
 }
 
 func (ss *Sim) ApplyParams() {
@@ -221,11 +231,13 @@ func (ss *Sim) NetViewUpdater(mode enums.Enum) *axon.NetViewUpdate {
 	return &ss.TestUpdate
 }
 
-// ConfigLoops configures the control loops: Training, Testing
+// ConfigLoops configures the control loops: Training, Testing, Sleep
 func (ss *Sim) ConfigLoops() {
 	ls := looper.NewStacks()
 
 	trials := int(math32.IntMultipleGE(float32(ss.Config.Run.Trials), float32(ss.Config.Run.NData)))
+	testTrials := int(math32.IntMultipleGE(float32(ss.Envs.ByMode(Test).(*env.FixedTable).Table.NumRows()), float32(ss.Config.Run.NData)))
+	sleepTrials := int(math32.IntMultipleGE(float32(ss.Config.Sleep.Trials), float32(ss.Config.Run.NData)))
 	cycles := ss.Config.Run.Cycles
 
 	ls.AddStack(Train, Trial).
@@ -236,13 +248,19 @@ func (ss *Sim) ConfigLoops() {
 
 	ls.AddStack(Test, Trial).
 		AddLevel(Epoch, 1).
-		AddLevelIncr(Trial, trials, ss.Config.Run.NData).
+		AddLevelIncr(Trial, testTrials, ss.Config.Run.NData).
+		AddLevel(Cycle, cycles)
+
+	ls.AddStack(Sleep, Trial).
+		AddLevel(Epoch, 1).
+		AddLevelIncr(Trial, sleepTrials, ss.Config.Run.NData).
 		AddLevel(Cycle, cycles)
 
 	axon.LooperStandard(ls, ss.Net, ss.NetViewUpdater, Cycle, Trial, Train,
 		func(mode enums.Enum) { ss.Net.ClearInputs() },
 		func(mode enums.Enum) { ss.ApplyInputs(mode.(Modes)) },
 	)
+	axon.LooperSleep(ls, ss.Net, &ss.Sleep, ss.NetViewUpdater, Sleep, Epoch, Trial, Cycle, ss.TestAll)
 	ls.Stacks[Train].OnInit.Add("Init", ss.Init)
 	ls.Loop(Train, Run).OnStart.Add("NewRun", ss.NewRun)
 
@@ -250,12 +268,30 @@ func (ss *Sim) ConfigLoops() {
 	trainEpoch.OnStart.Add("TestAtInterval", func() {
 		if (ss.Config.Run.TestInterval > 0) && ((trainEpoch.Counter.Cur+1)%ss.Config.Run.TestInterval == 0) {
 			ss.TestAll()
+			ss.StopMemCheck()
 		}
 	})
+	trainEpoch.IsDone.AddBool("StopMem", func() bool {
+		return ss.TrainList() == "AC" && ss.ListMem("AC") >= float64(ss.Config.Run.StopMem)
+	})
 
 	ls.AddOnStartToAll("StatsStart", ss.StatsStart)
 	ls.AddOnEndToAll("StatsStep", ss.StatsStep)
 
+	ls.Loop(Train, Trial).OnEnd.Add("Sleep:Record", func() {
+		if ss.Sleep.Mode != axon.SleepReplay {
+			return
+		}
+		for di := range ss.Net.Context().NData {
+			ss.Sleep.Replay.Record(ss.Net, di)
+		}
+	})
+	trainEpoch.OnEnd.Add("SleepAtInterval", func() {
+		if (ss.Config.Sleep.Interval > 0) && ((trainEpoch.Counter.Cur+1)%ss.Config.Sleep.Interval == 0) {
+			ss.SleepAll()
+		}
+	})
+
 	ls.Loop(Train, Run).OnEnd.Add("SaveWeights", func() {
 		ctrString := fmt.Sprintf("%03d_%05d", ls.Loop(Train, Run).Counter.Cur, ls.Loop(Train, Epoch).Counter.Cur)
 		axon.SaveWeightsIfConfigSet(ss.Net, ss.Config.Log.SaveWeights, ctrString, ss.RunName())
@@ -266,6 +302,7 @@ func (ss *Sim) ConfigLoops() {
 
 		ls.Stacks[Train].OnInit.Add("GUI-Init", ss.GUI.UpdateWindow)
 		ls.Stacks[Test].OnInit.Add("GUI-Init", ss.GUI.UpdateWindow)
+		ls.Stacks[Sleep].OnInit.Add("GUI-Init", ss.GUI.UpdateWindow)
 	}
 
 	if ss.Config.Debug {
@@ -280,9 +317,16 @@ func (ss *Sim) ApplyInputs(mode Modes) {
 	net := ss.Net
 	ndata := int(net.Context().NData)
 	curModeDir := ss.Current.Dir(mode.String())
+	net.InitExt()
+	if mode == Sleep {
+		for di := range ndata {
+			curModeDir.StringValue("TrialName", ndata).SetString1D(ss.Sleep.ApplyInputs(net, uint32(di)), di)
+		}
+		net.ApplyExts()
+		return
+	}
 	ev := ss.Envs.ByMode(mode)
 	lays := net.LayersByType(axon.InputLayer, axon.TargetLayer)
-	net.InitExt()
 	for di := range ndata {
 		ev.Step()
 		curModeDir.StringValue("TrialName", ndata).SetString1D(ev.String(), di)
@@ -304,14 +348,53 @@ func (ss *Sim) NewRun() {
 	ss.InitRandSeed(run)
 	ss.Envs.ByMode(Train).Init(run)
 	ss.Envs.ByMode(Test).Init(run)
+	ss.SetTrainList("AB")
 	ctx.Reset()
 	ss.Net.InitWeights()
+	ss.Sleep.Replay.Reset()
+}
+
+// TrainList returns the name of the list currently being trained: AB or AC.
+func (ss *Sim) TrainList() string {
+	return ss.Current.StringValue("List", 1).String1D(0)
+}
+
+// SetTrainList sets the training environment to use given list: AB or AC.
+func (ss *Sim) SetTrainList(list string) {
+	ss.Current.StringValue("List", 1).SetString1D(list, 0)
+	trn := ss.Envs.ByMode(Train).(*env.FixedTable)
+	trn.Config(table.NewView(tensorfs.DirTable(ss.Root.Dir("ABAC/Inputs/Train"+list), nil)))
+	trn.Init(ss.Loops.Loop(Train, Run).Counter.Cur)
+}
+
+// ListMem returns the memory for given list on the most recent test,
+// as the proportion of test items without any errors.
+func (ss *Sim) ListMem(list string) float64 {
+	return 1 - ss.Current.Dir(Test.String()).Float64("Err_"+list, 1).Float1D(0)
+}
+
+// StopMemCheck is called after testing, and switches training from the
+// AB to the AC list once the AB memory reaches the StopMem level,
+// marking the end of the AB task for weight consolidation.
+func (ss *Sim) StopMemCheck() {
+	if ss.TrainList() != "AB" || ss.ListMem("AB") < float64(ss.Config.Run.StopMem) {
+		return
+	}
+	ss.Net.ConsolSnapshot()
+	ss.SetTrainList("AC")
 }
 
 // TestAll runs through the full set of testing items
 func (ss *Sim) TestAll() {
+	prev := ss.Loops.Mode
 	ss.Envs.ByMode(Test).Init(0)
 	ss.Loops.ResetAndRun(Test)
+	ss.Loops.Mode = prev // important because this is called from Train or Sleep Run: go back.
+}
+
+// SleepAll runs an offline sleep phase, with testing before and after.
+func (ss *Sim) SleepAll() {
+	ss.Loops.ResetAndRun(Sleep)
 	ss.Loops.Mode = Train // important because this is called from Train Run: go back.
 }
 
@@ -399,6 +482,7 @@ func (ss *Sim) StatsInit() {
 		tbs.PlotTensorFS(axon.StatsNode(ss.Stats, Train, Epoch))
 		tbs.PlotTensorFS(axon.StatsNode(ss.Stats, Train, Run))
 		tbs.PlotTensorFS(axon.StatsNode(ss.Stats, Test, Trial))
+		tbs.PlotTensorFS(axon.StatsNode(ss.Stats, Sleep, Epoch))
 		tbs.PlotTensorFS(ss.Stats.Dir("Train/RunAll"))
 		tbs.SelectTabIndex(idx)
 	}
@@ -422,6 +506,9 @@ func (ss *Sim) ConfigStats() {
 	// todo: update stats
 	statNames := []string{"CorSim", "UnitErr", "Err", "NZero", "FirstZero", "LastZero"}
 	ss.AddStat(func(mode Modes, level Levels, start bool) {
+		if mode == Sleep {
+			return
+		}
 		for _, name := range statNames {
 			if name == "NZero" && (mode != Train || level == Trial) {
 				return
@@ -519,6 +606,9 @@ func (ss *Sim) ConfigStats() {
 		}
 	})
 
+	ss.AddStatStd(axon.StatSleep(ss.Stats, Test, Epoch, Sleep, Epoch, "CorSim", "UnitErr", "Err"))
+	ss.AddStatStd(axon.StatForgetting(ss.Stats, ss.Current, net, Test, Trial, Epoch, "Err", "AB", "AC", "Lure"))
+
 	lays := net.LayersByType(axon.SuperLayer, axon.CTLayer, axon.TargetLayer)
 	ss.AddStatStd(axon.StatLayerActGe(ss.Stats, net, Train, Trial, Run, lays...))
 
@@ -618,7 +708,7 @@ func (ss *Sim) RunNoGUI() {
 	runName := ss.SetRunName()
 	netName := ss.Net.Name
 	cfg := &ss.Config.Log
-	axon.OpenLogFiles(ss.Loops, ss.Stats, netName, runName, [][]string{cfg.Train, cfg.Test})
+	axon.OpenLogFiles(ss.Loops, ss.Stats, netName, runName, [][]string{cfg.Train, cfg.Test, cfg.Sleep})
 
 	mpi.Printf("Running %d Runs starting at %d\n", ss.Config.Run.Runs, ss.Config.Run.Run)
 	ss.Loops.Loop(Train, Run).Counter.SetCurMaxPlusN(ss.Config.Run.Run, ss.Config.Run.Runs)
@@ -66,6 +66,8 @@ var LayerParams = axon.LayerSheets{
 				// ly.Learn.RLRate.SigmoidMin =       0.01
 			}},
 	},
+	"EWC": {},
+	"SI":  {},
 }
 
 // PathParams sets the minimal non-default params.
@@ -190,4 +192,18 @@ var PathParams = axon.PathSheets{
 				pt.PathScale.Rel = 0.3 // Back proj should generally be very weak but we're specifically setting this here bc others are set already
 			}},
 	},
+	"EWC": {
+		{Sel: "Path", Doc: "elastic weight consolidation protects AB weights while learning AC",
+			Set: func(pt *axon.PathParams) {
+				pt.Learn.Consol.Rule = axon.ConsolEWC
+				pt.Learn.Consol.Strength = 1
+			}},
+	},
+	"SI": {
+		{Sel: "Path", Doc: "synaptic intelligence protects AB weights while learning AC",
+			Set: func(pt *axon.PathParams) {
+				pt.Learn.Consol.Rule = axon.ConsolSI
+				pt.Learn.Consol.Strength = 1
+			}},
+	},
 }
@@ -10,14 +10,16 @@ var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/sims/hip.EnvCon
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/sims/hip.ParamConfig", IDName: "param-config", Doc: "ParamConfig has config parameters related to sim params.", Fields: []types.Field{{Name: "InToEc2PCon", Doc: "InToEc2PCon is percent connectivity from Input to EC2."}, {Name: "Script", Doc: "Script is an interpreted script that is run to set parameters in Layer and Path\nsheets, by default using the \"Script\" set name."}, {Name: "Sheet", Doc: "Sheet is the extra params sheet name(s) to use (space separated\nif multiple). Must be valid name as listed in compiled-in params\nor loaded params."}, {Name: "Tag", Doc: "Tag is an extra tag to add to file names and logs saved from this run."}, {Name: "Note", Doc: "Note is additional info to describe the run params etc,\nlike a git commit message for the run."}, {Name: "SaveAll", Doc: "SaveAll will save a snapshot of all current param and config settings\nin a directory named params_<datestamp> (or _good if Good is true),\nthen quit. Useful for comparing to later changes and seeing multiple\nviews of current params."}, {Name: "Good", Doc: "Good is for SaveAll, save to params_good for a known good params state.\nThis can be done prior to making a new release after all tests are passing.\nAdd results to git to provide a full diff record of all params over level."}}})
 
-var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/sims/hip.RunConfig", IDName: "run-config", Doc: "RunConfig has config parameters related to running the sim.", Fields: []types.Field{{Name: "GPUDevice", Doc: "GPUDevice selects the gpu device to use."}, {Name: "NData", Doc: "NData is the number of data-parallel items to process in parallel per trial.\nIs significantly faster for both CPU and GPU.  Results in an effective\nmini-batch of learning."}, {Name: "NThreads", Doc: "NThreads is the number of parallel threads for CPU computation;\n0 = use default."}, {Name: "MemThr", Doc: "MemThr is the threshold on proportion on / off error to count item as remembered"}, {Name: "StopMem", Doc: "StopMem is memory pct correct level (proportion) above which training\non current list stops (switch from AB to AC or stop on AC)."}, {Name: "Run", Doc: "Run is the _starting_ run number, which determines the random seed.\nRuns counts up from there. Can do all runs in parallel by launching\nseparate jobs with each starting Run, Runs = 1."}, {Name: "Runs", Doc: "Runs is the total number of runs to do when running Train, starting from Run."}, {Name: "Epochs", Doc: "Epochs is the total number of epochs per run."}, {Name: "Trials", Doc: "Trials is the total number of trials per epoch.\nShould be an even multiple of NData."}, {Name: "Cycles", Doc: "Cycles is the total number of cycles per trial: at least 200."}, {Name: "PlusCycles", Doc: "PlusCycles is the total number of plus-phase cycles per trial. For Cycles=300, use 100."}, {Name: "TestInterval", Doc: "TestInterval is how often (in epochs) to run through all the test patterns,\nin terms of training epochs. Can use 0 or -1 for no testing."}}})
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/sims/hip.RunConfig", IDName: "run-config", Doc: "RunConfig has config parameters related to running the sim.", Fields: []types.Field{{Name: "GPUDevice", Doc: "GPUDevice selects the gpu device to use."}, {Name: "NData", Doc: "NData is the number of data-parallel items to process in parallel per trial.\nIs significantly faster for both CPU and GPU.  Results in an effective\nmini-batch of learning."}, {Name: "NThreads", Doc: "NThreads is the number of parallel threads for CPU computation;\n0 = use default."}, {Name: "MemThr", Doc: "MemThr is the threshold on proportion on / off error to count item as remembered"}, {Name: "StopMem", Doc: "StopMem is memory pct correct level (proportion) above which training\non current list stops (switch from AB to AC or stop on AC).\nMemory is 1 - Err over the test items for the current list,\nand is checked after each test. The AB weights are snapshot\nfor weight consolidation (e.g., EWC or SI params sheets)\nwhen switching to AC."}, {Name: "Run", Doc: "Run is the _starting_ run number, which determines the random seed.\nRuns counts up from there. Can do all runs in parallel by launching\nseparate jobs with each starting Run, Runs = 1."}, {Name: "Runs", Doc: "Runs is the total number of runs to do when running Train, starting from Run."}, {Name: "Epochs", Doc: "Epochs is the total number of epochs per run."}, {Name: "Trials", Doc: "Trials is the total number of trials per epoch.\nShould be an even multiple of NData."}, {Name: "Cycles", Doc: "Cycles is the total number of cycles per trial: at least 200."}, {Name: "PlusCycles", Doc: "PlusCycles is the total number of plus-phase cycles per trial. For Cycles=300, use 100."}, {Name: "TestInterval", Doc: "TestInterval is how often (in epochs) to run through all the test patterns,\nin terms of training epochs. Can use 0 or -1 for no testing."}}})
 
-var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/sims/hip.LogConfig", IDName: "log-config", Doc: "LogConfig has config parameters related to logging data.", Fields: []types.Field{{Name: "SaveWeights", Doc: "SaveWeights will save final weights after each run."}, {Name: "Train", Doc: "Train has the list of Train mode levels to save log files for."}, {Name: "Test", Doc: "Test has the list of Test mode levels to save log files for."}}})
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/sims/hip.SleepConfig", IDName: "sleep-config", Doc: "SleepConfig has config parameters related to the offline sleep phase,\nwhere the network runs without external inputs to consolidate learning.", Fields: []types.Field{{Name: "Interval", Doc: "Interval is how often (in epochs) to run an offline sleep phase\nat the end of a training epoch, with testing just before and after\nto measure the effects of sleep. Can use 0 or -1 for no sleep."}, {Name: "Trials", Doc: "Trials is the number of trials in each sleep phase.\nShould be an even multiple of NData."}, {Name: "Replay", Doc: "Replay replays recorded training patterns as partial cues to the\nInput layer during sleep, which the hippocampus completes and\nreinstates in EC, instead of running spontaneous activity driven\nonly by noise."}, {Name: "ReplayN", Doc: "ReplayN is the maximum number of training trial patterns to record\nfor replay, with the most recent ones replacing older ones."}}})
 
-var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/sims/hip.Config", IDName: "config", Doc: "Config has the overall Sim configuration options.", Embeds: []types.Field{{Name: "BaseConfig"}}, Fields: []types.Field{{Name: "Hip", Doc: "Hip has hippocampus sizing parameters."}, {Name: "Env", Doc: "Env has environment configuration options."}, {Name: "Params", Doc: "Params has parameter related configuration options."}, {Name: "Run", Doc: "Run has sim running related configuration options."}, {Name: "Log", Doc: "Log has data logging related configuration options."}}})
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/sims/hip.LogConfig", IDName: "log-config", Doc: "LogConfig has config parameters related to logging data.", Fields: []types.Field{{Name: "SaveWeights", Doc: "SaveWeights will save final weights after each run."}, {Name: "Train", Doc: "Train has the list of Train mode levels to save log files for."}, {Name: "Test", Doc: "Test has the list of Test mode levels to save log files for."}, {Name: "Sleep", Doc: "Sleep has the list of Sleep mode levels to save log files for."}}})
+
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/sims/hip.Config", IDName: "config", Doc: "Config has the overall Sim configuration options.", Embeds: []types.Field{{Name: "BaseConfig"}}, Fields: []types.Field{{Name: "Hip", Doc: "Hip has hippocampus sizing parameters."}, {Name: "Env", Doc: "Env has environment configuration options."}, {Name: "Params", Doc: "Params has parameter related configuration options."}, {Name: "Run", Doc: "Run has sim running related configuration options."}, {Name: "Sleep", Doc: "Sleep has offline sleep phase configuration options."}, {Name: "Log", Doc: "Log has data logging related configuration options."}}})
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/sims/hip.Modes", IDName: "modes", Doc: "Modes are the looping modes (Stacks) for running and statistics."})
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/sims/hip.Levels", IDName: "levels", Doc: "Levels are the looping levels for running and statistics."})
 
-var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/sims/hip.Sim", IDName: "sim", Doc: "Sim encapsulates the entire simulation model, and we define all the\nfunctionality as methods on this struct.  This structure keeps all relevant\nstate information organized and available without having to pass everything around\nas arguments to methods, and provides the core GUI interface (note the view tags\nfor the fields which provide hints to how things should be displayed).", Fields: []types.Field{{Name: "Config", Doc: "simulation configuration parameters -- set by .toml config file and / or args"}, {Name: "Net", Doc: "Net is the network: click to view / edit parameters for layers, paths, etc."}, {Name: "Params", Doc: "Params manages network parameter setting."}, {Name: "Loops", Doc: "Loops are the control loops for running the sim, in different Modes\nacross stacks of Levels."}, {Name: "Envs", Doc: "Envs provides mode-string based storage of environments."}, {Name: "TrainUpdate", Doc: "TrainUpdate has Train mode netview update parameters."}, {Name: "TestUpdate", Doc: "TestUpdate has Test mode netview update parameters."}, {Name: "Root", Doc: "Root is the root tensorfs directory, where all stats and other misc sim data goes."}, {Name: "Stats", Doc: "Stats has the stats directory within Root."}, {Name: "Current", Doc: "Current has the current stats values within Stats."}, {Name: "StatFuncs", Doc: "StatFuncs are statistics functions called at given mode and level,\nto perform all stats computations. phase = Start does init at start of given level,\nand all intialization / configuration (called during Init too)."}, {Name: "GUI", Doc: "GUI manages all the GUI elements"}, {Name: "RandSeeds", Doc: "RandSeeds is a list of random seeds to use for each run."}}})
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/sims/hip.Sim", IDName: "sim", Doc: "Sim encapsulates the entire simulation model, and we define all the\nfunctionality as methods on this struct.  This structure keeps all relevant\nstate information organized and available without having to pass everything around\nas arguments to methods, and provides the core GUI interface (note the view tags\nfor the fields which provide hints to how things should be displayed).", Fields: []types.Field{{Name: "Config", Doc: "simulation configuration parameters -- set by .toml config file and / or args"}, {Name: "Net", Doc: "Net is the network: click to view / edit parameters for layers, paths, etc."}, {Name: "Params", Doc: "Params manages network parameter setting."}, {Name: "Loops", Doc: "Loops are the control loops for running the sim, in different Modes\nacross stacks of Levels."}, {Name: "Sleep", Doc: "Sleep has the parameters and state for the offline sleep phase."}, {Name: "Envs", Doc: "Envs provides mode-string based storage of environments."}, {Name: "TrainUpdate", Doc: "TrainUpdate has Train mode netview update parameters."}, {Name: "TestUpdate", Doc: "TestUpdate has Test mode netview update parameters."}, {Name: "Root", Doc: "Root is the root tensorfs directory, where all stats and other misc sim data goes."}, {Name: "Stats", Doc: "Stats has the stats directory within Root."}, {Name: "Current", Doc: "Current has the current stats values within Stats."}, {Name: "StatFuncs", Doc: "StatFuncs are statistics functions called at given mode and level,\nto perform all stats computations. phase = Start does init at start of given level,\nand all intialization / configuration (called during Init too)."}, {Name: "GUI", Doc: "GUI manages all the GUI elements"}, {Name: "RandSeeds", Doc: "RandSeeds is a list of random seeds to use for each run."}}})
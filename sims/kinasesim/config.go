@@ -41,6 +41,10 @@ type RunConfig struct {
 	// Trials is the total number of epochs per run.
 	Trials int `default:"10"`
 
+	// Protocols runs the rate and timing protocol sweeps in nogui mode,
+	// instead of the standard minus-plus sweep.
+	Protocols bool
+
 	// Cycles is the total number of cycles to run.
 	Cycles int `min:"10" default:"200"`
 
@@ -59,6 +63,33 @@ func (rc *RunConfig) Update() {
 	rc.NCaBins = rc.Cycles / rc.CaBinCycles
 }
 
+// ProtocolConfig has config parameters for the rate and timing protocols
+// that compare the calcium-threshold rule with the CaP - CaD rule.
+type ProtocolConfig struct {
+
+	// MaxHz is the maximum sending and receiving firing rate for the rate protocol.
+	MaxHz float32 `default:"100"`
+
+	// StepHz is the step size for sampling firing rates in the rate protocol.
+	StepHz float32 `default:"10"`
+
+	// PairHz is the frequency of pre-post spike pairings in the timing protocol.
+	PairHz float32 `default:"10"`
+
+	// MaxDeltaT is the maximum post - pre spike timing difference
+	// in cycles (msec) for the timing protocol, which is sampled
+	// from -MaxDeltaT to +MaxDeltaT.
+	MaxDeltaT int `default:"50"`
+
+	// StepDeltaT is the step size for sampling spike timing differences.
+	StepDeltaT int `default:"5"`
+
+	// CaThrGain multiplies the change in calcium-threshold efficacy Rho
+	// over each trial, to put it on the scale of the CaP - CaD DWt,
+	// as Rho changes slowly.
+	CaThrGain float32 `default:"100"`
+}
+
 // LogConfig has config parameters related to logging data.
 type LogConfig struct {
 
@@ -100,6 +131,9 @@ type Config struct {
 	// Run has sim running related configuration options.
 	Run RunConfig `display:"add-fields"`
 
+	// Protocol has the rate and timing protocol configuration options.
+	Protocol ProtocolConfig `display:"add-fields"`
+
 	// Log has data logging related configuration options.
 	Log LogConfig `display:"add-fields"`
 }
@@ -59,15 +59,25 @@ func (kn *KinaseNeuron) StartTrial() {
 // Cycle does one cycle of neuron updating, with given exponential spike interval
 // based on target spiking firing rate.
 func (ss *Sim) Cycle(kn *KinaseNeuron, expInt float32, cyc int) {
-	kn.Spike = 0
+	spike := false
 	if expInt > 0 {
 		kn.SpikeP *= ss.Rand.Float32()
 		if kn.SpikeP <= expInt {
-			kn.Spike = 1
+			spike = true
 			kn.SpikeP = 1
-			kn.TotalSpikes += 1
 		}
 	}
+	ss.CycleSpike(kn, spike, cyc)
+}
+
+// CycleSpike does one cycle of neuron updating with given spike,
+// which is either generated randomly in Cycle, or by a timing protocol.
+func (ss *Sim) CycleSpike(kn *KinaseNeuron, spike bool, cyc int) {
+	kn.Spike = 0
+	if spike {
+		kn.Spike = 1
+		kn.TotalSpikes += 1
+	}
 	kn.CaSyn += ss.CaSpike.CaSynDt * (ss.CaSpike.SpikeCaSyn*kn.Spike - kn.CaSyn)
 	bin := cyc / ss.Config.Run.CaBinCycles
 	kn.CaBins[bin] += kn.CaSyn / float32(ss.Config.Run.CaBinCycles)
@@ -103,6 +113,55 @@ func (ks *KinaseSynapse) Init() {
 	ks.DWt = 0
 }
 
+// CaThrSynapse has the calcium-threshold synapse state,
+// per [kinase.CaThrParams].
+type CaThrSynapse struct {
+	// Ca is the synaptic calcium driven by pre and post spikes.
+	Ca float32 `edit:"-" width:"12"`
+
+	// Rho is the bistable synaptic efficacy.
+	Rho float32 `edit:"-" width:"12"`
+
+	// StartRho is the Rho value at the start of the trial.
+	StartRho float32 `edit:"-" width:"12"`
+
+	// DWt is the change in Rho over the trial, times [ProtocolConfig.CaThrGain].
+	DWt float32 `edit:"-" width:"12"`
+
+	// preSpikes is the ring buffer of pre spikes, for PreDelay.
+	preSpikes []float32
+
+	// preIndex is the current index into preSpikes.
+	preIndex int
+}
+
+func (cs *CaThrSynapse) Init() {
+	cs.Ca = 0
+	cs.Rho = 0.5
+	cs.StartRho = cs.Rho
+	cs.DWt = 0
+	clear(cs.preSpikes)
+}
+
+func (cs *CaThrSynapse) StartTrial() {
+	cs.StartRho = cs.Rho
+}
+
+// Cycle does one cycle of updating from given pre and post spikes,
+// applying the PreDelay to the pre spikes, with given noise sample.
+func (cs *CaThrSynapse) Cycle(cp *kinase.CaThrParams, preSpike, postSpike, noise float32) {
+	if nd := int(cp.PreDelay); nd > 0 {
+		if len(cs.preSpikes) != nd {
+			cs.preSpikes = make([]float32, nd)
+			cs.preIndex = 0
+		}
+		cs.preIndex = (cs.preIndex + 1) % nd
+		preSpike, cs.preSpikes[cs.preIndex] = cs.preSpikes[cs.preIndex], preSpike
+	}
+	cp.CaFromSpikes(preSpike, postSpike, &cs.Ca)
+	cp.RhoFromCa(cs.Ca, noise, &cs.Rho)
+}
+
 // KinaseState is basic Kinase equation state
 type KinaseState struct {
 
@@ -124,6 +183,12 @@ type KinaseState struct {
 	// phase-based firing rates
 	MinusHz, PlusHz float32
 
+	// SendHz is the sending firing rate for rate protocols.
+	SendHz float32
+
+	// DeltaT is the post - pre spike timing for timing protocols.
+	DeltaT float32
+
 	// ErrDWt is the target error dwt: PlusHz - MinusHz
 	ErrDWt float32
 
@@ -142,6 +207,9 @@ type KinaseState struct {
 	// Linear synapse values
 	LinearSyn KinaseSynapse
 
+	// Calcium-threshold synapse values
+	CaThrSyn CaThrSynapse
+
 	// binned integration of send, recv spikes
 	CaBins []float32
 }
@@ -151,6 +219,7 @@ func (ks *KinaseState) Init() {
 	ks.Recv.Init()
 	ks.StdSyn.Init()
 	ks.LinearSyn.Init()
+	ks.CaThrSyn.Init()
 	ks.CaBin = 0
 }
 
@@ -168,6 +237,7 @@ func (kn *KinaseState) StartTrial() {
 	kn.LinearSyn.CaM = 0
 	kn.LinearSyn.CaP = 0
 	kn.LinearSyn.CaD = 0
+	kn.CaThrSyn.StartTrial()
 }
 
 func (ss *Sim) ConfigKinase() {
@@ -208,6 +278,59 @@ func (ss *Sim) Sweep() {
 	}
 }
 
+// ProtocolSweep runs the rate and timing protocols, comparing the
+// calcium-threshold rule with the CaP - CaD rule.
+func (ss *Sim) ProtocolSweep() {
+	ss.RateSweep()
+	ss.TimingSweep()
+}
+
+// RateSweep runs a sweep over constant sending and receiving firing rates,
+// for the rate dependence of the learning rules.
+func (ss *Sim) RateSweep() {
+	pc := &ss.Config.Protocol
+	ks := &ss.Kinase
+	ss.StatsStart(Test, Condition)
+	cond := 0
+	for shz := pc.StepHz; shz <= pc.MaxHz; shz += pc.StepHz {
+		for rhz := pc.StepHz; rhz <= pc.MaxHz; rhz += pc.StepHz {
+			ks.Condition = cond
+			ks.Cond = fmt.Sprintf("rate %03d -> %03d", int(shz), int(rhz))
+			ss.StatsStart(Test, Condition)
+			ss.StatsStart(Test, Trial)
+			ks.Init()
+			for trl := range ss.Config.Run.Trials {
+				ks.Trial = trl
+				ss.RatesTrialImpl(shz, shz, rhz, rhz)
+			}
+			ss.StatsStep(Test, Condition)
+			cond++
+		}
+	}
+}
+
+// TimingSweep runs a sweep over the post - pre spike timing of regular
+// pairings, for the spike timing dependence of the learning rules.
+func (ss *Sim) TimingSweep() {
+	pc := &ss.Config.Protocol
+	ks := &ss.Kinase
+	ss.StatsStart(Test, Condition)
+	cond := 0
+	for dt := -pc.MaxDeltaT; dt <= pc.MaxDeltaT; dt += pc.StepDeltaT {
+		ks.Condition = cond
+		ks.Cond = fmt.Sprintf("timing %+04d", dt)
+		ss.StatsStart(Test, Condition)
+		ss.StatsStart(Test, Trial)
+		ks.Init()
+		for trl := range ss.Config.Run.Trials {
+			ks.Trial = trl
+			ss.TimingTrialImpl(dt)
+		}
+		ss.StatsStep(Test, Condition)
+		cond++
+	}
+}
+
 // Run runs for given parameters
 func (ss *Sim) Run() {
 	ss.RunImpl(ss.Config.MinusHz, ss.Config.PlusHz, ss.Config.Run.Trials)
@@ -234,6 +357,13 @@ func (ss *Sim) Trial() {
 
 // TrialImpl runs one trial for given parameters
 func (ss *Sim) TrialImpl(minusHz, plusHz float32) {
+	diff := ss.Config.SendDiffHz
+	ss.RatesTrialImpl(minusHz+diff, plusHz+diff, minusHz, plusHz)
+}
+
+// RatesTrialImpl runs one trial with given send and recv firing rates
+// in the minus and plus phases.
+func (ss *Sim) RatesTrialImpl(sendMinusHz, sendPlusHz, minusHz, plusHz float32) {
 	if ss.GUI.StopNow() {
 		return
 	}
@@ -243,26 +373,26 @@ func (ss *Sim) TrialImpl(minusHz, plusHz float32) {
 	ks.MinusHz = minusHz
 	ks.PlusHz = plusHz
 	ks.Cycle = 0
+	ks.SendHz = sendPlusHz
+	ks.DeltaT = 0
 	ks.ErrDWt = (plusHz - minusHz) / 100
 
 	minusCycles := cfg.Run.Cycles - cfg.Run.PlusCycles
-	nbins := ss.Config.Run.NCaBins
-	spikeBinCycles := ss.Config.Run.CaBinCycles
-	lsint := 1.0 / float32(spikeBinCycles)
 
 	ks.StartTrial()
 	for phs := 0; phs < 2; phs++ {
 		var maxcyc int
-		var rhz float32
+		var rhz, shz float32
 		switch phs {
 		case 0:
 			rhz = minusHz
+			shz = sendMinusHz
 			maxcyc = minusCycles
 		case 1:
 			rhz = plusHz
+			shz = sendPlusHz
 			maxcyc = cfg.Run.PlusCycles
 		}
-		shz := rhz + cfg.SendDiffHz
 		if shz < 0 {
 			shz = 0
 		}
@@ -277,38 +407,94 @@ func (ss *Sim) TrialImpl(minusHz, plusHz float32) {
 		for t := 0; t < maxcyc; t++ {
 			ss.Cycle(&ks.Send, Sint, ks.Cycle)
 			ss.Cycle(&ks.Recv, Rint, ks.Cycle)
+			ss.SynapseCycle()
+		}
+	}
+	ss.SynapseDWt()
+	ss.StatsStep(Test, Trial)
+}
 
-			// original synaptic-level integration into "StdSyn"
-			ca := 8 * ks.Send.CaSyn * ks.Recv.CaSyn // 8 is standard CaGain Factor
-			ss.CaSpike.Dt.FromCa(ca, &ks.StdSyn.CaM, &ks.StdSyn.CaP, &ks.StdSyn.CaD)
-
-			// CaBin linear regression integration.
-			bin := ks.Cycle / spikeBinCycles
-
-			sp := float32(0)
-			if bin == 0 {
-				sp = ks.Recv.CaBins[0] * ks.Send.CaBins[0]
-			} else {
-				if ss.SynCa20 {
-					sp = 0.25 * (ks.Recv.CaBins[0] + ks.Recv.CaBins[1]) * (ks.Send.CaBins[0] + ks.Send.CaBins[1])
-				} else {
-					sp = ks.Recv.CaBins[1] * ks.Send.CaBins[1]
-				}
-			}
-			ks.CaBins[bin] = sp
-			ks.CaBin = sp
-			ks.LinearSyn.CaM = sp
-			ks.LinearSyn.CaP += lsint * ss.CaPWts[bin] * sp // slow integ just for visualization
-			ks.LinearSyn.CaD += lsint * ss.CaDWts[bin] * sp
-
-			ss.StatsStep(Test, Cycle)
-			ks.Cycle++
+// TimingTrialImpl runs one trial of the spike timing protocol, with regular
+// pairings of pre and post spikes at Protocol.PairHz, where the post spike
+// occurs dt cycles after the pre spike (before if negative).
+func (ss *Sim) TimingTrialImpl(dt int) {
+	if ss.GUI.StopNow() {
+		return
+	}
+	ss.StatsStart(Test, Trial)
+	cfg := ss.Config
+	ks := &ss.Kinase
+	ks.MinusHz = 0
+	ks.PlusHz = 0
+	ks.SendHz = cfg.Protocol.PairHz
+	ks.DeltaT = float32(dt)
+	ks.Cycle = 0
+	ks.ErrDWt = 0
+
+	interval := int(math32.Round(1000 / cfg.Protocol.PairHz))
+	start := cfg.Protocol.MaxDeltaT // room for negative dt
+	ks.StartTrial()
+	for t := 0; t < cfg.Run.Cycles; t++ {
+		spre := t >= start && (t-start)%interval == 0
+		tpost := t - dt
+		spost := tpost >= start && (tpost-start)%interval == 0
+		ss.CycleSpike(&ks.Send, spre, ks.Cycle)
+		ss.CycleSpike(&ks.Recv, spost, ks.Cycle)
+		ss.SynapseCycle()
+	}
+	ss.SynapseDWt()
+	ss.StatsStep(Test, Trial)
+}
+
+// SynapseCycle does one cycle of updating of the synaptic learning
+// rules, based on the current Send and Recv spikes.
+func (ss *Sim) SynapseCycle() {
+	ks := &ss.Kinase
+	spikeBinCycles := ss.Config.Run.CaBinCycles
+	lsint := 1.0 / float32(spikeBinCycles)
+
+	// original synaptic-level integration into "StdSyn"
+	ca := 8 * ks.Send.CaSyn * ks.Recv.CaSyn // 8 is standard CaGain Factor
+	ss.CaSpike.Dt.FromCa(ca, &ks.StdSyn.CaM, &ks.StdSyn.CaP, &ks.StdSyn.CaD)
+
+	// CaBin linear regression integration.
+	bin := ks.Cycle / spikeBinCycles
+
+	sp := float32(0)
+	if bin == 0 {
+		sp = ks.Recv.CaBins[0] * ks.Send.CaBins[0]
+	} else {
+		if ss.SynCa20 {
+			sp = 0.25 * (ks.Recv.CaBins[0] + ks.Recv.CaBins[1]) * (ks.Send.CaBins[0] + ks.Send.CaBins[1])
+		} else {
+			sp = ks.Recv.CaBins[1] * ks.Send.CaBins[1]
 		}
 	}
+	ks.CaBins[bin] = sp
+	ks.CaBin = sp
+	ks.LinearSyn.CaM = sp
+	ks.LinearSyn.CaP += lsint * ss.CaPWts[bin] * sp // slow integ just for visualization
+	ks.LinearSyn.CaD += lsint * ss.CaDWts[bin] * sp
+
+	// calcium-threshold rule
+	noise := float32(0)
+	if ss.CaThr.Noise > 0 {
+		noise = float32(ss.Rand.NormFloat64())
+	}
+	ks.CaThrSyn.Cycle(&ss.CaThr, ks.Send.Spike, ks.Recv.Spike, noise)
+
+	ss.StatsStep(Test, Cycle)
+	ks.Cycle++
+}
+
+// SynapseDWt computes the DWt values at the end of the trial
+// for each of the synaptic learning rules.
+func (ss *Sim) SynapseDWt() {
+	ks := &ss.Kinase
 	ks.StdSyn.DWt = ks.StdSyn.CaP - ks.StdSyn.CaD
 
 	var cp, cd float32
-	for i := range nbins {
+	for i := range ss.Config.Run.NCaBins {
 		cp += ks.CaBins[i] * ss.CaPWts[i]
 		cd += ks.CaBins[i] * ss.CaDWts[i]
 	}
@@ -316,7 +502,7 @@ func (ss *Sim) TrialImpl(minusHz, plusHz float32) {
 	ks.LinearSyn.CaP = cp
 	ks.LinearSyn.CaD = cd
 
-	ss.StatsStep(Test, Trial)
+	ks.CaThrSyn.DWt = ss.Config.Protocol.CaThrGain * (ks.CaThrSyn.Rho - ks.CaThrSyn.StartRho)
 }
 
 // Regress runs the linear regression on the data
@@ -61,6 +61,9 @@ type Sim struct {
 	// SynCa20 determines whether to use 20 msec SynCa integration.
 	SynCa20 bool
 
+	// CaThr are the calcium-threshold learning rule params.
+	CaThr kinase.CaThrParams `display:"no-inline" new-window:"+"`
+
 	// CaPWts are CaBin integration weights for CaP
 	CaPWts []float32 `new-window:"+"`
 
@@ -103,6 +106,7 @@ func (ss *Sim) Body() *core.Body      { return ss.GUI.Body }
 
 func (ss *Sim) Defaults() {
 	ss.CaSpike.Defaults()
+	ss.CaThr.Defaults()
 	ss.SynCa20 = false
 	cli.SetFromDefaults(&ss.Config)
 }
@@ -258,14 +262,14 @@ func (ss *Sim) ConfigStats() {
 						}
 					case Trial:
 						switch name {
-						case "StdSyn.CaP", "StdSyn.CaD", "StdSyn.DWt", "LinearSyn.CaP", "LinearSyn.CaD", "LinearSyn.DWt":
+						case "StdSyn.CaP", "StdSyn.CaD", "StdSyn.DWt", "LinearSyn.CaP", "LinearSyn.CaD", "LinearSyn.DWt", "CaThrSyn.DWt":
 							s.On = true
 						case "Cycle":
 							s.Group = "none"
 						}
 					case Condition:
 						switch name {
-						case "StdSyn.DWt", "LinearSyn.DWt", "ErrDWt":
+						case "StdSyn.DWt", "LinearSyn.DWt", "CaThrSyn.DWt", "ErrDWt":
 							s.On = true
 						case "Cycle", "Trial":
 							s.Group = "none"
@@ -362,6 +366,19 @@ func (ss *Sim) MakeToolbar(p *tree.Plan) {
 			}
 		},
 	})
+	ss.GUI.AddToolbarItem(p, egui.ToolbarItem{Label: "Protocols", Icon: icons.PlayArrow,
+		Tooltip: "Runs rate and timing protocol sweeps, comparing the calcium-threshold rule with CaP - CaD.",
+		Active:  egui.ActiveStopped,
+		Func: func() {
+			if !ss.GUI.IsRunning() {
+				go func() {
+					ss.GUI.StartRun()
+					ss.ProtocolSweep()
+					ss.GUI.Stopped(Test, Condition)
+				}()
+			}
+		},
+	})
 	ss.GUI.AddToolbarItem(p, egui.ToolbarItem{Label: "Run", Icon: icons.PlayArrow,
 		Tooltip: "Runs NTrials of Kinase updating.",
 		Active:  egui.ActiveStopped,
@@ -440,6 +457,10 @@ func (ss *Sim) RunNoGUI() {
 	// axon.OpenLogFiles(ss.Loops, ss.Stats, netName, runName, [][]string{[]string{"Cycle"}})
 
 	mpi.Printf("Running %d Cycles\n", ss.Config.Run.Cycles)
-	ss.Sweep()
+	if ss.Config.Run.Protocols {
+		ss.ProtocolSweep()
+	} else {
+		ss.Sweep()
+	}
 	// axon.CloseLogFiles(ss.Loops, ss.Stats, Cycle)
 }
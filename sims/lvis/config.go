@@ -147,6 +147,13 @@ type RunConfig struct {
 
 	// Epoch counter to set when loading start weights.
 	StartEpoch int
+
+	// StartCheckpoint is the name of a checkpoint file to resume training from
+	// in nogui mode, as saved with Log.CheckpointInterval. This restores the full
+	// network state, looper counters and training environment state, so
+	// StartWeights and StartEpoch are not needed. The Run and Runs range
+	// must include the run that was saved in the checkpoint.
+	StartCheckpoint string
 }
 
 // Cycles returns the total number of cycles per trial: ISI + Minus + Plus.
@@ -163,6 +170,15 @@ type LogConfig struct {
 	// SaveWeightsAt is a list of epoch counters at which to save weights.
 	SaveWeightsAt []int `default:"[400, 800, 1200]"`
 
+	// BinaryWeights saves weights in the compact binary format
+	// instead of JSON, which is much smaller and faster for this size of network.
+	BinaryWeights bool
+
+	// CheckpointInterval is how often (in epochs) to save a full checkpoint
+	// of the network state and looper counters, which can be resumed
+	// using Run.StartCheckpoint. 0 = no checkpoints.
+	CheckpointInterval int
+
 	// Train has the list of Train mode levels to save log files for.
 	Train []string `default:"['Run', 'Epoch']" nest:"+"`
 
@@ -5,8 +5,11 @@
 package lvis
 
 import (
+	"encoding/binary"
 	"fmt"
 	"image"
+	"io"
+	"math/rand"
 	"path/filepath"
 	"sort"
 
@@ -154,6 +157,10 @@ type ImagesEnv struct {
 	// RunRandSeed is the random seed multiplier for run counter.
 	// It is set to 173 if 0 at start for consistent results by default.
 	RunRandSeed int64 `edit:"-"`
+
+	// randSrc is the source for Rand, which records its state
+	// for SaveCheckpoint.
+	randSrc *countSource
 }
 
 func (ev *ImagesEnv) Label() string { return ev.Name }
@@ -212,7 +219,13 @@ func (ev *ImagesEnv) Init(run int) {
 	if ev.RunRandSeed == 0 {
 		ev.RunRandSeed = 173
 	}
-	randx.InitSysRand(&ev.Rand, ev.RunRandSeed*(int64(run)+1))
+	seed := ev.RunRandSeed * (int64(run) + 1)
+	if ev.randSrc == nil {
+		ev.randSrc = newCountSource(seed)
+		ev.Rand = &randx.SysRand{Rand: rand.New(ev.randSrc)}
+	} else {
+		ev.Rand.Seed(seed)
+	}
 	ev.Images.Rand = ev.Rand
 	ev.Row.Cur = -1 // init state -- key so that first Step() = 0
 	nitm := len(ev.ImageList())
@@ -346,6 +359,80 @@ func (ev *ImagesEnv) NewShuffle() {
 	randx.PermuteInts(ev.Shuffle, ev.Rand)
 }
 
+// SaveCheckpoint writes the current item order, Row counter, and
+// random number generator state, implementing [axon.CheckpointState].
+func (ev *ImagesEnv) SaveCheckpoint(w io.Writer) error {
+	st := []int64{int64(ev.Row.Cur), int64(ev.Row.Prev), ev.randSrc.seed, ev.randSrc.n, int64(len(ev.Shuffle))}
+	for _, v := range ev.Shuffle {
+		st = append(st, int64(v))
+	}
+	return binary.Write(w, binary.LittleEndian, st)
+}
+
+// LoadCheckpoint reads the state saved by [ImagesEnv.SaveCheckpoint],
+// implementing [axon.CheckpointState].
+func (ev *ImagesEnv) LoadCheckpoint(r io.Reader) error {
+	var hdr [5]int64
+	if err := binary.Read(r, binary.LittleEndian, hdr[:]); err != nil {
+		return err
+	}
+	if int(hdr[4]) != len(ev.Shuffle) {
+		return fmt.Errorf("ImagesEnv: number of items in checkpoint: %d != current: %d", hdr[4], len(ev.Shuffle))
+	}
+	if hdr[3] < 0 {
+		return fmt.Errorf("ImagesEnv: invalid random number count in checkpoint: %d", hdr[3])
+	}
+	shuf := make([]int64, hdr[4])
+	if err := binary.Read(r, binary.LittleEndian, shuf); err != nil {
+		return err
+	}
+	ev.Row.Cur, ev.Row.Prev = int(hdr[0]), int(hdr[1])
+	ev.randSrc.restore(hdr[2], hdr[3])
+	for i, v := range shuf {
+		ev.Shuffle[i] = int(v)
+	}
+	return nil
+}
+
+// countSource is a [rand.Source64] that counts the number of values
+// generated since it was last seeded, so that its state can be saved
+// as the seed and count, and restored by re-seeding and skipping
+// that many values, with the same sequence as [rand.NewSource].
+type countSource struct {
+	src  rand.Source64
+	seed int64
+	n    int64
+}
+
+func newCountSource(seed int64) *countSource {
+	return &countSource{src: rand.NewSource(seed).(rand.Source64), seed: seed}
+}
+
+func (cs *countSource) Seed(seed int64) {
+	cs.src.Seed(seed)
+	cs.seed = seed
+	cs.n = 0
+}
+
+func (cs *countSource) Int63() int64 {
+	cs.n++
+	return cs.src.Int63()
+}
+
+func (cs *countSource) Uint64() uint64 {
+	cs.n++
+	return cs.src.Uint64()
+}
+
+// restore restores the state after n values from given seed.
+func (cs *countSource) restore(seed, n int64) {
+	cs.Seed(seed)
+	for range n {
+		cs.src.Uint64()
+	}
+	cs.n = n
+}
+
 // CurImage returns current image based on row and
 func (ev *ImagesEnv) CurImage(st *TrialState) string {
 	il := ev.ImageList()
@@ -126,6 +126,9 @@ func (ss *Sim) ConfigSim() {
 	tensorfs.CurRoot = ss.Root
 	ss.Paths.Defaults()
 	ss.Net = axon.NewNetwork(ss.Config.Name)
+	if ss.Config.Log.BinaryWeights {
+		ss.Net.WeightsFileExt = axon.WeightsBinaryExt + ".gz"
+	}
 	ss.Params.Config(LayerParams, PathParams, ss.Config.Params.Sheet, ss.Config.Params.Tag, reflect.ValueOf(ss))
 	ss.RandSeeds.Init(100) // max 100 runs
 	ss.InitRandSeed(0)
@@ -641,6 +644,13 @@ func (ss *Sim) ConfigLoops() {
 	ls.AddOnStartToAll("StatsStart", ss.StatsStart)
 	ls.AddOnEndToAll("StatsStep", ss.StatsStep)
 
+	if ss.Config.Log.CheckpointInterval > 0 {
+		axon.LooperAddCheckpoint(ls, ss.Net, Train, Epoch, ss.Config.Log.CheckpointInterval, func() string {
+			ctrString := fmt.Sprintf("%03d_%05d", ls.Loop(Train, Run).Counter.Cur, trainEpoch.Counter.Cur+1)
+			return axon.CheckpointFilename(ss.Net, ctrString, ss.RunName())
+		}, ss.Envs.ByMode(Train).(*ImagesEnv))
+	}
+
 	ls.Loop(Train, Run).OnEnd.Add("SaveWeights", func() {
 		ctrString := fmt.Sprintf("%03d_%05d", ls.Loop(Train, Run).Counter.Cur, ls.Loop(Train, Epoch).Counter.Cur)
 		axon.SaveWeightsIfConfigSet(ss.Net, ss.Config.Log.SaveWeights, ctrString, ss.RunName())
@@ -697,7 +707,7 @@ func (ss *Sim) NewRun() {
 	ctx.Reset()
 	ss.Net.InitWeights()
 	if ss.Config.Run.StartWeights != "" {
-		ss.Net.OpenWeightsJSON(core.Filename(ss.Config.Run.StartWeights))
+		ss.Net.OpenWeights(core.Filename(ss.Config.Run.StartWeights))
 		mpi.Printf("Starting with initial weights from: %s\n", ss.Config.Run.StartWeights)
 	}
 }
@@ -1054,6 +1064,13 @@ func (ss *Sim) RunNoGUI() {
 	if ss.Config.Run.StartWeights != "" {
 		ss.Loops.Loop(Train, Epoch).Counter.Cur = ss.Config.Run.StartEpoch
 	}
+	if ss.Config.Run.StartCheckpoint != "" {
+		err := axon.LooperOpenCheckpointFile(core.Filename(ss.Config.Run.StartCheckpoint), ss.Loops, ss.Net, ss.Envs.ByMode(Train).(*ImagesEnv))
+		if err != nil {
+			os.Exit(1)
+		}
+		mpi.Printf("Resuming from checkpoint: %s\n", ss.Config.Run.StartCheckpoint)
+	}
 
 	ss.Loops.Run(Train)
 
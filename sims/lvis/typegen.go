@@ -10,9 +10,9 @@ var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/sims/lvis.EnvCo
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/sims/lvis.ParamConfig", IDName: "param-config", Doc: "ParamConfig has config parameters related to sim params.", Fields: []types.Field{{Name: "SubPools", Doc: "SubPools if true, organize layers and connectivity with 2x2 sub-pools\nwithin each topological pool."}, {Name: "Script", Doc: "Script is an interpreted script that is run to set parameters in Layer and Path\nsheets, by default using the \"Script\" set name."}, {Name: "Sheet", Doc: "Sheet is the extra params sheet name(s) to use (space separated\nif multiple). Must be valid name as listed in compiled-in params\nor loaded params."}, {Name: "Tag", Doc: "Tag is an extra tag to add to file names and logs saved from this run."}, {Name: "Note", Doc: "Note is additional info to describe the run params etc,\nlike a git commit message for the run."}, {Name: "SaveAll", Doc: "SaveAll will save a snapshot of all current param and config settings\nin a directory named params_<datestamp> (or _good if Good is true),\nthen quit. Useful for comparing to later changes and seeing multiple\nviews of current params."}, {Name: "Good", Doc: "Good is for SaveAll, save to params_good for a known good params state.\nThis can be done prior to making a new release after all tests are passing.\nAdd results to git to provide a full diff record of all params over level."}}})
 
-var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/sims/lvis.RunConfig", IDName: "run-config", Doc: "RunConfig has config parameters related to running the sim.", Fields: []types.Field{{Name: "GPUDevice", Doc: "GPUDevice selects the gpu device to use."}, {Name: "MPI", Doc: "MPI uses MPI message passing interface for data parallel computation\nbetween nodes running identical copies of the same sim, sharing DWt changes."}, {Name: "GPUSameNodeMPI", Doc: "GPUSameNodeMPI if true and both MPI and GPU are being used, this selects\na different GPU for each MPI proc rank, assuming a multi-GPU node.\nset to false if running MPI across multiple GPU nodes."}, {Name: "NData", Doc: "NData is the number of data-parallel items to process in parallel per trial.\nIs significantly faster for both CPU and GPU.  Results in an effective\nmini-batch of learning."}, {Name: "SlowInterval", Doc: "SlowInterval is the interval between slow adaptive processes.\nThis generally needs to be longer than the default of 100 in larger models."}, {Name: "AdaptGiInterval", Doc: "AdaptGiInterval is the interval between adapting inhibition steps."}, {Name: "NThreads", Doc: "NThreads is the number of parallel threads for CPU computation;\n0 = use default."}, {Name: "Run", Doc: "Run is the _starting_ run number, which determines the random seed.\nRuns counts up from there. Can do all runs in parallel by launching\nseparate jobs with each starting Run, Runs = 1."}, {Name: "Runs", Doc: "Runs is the total number of runs to do when running Train, starting from Run."}, {Name: "Epochs", Doc: "Epochs is the total number of epochs per run."}, {Name: "Trials", Doc: "Trials is the total number of trials per epoch.\nShould be an even multiple of NData."}, {Name: "ISICycles", Doc: "ISICycles is the number of no-input inter-stimulus interval\ncycles at the start of the trial."}, {Name: "MinusCycles", Doc: "MinusCycles is the number of cycles in the minus phase per trial."}, {Name: "PlusCycles", Doc: "PlusCycles is the number of cycles in the plus phase per trial."}, {Name: "NZero", Doc: "NZero is how many perfect, zero-error epochs before stopping a Run."}, {Name: "TestInterval", Doc: "TestInterval is how often (in epochs) to run through all the test patterns,\nin terms of training epochs. Can use 0 or -1 for no testing."}, {Name: "PCAInterval", Doc: "PCAInterval is how often (in epochs) to compute PCA on hidden\nrepresentations to measure variance."}, {Name: "ConfusionEpc", Doc: "ConfusionEpc is the epoch to start recording confusion matrix."}, {Name: "StartWeights", Doc: "StartWeights is the name of weights file to load at start of first run."}, {Name: "StartEpoch", Doc: "Epoch counter to set when loading start weights."}}})
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/sims/lvis.RunConfig", IDName: "run-config", Doc: "RunConfig has config parameters related to running the sim.", Fields: []types.Field{{Name: "GPUDevice", Doc: "GPUDevice selects the gpu device to use."}, {Name: "MPI", Doc: "MPI uses MPI message passing interface for data parallel computation\nbetween nodes running identical copies of the same sim, sharing DWt changes."}, {Name: "GPUSameNodeMPI", Doc: "GPUSameNodeMPI if true and both MPI and GPU are being used, this selects\na different GPU for each MPI proc rank, assuming a multi-GPU node.\nset to false if running MPI across multiple GPU nodes."}, {Name: "NData", Doc: "NData is the number of data-parallel items to process in parallel per trial.\nIs significantly faster for both CPU and GPU.  Results in an effective\nmini-batch of learning."}, {Name: "SlowInterval", Doc: "SlowInterval is the interval between slow adaptive processes.\nThis generally needs to be longer than the default of 100 in larger models."}, {Name: "AdaptGiInterval", Doc: "AdaptGiInterval is the interval between adapting inhibition steps."}, {Name: "NThreads", Doc: "NThreads is the number of parallel threads for CPU computation;\n0 = use default."}, {Name: "Run", Doc: "Run is the _starting_ run number, which determines the random seed.\nRuns counts up from there. Can do all runs in parallel by launching\nseparate jobs with each starting Run, Runs = 1."}, {Name: "Runs", Doc: "Runs is the total number of runs to do when running Train, starting from Run."}, {Name: "Epochs", Doc: "Epochs is the total number of epochs per run."}, {Name: "Trials", Doc: "Trials is the total number of trials per epoch.\nShould be an even multiple of NData."}, {Name: "ISICycles", Doc: "ISICycles is the number of no-input inter-stimulus interval\ncycles at the start of the trial."}, {Name: "MinusCycles", Doc: "MinusCycles is the number of cycles in the minus phase per trial."}, {Name: "PlusCycles", Doc: "PlusCycles is the number of cycles in the plus phase per trial."}, {Name: "NZero", Doc: "NZero is how many perfect, zero-error epochs before stopping a Run."}, {Name: "TestInterval", Doc: "TestInterval is how often (in epochs) to run through all the test patterns,\nin terms of training epochs. Can use 0 or -1 for no testing."}, {Name: "PCAInterval", Doc: "PCAInterval is how often (in epochs) to compute PCA on hidden\nrepresentations to measure variance."}, {Name: "ConfusionEpc", Doc: "ConfusionEpc is the epoch to start recording confusion matrix."}, {Name: "StartWeights", Doc: "StartWeights is the name of weights file to load at start of first run."}, {Name: "StartEpoch", Doc: "Epoch counter to set when loading start weights."}, {Name: "StartCheckpoint", Doc: "StartCheckpoint is the name of a checkpoint file to resume training from\nin nogui mode, as saved with Log.CheckpointInterval. This restores the full\nnetwork state, looper counters and training environment state, so\nStartWeights and StartEpoch are not needed. The Run and Runs range\nmust include the run that was saved in the checkpoint."}}})
 
-var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/sims/lvis.LogConfig", IDName: "log-config", Doc: "LogConfig has config parameters related to logging data.", Fields: []types.Field{{Name: "SaveWeights", Doc: "SaveWeights will save final weights after each run."}, {Name: "SaveWeightsAt", Doc: "SaveWeightsAt is a list of epoch counters at which to save weights."}, {Name: "Train", Doc: "Train has the list of Train mode levels to save log files for."}, {Name: "Test", Doc: "Test has the list of Test mode levels to save log files for."}}})
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/sims/lvis.LogConfig", IDName: "log-config", Doc: "LogConfig has config parameters related to logging data.", Fields: []types.Field{{Name: "SaveWeights", Doc: "SaveWeights will save final weights after each run."}, {Name: "SaveWeightsAt", Doc: "SaveWeightsAt is a list of epoch counters at which to save weights."}, {Name: "BinaryWeights", Doc: "BinaryWeights saves weights in the compact binary format\ninstead of JSON, which is much smaller and faster for this size of network."}, {Name: "CheckpointInterval", Doc: "CheckpointInterval is how often (in epochs) to save a full checkpoint\nof the network state and looper counters, which can be resumed\nusing Run.StartCheckpoint. 0 = no checkpoints."}, {Name: "Train", Doc: "Train has the list of Train mode levels to save log files for."}, {Name: "Test", Doc: "Test has the list of Test mode levels to save log files for."}}})
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/sims/lvis.Config", IDName: "config", Doc: "Config has the overall Sim configuration options.", Embeds: []types.Field{{Name: "BaseConfig"}}, Fields: []types.Field{{Name: "Env", Doc: "environment configuration options"}, {Name: "Params", Doc: "Params has parameter related configuration options."}, {Name: "Run", Doc: "Run has sim running related configuration options."}, {Name: "Log", Doc: "Log has data logging related configuration options."}}})
 
@@ -7,6 +7,7 @@ package mpi
 import (
 	"cogentcore.org/core/core"
 	"cogentcore.org/core/math32/vecint"
+	"github.com/emer/axon/v2/axon"
 	"github.com/emer/emergent/v2/egui"
 )
 
@@ -66,6 +67,16 @@ type RunConfig struct {
 	// 0 = use default.
 	NThreads int `default:"0"`
 
+	// Backend is the backend for data-parallel learning across multiple
+	// processes, which each learn on a different subset of the patterns.
+	// CommMPI requires building with -tags mpi and launching with mpirun,
+	// and CommLocal automatically starts NProcs processes on this machine.
+	// Only used in nogui mode.
+	Backend axon.CommBackends
+
+	// NProcs is the number of processes to use for the CommLocal Backend.
+	NProcs int `default:"2" min:"1"`
+
 	// Run is the _starting_ run number, which determines the random seed.
 	// Runs counts up from there. Can do all runs in parallel by launching
 	// separate jobs with each starting Run, Runs = 1.
@@ -107,6 +107,14 @@ type Sim struct {
 
 	// RandSeeds is a list of random seeds to use for each run.
 	RandSeeds randx.Seeds `display:"-"`
+
+	// Comm is the communicator for data-parallel learning across processes,
+	// according to Config.Run.Backend (nil if not used).
+	Comm axon.DWtComm `display:"-"`
+
+	// AllDWts is the buffer of all the DWt weight changes, for sharing
+	// across processes.
+	AllDWts []float32 `display:"-"`
 }
 
 func (ss *Sim) SetConfig(cfg *Config) { ss.Config = cfg }
@@ -124,6 +132,7 @@ func (ss *Sim) ConfigSim() {
 		axon.GPUInit()
 		axon.UseGPU = true
 	}
+	ss.ConfigComm()
 	// ss.ConfigInputs()
 	ss.OpenInputs()
 	ss.ConfigEnv()
@@ -153,9 +162,20 @@ func (ss *Sim) ConfigEnv() {
 
 	inputs := tensorfs.DirTable(ss.Root.Dir("Inputs/Train"), nil)
 
+	// each process learns on a different subset of the training patterns
+	trnView := table.NewView(inputs)
+	if ss.Comm != nil {
+		st, ed, err := axon.CommAllocN(ss.Comm, trnView.NumRows())
+		if errors.Log(err) != nil {
+			os.Exit(1)
+		}
+		trnView.IndexesNeeded()
+		trnView.Indexes = trnView.Indexes[st:ed]
+	}
+
 	// note: names must be standard here!
 	trn.Name = Train.String()
-	trn.Config(table.NewView(inputs))
+	trn.Config(trnView)
 	trn.Validate()
 
 	tst.Name = Test.String()
@@ -246,7 +266,8 @@ func (ss *Sim) NetViewUpdater(mode enums.Enum) *axon.NetViewUpdate {
 func (ss *Sim) ConfigLoops() {
 	ls := looper.NewStacks()
 
-	trials := int(math32.IntMultipleGE(float32(ss.Config.Run.Trials), float32(ss.Config.Run.NData)))
+	// trials are divided among data-parallel processes
+	trials := int(math32.IntMultipleGE(float32(ss.Config.Run.Trials/ss.CommSize()), float32(ss.Config.Run.NData)))
 	cycles := ss.Config.Run.Cycles()
 
 	ls.AddStack(Train, Trial).
@@ -265,6 +286,9 @@ func (ss *Sim) ConfigLoops() {
 		func(mode enums.Enum) { ss.Net.ClearInputs() },
 		func(mode enums.Enum) { ss.ApplyInputs(mode.(Modes)) },
 	)
+	if ss.Comm != nil {
+		axon.LooperDataParallel(ls, ss.Net, ss.NetViewUpdater, ss.Comm, &ss.AllDWts, Cycle, Trial, Train)
+	}
 	ls.Stacks[Train].OnInit.Add("Init", ss.Init)
 	ls.Loop(Train, Run).OnStart.Add("NewRun", ss.NewRun)
 
@@ -341,7 +365,7 @@ func (ss *Sim) NewRun() {
 	ctx.Reset()
 	ss.Net.InitWeights()
 	if ss.Config.Run.StartWeights != "" {
-		ss.Net.OpenWeightsJSON(core.Filename(ss.Config.Run.StartWeights))
+		ss.Net.OpenWeights(core.Filename(ss.Config.Run.StartWeights))
 		mpi.Printf("Starting with initial weights from: %s\n", ss.Config.Run.StartWeights)
 	}
 }
@@ -565,6 +589,9 @@ func (ss *Sim) ConfigStats() {
 				switch name {
 				case "NZero":
 					err := stats.StatSum.Call(subDir.Value("Err")).Float1D(0)
+					if mode == Train {
+						err = ss.CommMean(err)
+					}
 					stat = curModeDir.Float64(name, 1).Float1D(0)
 					if err == 0 {
 						stat++
@@ -586,6 +613,9 @@ func (ss *Sim) ConfigStats() {
 					curModeDir.Float64(name, 1).SetFloat1D(stat, 0)
 				default:
 					stat = stats.StatMean.Call(subDir.Value(name)).Float1D(0)
+					if mode == Train {
+						stat = ss.CommMean(stat)
+					}
 				}
 				tsr.AppendRowFloat(stat)
 			case Run:
@@ -695,7 +725,9 @@ func (ss *Sim) RunNoGUI() {
 	runName := ss.SetRunName()
 	netName := ss.Net.Name
 	cfg := &ss.Config.Log
-	axon.OpenLogFiles(ss.Loops, ss.Stats, netName, runName, [][]string{cfg.Train, cfg.Test})
+	if axon.CommRank() == 0 {
+		axon.OpenLogFiles(ss.Loops, ss.Stats, netName, runName, [][]string{cfg.Train, cfg.Test})
+	}
 
 	mpi.Printf("Running %d Runs starting at %d\n", ss.Config.Run.Runs, ss.Config.Run.Run)
 	ss.Loops.Loop(Train, Run).Counter.SetCurMaxPlusN(ss.Config.Run.Run, ss.Config.Run.Runs)
@@ -704,4 +736,46 @@ func (ss *Sim) RunNoGUI() {
 
 	axon.CloseLogFiles(ss.Loops, ss.Stats, Cycle)
 	axon.GPURelease()
+	if ss.Comm != nil {
+		errors.Log(ss.Comm.Close())
+	}
+}
+
+////////  Data parallel
+
+// ConfigComm configures the communicator for data-parallel learning
+// across processes, according to Config.Run.Backend, in nogui mode.
+// This must be called before ConfigEnv, which allocates the training
+// patterns across the processes.
+func (ss *Sim) ConfigComm() {
+	backend := ss.Config.Run.Backend
+	if ss.Config.GUI || backend == axon.NoComm {
+		return
+	}
+	comm, err := axon.NewDWtComm(backend, ss.Config.Run.NProcs)
+	if errors.Log(err) != nil {
+		os.Exit(1)
+	}
+	ss.Comm = comm
+	mpi.Printf("%s running on %d procs\n", backend, comm.Size())
+}
+
+// CommSize returns the number of data-parallel processes.
+func (ss *Sim) CommSize() int {
+	if ss.Comm == nil {
+		return 1
+	}
+	return ss.Comm.Size()
+}
+
+// CommMean returns the mean of given value across the data-parallel
+// processes, so that the Train epoch stats reflect all of the patterns,
+// and all processes stop at the same epoch.
+func (ss *Sim) CommMean(v float64) float64 {
+	if ss.Comm == nil {
+		return v
+	}
+	vals := []float32{float32(v)}
+	errors.Log(ss.Comm.AllReduceF32(vals, nil))
+	return float64(vals[0]) / float64(ss.Comm.Size())
 }
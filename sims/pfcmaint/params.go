@@ -48,6 +48,14 @@ var LayerParams = axon.LayerSheets{
 				ly.Learn.NeuroMod.AChDisInhib = 0
 			}},
 	},
+	"ThreeFactor": {
+		{Sel: ".PFC", Doc: "noise provides the perturbations for three-factor learning",
+			Set: func(ly *axon.LayerParams) {
+				ly.Acts.Noise.On.SetBool(true)
+				ly.Acts.Noise.Ge = 0.002
+				ly.Acts.Noise.Gi = 0.002
+			}},
+	},
 }
 
 // PathParams sets the minimal non-default params.
@@ -105,6 +113,24 @@ var PathParams = axon.PathSheets{
 				pt.PathScale.Rel = 0.5
 			}},
 	},
+	"ThreeFactor": {
+		{Sel: ".PTSelfMaint", Doc: "learn maintenance purely from the sequence reward, instead of error-driven (only with MaintCons)",
+			Set: func(pt *axon.PathParams) {
+				pt.Learn.ThreeFactor.Rule = axon.PerturbThreeFactor
+				pt.Learn.ThreeFactor.Mod = axon.ThreeFactorGlobal
+				pt.Learn.ThreeFactor.Global = axon.GvRew
+				pt.Learn.ThreeFactor.TraceDecay = 0.9      // maintain over the sequence
+				pt.Learn.ThreeFactor.Delayed.SetBool(true) // reward only at end of sequence
+			}},
+		{Sel: ".InputToPFC", Doc: "learn encoding purely from the sequence reward, instead of error-driven",
+			Set: func(pt *axon.PathParams) {
+				pt.Learn.ThreeFactor.Rule = axon.PerturbThreeFactor
+				pt.Learn.ThreeFactor.Mod = axon.ThreeFactorGlobal
+				pt.Learn.ThreeFactor.Global = axon.GvRew
+				pt.Learn.ThreeFactor.TraceDecay = 0.9
+				pt.Learn.ThreeFactor.Delayed.SetBool(true)
+			}},
+	},
 }
 
 // LayerParamsCons are params for MaintCons case
@@ -65,6 +65,17 @@ type PFCMaintEnv struct {
 	// Created in Init if not already there.
 	Rand randx.Rand `display:"-"`
 
+	// PerfSum is the sum of the ItemP prediction performance over the
+	// predictable trials of the current sequence, for the reward.
+	PerfSum float32 `edit:"-"`
+
+	// PerfN is the number of trials in PerfSum.
+	PerfN int `edit:"-"`
+
+	// PerfAvg is the running average of the sequence performance,
+	// which is subtracted from it to compute the reward.
+	PerfAvg float32 `edit:"-"`
+
 	// RunRandSeed is the random seed multiplier for run counter.
 	// It is set to 173 if 0 at start for consistent results by default.
 	RunRandSeed int64 `edit:"-"`
@@ -129,6 +140,38 @@ func (ev *PFCMaintEnv) Init(run int) {
 	randx.InitSysRand(&ev.Rand, ev.RunRandSeed*(int64(run)+1))
 	ev.Sequence.Init()
 	ev.Trial.Init()
+	ev.PerfSum = 0
+	ev.PerfN = 0
+	ev.PerfAvg = -1
+}
+
+// AddPerf adds the ItemP prediction performance for the trial
+// that just finished, prior to Step, if it was predictable
+// (not the first in its sequence).
+func (ev *PFCMaintEnv) AddPerf(perf float32) {
+	if ev.Trial.Prev <= 0 {
+		return
+	}
+	ev.PerfSum += perf
+	ev.PerfN++
+}
+
+// SeqReward returns the reward for the sequence that just finished,
+// as its mean performance relative to the running average PerfAvg,
+// which is then updated, and resets the accumulated performance.
+func (ev *PFCMaintEnv) SeqReward() float32 {
+	if ev.PerfN == 0 {
+		return 0
+	}
+	perf := ev.PerfSum / float32(ev.PerfN)
+	ev.PerfSum = 0
+	ev.PerfN = 0
+	if ev.PerfAvg < 0 {
+		ev.PerfAvg = perf
+	}
+	rew := perf - ev.PerfAvg
+	ev.PerfAvg += 0.1 * rew
+	return rew
 }
 
 func (ev *PFCMaintEnv) State(el string) tensor.Values {
@@ -308,9 +308,11 @@ func (ss *Sim) ApplyInputs(mode Modes, trial, theta int) {
 	ndata := int(net.Context().NData)
 	curModeDir := ss.Current.Dir(mode.String())
 	lays := []string{"Item", "Time", "GPi"}
+	itemly := net.LayerByName("ItemP")
 	net.InitExt()
 	for di := range ndata {
 		ev := ss.Envs.ByModeDi(mode, di).(*PFCMaintEnv)
+		ev.AddPerf(1 - axon.LayerStates.Value(int(itemly.Index), di, int(axon.LayerPhaseDiff)))
 		ev.Step()
 		for _, lnm := range lays {
 			ly := ss.Net.LayerByName(lnm)
@@ -325,12 +327,15 @@ func (ss *Sim) ApplyInputs(mode Modes, trial, theta int) {
 	net.ApplyExts()
 }
 
-// ApplyRubicon applies Rubicon reward inputs
+// ApplyRubicon applies Rubicon reward inputs. The reward on the last
+// trial of each sequence is the ItemP prediction performance over the
+// prior trials of the sequence relative to its running average,
+// which drives the three-factor learning in the ThreeFactor params sheet.
 func (ss *Sim) ApplyRubicon(ev *PFCMaintEnv, mode Modes, trial int, di uint32) {
 	rp := &ss.Net.Rubicon
 	rp.NewState(di, ss.Net.Rand) // first before anything else is updated
 	if ev.Trial.Cur == 0 {       // reset maint on rew -- trial counter wraps around to 0
-		axon.GlobalSetRew(di, 1, true)
+		axon.GlobalSetRew(di, ev.SeqReward(), true)
 	}
 }
 
@@ -16,7 +16,7 @@ var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/sims/pfcmaint.L
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/sims/pfcmaint.Config", IDName: "config", Doc: "Config has the overall Sim configuration options.", Embeds: []types.Field{{Name: "BaseConfig"}}, Fields: []types.Field{{Name: "Env", Doc: "Env has environment configuration options."}, {Name: "Params", Doc: "Params has parameter related configuration options."}, {Name: "Run", Doc: "Run has sim running related configuration options."}, {Name: "Log", Doc: "Log has data logging related configuration options."}}})
 
-var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/sims/pfcmaint.PFCMaintEnv", IDName: "pfc-maint-env", Doc: "PFCMaintEnv implements a simple store-maintain-recall active maintenance task", Fields: []types.Field{{Name: "Name", Doc: "name of environment -- Train or Test"}, {Name: "Mode", Doc: "training or testing env?"}, {Name: "Sequence", Doc: "sequence counter is for the outer loop of maint per item"}, {Name: "Trial", Doc: "trial counter is for the maint step within item"}, {Name: "Di", Doc: "Di is the data parallel index."}, {Name: "NData", Doc: "ndata is number of data parallel total."}, {Name: "NItems", Doc: "number of different items to maintain."}, {Name: "StartItem", Doc: "StartItem is item we start on, based on Di, NData."}, {Name: "NTrials", Doc: "number of trials to maintain"}, {Name: "NUnitsY", Doc: "state rep, number of units, Y"}, {Name: "NUnitsX", Doc: "state rep, number of units, X"}, {Name: "NUnits", Doc: "total number of units"}, {Name: "Pats", Doc: "item patterns"}, {Name: "States", Doc: "named states: ACCPos, ACCNeg"}, {Name: "Rand", Doc: "Rand is the random number generator for the env.\nCreated in Init if not already there."}, {Name: "RunRandSeed", Doc: "RunRandSeed is the random seed multiplier for run counter.\nIt is set to 173 if 0 at start for consistent results by default."}}})
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/sims/pfcmaint.PFCMaintEnv", IDName: "pfc-maint-env", Doc: "PFCMaintEnv implements a simple store-maintain-recall active maintenance task", Fields: []types.Field{{Name: "Name", Doc: "name of environment -- Train or Test"}, {Name: "Mode", Doc: "training or testing env?"}, {Name: "Sequence", Doc: "sequence counter is for the outer loop of maint per item"}, {Name: "Trial", Doc: "trial counter is for the maint step within item"}, {Name: "Di", Doc: "Di is the data parallel index."}, {Name: "NData", Doc: "ndata is number of data parallel total."}, {Name: "NItems", Doc: "number of different items to maintain."}, {Name: "StartItem", Doc: "StartItem is item we start on, based on Di, NData."}, {Name: "NTrials", Doc: "number of trials to maintain"}, {Name: "NUnitsY", Doc: "state rep, number of units, Y"}, {Name: "NUnitsX", Doc: "state rep, number of units, X"}, {Name: "NUnits", Doc: "total number of units"}, {Name: "Pats", Doc: "item patterns"}, {Name: "States", Doc: "named states: ACCPos, ACCNeg"}, {Name: "Rand", Doc: "Rand is the random number generator for the env.\nCreated in Init if not already there."}, {Name: "PerfSum", Doc: "PerfSum is the sum of the ItemP prediction performance over the\npredictable trials of the current sequence, for the reward."}, {Name: "PerfN", Doc: "PerfN is the number of trials in PerfSum."}, {Name: "PerfAvg", Doc: "PerfAvg is the running average of the sequence performance,\nwhich is subtracted from it to compute the reward."}, {Name: "RunRandSeed", Doc: "RunRandSeed is the random seed multiplier for run counter.\nIt is set to 173 if 0 at start for consistent results by default."}}})
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/sims/pfcmaint.Modes", IDName: "modes", Doc: "Modes are the looping modes (Stacks) for running and statistics."})
 
@@ -7,6 +7,7 @@ package ra25
 import (
 	"cogentcore.org/core/core"
 	"cogentcore.org/core/math32/vecint"
+	"github.com/emer/axon/v2/axon"
 	"github.com/emer/emergent/v2/egui"
 )
 
@@ -19,6 +20,11 @@ type ParamConfig struct {
 	// Hidden2Size is the size of hidden 2 layer.
 	Hidden2Size vecint.Vector2i `default:"{'X':10,'Y':10}" nest:"+"`
 
+	// LRateSched is an optional learning rate schedule, which is applied
+	// automatically over training epochs to the pathways selected by
+	// its Sel and LayerSel params selectors, if On.
+	LRateSched axon.LRateSchedule `display:"add-fields"`
+
 	// Script is an interpreted script that is run to set parameters in Layer and Path
 	// sheets, by default using the "Script" set name.
 	Script string `new-window:"+" width:"100"`
@@ -111,17 +117,47 @@ func (rc *RunConfig) Cycles() int {
 	return rc.ISICycles + rc.MinusCycles + rc.PlusCycles
 }
 
+// SleepConfig has config parameters related to the offline sleep phase,
+// where the network runs without external inputs to consolidate learning.
+type SleepConfig struct {
+
+	// Interval is how often (in epochs) to run an offline sleep phase
+	// at the end of a training epoch, with testing just before and after
+	// to measure the effects of sleep. Can use 0 or -1 for no sleep.
+	Interval int `default:"0"`
+
+	// Trials is the number of trials in each sleep phase.
+	// Should be an even multiple of NData.
+	Trials int `default:"50"`
+
+	// Replay replays recorded training patterns as partial cues during
+	// sleep, instead of running spontaneous activity driven only by noise.
+	Replay bool
+
+	// ReplayN is the maximum number of training trial patterns to record
+	// for replay, with the most recent ones replacing older ones.
+	ReplayN int `default:"100"`
+}
+
 // LogConfig has config parameters related to logging data.
 type LogConfig struct {
 
 	// SaveWeights will save final weights after each run.
 	SaveWeights bool
 
+	// Trace records a timeline of the Epoch and Trial levels, network
+	// functions and CPU kernels, which is saved at the end of a nogui run
+	// as a Chrome trace (.trace.json) and a pprof profile (.pprof.gz).
+	Trace bool
+
 	// Train has the list of Train mode levels to save log files for.
 	Train []string `default:"['Expt', 'Run', 'Epoch']" nest:"+"`
 
 	// Test has the list of Test mode levels to save log files for.
 	Test []string `nest:"+"`
+
+	// Sleep has the list of Sleep mode levels to save log files for.
+	Sleep []string `default:"['Epoch']" nest:"+"`
 }
 
 // Config has the overall Sim configuration options.
@@ -134,6 +170,9 @@ type Config struct {
 	// Run has sim running related configuration options.
 	Run RunConfig `display:"add-fields"`
 
+	// Sleep has offline sleep phase configuration options.
+	Sleep SleepConfig `display:"add-fields"`
+
 	// Log has data logging related configuration options.
 	Log LogConfig `display:"add-fields"`
 }
@@ -48,6 +48,7 @@ type Modes int32 //enums:enum
 const (
 	Train Modes = iota
 	Test
+	Sleep
 )
 
 // Levels are the looping levels for running and statistics.
@@ -82,6 +83,9 @@ type Sim struct {
 	// across stacks of Levels.
 	Loops *looper.Stacks `new-window:"+" display:"no-inline"`
 
+	// Sleep has the parameters and state for the offline sleep phase.
+	Sleep axon.Sleep `display:"inline"`
+
 	// Envs provides mode-string based storage of environments.
 	Envs env.Envs `new-window:"+" display:"no-inline"`
 
@@ -122,6 +126,7 @@ func (ss *Sim) ConfigSim() {
 	ss.Params.Config(LayerParams, PathParams, ss.Config.Params.Sheet, ss.Config.Params.Tag, reflect.ValueOf(ss))
 	ss.RandSeeds.Init(100) // max 100 runs
 	ss.InitRandSeed(0)
+	ss.Sleep.Defaults()
 	if ss.Config.GPU {
 		// gpu.DebugAdapter = true
 		gpu.SelectAdapter = ss.Config.Run.GPUDevice
@@ -218,6 +223,16 @@ func (ss *Sim) ConfigNet(net *axon.Network) {
 	net.SetNThreads(ss.Config.Run.NThreads)
 	ss.ApplyParams()
 	net.InitWeights()
+
+	if ss.Config.Params.LRateSched.On {
+		sc := ss.Config.Params.LRateSched
+		net.LRateScheds = append(net.LRateScheds, &sc)
+	}
+
+	if ss.Config.Sleep.Replay {
+		ss.Sleep.Mode = axon.SleepReplay
+		ss.Sleep.Replay.Config(ss.Config.Sleep.ReplayN, "Input")
+	}
 }
 
 func (ss *Sim) ApplyParams() {
@@ -255,11 +270,12 @@ func (ss *Sim) NetViewUpdater(mode enums.Enum) *axon.NetViewUpdate {
 	return &ss.TestUpdate
 }
 
-// ConfigLoops configures the control loops: Training, Testing
+// ConfigLoops configures the control loops: Training, Testing, Sleep
 func (ss *Sim) ConfigLoops() {
 	ls := looper.NewStacks()
 
 	trials := int(math32.IntMultipleGE(float32(ss.Config.Run.Trials), float32(ss.Config.Run.NData)))
+	sleepTrials := int(math32.IntMultipleGE(float32(ss.Config.Sleep.Trials), float32(ss.Config.Run.NData)))
 	cycles := ss.Config.Run.Cycles()
 
 	ls.AddStack(Train, Trial).
@@ -274,10 +290,16 @@ func (ss *Sim) ConfigLoops() {
 		AddLevelIncr(Trial, trials, ss.Config.Run.NData).
 		AddLevel(Cycle, cycles)
 
+	ls.AddStack(Sleep, Trial).
+		AddLevel(Epoch, 1).
+		AddLevelIncr(Trial, sleepTrials, ss.Config.Run.NData).
+		AddLevel(Cycle, cycles)
+
 	axon.LooperStandard(ls, ss.Net, ss.NetViewUpdater, Cycle, Trial, Train,
 		func(mode enums.Enum) { ss.Net.ClearInputs() },
 		func(mode enums.Enum) { ss.ApplyInputs(mode.(Modes)) },
 	)
+	axon.LooperSleep(ls, ss.Net, &ss.Sleep, ss.NetViewUpdater, Sleep, Epoch, Trial, Cycle, ss.TestAll)
 	ls.Stacks[Train].OnInit.Add("Init", ss.Init)
 	ls.Loop(Train, Run).OnStart.Add("NewRun", ss.NewRun)
 
@@ -302,17 +324,35 @@ func (ss *Sim) ConfigLoops() {
 
 	ls.AddOnStartToAll("StatsStart", ss.StatsStart)
 	ls.AddOnEndToAll("StatsStep", ss.StatsStep)
+	axon.LooperLRateSched(ls, ss.Net, ss.Root.Dir("Stats"), Train, Epoch, Trial)
+
+	ls.Loop(Train, Trial).OnEnd.Add("Sleep:Record", func() {
+		if ss.Sleep.Mode != axon.SleepReplay {
+			return
+		}
+		for di := range ss.Net.Context().NData {
+			ss.Sleep.Replay.Record(ss.Net, di)
+		}
+	})
+	trainEpoch.OnEnd.Add("SleepAtInterval", func() {
+		if (ss.Config.Sleep.Interval > 0) && ((trainEpoch.Counter.Cur+1)%ss.Config.Sleep.Interval == 0) {
+			ss.SleepAll()
+		}
+	})
 
 	ls.Loop(Train, Run).OnEnd.Add("SaveWeights", func() {
 		ctrString := fmt.Sprintf("%03d_%05d", ls.Loop(Train, Run).Counter.Cur, ls.Loop(Train, Epoch).Counter.Cur)
 		axon.SaveWeightsIfConfigSet(ss.Net, ss.Config.Log.SaveWeights, ctrString, ss.RunName())
 	})
 
+	axon.LooperTrace(ls, ss.Net, Epoch, Trial)
+
 	if ss.Config.GUI {
 		axon.LooperUpdateNetView(ls, Cycle, Trial, ss.NetViewUpdater)
 
 		ls.Stacks[Train].OnInit.Add("GUI-Init", ss.GUI.UpdateWindow)
 		ls.Stacks[Test].OnInit.Add("GUI-Init", ss.GUI.UpdateWindow)
+		ls.Stacks[Sleep].OnInit.Add("GUI-Init", ss.GUI.UpdateWindow)
 	}
 
 	if ss.Config.Debug {
@@ -327,9 +367,16 @@ func (ss *Sim) ApplyInputs(mode Modes) {
 	net := ss.Net
 	ndata := int(net.Context().NData)
 	curModeDir := ss.Current.Dir(mode.String())
+	net.InitExt()
+	if mode == Sleep {
+		for di := range ndata {
+			curModeDir.StringValue("TrialName", ndata).SetString1D(ss.Sleep.ApplyInputs(net, uint32(di)), di)
+		}
+		net.ApplyExts()
+		return
+	}
 	ev := ss.Envs.ByMode(mode)
 	lays := net.LayersByType(axon.InputLayer, axon.TargetLayer)
-	net.InitExt()
 	for di := range ndata {
 		ev.Step()
 		curModeDir.StringValue("TrialName", ndata).SetString1D(ev.String(), di)
@@ -353,16 +400,24 @@ func (ss *Sim) NewRun() {
 	ss.Envs.ByMode(Test).Init(run)
 	ctx.Reset()
 	ss.Net.InitWeights()
+	ss.Sleep.Replay.Reset()
 	if ss.Config.Run.StartWeights != "" {
-		ss.Net.OpenWeightsJSON(core.Filename(ss.Config.Run.StartWeights))
+		ss.Net.OpenWeights(core.Filename(ss.Config.Run.StartWeights))
 		mpi.Printf("Starting with initial weights from: %s\n", ss.Config.Run.StartWeights)
 	}
 }
 
 // TestAll runs through the full set of testing items
 func (ss *Sim) TestAll() {
+	prev := ss.Loops.Mode
 	ss.Envs.ByMode(Test).Init(0)
 	ss.Loops.ResetAndRun(Test)
+	ss.Loops.Mode = prev // important because this is called from Train or Sleep Run: go back.
+}
+
+// SleepAll runs an offline sleep phase, with testing before and after.
+func (ss *Sim) SleepAll() {
+	ss.Loops.ResetAndRun(Sleep)
 	ss.Loops.Mode = Train // important because this is called from Train Run: go back.
 }
 
@@ -487,6 +542,7 @@ func (ss *Sim) StatsInit() {
 		tbs.PlotTensorFS(axon.StatsNode(ss.Stats, Train, Epoch))
 		tbs.PlotTensorFS(axon.StatsNode(ss.Stats, Train, Run))
 		tbs.PlotTensorFS(axon.StatsNode(ss.Stats, Test, Trial))
+		tbs.PlotTensorFS(axon.StatsNode(ss.Stats, Sleep, Epoch))
 		tbs.PlotTensorFS(ss.Stats.Dir("Train/RunAll"))
 		tbs.SelectTabIndex(idx)
 	}
@@ -519,6 +575,9 @@ func (ss *Sim) ConfigStats() {
 		"LastZero":  "The epoch when training was stopped because NZero got above the threshold for number of perfect epochs in a row",
 	}
 	ss.AddStat(func(mode Modes, level Levels, start bool) {
+		if mode == Sleep {
+			return
+		}
 		for _, name := range statNames {
 			if name == "NZero" && (mode != Train || level == Trial) {
 				return
@@ -629,6 +688,9 @@ func (ss *Sim) ConfigStats() {
 
 	ss.AddStatStd(axon.StatLayerState(ss.Stats, net, Test, Trial, true, "ActM", "Input", "Output"))
 
+	ss.AddStatStd(axon.StatSleep(ss.Stats, Test, Epoch, Sleep, Epoch, "CorSim", "UnitErr", "Err"))
+	ss.AddStatStd(axon.StatLRateSched(ss.Stats, net, Train, Epoch))
+
 	ss.AddStatStd(axon.StatLevelAll(ss.Stats, Train, Run, func(s *plot.Style, cl tensor.Values) {
 		name := metadata.Name(cl)
 		switch name {
@@ -724,12 +786,19 @@ func (ss *Sim) RunNoGUI() {
 	runName := ss.SetRunName()
 	netName := ss.Net.Name
 	cfg := &ss.Config.Log
-	axon.OpenLogFiles(ss.Loops, ss.Stats, netName, runName, [][]string{cfg.Train, cfg.Test})
+	axon.OpenLogFiles(ss.Loops, ss.Stats, netName, runName, [][]string{cfg.Train, cfg.Test, cfg.Sleep})
 
 	mpi.Printf("Running %d Runs starting at %d\n", ss.Config.Run.Runs, ss.Config.Run.Run)
 	ss.Loops.Loop(Train, Run).Counter.SetCurMaxPlusN(ss.Config.Run.Run, ss.Config.Run.Runs)
 
+	if cfg.Trace {
+		ss.Net.StartTrace()
+	}
 	ss.Loops.Run(Train)
+	if cfg.Trace {
+		ss.Net.StopTrace()
+		axon.SaveTrace(ss.Net, runName)
+	}
 
 	axon.CloseLogFiles(ss.Loops, ss.Stats, Cycle)
 	axon.GPURelease()
@@ -6,6 +6,7 @@ package rl
 
 import (
 	"fmt"
+	"strconv"
 
 	"cogentcore.org/lab/base/randx"
 	"cogentcore.org/lab/tensor"
@@ -84,6 +85,29 @@ type CondEnv struct {
 	// Unconditioned stimulus -- reward
 	US OnOff `display:"inline"`
 
+	// Delay, if > 0, sets the interval in time steps from CSA onset
+	// to US onset, for delay conditioning experiments, by setting the
+	// CSA On time relative to the US On time. Longer delays require
+	// the TD error to propagate back over more time steps, which is
+	// much faster with TD(lambda) eligibility traces.
+	Delay int
+
+	// Trace, if > 0 (along with Delay), is the number of time steps
+	// between CSA offset and US onset, for trace conditioning, where
+	// nothing is present in the input during the trace interval.
+	// TD(0) learning cannot bridge this gap, while TD(lambda) can.
+	// Otherwise CSA stays on through the US, for standard delay conditioning.
+	Trace int
+
+	// Instrumental makes the US contingent on the actor's action:
+	// reward is only delivered if the Go action (0) was selected
+	// on the time step just before the US. See Action.
+	Instrumental bool
+
+	// Act is the last action received from the actor via Action,
+	// which was selected on the prior time step (-1 = none).
+	Act int `edit:"-"`
+
 	// value for reward
 	RewVal float32
 
@@ -128,9 +152,29 @@ func (ev *CondEnv) Validate() error {
 	if ev.TotTime == 0 {
 		ev.Defaults()
 	}
+	if ev.Delay > 0 && ev.US.On-ev.Delay < 0 {
+		return fmt.Errorf("CondEnv: Delay %d is longer than US On time %d", ev.Delay, ev.US.On)
+	}
+	if ev.Trace >= ev.Delay && ev.Delay > 0 {
+		return fmt.Errorf("CondEnv: Trace %d must be less than Delay %d", ev.Trace, ev.Delay)
+	}
 	return nil
 }
 
+// UpdateDelay sets the CSA timing according to the Delay and Trace
+// settings, if Delay > 0.
+func (ev *CondEnv) UpdateDelay() {
+	if ev.Delay <= 0 {
+		return
+	}
+	ev.CSA.On = max(ev.US.On-ev.Delay, 0)
+	if ev.Trace > 0 {
+		ev.CSA.Off = max(ev.US.On-ev.Trace, ev.CSA.On+1)
+	} else {
+		ev.CSA.Off = ev.US.Off
+	}
+}
+
 func (ev *CondEnv) State(element string) tensor.Values {
 	switch element {
 	case "Input":
@@ -158,6 +202,8 @@ func (ev *CondEnv) Init(run int) {
 	ev.Event.Init()
 	ev.Event.Max = ev.TotTime
 	ev.Event.Cur = -1 // init state -- key so that first Step() = 0
+	ev.Act = -1
+	ev.UpdateDelay()
 	ev.TrialUpdate()
 }
 
@@ -188,6 +234,9 @@ func (ev *CondEnv) SetInput() {
 func (ev *CondEnv) SetReward() bool {
 	tm := ev.Event.Cur
 	rw := ev.US.IsOn(tm)
+	if ev.Instrumental && ev.Act != 0 {
+		rw = false
+	}
 	if rw {
 		ev.HasRew = true
 		ev.Reward.Values[0] = float64(ev.RewVal)
@@ -212,8 +261,11 @@ func (ev *CondEnv) Step() bool {
 	return true
 }
 
-func (ev *CondEnv) Action(element string, input tensor.Values) {
-	// nop
+// Action records the action selected by the actor on the prior
+// time step, as an integer string, which determines the reward
+// for Instrumental conditioning.
+func (ev *CondEnv) Action(action string, nop tensor.Values) {
+	ev.Act, _ = strconv.Atoi(action)
 }
 
 // Compile-time check that implements Env interface
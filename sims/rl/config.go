@@ -118,6 +118,12 @@ type Config struct {
 	// if true, use Rescorla-Wagner -- set in code or rebuild network
 	RW bool
 
+	// AC adds an actor-critic pairing to the TD critic, with an Actor layer
+	// that learns to select between Go (0) and NoGo (1) actions from the TD
+	// dopamine signal. Use with Env Instrumental on, so that the reward
+	// depends on the Go action. Set in code or rebuild network.
+	AC bool
+
 	// Env has environment configuration options.
 	Env EnvConfig `display:"add-fields"`
 
@@ -45,6 +45,7 @@ var LayerParams = axon.LayerSheets{
 				ly.TDInteg.PredGain = 1.0
 			}},
 	},
+	"Lambda": {},
 }
 
 // PathParams sets the minimal non-default params.
@@ -72,4 +73,10 @@ var PathParams = axon.PathSheets{
 				pt.RLPred.OppSignLRate = 1.0
 			}},
 	},
+	"Lambda": {
+		{Sel: ".TDPredPath", Doc: "TD(lambda) eligibility traces",
+			Set: func(pt *axon.PathParams) {
+				pt.RLPred.Lambda = 0.9
+			}},
+	},
 }
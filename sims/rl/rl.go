@@ -15,6 +15,7 @@ import (
 	"reflect"
 
 	"cogentcore.org/core/base/metadata"
+	"cogentcore.org/core/base/num"
 	"cogentcore.org/core/base/reflectx"
 	"cogentcore.org/core/core"
 	"cogentcore.org/core/enums"
@@ -160,14 +161,19 @@ func (ss *Sim) ConfigNet(net *axon.Network) {
 	space := float32(4)
 	full := paths.NewFull()
 
-	var rp, rplay, rew *axon.Layer
+	var rp, rplay, rew, actor *axon.Layer
 	var ptype axon.PathTypes
 
-	if ss.Config.RW {
+	switch {
+	case ss.Config.RW:
 		rew, rp, _ = net.AddRWLayers("", relpos.RightOf, space)
 		rplay = rp
 		ptype = axon.RWPath
-	} else {
+	case ss.Config.AC:
+		rew, rp, _, _, actor = net.AddActorCritic("", 2, relpos.RightOf, space)
+		rplay = rp
+		ptype = axon.TDPredPath
+	default:
 		rew, rp, _, _ = net.AddTDLayers("", relpos.RightOf, space)
 		rplay = rp
 		ptype = axon.TDPredPath
@@ -178,6 +184,9 @@ func (ss *Sim) ConfigNet(net *axon.Network) {
 	inp := net.AddLayer2D("Input", axon.InputLayer, 3, 20)
 	inp.PlaceAbove(rew)
 	net.ConnectLayers(inp, rplay, full, ptype)
+	if actor != nil {
+		net.ConnectToActor(inp, actor, full)
+	}
 
 	net.Build()
 	net.Defaults()
@@ -270,7 +279,15 @@ func (ss *Sim) ApplyInputs(mode Modes) {
 	lays := net.LayersByType(axon.InputLayer, axon.TargetLayer)
 	net.InitExt()
 	for di := range ndata {
+		if ss.Config.AC {
+			act := net.LayerByName("Actor").ActorAction(uint32(di))
+			ev.Action(fmt.Sprintf("%d", act), nil)
+			curModeDir.Float64("Go", ndata).SetFloat1D(num.FromBool[float64](act == 0), di)
+		}
 		ev.Step()
+		if ev.Event.Cur == 0 { // new episode
+			net.ResetTDTraces(uint32(di))
+		}
 		curModeDir.StringValue("TrialName", ndata).SetString1D(ev.String(), di)
 		for _, lnm := range lays {
 			ly := ss.Net.LayerByName(lnm)
@@ -466,6 +483,35 @@ func (ss *Sim) ConfigStats() {
 		}
 	})
 
+	if ss.Config.AC {
+		ss.AddStat(func(mode Modes, level Levels, start bool) {
+			name := "Go"
+			modeDir := ss.Stats.Dir(mode.String())
+			curModeDir := ss.Current.Dir(mode.String())
+			levelDir := modeDir.Dir(level.String())
+			subDir := modeDir.Dir((level - 1).String())
+			tsr := levelDir.Float64(name)
+			ndata := int(ss.Net.Context().NData)
+			if start {
+				tsr.SetNumRows(0)
+				plot.SetFirstStyler(tsr, func(s *plot.Style) {
+					s.Range.SetMin(0).SetMax(1)
+					s.On = true
+				})
+				return
+			}
+			switch level {
+			case Trial:
+				for di := range ndata {
+					// saved in apply inputs
+					tsr.AppendRowFloat(curModeDir.Float64(name, ndata).Float1D(di))
+				}
+			default:
+				tsr.AppendRowFloat(stats.StatMean.Call(subDir.Value(name)).Float1D(0))
+			}
+		})
+	}
+
 	ss.AddStatStd(axon.StatLevelAll(ss.Stats, Train, Trial, func(s *plot.Style, cl tensor.Values) {
 		s.Range.SetMin(0).SetMax(1)
 		name := metadata.Name(cl)
@@ -8,7 +8,7 @@ import (
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/sims/rl.OnOff", IDName: "on-off", Doc: "OnOff represents stimulus On / Off timing", Fields: []types.Field{{Name: "Act", Doc: "is this stimulus active -- use it?"}, {Name: "On", Doc: "when stimulus turns on"}, {Name: "Off", Doc: "when stimulu turns off"}, {Name: "P", Doc: "probability of being active on any given trial"}, {Name: "OnVar", Doc: "variability in onset timing (max number of trials before/after On that it could start)"}, {Name: "OffVar", Doc: "variability in offset timing (max number of trials before/after Off that it could end)"}, {Name: "CurAct", Doc: "current active status based on P probability"}, {Name: "CurOn", Doc: "current on / off values using Var variability"}, {Name: "CurOff", Doc: "current on / off values using Var variability"}}})
 
-var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/sims/rl.CondEnv", IDName: "cond-env", Doc: "CondEnv simulates an n-armed bandit, where each of n inputs is associated with\na specific probability of reward.", Fields: []types.Field{{Name: "Name", Doc: "name of this environment"}, {Name: "TotTime", Doc: "total time for trial"}, {Name: "CSA", Doc: "Conditioned stimulus A (e.g., Tone)"}, {Name: "CSB", Doc: "Conditioned stimulus B (e.g., Light)"}, {Name: "CSC", Doc: "Conditioned stimulus C"}, {Name: "US", Doc: "Unconditioned stimulus -- reward"}, {Name: "RewVal", Doc: "value for reward"}, {Name: "NoRewVal", Doc: "value for non-reward"}, {Name: "Input", Doc: "one-hot input representation of current option"}, {Name: "Reward", Doc: "single reward value"}, {Name: "HasRew", Doc: "true if a US reward value was set"}, {Name: "Trial", Doc: "one trial is a pass through all TotTime Events"}, {Name: "Event", Doc: "event is one time step within Trial -- e.g., CS turning on, etc"}, {Name: "Rand", Doc: "Rand is the random number generator for the env.\nCreated in Init if not already there."}, {Name: "RunRandSeed", Doc: "RunRandSeed is the random seed multiplier for run counter.\nIt is set to 173 if 0 at start for consistent results by default."}}})
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/sims/rl.CondEnv", IDName: "cond-env", Doc: "CondEnv simulates an n-armed bandit, where each of n inputs is associated with\na specific probability of reward.", Fields: []types.Field{{Name: "Name", Doc: "name of this environment"}, {Name: "TotTime", Doc: "total time for trial"}, {Name: "CSA", Doc: "Conditioned stimulus A (e.g., Tone)"}, {Name: "CSB", Doc: "Conditioned stimulus B (e.g., Light)"}, {Name: "CSC", Doc: "Conditioned stimulus C"}, {Name: "US", Doc: "Unconditioned stimulus -- reward"}, {Name: "Delay", Doc: "Delay, if > 0, sets the interval in time steps from CSA onset\nto US onset, for delay conditioning experiments, by setting the\nCSA On time relative to the US On time. Longer delays require\nthe TD error to propagate back over more time steps, which is\nmuch faster with TD(lambda) eligibility traces."}, {Name: "Trace", Doc: "Trace, if > 0 (along with Delay), is the number of time steps\nbetween CSA offset and US onset, for trace conditioning, where\nnothing is present in the input during the trace interval.\nTD(0) learning cannot bridge this gap, while TD(lambda) can.\nOtherwise CSA stays on through the US, for standard delay conditioning."}, {Name: "Instrumental", Doc: "Instrumental makes the US contingent on the actor's action:\nreward is only delivered if the Go action (0) was selected\non the time step just before the US. See Action."}, {Name: "Act", Doc: "Act is the last action received from the actor via Action,\nwhich was selected on the prior time step (-1 = none)."}, {Name: "RewVal", Doc: "value for reward"}, {Name: "NoRewVal", Doc: "value for non-reward"}, {Name: "Input", Doc: "one-hot input representation of current option"}, {Name: "Reward", Doc: "single reward value"}, {Name: "HasRew", Doc: "true if a US reward value was set"}, {Name: "Trial", Doc: "one trial is a pass through all TotTime Events"}, {Name: "Event", Doc: "event is one time step within Trial -- e.g., CS turning on, etc"}, {Name: "Rand", Doc: "Rand is the random number generator for the env.\nCreated in Init if not already there."}, {Name: "RunRandSeed", Doc: "RunRandSeed is the random seed multiplier for run counter.\nIt is set to 173 if 0 at start for consistent results by default."}}})
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/sims/rl.EnvConfig", IDName: "env-config", Doc: "EnvConfig has config params for environment.", Fields: []types.Field{{Name: "Env", Doc: "Env parameters: can set any field/subfield on Env struct,\nusing standard TOML formatting."}}})
 
@@ -18,7 +18,7 @@ var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/sims/rl.RunConf
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/sims/rl.LogConfig", IDName: "log-config", Doc: "LogConfig has config parameters related to logging data.", Fields: []types.Field{{Name: "SaveWeights", Doc: "SaveWeights will save final weights after each run."}, {Name: "Train", Doc: "Train has the list of Train mode levels to save log files for."}, {Name: "Test", Doc: "Test has the list of Test mode levels to save log files for."}}})
 
-var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/sims/rl.Config", IDName: "config", Doc: "Config has the overall Sim configuration options.", Embeds: []types.Field{{Name: "BaseConfig"}}, Fields: []types.Field{{Name: "RW", Doc: "if true, use Rescorla-Wagner -- set in code or rebuild network"}, {Name: "Env", Doc: "Env has environment configuration options."}, {Name: "Params", Doc: "Params has parameter related configuration options."}, {Name: "Run", Doc: "Run has sim running related configuration options."}, {Name: "Log", Doc: "Log has data logging related configuration options."}}})
+var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/sims/rl.Config", IDName: "config", Doc: "Config has the overall Sim configuration options.", Embeds: []types.Field{{Name: "BaseConfig"}}, Fields: []types.Field{{Name: "RW", Doc: "if true, use Rescorla-Wagner -- set in code or rebuild network"}, {Name: "AC", Doc: "AC adds an actor-critic pairing to the TD critic, with an Actor layer\nthat learns to select between Go (0) and NoGo (1) actions from the TD\ndopamine signal. Use with Env Instrumental on, so that the reward\ndepends on the Go action. Set in code or rebuild network."}, {Name: "Env", Doc: "Env has environment configuration options."}, {Name: "Params", Doc: "Params has parameter related configuration options."}, {Name: "Run", Doc: "Run has sim running related configuration options."}, {Name: "Log", Doc: "Log has data logging related configuration options."}}})
 
 var _ = types.AddType(&types.Type{Name: "github.com/emer/axon/v2/sims/rl.Modes", IDName: "modes", Doc: "Modes are the looping modes (Stacks) for running and statistics."})
 